@@ -0,0 +1,102 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// BackupManifestObject holds the node's spec and crypto secrets in an archive, encrypted with the storage key
+	BackupManifestObject = "manifest.json.enc"
+
+	backupArchiveTimeFormat = "20060102-150405"
+)
+
+// NewObject returns an empty object of the target's kind, nil if the kind is not supported
+func (t BackupTarget) NewObject() client.Object {
+	switch t.Kind {
+	case "IBPPeer":
+		return &IBPPeer{}
+	case "IBPOrderer":
+		return &IBPOrderer{}
+	case "IBPCA":
+		return &IBPCA{}
+	}
+	return nil
+}
+
+// GetPrefix returns the object key prefix of the target's archives
+func (s BackupStorage) GetPrefix(namespace string, target BackupTarget) string {
+	if s.Prefix != "" {
+		return s.Prefix
+	}
+	return path.Join(namespace, target.Name)
+}
+
+// ArchiveObject returns the object key of name in archive
+func (s BackupStorage) ArchiveObject(namespace string, target BackupTarget, archive, name string) string {
+	return path.Join(s.GetPrefix(namespace, target), archive, name)
+}
+
+// BackupVolumeObject returns the object name of an archived volume
+func BackupVolumeObject(volume string) string {
+	return volume + ".tar.gz"
+}
+
+// NewArchiveName names the archive of target started at t
+func NewArchiveName(target BackupTarget, t time.Time) string {
+	return fmt.Sprintf("%s-%s", target.Name, t.UTC().Format(backupArchiveTimeFormat))
+}
+
+func (b *Backup) GetRetention() int {
+	if b.Spec.Retention <= 0 {
+		return DefaultBackupRetention
+	}
+	return b.Spec.Retention
+}
+
+func (b *Backup) HasType() bool {
+	return b.Status.CRStatus.Type != ""
+}
+
+// LatestArchive returns the newest completed archive, nil if there is none
+func (b *Backup) LatestArchive() *BackupArchive {
+	if len(b.Status.Archives) == 0 {
+		return nil
+	}
+	return &b.Status.Archives[len(b.Status.Archives)-1]
+}
+
+// ExpiredArchives returns the oldest archives beyond retention
+func (b *Backup) ExpiredArchives() []BackupArchive {
+	expired := len(b.Status.Archives) - b.GetRetention()
+	if expired <= 0 {
+		return nil
+	}
+	return b.Status.Archives[:expired]
+}
+
+func (r *Restore) HasFinished() bool {
+	return r.Status.Phase == RestoreSucceeded || r.Status.Phase == RestoreFailed
+}
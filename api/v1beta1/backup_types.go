@@ -0,0 +1,162 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	DefaultBackupRetention = 7
+)
+
+// BackupTarget is the node to back up, it must live in the same namespace as the backup
+type BackupTarget struct {
+	// Kind of the node
+	// +kubebuilder:validation:Enum=IBPPeer;IBPOrderer;IBPCA
+	Kind string `json:"kind"`
+	// Name of the node, an orderer must be a node of the cluster like `orderernode1`
+	Name string `json:"name"`
+}
+
+// BackupStorage is an S3 compatible object store where archives are kept
+type BackupStorage struct {
+	// Host of the object store like `minio.minio-system:9000`, default to the operator's minio
+	// +optional
+	Host string `json:"host,omitempty"`
+	// Secure uses https to the object store
+	// +optional
+	Secure bool `json:"secure,omitempty"`
+	// Bucket to keep archives, created when missing
+	Bucket string `json:"bucket"`
+	// Prefix of the archives' object keys, default to `<namespace>/<target name>`
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// CredentialSecret in the same namespace with keys `accessKey` and `secretKey`,
+	// default to the operator's minio credentials
+	// +optional
+	CredentialSecret string `json:"credentialSecret,omitempty"`
+	// EncryptionSecret in the same namespace with key `encryptionKey` of 32 bytes, the manifest of
+	// an archive holds the node's private keys and is encrypted with it before the upload
+	EncryptionSecret string `json:"encryptionSecret"`
+}
+
+// BackupSpec defines how and when a node is archived
+type BackupSpec struct {
+	Target  BackupTarget  `json:"target"`
+	Storage BackupStorage `json:"storage"`
+	// Schedule in cron format like `0 2 * * *`, descriptors like `@daily` or intervals like `@every 12h`.
+	// The backup runs once when empty
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+	// Retention is how many archives are kept, older ones are deleted from the object store
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Retention int `json:"retention,omitempty"`
+	// Suspend stops scheduling new runs, a running one still finishes
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+type BackupPhase string
+
+const (
+	// BackupQuiescing scales the node down to zero replicas
+	BackupQuiescing BackupPhase = "Quiescing"
+	// BackupArchiving uploads the node's volumes with a job
+	BackupArchiving BackupPhase = "Archiving"
+	// BackupResuming scales the node back
+	BackupResuming BackupPhase = "Resuming"
+)
+
+// BackupRun is the backup in progress
+type BackupRun struct {
+	// Archive being created
+	Archive string      `json:"archive"`
+	Phase   BackupPhase `json:"phase"`
+	// Replicas of the node before it was quiesced
+	Replicas int32 `json:"replicas"`
+	// Volumes of the node archived by the job
+	// +optional
+	Volumes   []string    `json:"volumes,omitempty"`
+	StartTime metav1.Time `json:"startTime"`
+	// Error which failed the run, the node is still resumed
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// BackupArchive is a completed archive in the object store
+type BackupArchive struct {
+	// Name of the archive, objects are kept under `<prefix>/<name>/`
+	Name string      `json:"name"`
+	Time metav1.Time `json:"time"`
+	// Volumes archived besides the manifest
+	// +optional
+	Volumes []string `json:"volumes,omitempty"`
+}
+
+// BackupStatus defines the observed state of Backup
+type BackupStatus struct {
+	CRStatus `json:",inline"`
+
+	// Run is the backup in progress
+	// +optional
+	Run *BackupRun `json:"run,omitempty"`
+	// LastScheduleTime is when the last run started
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// LastSuccessfulTime is when the last archive completed
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+	// Archives kept in the object store, oldest first
+	// +optional
+	Archives []BackupArchive `json:"archives,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:deepcopy-gen=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=bk
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.target.name`
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="Last Successful",type=date,JSONPath=`.status.lastSuccessfulTime`
+// +genclient
+// Backup archives the crypto, config override and ledger volumes of a node to an object store
+type Backup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	Spec BackupSpec `json:"spec"`
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	Status BackupStatus `json:"status,omitempty"`
+}
+
+// BackupList contains a list of Backup
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:deepcopy-gen=true
+type BackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Backup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Backup{}, &BackupList{})
+}
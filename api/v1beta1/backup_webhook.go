@@ -0,0 +1,134 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util/cron"
+	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// log is for logging in this package.
+var backupLogger = logf.Log.WithName("backup-resource")
+
+var (
+	errBackupMissingBucket     = errors.New("storage bucket can't be empty")
+	errBackupMissingEncryption = errors.New("storage encryption secret can't be empty")
+	errBackupUnsupportedTarget = errors.New("target must be an IBPPeer, IBPOrderer or IBPCA")
+	errBackupOrdererCluster    = errors.New("target orderer must be a node of the cluster")
+	errBackupPostgresCA        = errors.New("target ca keeps its database in postgres, which is not archived")
+	errBackupTargetImmutable   = errors.New("target and storage of a backup can not be updated")
+	errBackupRunning           = errors.New("backup is running, the node is resumed when it finishes")
+)
+
+//+kubebuilder:webhook:path=/mutate-ibp-com-v1beta1-backup,mutating=true,failurePolicy=fail,sideEffects=None,groups=ibp.com,resources=backups,verbs=create;update,versions=v1beta1,name=backup.mutate.webhook,admissionReviewVersions=v1
+
+var _ defaulter = &Backup{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *Backup) Default(ctx context.Context, client client.Client, user authenticationv1.UserInfo) {
+	backupLogger.Info("default", "name", r.Name, "user", user.String())
+	if r.Spec.Retention == 0 {
+		r.Spec.Retention = DefaultBackupRetention
+	}
+	if r.Spec.Storage.Prefix == "" {
+		r.Spec.Storage.Prefix = r.Spec.Storage.GetPrefix(r.GetNamespace(), r.Spec.Target)
+	}
+}
+
+//+kubebuilder:webhook:path=/validate-ibp-com-v1beta1-backup,mutating=false,failurePolicy=fail,sideEffects=None,groups=ibp.com,resources=backups,verbs=create;update;delete,versions=v1beta1,name=backup.validate.webhook,admissionReviewVersions=v1
+
+var _ validator = &Backup{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Backup) ValidateCreate(ctx context.Context, c client.Client, user authenticationv1.UserInfo) error {
+	backupLogger.Info("validate create", "name", r.Name, "user", user.String())
+	if err := validateBackupSchedule(r.Spec.Schedule); err != nil {
+		return err
+	}
+	if err := validateBackupStorage(r.Spec.Storage); err != nil {
+		return err
+	}
+	return validateBackupTarget(ctx, c, r.GetNamespace(), r.Spec.Target)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Backup) ValidateUpdate(ctx context.Context, c client.Client, old runtime.Object, user authenticationv1.UserInfo) error {
+	backupLogger.Info("validate update", "name", r.Name, "user", user.String())
+	oldBackup := old.(*Backup)
+	// archives already taken are pruned from the original storage
+	if !reflect.DeepEqual(r.Spec.Target, oldBackup.Spec.Target) || !reflect.DeepEqual(r.Spec.Storage, oldBackup.Spec.Storage) {
+		return errBackupTargetImmutable
+	}
+	return validateBackupSchedule(r.Spec.Schedule)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Backup) ValidateDelete(ctx context.Context, c client.Client, user authenticationv1.UserInfo) error {
+	backupLogger.Info("validate delete", "name", r.Name, "user", user.String())
+	// deleting a running backup would leave the node scaled down
+	if r.Status.Run != nil {
+		return errors.Wrapf(errBackupRunning, "archive %s phase %s", r.Status.Run.Archive, r.Status.Run.Phase)
+	}
+	return nil
+}
+
+func validateBackupSchedule(schedule string) error {
+	if schedule == "" {
+		return nil
+	}
+	if _, err := cron.Parse(schedule); err != nil {
+		return errors.Wrap(err, "invalid schedule")
+	}
+	return nil
+}
+
+func validateBackupStorage(storage BackupStorage) error {
+	if storage.Bucket == "" {
+		return errBackupMissingBucket
+	}
+	if storage.EncryptionSecret == "" {
+		return errBackupMissingEncryption
+	}
+	return nil
+}
+
+func validateBackupTarget(ctx context.Context, c client.Client, namespace string, target BackupTarget) error {
+	obj := target.NewObject()
+	if obj == nil {
+		return errors.Wrapf(errBackupUnsupportedTarget, "kind %s", target.Kind)
+	}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: target.Name}, obj); err != nil {
+		return err
+	}
+	if orderer, ok := obj.(*IBPOrderer); ok && orderer.Spec.NodeNumber == nil {
+		return errors.Wrapf(errBackupOrdererCluster, "orderer %s", target.Name)
+	}
+	if ca, ok := obj.(*IBPCA); ok && ca.UsingPostgres() {
+		return errors.Wrapf(errBackupPostgresCA, "ca %s", target.Name)
+	}
+	return nil
+}
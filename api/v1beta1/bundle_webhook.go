@@ -0,0 +1,87 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"context"
+	"net/http"
+
+	v1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var bundlelog = logf.Log.WithName("bundle-resource")
+
+const bundleValidatePath = "/validate-v1-configmap-bundle"
+
+// The objectSelector limiting this webhook to configmaps labeled with BundleImportLabel is patched in config/webhook
+//+kubebuilder:webhook:path=/validate-v1-configmap-bundle,mutating=false,failurePolicy=fail,sideEffects=None,groups="",resources=configmaps,verbs=create;update,versions=v1,name=bundle.validate.webhook,admissionReviewVersions=v1
+
+var _ webhook.AdmissionHandler = &bundleHandler{}
+
+// bundleHandler makes sure bundles to import are created by admins of the organization whose namespace holds them.
+// The operator imports them with its own client, so the webhook of ExternalOrganization never sees the importer
+type bundleHandler struct {
+	operatorUser string
+	client       client.Client
+	decoder      *admission.Decoder
+}
+
+// InjectDecoder injects the decoder into a bundleHandler.
+func (h *bundleHandler) InjectDecoder(d *admission.Decoder) error {
+	h.decoder = d
+	return nil
+}
+
+// Handle handles admission requests.
+func (h *bundleHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	ctx = newContextWithOperatorUser(ctx, h.operatorUser)
+
+	if req.Operation != v1.Create && req.Operation != v1.Update {
+		return admission.Allowed("")
+	}
+	cm := &corev1.ConfigMap{}
+	if err := h.decoder.Decode(req, cm); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if cm.GetLabels()[BundleImportLabel] != BundleImportLabelValue || isSuperUser(ctx, req.UserInfo) {
+		return admission.Allowed("")
+	}
+	bundlelog.Info("validate bundle", "namespace", req.Namespace, "name", req.Name, "user", req.UserInfo.String())
+
+	// organization's namespace has the same name as organization
+	org := &Organization{}
+	if err := h.client.Get(ctx, types.NamespacedName{Name: req.Namespace}, org); err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.Denied(errNotOrganizationAdmin.Error())
+		}
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if !org.IsAdmin(req.UserInfo.Username) {
+		return admission.Denied(errNotOrganizationAdmin.Error())
+	}
+	return admission.Allowed("")
+}
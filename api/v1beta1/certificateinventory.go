@@ -0,0 +1,64 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import "time"
+
+// GetExpiringWithin returns how long before expiry certificates are expiring
+func (i *CertificateInventory) GetExpiringWithin() time.Duration {
+	if i.Spec.ExpiringWithin != nil {
+		return i.Spec.ExpiringWithin.Duration
+	}
+	d, _ := time.ParseDuration(DefaultExpiringWithin)
+	return d
+}
+
+// GetRefreshInterval returns how often the inventory is refreshed
+func (i *CertificateInventory) GetRefreshInterval() time.Duration {
+	if i.Spec.RefreshInterval != nil && i.Spec.RefreshInterval.Duration > 0 {
+		return i.Spec.RefreshInterval.Duration
+	}
+	d, _ := time.ParseDuration(DefaultRefreshInterval)
+	return d
+}
+
+// StateAt returns the state of a certificate expiring at notAfter
+func (c *CertificateInfo) StateAt(now time.Time, expiringWithin time.Duration) CertificateState {
+	switch {
+	case !c.NotAfter.After(now):
+		return CertificateExpired
+	case c.NotAfter.Sub(now) <= expiringWithin:
+		return CertificateExpiring
+	default:
+		return CertificateValid
+	}
+}
+
+// Add counts a certificate of state
+func (s *CertificateSummary) Add(state CertificateState) {
+	s.Total++
+	switch state {
+	case CertificateExpired:
+		s.Expired++
+	case CertificateExpiring:
+		s.Expiring++
+	default:
+		s.Valid++
+	}
+}
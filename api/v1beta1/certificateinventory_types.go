@@ -0,0 +1,146 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	DefaultExpiringWithin  = "720h"
+	DefaultRefreshInterval = "10m"
+)
+
+// CertificateType is the role of a certificate
+type CertificateType string
+
+const (
+	// CertificateEcert is the enrollment certificate of a peer or orderer
+	CertificateEcert CertificateType = "ecert"
+	// CertificateTLS is the tls certificate of a peer, orderer or CA server
+	CertificateTLS CertificateType = "tls"
+	// CertificateCA is the signing certificate of a CA
+	CertificateCA CertificateType = "ca"
+	// CertificateTLSCA is the signing certificate of a TLS CA
+	CertificateTLSCA CertificateType = "tlsca"
+	// CertificateOperations is the operations endpoint certificate of a CA server
+	CertificateOperations CertificateType = "operations"
+	// CertificateAdmin is the client enrollment of an organization's admin
+	CertificateAdmin CertificateType = "admin"
+	// CertificateAdminTLS is the tls enrollment of an organization's admin
+	CertificateAdminTLS CertificateType = "admintls"
+	// CertificateClient is the enrollment of a client identity of an organization
+	CertificateClient CertificateType = "client"
+)
+
+// CertificateState is the expiry state of a certificate
+type CertificateState string
+
+const (
+	CertificateValid    CertificateState = "Valid"
+	CertificateExpiring CertificateState = "Expiring"
+	CertificateExpired  CertificateState = "Expired"
+)
+
+// CertificateInventorySpec defines which certificates are inventoried
+type CertificateInventorySpec struct {
+	// Namespaces to inventory, all namespaces when empty
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+	// ExpiringWithin marks certificates as expiring when they expire within it, default to 720h
+	// +optional
+	ExpiringWithin *metav1.Duration `json:"expiringWithin,omitempty"`
+	// RefreshInterval is how often the inventory is refreshed, default to 10m
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// CertificateInfo is a certificate of a component
+type CertificateInfo struct {
+	// Kind of the component, one of IBPPeer, IBPOrderer, IBPCA, Organization or Identity
+	Kind      string          `json:"kind"`
+	Namespace string          `json:"namespace"`
+	Name      string          `json:"name"`
+	Type      CertificateType `json:"type"`
+	// Intermediate is set for CA certificates which are not self signed
+	// +optional
+	Intermediate bool `json:"intermediate,omitempty"`
+	// Secret keeping the certificate
+	Secret   string           `json:"secret"`
+	Subject  string           `json:"subject"`
+	Issuer   string           `json:"issuer"`
+	NotAfter metav1.Time      `json:"notAfter"`
+	State    CertificateState `json:"state"`
+}
+
+// CertificateSummary counts inventoried certificates by state
+type CertificateSummary struct {
+	Total    int `json:"total"`
+	Valid    int `json:"valid"`
+	Expiring int `json:"expiring"`
+	Expired  int `json:"expired"`
+}
+
+// CertificateInventoryStatus defines the observed state of CertificateInventory
+type CertificateInventoryStatus struct {
+	CRStatus `json:",inline"`
+
+	// LastRefreshTime is when certificates were last read
+	// +optional
+	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty"`
+	// +optional
+	Summary CertificateSummary `json:"summary,omitempty"`
+	// Certificates ordered by expiry, the next to expire first
+	// +optional
+	Certificates []CertificateInfo `json:"certificates,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:deepcopy-gen=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=certinv
+// +kubebuilder:printcolumn:name="Total",type=integer,JSONPath=`.status.summary.total`
+// +kubebuilder:printcolumn:name="Expiring",type=integer,JSONPath=`.status.summary.expiring`
+// +kubebuilder:printcolumn:name="Expired",type=integer,JSONPath=`.status.summary.expired`
+// +kubebuilder:printcolumn:name="Next Expiry",type=string,JSONPath=`.status.certificates[0].notAfter`
+// +genclient
+// +genclient:nonNamespaced
+// CertificateInventory aggregates the expiry of ecert, TLS, CA and organization admin certificates across namespaces
+type CertificateInventory struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	Spec CertificateInventorySpec `json:"spec,omitempty"`
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	Status CertificateInventoryStatus `json:"status,omitempty"`
+}
+
+// CertificateInventoryList contains a list of CertificateInventory
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:deepcopy-gen=true
+type CertificateInventoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []CertificateInventory `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CertificateInventory{}, &CertificateInventoryList{})
+}
@@ -2,7 +2,12 @@ package v1beta1
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-protos-go/msp"
+	"github.com/hyperledger/fabric-sdk-go/third_party/github.com/hyperledger/fabric/common/policydsl"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -13,11 +18,12 @@ const (
 	ChaincodeChannelLabel          = "bestchains.chaincode.channel"
 	ChaincodeIDLabel               = "bestchains.chaincode.id"
 	ChaincodeVersionLabel          = "bestchains.chaincode.version"
+	ChaincodeNameLabel             = "bestchains.chaincode.name"
 	ChaincodeUsedEndorsementPolicy = "bestchians.chaincode.endorsementpolicy"
 )
 
 var condOrder = []ChaincodeConditionType{ChaincodeCondDone, ChaincodeCondPackaged, ChaincodeCondInstalled,
-	ChaincodeCondApproved, ChaincodeCondCommitted, ChaincodeCondRunning}
+	ChaincodeCondApproved, ChaincodeCondCanary, ChaincodeCondCommitted, ChaincodeCondRunning}
 
 func NextCond(instance *Chaincode) ChaincodeConditionType {
 
@@ -39,9 +45,71 @@ func NextCond(instance *Chaincode) ChaincodeConditionType {
 			break
 		}
 	}
+	if condOrder[exp] == ChaincodeCondCanary && len(instance.CanaryOrganizations()) == 0 {
+		return ChaincodeCondCommitted
+	}
 	return condOrder[exp]
 }
 
+// IsCCaaS returns true if the operator runs the chaincode as a service
+func (i *Chaincode) IsCCaaS() bool {
+	return i.Spec.Mode == ChaincodeModeCCaaS
+}
+
+// Committed returns true if a definition of the chaincode has been committed to the channel
+func (i *Chaincode) Committed() bool {
+	if len(i.Status.History) > 0 {
+		return true
+	}
+	for _, c := range i.Status.Conditions {
+		if c.Type == ChaincodeCondCommitted && c.Status == metav1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPort returns the port the chaincode server listens on
+func (s *ChaincodeServer) GetPort() int32 {
+	if s == nil || s.Port == 0 {
+		return 7052
+	}
+	return s.Port
+}
+
+// GetReplicas returns the replicas of the chaincode server
+func (s *ChaincodeServer) GetReplicas() int32 {
+	if s == nil || s.Replicas == nil {
+		return 1
+	}
+	return *s.Replicas
+}
+
+// CanaryOrganizations returns the organizations which must run the new package healthy before commit
+func (i *Chaincode) CanaryOrganizations() []string {
+	if i.Spec.Canary == nil {
+		return nil
+	}
+	return i.Spec.Canary.Organizations
+}
+
+// Organization returns the progress of organization at the current sequence, the entry is added
+// if missing and reset if it was recorded at an older sequence
+func (s *ChaincodeStatus) Organization(name string) *ChaincodeOrganizationStatus {
+	for idx := range s.Organizations {
+		org := &s.Organizations[idx]
+		if org.Organization != name {
+			continue
+		}
+		if org.Sequence != s.Sequence {
+			*org = ChaincodeOrganizationStatus{Organization: name, Sequence: s.Sequence}
+		}
+		return org
+	}
+	s.Organizations = append(s.Organizations, ChaincodeOrganizationStatus{Organization: name, Sequence: s.Sequence})
+	return &s.Organizations[len(s.Organizations)-1]
+}
+
 // GetChannel
 func (i *Chaincode) GetChannel(r client.Reader) (*Channel, error) {
 	ch := &Channel{}
@@ -50,3 +118,110 @@ func (i *Chaincode) GetChannel(r client.Reader) (*Channel, error) {
 	}
 	return ch, nil
 }
+
+// GetHistory returns the latest history entry of version
+func (i *Chaincode) GetHistory(version string) (ChaincodeHistory, bool) {
+	var (
+		found ChaincodeHistory
+		ok    bool
+	)
+	for _, item := range i.Status.History {
+		if item.Version != version {
+			continue
+		}
+		if !ok || found.UpgradeTime.Before(&item.UpgradeTime) {
+			found, ok = item, true
+		}
+	}
+	return found, ok
+}
+
+// ValidateCollectionsUpgrade checks collections of a new definition against the committed ones.
+// Fabric rejects a definition which drops an existing collection or changes its blockToLive.
+func ValidateCollectionsUpgrade(committed, collections []Collection) error {
+	upgraded := make(map[string]Collection, len(collections))
+	for _, c := range collections {
+		upgraded[c.Name] = c
+	}
+	for _, c := range committed {
+		u, ok := upgraded[c.Name]
+		if !ok {
+			return fmt.Errorf("committed collection %s can't be removed", c.Name)
+		}
+		if u.BlockToLive != c.BlockToLive {
+			return fmt.Errorf("blockToLive of committed collection %s can't be changed", c.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateCollections checks private data collections, organizations in their policies must be channel members
+func ValidateCollections(collections []Collection, members []Member) error {
+	isMember := make(map[string]bool, len(members))
+	for _, m := range members {
+		isMember[m.Name] = true
+	}
+	checkPolicy := func(policy string) error {
+		orgs, err := PolicyOrganizations(policy)
+		if err != nil {
+			return err
+		}
+		for _, org := range orgs {
+			if !isMember[org] {
+				return fmt.Errorf("organization %s in policy %s is not a channel member", org, policy)
+			}
+		}
+		return nil
+	}
+
+	names := make(map[string]bool, len(collections))
+	for _, c := range collections {
+		if c.Name == "" {
+			return fmt.Errorf("collection name can't be empty")
+		}
+		if names[c.Name] {
+			return fmt.Errorf("duplicate collection %s", c.Name)
+		}
+		names[c.Name] = true
+		if c.RequiredPeerCount < 0 || c.MaxPeerCount < 0 {
+			return fmt.Errorf("collection %s: peer count can't be negative", c.Name)
+		}
+		if c.RequiredPeerCount > c.MaxPeerCount {
+			return fmt.Errorf("collection %s: requiredPeerCount %d exceeds maxPeerCount %d", c.Name, c.RequiredPeerCount, c.MaxPeerCount)
+		}
+		if err := checkPolicy(c.Policy); err != nil {
+			return fmt.Errorf("collection %s: %w", c.Name, err)
+		}
+		if ep := c.EndorsementPolicy; ep != nil {
+			if (ep.SignaturePolicy == "") == (ep.ChannelConfigPolicy == "") {
+				return fmt.Errorf("collection %s: endorsement policy needs exactly one of signaturePolicy and channelConfigPolicy", c.Name)
+			}
+			if ep.SignaturePolicy != "" {
+				if err := checkPolicy(ep.SignaturePolicy); err != nil {
+					return fmt.Errorf("collection %s endorsement policy: %w", c.Name, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// PolicyOrganizations returns the msp ids referenced by a signature policy like OR('org1.member','org2.member')
+func PolicyOrganizations(policy string) ([]string, error) {
+	envelope, err := policydsl.FromString(policy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy %s: %w", policy, err)
+	}
+	orgs := make([]string, 0, len(envelope.Identities))
+	for _, principal := range envelope.Identities {
+		if principal.PrincipalClassification != msp.MSPPrincipal_ROLE {
+			return nil, fmt.Errorf("unsupported principal classification %s in policy %s", principal.PrincipalClassification, policy)
+		}
+		role := &msp.MSPRole{}
+		if err = proto.Unmarshal(principal.Principal, role); err != nil {
+			return nil, err
+		}
+		orgs = append(orgs, role.MspIdentifier)
+	}
+	return orgs, nil
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package v1beta1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateCollections(t *testing.T) {
+	members := []Member{{Name: "org1"}, {Name: "org2"}}
+	valid := Collection{Name: "private", Policy: "OR('org1.member','org2.member')", RequiredPeerCount: 1, MaxPeerCount: 2}
+	if err := ValidateCollections([]Collection{valid}, members); err != nil {
+		t.Fatal(err)
+	}
+
+	notMember := valid
+	notMember.Policy = "OR('org3.member')"
+	duplicate := valid
+	peerCount := valid
+	peerCount.RequiredPeerCount = 3
+	endorsement := valid
+	endorsement.EndorsementPolicy = &CollectionEndorsementPolicy{SignaturePolicy: "OR('org1.member')", ChannelConfigPolicy: "/Channel/Application/Endorsement"}
+	for name, collections := range map[string][]Collection{
+		"not member":  {notMember},
+		"duplicate":   {valid, duplicate},
+		"peer count":  {peerCount},
+		"endorsement": {endorsement},
+	} {
+		if err := ValidateCollections(collections, members); err == nil {
+			t.Fatalf("%s: expect error", name)
+		}
+	}
+}
+
+func TestValidateCollectionsUpgrade(t *testing.T) {
+	committed := []Collection{{Name: "private", BlockToLive: 10}}
+	if err := ValidateCollectionsUpgrade(committed, []Collection{{Name: "private", BlockToLive: 10, MaxPeerCount: 2}, {Name: "added"}}); err != nil {
+		t.Fatal(err)
+	}
+	for name, collections := range map[string][]Collection{
+		"removed":     {{Name: "added"}},
+		"blockToLive": {{Name: "private", BlockToLive: 20}},
+	} {
+		if err := ValidateCollectionsUpgrade(committed, collections); err == nil {
+			t.Fatalf("%s: expect error", name)
+		}
+	}
+
+	cc := &Chaincode{}
+	if cc.Committed() {
+		t.Fatal("expect a new chaincode not committed")
+	}
+	cc.Status.Conditions = []ChaincodeCondition{{Type: ChaincodeCondCommitted, Status: metav1.ConditionTrue}}
+	if !cc.Committed() {
+		t.Fatal("expect the chaincode committed")
+	}
+}
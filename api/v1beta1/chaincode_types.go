@@ -1,6 +1,7 @@
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -18,6 +19,15 @@ const (
 	ChaincodePhaseRunning ChaincodePhase = "ChaincodeRunning"
 )
 
+type ChaincodeMode string
+
+const (
+	// ChaincodeModeK8s the peers launch the chaincode pods with fabric-builder-k8s
+	ChaincodeModeK8s ChaincodeMode = "k8s"
+	// ChaincodeModeCCaaS the operator runs the chaincode as a service and the peers connect to it
+	ChaincodeModeCCaaS ChaincodeMode = "ccaas"
+)
+
 type ChaincodeConditionType string
 
 const (
@@ -27,6 +37,8 @@ const (
 	ChaincodeCondInstalled ChaincodeConditionType = "Installed"
 	// ChaincodeCondApproved chaincode definition approved
 	ChaincodeCondApproved ChaincodeConditionType = "Approved"
+	// ChaincodeCondCanary the canary organizations run the new package healthy, only used when spec.canary is set
+	ChaincodeCondCanary ChaincodeConditionType = "Canary"
 	// ChaincodeCondCommitted chaincode commits to the chain
 	ChaincodeCondCommitted ChaincodeConditionType = "Committed"
 	// ChaincodeCondRunning chaincode is running, pod is in running state
@@ -80,9 +92,95 @@ type ChaincodeSpec struct {
 
 	EndorsePolicyRef `json:"endorsePolicyRef"`
 	// ExternalBuilder used, default is k8s
-	ExternalBuilder string `json:"externalBuilder"`
+	// Optional in ccaas mode when images is set directly.
+	// +optional
+	ExternalBuilder string `json:"externalBuilder,omitempty"`
+	// Mode is how the chaincode runs, default is k8s
+	// +optional
+	// +kubebuilder:validation:Enum=k8s;ccaas
+	Mode ChaincodeMode `json:"mode,omitempty"`
+	// Server is the chaincode service run by the operator in ccaas mode
+	// +optional
+	Server *ChaincodeServer `json:"server,omitempty"`
 	// the image used by the current version of chaincode
 	Images ChaincodeImage `json:"images,omitempty"`
+	// Collections are the private data collections of chaincode.
+	// Changing collections requires a new sequence, so it must be done by an upgrade proposal.
+	// +optional
+	Collections []Collection `json:"collections,omitempty"`
+	// Canary lets some organizations run the new package before the new definition is committed.
+	// +optional
+	Canary *ChaincodeCanary `json:"canary,omitempty"`
+}
+
+// ChaincodeServer defines the deployment of a chaincode running as a service.
+// The chaincode image must start a chaincode server (shim.ChaincodeServer) with
+// CHAINCODE_ID, CHAINCODE_SERVER_ADDRESS and the CHAINCODE_TLS_* environment variables set by the operator.
+type ChaincodeServer struct {
+	// Organization runs the chaincode service in its namespace, its CA issues the tls certificate
+	Organization string `json:"organization"`
+	// Port the chaincode server listens on
+	// +optional
+	// +kubebuilder:default:=7052
+	Port int32 `json:"port,omitempty"`
+	// +optional
+	// +kubebuilder:default:=1
+	Replicas *int32 `json:"replicas,omitempty"`
+	// DisableTLS serves the chaincode without tls
+	// +optional
+	DisableTLS bool `json:"disableTLS,omitempty"`
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// ChaincodeCanary defines the organizations which must report a new version healthy before it is committed.
+// Package IDs are local to each organization, so the canary organizations re-approve the committed
+// definition with the new package and their peers run it alongside the old package of the other organizations.
+type ChaincodeCanary struct {
+	// Organizations are the channel members running the new package ahead of commit
+	// +kubebuilder:validation:MinItems:=1
+	Organizations []string `json:"organizations"`
+}
+
+// Collection defines a private data collection
+type Collection struct {
+	// +kubebuilder:validation:Pattern:=`^[A-Za-z0-9-]+$`
+	Name string `json:"name"`
+	// Policy defines which organizations' peers store the private data,
+	// such as OR('org1.member','org2.member'). Only channel members are allowed.
+	Policy string `json:"policy"`
+	// RequiredPeerCount is the minimum number of peers the private data is disseminated to on endorsement
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	RequiredPeerCount int32 `json:"requiredPeerCount,omitempty"`
+	// MaxPeerCount is the maximum number of peers the private data is disseminated to on endorsement
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	MaxPeerCount int32 `json:"maxPeerCount,omitempty"`
+	// BlockToLive is how many blocks the private data lives before purged, 0 means never purged
+	// +optional
+	BlockToLive uint64 `json:"blockToLive,omitempty"`
+	// MemberOnlyRead only allows clients of member organizations to read the private data
+	// +optional
+	MemberOnlyRead bool `json:"memberOnlyRead,omitempty"`
+	// MemberOnlyWrite only allows clients of member organizations to write the private data
+	// +optional
+	MemberOnlyWrite bool `json:"memberOnlyWrite,omitempty"`
+	// EndorsementPolicy overrides the chaincode endorsement policy for writes to the collection
+	// +optional
+	EndorsementPolicy *CollectionEndorsementPolicy `json:"endorsementPolicy,omitempty"`
+}
+
+// CollectionEndorsementPolicy is either a signature policy or a reference to a channel config policy
+type CollectionEndorsementPolicy struct {
+	// SignaturePolicy such as OR('org1.member')
+	// +optional
+	SignaturePolicy string `json:"signaturePolicy,omitempty"`
+	// ChannelConfigPolicy such as /Channel/Application/Endorsement
+	// +optional
+	ChannelConfigPolicy string `json:"channelConfigPolicy,omitempty"`
 }
 
 type EndorsePolicyRef struct {
@@ -102,7 +200,22 @@ type ChaincodeHistory struct {
 	Version         string         `json:"version"`
 	Image           ChaincodeImage `json:"image"`
 	ExternalBuilder string         `json:"externalBuilder"`
-	UpgradeTime     metav1.Time    `json:"upgradeTime"`
+	// +optional
+	Label string `json:"label,omitempty"`
+	// +optional
+	Collections []Collection `json:"collections,omitempty"`
+	UpgradeTime metav1.Time  `json:"upgradeTime"`
+}
+
+// ChaincodeRollback records the rollback in progress
+type ChaincodeRollback struct {
+	// Proposal which triggered the rollback
+	Proposal string `json:"proposal"`
+	// Version rolled back to
+	Version string `json:"version"`
+	// Sequence the rolled back version is committed at
+	Sequence     int64       `json:"sequence"`
+	RollbackTime metav1.Time `json:"rollbackTime"`
 }
 
 type ChaincodeCondition struct {
@@ -121,6 +234,31 @@ type ChaincodeCondition struct {
 	NextStage ChaincodeConditionType `json:"nextStage"`
 }
 
+// ChaincodeOrganizationStatus is the progress of one organization at the current sequence
+type ChaincodeOrganizationStatus struct {
+	Organization string `json:"organization"`
+	Sequence     int64  `json:"sequence"`
+	// PackageID installed on the peers of the organization
+	// +optional
+	PackageID string `json:"packageID,omitempty"`
+	// Installed is true when the package is installed on all joined peers of the organization
+	// +optional
+	Installed bool `json:"installed,omitempty"`
+	// Approved is true when the organization approved the definition at the current sequence
+	// +optional
+	Approved bool `json:"approved,omitempty"`
+	// Canary is true when the organization runs the package ahead of commit
+	// +optional
+	Canary bool `json:"canary,omitempty"`
+	// Healthy is true when the chaincode pods of the package are running on all joined peers of the organization
+	// +optional
+	Healthy bool `json:"healthy,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
 type ChaincodeStatus struct {
 	// Chaincode upgrade history
 	// +optional
@@ -139,6 +277,12 @@ type ChaincodeStatus struct {
 	LastHeartbeatTime metav1.Time `json:"lastHeartbeatTime,omitempty"`
 	// +kubebuilder:validation:Minimum:=1
 	Sequence int64 `json:"sequence"`
+	// Rollback is set when the current sequence is a rollback to a history version
+	// +optional
+	Rollback *ChaincodeRollback `json:"rollback,omitempty"`
+	// Organizations tracks the progress of each member organization
+	// +optional
+	Organizations []ChaincodeOrganizationStatus `json:"organizations,omitempty"`
 }
 
 func init() {
@@ -90,13 +90,101 @@ func (r *Chaincode) checkChAndEp(c client.Client) error {
 	return c.Get(context.TODO(), types.NamespacedName{Name: r.Spec.EndorsePolicyRef.Name}, ep)
 }
 
+// checkCollections Check if collections only refer to channel members
+func (r *Chaincode) checkCollections(c client.Client) error {
+	if len(r.Spec.Collections) == 0 {
+		return nil
+	}
+	ch, err := r.GetChannel(c)
+	if err != nil {
+		return err
+	}
+	return ValidateCollections(r.Spec.Collections, ch.Spec.Members)
+}
+
+// checkImage Check the image of chaincode, a ccaas chaincode can use an image built outside of the cluster
+func (r *Chaincode) checkImage(ctx context.Context, c client.Client) error {
+	if r.IsCCaaS() && r.Spec.ExternalBuilder == "" {
+		if r.Spec.Images.Name == "" || r.Spec.ID == "" || r.Spec.Version == "" {
+			return fmt.Errorf("images.name, id and version are required in ccaas mode without external builder")
+		}
+		return nil
+	}
+	return checkChaincodeBuildImage(ctx, c, r.Spec.ExternalBuilder)
+}
+
+// checkServer Check if the chaincode server is run by a channel member
+func (r *Chaincode) checkServer(c client.Client) error {
+	if !r.IsCCaaS() {
+		if r.Spec.Server != nil {
+			return fmt.Errorf("server is only used in %s mode", ChaincodeModeCCaaS)
+		}
+		return nil
+	}
+	if r.Spec.Server == nil || r.Spec.Server.Organization == "" {
+		return fmt.Errorf("server.organization is required in %s mode", ChaincodeModeCCaaS)
+	}
+	ch, err := r.GetChannel(c)
+	if err != nil {
+		return err
+	}
+	for _, m := range ch.Spec.Members {
+		if m.Name == r.Spec.Server.Organization {
+			return nil
+		}
+	}
+	return fmt.Errorf("server organization %s is not a member of channel %s", r.Spec.Server.Organization, ch.GetName())
+}
+
+// serverEndpointChanged returns true if the change of server modifies the connection of peers
+func serverEndpointChanged(new, old *ChaincodeServer) bool {
+	if new == nil || old == nil {
+		return new != old
+	}
+	return new.Organization != old.Organization || new.GetPort() != old.GetPort() || new.DisableTLS != old.DisableTLS
+}
+
+// checkCanary Check if canary organizations are channel members
+func (r *Chaincode) checkCanary(c client.Client) error {
+	orgs := r.CanaryOrganizations()
+	if len(orgs) == 0 {
+		return nil
+	}
+	ch, err := r.GetChannel(c)
+	if err != nil {
+		return err
+	}
+	isMember := make(map[string]bool, len(ch.Spec.Members))
+	for _, m := range ch.Spec.Members {
+		isMember[m.Name] = true
+	}
+	for _, org := range orgs {
+		if !isMember[org] {
+			return fmt.Errorf("canary organization %s is not a member of channel %s", org, ch.GetName())
+		}
+	}
+	return nil
+}
+
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (r *Chaincode) ValidateCreate(ctx context.Context, client client.Client, user authenticationv1.UserInfo) error {
 	ccLogger.Info("validate create", "name", r.Name, "user", user.String())
-	if err := checkChaincodeBuildImage(ctx, client, r.Spec.ExternalBuilder); err != nil {
+	if err := r.checkImage(ctx, client); err != nil {
 		ccLogger.Error(err, "")
 		return err
 	}
+	if err := r.checkServer(client); err != nil {
+		return err
+	}
+	if err := verifyChaincodeBuild(ctx, client, r.Spec.Channel, r.Spec.ExternalBuilder, r.Spec.Images.Digest); err != nil {
+		return err
+	}
+	if err := r.checkCollections(client); err != nil {
+		return err
+	}
+	if err := r.checkCanary(client); err != nil {
+		return err
+	}
 	return r.checkChAndEp(client)
 }
 
@@ -104,10 +192,19 @@ func (r *Chaincode) ValidateCreate(ctx context.Context, client client.Client, us
 func (r *Chaincode) ValidateUpdate(ctx context.Context, client client.Client, old runtime.Object, user authenticationv1.UserInfo) error {
 	ccLogger.Info("validate update", "name", r.Name, "user", user.String())
 	oldcc := old.(*Chaincode)
-	if err := checkChaincodeBuildImage(ctx, client, r.Spec.ExternalBuilder); err != nil {
+	if err := r.checkImage(ctx, client); err != nil {
 		ccLogger.Error(err, "")
 		return err
 	}
+	// The mode and the endpoint of the chaincode server are part of the package
+	if r.Spec.Mode != oldcc.Spec.Mode || serverEndpointChanged(r.Spec.Server, oldcc.Spec.Server) {
+		if oldcc.Status.Phase != ChaincodePhasePending {
+			return fmt.Errorf("mode, server organization, port and tls can't be changed once the chaincode is deployed")
+		}
+	}
+	if err := r.checkServer(client); err != nil {
+		return err
+	}
 
 	// If the information relating to the mirror of the chaincode has changed,
 	// it can only be updated if the previous version of the chaincode is in a pending phase.
@@ -115,6 +212,28 @@ func (r *Chaincode) ValidateUpdate(ctx context.Context, client client.Client, ol
 		if oldcc.Status.Phase != ChaincodePhasePending {
 			return fmt.Errorf("please upgrade via proposal")
 		}
+		if err := verifyChaincodeBuild(ctx, client, r.Spec.Channel, r.Spec.ExternalBuilder, r.Spec.Images.Digest); err != nil {
+			return err
+		}
+	}
+	// Changing collections requires a new sequence, same as the chaincode definition above.
+	if !reflect.DeepEqual(r.Spec.Collections, oldcc.Spec.Collections) {
+		if oldcc.Status.Phase != ChaincodePhasePending {
+			return fmt.Errorf("please update collections via upgrade proposal")
+		}
+		if oldcc.Committed() {
+			if err := ValidateCollectionsUpgrade(oldcc.Spec.Collections, r.Spec.Collections); err != nil {
+				return err
+			}
+		}
+		if err := r.checkCollections(client); err != nil {
+			return err
+		}
+	}
+	if !reflect.DeepEqual(r.Spec.Canary, oldcc.Spec.Canary) {
+		if err := r.checkCanary(client); err != nil {
+			return err
+		}
 	}
 	return oldcc.checkChAndEp(client)
 }
@@ -139,3 +258,30 @@ func checkChaincodeBuildImage(ctx context.Context, c client.Client, chaincodebui
 
 	return ccb.HasImage()
 }
+
+// verifyChaincodeBuild enforces the chaincode provenance policy of channel on the build of the image,
+// a pinned digest must be the signed digest of the build.
+func verifyChaincodeBuild(ctx context.Context, c client.Client, channel, chaincodebuildName, digest string) error {
+	ch := &Channel{}
+	if err := c.Get(ctx, types.NamespacedName{Name: channel}, ch); err != nil {
+		return err
+	}
+	policy := ch.Spec.ChaincodeProvenance
+	if policy == nil {
+		return nil
+	}
+	if chaincodebuildName == "" {
+		return fmt.Errorf("channel %s requires chaincode images from a signed chaincodebuild", channel)
+	}
+	ccb := &ChaincodeBuild{}
+	if err := c.Get(ctx, types.NamespacedName{Name: chaincodebuildName}, ccb); err != nil {
+		return err
+	}
+	if err := ccb.VerifyProvenance(policy, ProvenanceRegistry); err != nil {
+		return err
+	}
+	if digest != "" && digest != ccb.Status.Provenance.Digest {
+		return fmt.Errorf("image digest %s is not the signed digest %s of chaincodebuild %s", digest, ccb.Status.Provenance.Digest, chaincodebuildName)
+	}
+	return nil
+}
@@ -21,20 +21,38 @@ package v1beta1
 import (
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/IBM-Blockchain/fabric-operator/pkg/provenance"
 	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 )
 
 var (
 	errInvalidSourceMinio = errors.New("pipeline source minio is invalid.missing bucket or object")
 	errInvalidSourceGit   = errors.New("pipeline source git is invalid.missing url")
+	errInvalidSourceOCI   = errors.New("pipeline source oci is invalid.missing reference")
+	errInvalidSourceHTTP  = errors.New("pipeline source http is invalid.missing url")
+	errMultipleSources    = errors.New("pipeline must have only one source(Git/Minio/OCI/HTTP)")
+	errInvalidBuild       = errors.New("pipeline must have either dockerBuild or languageBuild")
+	errMissingAppImage    = errors.New("pipeline build is invalid.missing appImage")
 )
 
 const (
 	IMAGE_URL    = "IMAGE_URL"
 	IMAGE_DIGEST = "IMAGE_DIGEST"
+
+	// optional pipeline results recorded as provenance
+	SOURCE_COMMIT = "SOURCE_COMMIT"
+	SBOM_FORMAT   = "SBOM_FORMAT"
+	SBOM_DIGEST   = "SBOM_DIGEST"
+	SBOM_URI      = "SBOM_URI"
+	SIGNATURE     = "SIGNATURE"
 )
 
+// ProvenanceRegistry resolves image digests when verifying provenance, digests are not resolved if nil.
+// It can be a local stand-in of the registry, so verification works offline.
+var ProvenanceRegistry provenance.Registry
+
 func init() {
 	SchemeBuilder.Register(&ChaincodeBuild{}, &ChaincodeBuildList{})
 }
@@ -44,10 +62,26 @@ func (build *ChaincodeBuild) HasType() bool {
 }
 
 func (buildSpec *ChaincodeBuildSpec) HasPipelineSource() bool {
-	return buildSpec.PipelineRunSpec.Git != nil || buildSpec.PipelineRunSpec.Minio != nil
+	return buildSpec.PipelineRunSpec.GetSourceType() != ""
 }
 
 func (buildSpec *ChaincodeBuildSpec) ValidatePipelineSource() error {
+	p := buildSpec.PipelineRunSpec
+	sources := 0
+	for _, set := range []bool{p.Git != nil, p.Minio != nil, p.OCI != nil, p.HTTP != nil} {
+		if set {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return errMultipleSources
+	}
+	if p.OCI != nil && p.OCI.Reference == "" {
+		return errInvalidSourceOCI
+	}
+	if p.HTTP != nil && p.HTTP.URL == "" {
+		return errInvalidSourceHTTP
+	}
 	if buildSpec.PipelineRunSpec.Minio != nil {
 		if buildSpec.PipelineRunSpec.Minio.Bucket == "" || buildSpec.PipelineRunSpec.Minio.Object == "" {
 			return errInvalidSourceMinio
@@ -61,12 +95,40 @@ func (buildSpec *ChaincodeBuildSpec) ValidatePipelineSource() error {
 	return nil
 }
 
+// ValidatePipelineBuild checks the image is built either by a Dockerfile or by the language builder
+func (buildSpec *ChaincodeBuildSpec) ValidatePipelineBuild() error {
+	p := buildSpec.PipelineRunSpec
+	if (p.Dockerbuild == nil) == (p.LanguageBuild == nil) {
+		return errInvalidBuild
+	}
+	if p.GetAppImage() == "" {
+		return errMissingAppImage
+	}
+	return nil
+}
+
 func (build *ChaincodeBuild) GetPipelineRunID() string {
 	return build.GetName() + "-pipelinerun"
 }
 
 func (build *ChaincodeBuild) GetDockerPushSecret() string {
-	return build.Spec.PipelineRunSpec.PushSecret
+	if build.Spec.PipelineRunSpec.LanguageBuild != nil {
+		return build.Spec.PipelineRunSpec.LanguageBuild.PushSecret
+	}
+	if build.Spec.PipelineRunSpec.Dockerbuild != nil {
+		return build.Spec.PipelineRunSpec.Dockerbuild.PushSecret
+	}
+	return ""
+}
+
+func (p PipelineRunSpec) GetAppImage() string {
+	if p.LanguageBuild != nil {
+		return p.LanguageBuild.AppImage
+	}
+	if p.Dockerbuild != nil {
+		return p.Dockerbuild.AppImage
+	}
+	return ""
 }
 
 func (p PipelineRunSpec) GetSourceType() SourceType {
@@ -76,6 +138,12 @@ func (p PipelineRunSpec) GetSourceType() SourceType {
 	if p.Git != nil {
 		return SourceGit
 	}
+	if p.OCI != nil {
+		return SourceOCI
+	}
+	if p.HTTP != nil {
+		return SourceHTTP
+	}
 	return ""
 }
 
@@ -93,10 +161,19 @@ func (p PipelineRunSpec) ToPipelineParams() []pipelinev1beta1.Param {
 		minioParams := p.Minio.ToPipelineParams()
 		params = append(params, minioParams...)
 	}
+	if p.OCI != nil {
+		params = append(params, p.OCI.ToPipelineParams()...)
+	}
+	if p.HTTP != nil {
+		params = append(params, p.HTTP.ToPipelineParams()...)
+	}
 	if p.Dockerbuild != nil {
 		dockerParams := p.Dockerbuild.ToPipelineParams()
 		params = append(params, dockerParams...)
 	}
+	if p.LanguageBuild != nil {
+		params = append(params, p.LanguageBuild.ToPipelineParams()...)
+	}
 
 	return params
 }
@@ -139,6 +216,50 @@ func (p Minio) ToPipelineParams() []pipelinev1beta1.Param {
 	}
 }
 
+func (p OCI) ToPipelineParams() []pipelinev1beta1.Param {
+	return []pipelinev1beta1.Param{
+		{
+			Name:  "SOURCE_OCI_REFERENCE",
+			Value: *pipelinev1beta1.NewArrayOrString(p.Reference),
+		},
+	}
+}
+
+func (p HTTP) ToPipelineParams() []pipelinev1beta1.Param {
+	return []pipelinev1beta1.Param{
+		{
+			Name:  "SOURCE_HTTP_URL",
+			Value: *pipelinev1beta1.NewArrayOrString(p.URL),
+		},
+		{
+			Name:  "SOURCE_HTTP_SHA256",
+			Value: *pipelinev1beta1.NewArrayOrString(p.SHA256),
+		},
+	}
+}
+
+// ToPipelineParams returns the params of language build, BUILDER_IMAGE is set by the operator
+func (p LanguageBuild) ToPipelineParams() []pipelinev1beta1.Param {
+	modulePath := p.ModulePath
+	if modulePath == "" {
+		modulePath = "."
+	}
+	return []pipelinev1beta1.Param{
+		{
+			Name:  "LANGUAGE",
+			Value: *pipelinev1beta1.NewArrayOrString(string(p.Language)),
+		},
+		{
+			Name:  "MODULE_PATH",
+			Value: *pipelinev1beta1.NewArrayOrString(modulePath),
+		},
+		{
+			Name:  "APP_IMAGE",
+			Value: *pipelinev1beta1.NewArrayOrString(p.AppImage),
+		},
+	}
+}
+
 func (p Dockerbuild) ToPipelineParams() []pipelinev1beta1.Param {
 	return []pipelinev1beta1.Param{
 		{
@@ -156,6 +277,80 @@ func (p Dockerbuild) ToPipelineParams() []pipelinev1beta1.Param {
 	}
 }
 
+// GetSourceURI returns the location of chaincode source
+func (p PipelineRunSpec) GetSourceURI() string {
+	switch p.GetSourceType() {
+	case SourceGit:
+		if p.Git.Reference != "" {
+			return fmt.Sprintf("%s@%s", p.Git.URL, p.Git.Reference)
+		}
+		return p.Git.URL
+	case SourceMinio:
+		return fmt.Sprintf("minio://%s/%s", p.Minio.Bucket, p.Minio.Object)
+	case SourceOCI:
+		return "oci://" + p.OCI.Reference
+	case SourceHTTP:
+		return p.HTTP.URL
+	}
+	return ""
+}
+
+// RecordProvenance records the provenance from the pipeline results
+func (ccb *ChaincodeBuild) RecordProvenance() {
+	results := make(map[string]string, len(ccb.Status.PipelineRunResults))
+	for _, item := range ccb.Status.PipelineRunResults {
+		results[item.Name] = strings.TrimSpace(item.Value)
+	}
+	p := &BuildProvenance{
+		Source:    ccb.Spec.PipelineRunSpec.GetSourceURI(),
+		Commit:    results[SOURCE_COMMIT],
+		Image:     results[IMAGE_URL],
+		Digest:    results[IMAGE_DIGEST],
+		Signature: results[SIGNATURE],
+	}
+	if results[SBOM_DIGEST] != "" {
+		p.SBOM = &SBOM{Format: results[SBOM_FORMAT], Digest: results[SBOM_DIGEST], URI: results[SBOM_URI]}
+	}
+	ccb.Status.Provenance = p
+}
+
+// Statement returns the signed statement of the provenance
+func (p *BuildProvenance) Statement() provenance.Statement {
+	s := provenance.Statement{Source: p.Source, Commit: p.Commit, Image: p.Image, Digest: p.Digest}
+	if p.SBOM != nil {
+		s.SBOMDigest = p.SBOM.Digest
+	}
+	return s
+}
+
+// VerifyProvenance checks the build is signed by a trusted key, built from an allowed source
+// and its image digest matches the registry.
+func (ccb *ChaincodeBuild) VerifyProvenance(policy *ProvenancePolicy, registry provenance.Registry) error {
+	p := ccb.Status.Provenance
+	if p == nil || p.Digest == "" {
+		return fmt.Errorf("chaincodebuild %s has no provenance", ccb.GetName())
+	}
+	if !policy.AllowSource(p.Source) {
+		return fmt.Errorf("chaincodebuild %s source %s is not allowed", ccb.GetName(), p.Source)
+	}
+	if policy.RequireSBOM && (p.SBOM == nil || p.SBOM.Digest == "") {
+		return fmt.Errorf("chaincodebuild %s has no sbom", ccb.GetName())
+	}
+	if err := provenance.Verify(policy.TrustedKeys, p.Statement(), p.Signature); err != nil {
+		return fmt.Errorf("chaincodebuild %s: %s", ccb.GetName(), err.Error())
+	}
+	if registry != nil {
+		digest, err := registry.Digest(p.Image)
+		if err != nil {
+			return fmt.Errorf("chaincodebuild %s: %s", ccb.GetName(), err.Error())
+		}
+		if digest != p.Digest {
+			return fmt.Errorf("chaincodebuild %s image %s has digest %s in registry, but %s is signed", ccb.GetName(), p.Image, digest, p.Digest)
+		}
+	}
+	return nil
+}
+
 func (ccb *ChaincodeBuild) HasImage() error {
 	url, digest := "", ""
 	for _, item := range ccb.Status.PipelineRunResults {
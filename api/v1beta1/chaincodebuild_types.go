@@ -51,9 +51,16 @@ type ChaincodeBuildSpec struct {
 }
 
 type PipelineRunSpec struct {
-	*Git         `json:"git,omitempty"`
-	*Minio       `json:"minio,omitempty"`
-	*Dockerbuild `json:"dockerBuild"`
+	*Git   `json:"git,omitempty"`
+	*Minio `json:"minio,omitempty"`
+	*OCI   `json:"oci,omitempty"`
+	*HTTP  `json:"http,omitempty"`
+	// Dockerbuild builds the image with the Dockerfile in source
+	// +optional
+	*Dockerbuild `json:"dockerBuild,omitempty"`
+	// LanguageBuild builds the image from the chaincode source without a Dockerfile
+	// +optional
+	LanguageBuild *LanguageBuild `json:"languageBuild,omitempty"`
 }
 
 type SourceType string
@@ -61,6 +68,8 @@ type SourceType string
 const (
 	SourceGit   SourceType = "git"
 	SourceMinio SourceType = "minio"
+	SourceOCI   SourceType = "oci"
+	SourceHTTP  SourceType = "http"
 )
 
 type Git struct {
@@ -76,6 +85,39 @@ type Minio struct {
 	Object    string `json:"object,omitempty"`
 }
 
+// OCI is an OCI artifact whose layer is the tarball of chaincode source
+type OCI struct {
+	// Reference of the artifact, e.g. registry.example.com/chaincode/basic:v1
+	Reference string `json:"reference,omitempty"`
+}
+
+// HTTP is a tarball of chaincode source downloaded over http(s)
+type HTTP struct {
+	URL string `json:"url,omitempty"`
+	// SHA256 checks the downloaded tarball if set
+	// +optional
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+type ChaincodeLanguage string
+
+const (
+	ChaincodeLanguageGo   ChaincodeLanguage = "golang"
+	ChaincodeLanguageNode ChaincodeLanguage = "node"
+	ChaincodeLanguageJava ChaincodeLanguage = "java"
+)
+
+// LanguageBuild builds the chaincode image with the builder image of the language
+type LanguageBuild struct {
+	// +kubebuilder:validation:Enum=golang;node;java
+	Language ChaincodeLanguage `json:"language"`
+	// ModulePath is the path of the chaincode module in source, default is the root of source
+	// +optional
+	ModulePath string `json:"modulePath,omitempty"`
+	PushSecret string `json:"pushSecret,omitempty"`
+	AppImage   string `json:"appImage,omitempty"`
+}
+
 type Dockerbuild struct {
 	PushSecret string `json:"pushSecret,omitempty"`
 	AppImage   string `json:"appImage,omitempty"`
@@ -88,6 +130,38 @@ type ChaincodeBuildStatus struct {
 	CRStatus `json:",inline"`
 	// PipelineRunResults after pipeline completed
 	PipelineRunResults []pipelinev1beta1.PipelineRunResult `json:"pipelineResults,omitempty"`
+	// Provenance of the image, recorded from the pipeline results
+	// +optional
+	Provenance *BuildProvenance `json:"provenance,omitempty"`
+}
+
+// BuildProvenance describes where the image comes from and who signed it
+type BuildProvenance struct {
+	// Source is the location of chaincode source derived from spec
+	Source string `json:"source"`
+	// Commit of the source, reported by the pipeline
+	// +optional
+	Commit string `json:"commit,omitempty"`
+	// Image pushed by the pipeline
+	Image string `json:"image"`
+	// Digest of the pushed image
+	Digest string `json:"digest"`
+	// +optional
+	SBOM *SBOM `json:"sbom,omitempty"`
+	// Signature is the base64 encoded signature of the provenance statement
+	// +optional
+	Signature string `json:"signature,omitempty"`
+}
+
+// SBOM is the software bill of materials of the image
+type SBOM struct {
+	// Format of the SBOM, e.g. spdx-json or cyclonedx-json
+	Format string `json:"format,omitempty"`
+	// Digest of the SBOM document
+	Digest string `json:"digest"`
+	// URI where the SBOM document is stored
+	// +optional
+	URI string `json:"uri,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -78,6 +78,15 @@ func versionConflict(c client.Client, networkName, id, version string) error {
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (r *ChaincodeBuild) ValidateCreate(ctx context.Context, client client.Client, user authenticationv1.UserInfo) error {
 	ccbLogger.Info("validate create", "name", r.Name, "user", user.String())
+	if !r.Spec.HasPipelineSource() {
+		return fmt.Errorf("chaincodebuild must provide a pipeline source(Git/Minio/OCI/HTTP)")
+	}
+	if err := r.Spec.ValidatePipelineSource(); err != nil {
+		return err
+	}
+	if err := r.Spec.ValidatePipelineBuild(); err != nil {
+		return err
+	}
 	return versionConflict(client, r.Spec.Network, r.Spec.ID, r.Spec.Version)
 }
 
@@ -0,0 +1,113 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package v1beta1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type InvocationPhase string
+
+const (
+	InvocationPending InvocationPhase = "Pending"
+	// InvocationRunning is recorded with the transaction id before the transaction is sent to the orderer
+	InvocationRunning   InvocationPhase = "Running"
+	InvocationSucceeded InvocationPhase = "Succeeded"
+	InvocationFailed    InvocationPhase = "Failed"
+)
+
+// ChaincodeInvocationSpec defines a single invoke or query against a running chaincode
+type ChaincodeInvocationSpec struct {
+	// Chaincode to call, must be running
+	Chaincode string `json:"chaincode"`
+	// Organization whose admin identity sends the call, must be managed by the creator
+	Organization string `json:"organization"`
+	// Query evaluates the call on peers only, nothing is submitted to the orderer
+	// +optional
+	Query bool `json:"query,omitempty"`
+	// Function name of chaincode
+	Function string `json:"function"`
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// TransientSecret is the name of a secret in the organization's namespace. Each of its keys is passed
+	// to chaincode as transient data, which is not written to the ledger
+	// +optional
+	TransientSecret string `json:"transientSecret,omitempty"`
+	// TargetOrganizations whose peers endorse the call, default to all channel members
+	// +optional
+	TargetOrganizations []string `json:"targetOrganizations,omitempty"`
+}
+
+// EndorsementResponse is the response of one endorsing peer
+type EndorsementResponse struct {
+	Peer    string `json:"peer"`
+	Status  int32  `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// ChaincodeInvocationStatus records the result of the call
+type ChaincodeInvocationStatus struct {
+	// +optional
+	Phase InvocationPhase `json:"phase,omitempty"`
+	// +optional
+	TxID string `json:"txID,omitempty"`
+	// ValidationCode of the transaction, empty for queries
+	// +optional
+	ValidationCode string `json:"validationCode,omitempty"`
+	// Payload returned by chaincode
+	// +optional
+	Payload string `json:"payload,omitempty"`
+	// +optional
+	Endorsements []EndorsementResponse `json:"endorsements,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// SubmitTime is when the transaction was sent to the orderer
+	// +optional
+	SubmitTime metav1.Time `json:"submitTime,omitempty"`
+	// +optional
+	CompletionTime metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:deepcopy-gen=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=ccinvoke
+// +genclient
+// +genclient:nonNamespaced
+type ChaincodeInvocation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	Spec ChaincodeInvocationSpec `json:"spec"`
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	Status ChaincodeInvocationStatus `json:"status,omitempty"`
+}
+
+// ChaincodeInvocationList contains a list of ChaincodeInvocation
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:deepcopy-gen=true
+type ChaincodeInvocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ChaincodeInvocation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChaincodeInvocation{}, &ChaincodeInvocationList{})
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package v1beta1
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// log is for logging in this package.
+var invocationLogger = logf.Log.WithName("chaincodeinvocation-resource")
+
+var (
+	errInvocationImmutable        = errors.New("chaincode invocation can not be updated")
+	errInvocationChaincodeStopped = errors.New("chaincode is not running")
+	errInvocationNotMember        = errors.New("organization is not a channel member")
+	errInvocationNoPermission     = errors.New("no permission to invoke as the organization")
+)
+
+//+kubebuilder:webhook:path=/mutate-ibp-com-v1beta1-chaincodeinvocation,mutating=true,failurePolicy=fail,sideEffects=None,groups=ibp.com,resources=chaincodeinvocations,verbs=create;update,versions=v1beta1,name=chaincodeinvocation.mutate.webhook,admissionReviewVersions=v1
+
+var _ defaulter = &ChaincodeInvocation{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *ChaincodeInvocation) Default(ctx context.Context, client client.Client, user authenticationv1.UserInfo) {
+	invocationLogger.Info("default", "name", r.Name, "user", user.String())
+}
+
+//+kubebuilder:webhook:path=/validate-ibp-com-v1beta1-chaincodeinvocation,mutating=false,failurePolicy=fail,sideEffects=None,groups=ibp.com,resources=chaincodeinvocations,verbs=create;update;delete,versions=v1beta1,name=chaincodeinvocation.validate.webhook,admissionReviewVersions=v1
+
+var _ validator = &ChaincodeInvocation{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ChaincodeInvocation) ValidateCreate(ctx context.Context, c client.Client, user authenticationv1.UserInfo) error {
+	invocationLogger.Info("validate create", "name", r.Name, "user", user.String())
+	if r.Spec.Function == "" {
+		return errors.New("function can't be empty")
+	}
+
+	cc := &Chaincode{}
+	if err := c.Get(ctx, types.NamespacedName{Name: r.Spec.Chaincode}, cc); err != nil {
+		return err
+	}
+	if cc.Status.Phase != ChaincodePhaseRunning {
+		return errors.Wrapf(errInvocationChaincodeStopped, "chaincode %s phase %s", cc.GetName(), cc.Status.Phase)
+	}
+	ch, err := cc.GetChannel(c)
+	if err != nil {
+		return err
+	}
+	members := make(map[string]bool, len(ch.Spec.Members))
+	for _, m := range ch.Spec.Members {
+		members[m.Name] = true
+	}
+	for _, org := range append([]string{r.Spec.Organization}, r.Spec.TargetOrganizations...) {
+		if !members[org] {
+			return errors.Wrapf(errInvocationNotMember, "organization %s channel %s", org, ch.GetName())
+		}
+	}
+
+	// the call is signed by the organization admin, so only who manages the organization can create it
+	managedOrgs, err := filterManagedOrgs(ctx, c, user, []Member{{Name: r.Spec.Organization}})
+	if err != nil {
+		return err
+	}
+	if len(managedOrgs) == 0 {
+		return errors.Wrapf(errInvocationNoPermission, "organization %s", r.Spec.Organization)
+	}
+	return nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ChaincodeInvocation) ValidateUpdate(ctx context.Context, c client.Client, old runtime.Object, user authenticationv1.UserInfo) error {
+	invocationLogger.Info("validate update", "name", r.Name, "user", user.String())
+	oldInvocation := old.(*ChaincodeInvocation)
+	if !reflect.DeepEqual(r.Spec, oldInvocation.Spec) {
+		return errInvocationImmutable
+	}
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ChaincodeInvocation) ValidateDelete(ctx context.Context, c client.Client, user authenticationv1.UserInfo) error {
+	invocationLogger.Info("validate delete", "name", r.Name, "user", user.String())
+	return nil
+}
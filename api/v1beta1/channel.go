@@ -18,12 +18,28 @@
 
 package v1beta1
 
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Blockchain/fabric-operator/pkg/provenance"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 func init() {
 	SchemeBuilder.Register(&Channel{}, &ChannelList{})
 }
 
 const CHANNEL_NETWORK_LABEL = "bestchains.channel.network"
 
+const (
+	// MaxChannelConfigHistory is the max number of config records kept in channel status
+	MaxChannelConfigHistory = 20
+	// MaxChannelConfigChanges is the max number of changes kept in a config record
+	MaxChannelConfigChanges = 50
+)
+
 func (channel *Channel) GetConnectionPorfile() string {
 	return "chan-" + channel.GetName() + "-connection-profile"
 }
@@ -59,6 +75,171 @@ func (channel *Channel) HashMembers() bool {
 	return len(channel.Spec.Members) > 0
 }
 
+// IsEmpty returns true if config updates nothing
+func (config *ChannelConfig) IsEmpty() bool {
+	return config.Orderer == nil && config.ApplicationCapabilities == nil && config.ChannelCapabilities == nil && len(config.ACLs) == 0
+}
+
+// Validate checks the channel config can be applied to a channel
+func (config *ChannelConfig) Validate() error {
+	if o := config.Orderer; o != nil {
+		if o.BatchTimeout != "" {
+			timeout, err := time.ParseDuration(o.BatchTimeout)
+			if err != nil {
+				return fmt.Errorf("invalid batch timeout: %w", err)
+			}
+			if timeout <= 0 {
+				return fmt.Errorf("batch timeout should be positive")
+			}
+		}
+		if b := o.BatchSize; b != nil && b.AbsoluteMaxBytes != 0 && b.PreferredMaxBytes > b.AbsoluteMaxBytes {
+			return fmt.Errorf("preferred max bytes %d should not exceed absolute max bytes %d", b.PreferredMaxBytes, b.AbsoluteMaxBytes)
+		}
+	}
+	for _, c := range append(config.ApplicationCapabilities, config.ChannelCapabilities...) {
+		if c == "" {
+			return fmt.Errorf("capability should not be empty")
+		}
+	}
+	for resource, policy := range config.ACLs {
+		if resource == "" || policy == "" {
+			return fmt.Errorf("acl should have both resource and policy")
+		}
+	}
+	return nil
+}
+
+// ValidateAnchorPeers checks each member is listed once with its own peers
+func ValidateAnchorPeers(anchorPeers []MemberAnchorPeers) error {
+	orgs := make(map[string]bool, len(anchorPeers))
+	for _, anchor := range anchorPeers {
+		if orgs[anchor.Organization] {
+			return fmt.Errorf("anchor peers of %s are set more than once", anchor.Organization)
+		}
+		orgs[anchor.Organization] = true
+		for _, p := range anchor.Peers {
+			if p.Namespace != anchor.Organization {
+				return fmt.Errorf("anchor peer %s does not belong to %s", p.String(), anchor.Organization)
+			}
+		}
+	}
+	return nil
+}
+
+// Merge returns a copy of config updated with the fields set in update
+func (config *ChannelConfig) Merge(update ChannelConfig) *ChannelConfig {
+	merged := &ChannelConfig{}
+	if config != nil {
+		config.DeepCopyInto(merged)
+	}
+	update = *update.DeepCopy()
+
+	if update.Orderer != nil {
+		if merged.Orderer == nil {
+			merged.Orderer = &ChannelOrdererConfig{}
+		}
+		if update.Orderer.BatchTimeout != "" {
+			merged.Orderer.BatchTimeout = update.Orderer.BatchTimeout
+		}
+		if b := update.Orderer.BatchSize; b != nil {
+			if merged.Orderer.BatchSize == nil {
+				merged.Orderer.BatchSize = &BatchSize{}
+			}
+			if b.MaxMessageCount != 0 {
+				merged.Orderer.BatchSize.MaxMessageCount = b.MaxMessageCount
+			}
+			if b.AbsoluteMaxBytes != 0 {
+				merged.Orderer.BatchSize.AbsoluteMaxBytes = b.AbsoluteMaxBytes
+			}
+			if b.PreferredMaxBytes != 0 {
+				merged.Orderer.BatchSize.PreferredMaxBytes = b.PreferredMaxBytes
+			}
+		}
+	}
+	if update.ApplicationCapabilities != nil {
+		merged.ApplicationCapabilities = update.ApplicationCapabilities
+	}
+	if update.ChannelCapabilities != nil {
+		merged.ChannelCapabilities = update.ChannelCapabilities
+	}
+	if len(update.ACLs) != 0 && merged.ACLs == nil {
+		merged.ACLs = make(map[string]string, len(update.ACLs))
+	}
+	for resource, policy := range update.ACLs {
+		merged.ACLs[resource] = policy
+	}
+	merged.Proposal = update.Proposal
+	return merged
+}
+
+// MergeAnchorPeers returns a copy of anchorPeers where the anchor peers of members listed in update are replaced
+func MergeAnchorPeers(anchorPeers []MemberAnchorPeers, update []MemberAnchorPeers) []MemberAnchorPeers {
+	merged := make([]MemberAnchorPeers, 0, len(anchorPeers)+len(update))
+	for _, anchor := range anchorPeers {
+		merged = append(merged, *anchor.DeepCopy())
+	}
+	for _, anchor := range update {
+		replaced := false
+		for i := range merged {
+			if merged[i].Organization == anchor.Organization {
+				merged[i] = *anchor.DeepCopy()
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, *anchor.DeepCopy())
+		}
+	}
+	return merged
+}
+
+// GetAnchorPeers returns the anchor peers of a member
+func (channel *Channel) GetAnchorPeers(org string) []NamespacedName {
+	for _, anchor := range channel.Spec.AnchorPeers {
+		if anchor.Organization == org {
+			return anchor.Peers
+		}
+	}
+	return nil
+}
+
+// IsJoined returns true if the peer has joined the channel
+func (condition PeerCondition) IsJoined() bool {
+	return condition.Type == PeerJoined || condition.Type == PeerAnchored
+}
+
+// ArchiveInProgress returns true if an archive or unarchive is not finished yet
+func (channel *Channel) ArchiveInProgress() bool {
+	archive := channel.Status.Archive
+	return archive != nil && archive.Phase != ArchiveCompleted && archive.Phase != UnarchiveCompleted
+}
+
+// SetPhase moves the archive to the next phase and clears the failure message
+func (archive *ChannelArchive) SetPhase(phase ChannelArchivePhase) {
+	archive.Phase = phase
+	archive.Message = ""
+	archive.LastTransitionTime = metav1.Now()
+}
+
+// GetSnapshot returns the snapshot taken in organization and its index, -1 if none
+func (archive *ChannelArchive) GetSnapshot(org string) (int, *PeerSnapshot) {
+	for i := range archive.Snapshots {
+		if archive.Snapshots[i].Namespace == org {
+			return i, &archive.Snapshots[i]
+		}
+	}
+	return -1, nil
+}
+
+// SnapshotOf returns the snapshot taken on the peer, nil if none
+func (archive *ChannelArchive) SnapshotOf(peer NamespacedName) *PeerSnapshot {
+	if _, snapshot := archive.GetSnapshot(peer.Namespace); snapshot != nil && snapshot.Name == peer.Name {
+		return snapshot
+	}
+	return nil
+}
+
 func DifferChannelPeers(old []NamespacedName, new []NamespacedName) (added []NamespacedName, removed []NamespacedName) {
 	// cache in map
 	oldMapper := make(map[string]NamespacedName, len(old))
@@ -85,3 +266,82 @@ func DifferChannelPeers(old []NamespacedName, new []NamespacedName) (added []Nam
 
 	return
 }
+
+// LatestConfigRecord returns the latest observed config record, nil if none
+func (channel *Channel) LatestConfigRecord() *ChannelConfigRecord {
+	if len(channel.Status.ConfigHistory) == 0 {
+		return nil
+	}
+	return &channel.Status.ConfigHistory[len(channel.Status.ConfigHistory)-1]
+}
+
+// AddConfigRecord appends record to config history and drops the oldest records beyond MaxChannelConfigHistory
+func (channel *Channel) AddConfigRecord(record ChannelConfigRecord) {
+	if len(record.Changes) > MaxChannelConfigChanges {
+		record.Truncated += len(record.Changes) - MaxChannelConfigChanges
+		record.Changes = record.Changes[:MaxChannelConfigChanges]
+	}
+	history := append(channel.Status.ConfigHistory, record)
+	if len(history) > MaxChannelConfigHistory {
+		history = history[len(history)-MaxChannelConfigHistory:]
+	}
+	channel.Status.ConfigHistory = history
+}
+
+// RemoveMembers removes orgs from channel members, as well as their peers and anchor peers
+func (channel *Channel) RemoveMembers(orgs []string) {
+	if len(orgs) == 0 {
+		return
+	}
+	removed := make(map[string]bool, len(orgs))
+	for _, org := range orgs {
+		removed[org] = true
+	}
+	members := make([]Member, 0, len(channel.Spec.Members))
+	for _, m := range channel.Spec.Members {
+		if !removed[m.Name] {
+			members = append(members, m)
+		}
+	}
+	channel.Spec.Members = members
+	peers := make([]NamespacedName, 0, len(channel.Spec.Peers))
+	for _, p := range channel.Spec.Peers {
+		if !removed[p.Namespace] {
+			peers = append(peers, p)
+		}
+	}
+	channel.Spec.Peers = peers
+	anchorPeers := make([]MemberAnchorPeers, 0, len(channel.Spec.AnchorPeers))
+	for _, a := range channel.Spec.AnchorPeers {
+		if !removed[a.Organization] {
+			anchorPeers = append(anchorPeers, a)
+		}
+	}
+	channel.Spec.AnchorPeers = anchorPeers
+}
+
+// Validate checks the trusted keys are ECDSA public keys
+func (policy *ProvenancePolicy) Validate() error {
+	if len(policy.TrustedKeys) == 0 {
+		return fmt.Errorf("chaincode provenance needs at least one trusted key")
+	}
+	for i, k := range policy.TrustedKeys {
+		if _, err := provenance.ParsePublicKey([]byte(k)); err != nil {
+			return fmt.Errorf("chaincode provenance trusted key %d: %s", i, err.Error())
+		}
+	}
+	return nil
+}
+
+// AllowSource returns true if source has one of the allowed prefixes
+func (policy *ProvenancePolicy) AllowSource(source string) bool {
+	if len(policy.Sources) == 0 {
+		return true
+	}
+	for _, prefix := range policy.Sources {
+		if strings.HasPrefix(source, prefix) {
+			return true
+		}
+	}
+	return false
+}
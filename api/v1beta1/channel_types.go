@@ -43,16 +43,111 @@ type ChannelSpec struct {
 	// Peers list all fabric peers joined at this channel
 	Peers []NamespacedName `json:"peers,omitempty"`
 
+	// AnchorPeers of each member. Members manage their own anchor peers,
+	// which must be listed in Peers. A member listed without peers has no anchor peer.
+	// +optional
+	AnchorPeers []MemberAnchorPeers `json:"anchorPeers,omitempty"`
+
 	// Description for this Channel
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
 	Description string `json:"description,omitempty"`
+
+	// Config is the channel configuration adopted by UpdateChannelConfig proposals
+	// on top of the configuration the channel was created with.
+	// Only superusers can update it directly.
+	// +optional
+	Config *ChannelConfig `json:"config,omitempty"`
+
+	// ChaincodeProvenance is enforced on the chaincode images deployed on this channel.
+	// Only superusers can update it directly.
+	// +optional
+	ChaincodeProvenance *ProvenancePolicy `json:"chaincodeProvenance,omitempty"`
+}
+
+// ProvenancePolicy requires chaincode images to come from a signed ChaincodeBuild
+type ProvenancePolicy struct {
+	// TrustedKeys are PEM encoded ECDSA public keys signing chaincode builds
+	// +kubebuilder:validation:MinItems:=1
+	TrustedKeys []string `json:"trustedKeys"`
+	// Sources are the allowed prefixes of build sources, any source is allowed if empty
+	// +optional
+	Sources []string `json:"sources,omitempty"`
+	// RequireSBOM requires builds to record a SBOM
+	// +optional
+	RequireSBOM bool `json:"requireSBOM,omitempty"`
+}
+
+// ChannelConfig defines the parts of the channel configuration which can be updated after creation.
+// Empty fields keep the current configuration.
+type ChannelConfig struct {
+	// Orderer defines how the ordering service cuts blocks for this channel
+	// +optional
+	Orderer *ChannelOrdererConfig `json:"orderer,omitempty"`
+
+	// ApplicationCapabilities replaces the application capabilities, e.g. V2_0
+	// +optional
+	ApplicationCapabilities []string `json:"applicationCapabilities,omitempty"`
+
+	// ChannelCapabilities replaces the channel capabilities, e.g. V2_0
+	// +optional
+	ChannelCapabilities []string `json:"channelCapabilities,omitempty"`
+
+	// ACLs overrides the policy of application resources,
+	// e.g. "peer/Propose": "/Channel/Application/Writers"
+	// +optional
+	ACLs map[string]string `json:"acls,omitempty"`
+
+	// Proposal which adopted this configuration
+	// +optional
+	Proposal string `json:"proposal,omitempty"`
+}
+
+// ChannelOrdererConfig defines the batch settings of the ordering service
+type ChannelOrdererConfig struct {
+	// BatchTimeout is the time to wait before cutting a block, e.g. 2s
+	// +optional
+	BatchTimeout string `json:"batchTimeout,omitempty"`
+
+	// BatchSize limits the size of blocks
+	// +optional
+	BatchSize *BatchSize `json:"batchSize,omitempty"`
+}
+
+// BatchSize limits the size of blocks. Zero values keep the current limit.
+type BatchSize struct {
+	// MaxMessageCount is the maximum number of transactions in a block
+	// +optional
+	MaxMessageCount uint32 `json:"maxMessageCount,omitempty"`
+	// AbsoluteMaxBytes is the maximum size of a block
+	// +optional
+	AbsoluteMaxBytes uint32 `json:"absoluteMaxBytes,omitempty"`
+	// PreferredMaxBytes is the preferred size of a block
+	// +optional
+	PreferredMaxBytes uint32 `json:"preferredMaxBytes,omitempty"`
+}
+
+// MemberAnchorPeers lists the anchor peers of one channel member
+type MemberAnchorPeers struct {
+	// Organization is the channel member which owns the peers
+	Organization string `json:"organization"`
+	// Peers used as anchor peers. Empty means no anchor peer.
+	// +optional
+	Peers []NamespacedName `json:"peers,omitempty"`
 }
 
 type PeerConditionType string
 
 const (
 	PeerJoined PeerConditionType = "PeerJoined"
-	PeerError  PeerConditionType = "PeerError"
+	// PeerAnchored means the peer joined and is an anchor peer of its organization
+	PeerAnchored PeerConditionType = "PeerAnchored"
+	PeerError    PeerConditionType = "PeerError"
+	// PeerSnapshotted means a snapshot of the channel ledger was taken on the peer before archiving
+	PeerSnapshotted PeerConditionType = "PeerSnapshotted"
+	// PeerUnjoining means the peer is restarting to unjoin the archived channel
+	PeerUnjoining PeerConditionType = "PeerUnjoining"
+	// PeerArchived means the peer unjoined the archived channel
+	PeerArchived PeerConditionType = "PeerArchived"
 )
 
 // ChannelPeer is the IBPPeer which joins this channel
@@ -79,6 +174,129 @@ type ChannelStatus struct {
 	CRStatus       `json:",inline"`
 	ArchivedStatus CRStatus        `json:"archivedStatus,omitempty"`
 	PeerConditions []PeerCondition `json:"peerConditions,omitempty"`
+	// AppliedConfig records the last channel configuration update
+	// +optional
+	AppliedConfig *AppliedChannelConfig `json:"appliedConfig,omitempty"`
+	// ConfigHistory records the config blocks observed on this channel, oldest first.
+	// At most MaxChannelConfigHistory records are kept.
+	// +optional
+	ConfigHistory []ChannelConfigRecord `json:"configHistory,omitempty"`
+	// ObservedConfig is the flattened(path to value) config of the latest observed config block,
+	// which the next config block is compared with
+	// +optional
+	ObservedConfig map[string]string `json:"observedConfig,omitempty"`
+	// Archive is the progress of the last archive or unarchive
+	// +optional
+	Archive *ChannelArchive `json:"archive,omitempty"`
+}
+
+// ChannelArchivePhase is a step of archiving or unarchiving a channel
+type ChannelArchivePhase string
+
+const (
+	// ArchiveSnapshotting takes a snapshot of the channel ledger on one peer per organization
+	ArchiveSnapshotting ChannelArchivePhase = "Snapshotting"
+	// ArchiveRemovingOrderers removes the channel from all orderers but the keeper
+	ArchiveRemovingOrderers ChannelArchivePhase = "RemovingOrderers"
+	// ArchiveUnjoiningPeers restarts the peers to unjoin the channel
+	ArchiveUnjoiningPeers ChannelArchivePhase = "UnjoiningPeers"
+	// ArchiveCompleted means the channel is archived
+	ArchiveCompleted ChannelArchivePhase = "Archived"
+	// UnarchiveRestoringOrderers joins the removed orderers back into the channel
+	UnarchiveRestoringOrderers ChannelArchivePhase = "RestoringOrderers"
+	// UnarchiveRejoiningPeers joins the peers back into the channel, from the snapshots if any
+	UnarchiveRejoiningPeers ChannelArchivePhase = "RejoiningPeers"
+	// UnarchiveCompleted means the channel is served again
+	UnarchiveCompleted ChannelArchivePhase = "Unarchived"
+)
+
+// ChannelArchive records how a channel was archived, which is used to unarchive it
+type ChannelArchive struct {
+	// Proposal which archived or unarchived the channel
+	Proposal string `json:"proposal"`
+	// Phase is the current step
+	Phase ChannelArchivePhase `json:"phase"`
+	// Snapshots of the channel ledger, at most one per organization
+	// +optional
+	Snapshots []PeerSnapshot `json:"snapshots,omitempty"`
+	// Keeper is the orderer which keeps the channel ledger while archived.
+	// The removed orderers replicate the channel from it when unarchived.
+	// +optional
+	Keeper *NamespacedName `json:"keeper,omitempty"`
+	// RemovedOrderers are the orderers the channel was removed from
+	// +optional
+	RemovedOrderers []NamespacedName `json:"removedOrderers,omitempty"`
+	// ConfigBlock is the configmap which holds the last config block, used to join the removed orderers again
+	// +optional
+	ConfigBlock *NamespacedName `json:"configBlock,omitempty"`
+	// Message describes the last failure of the current phase
+	// +optional
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime is the time the phase changed
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// PeerSnapshot is a snapshot of the channel ledger on a peer
+type PeerSnapshot struct {
+	NamespacedName `json:",inline"`
+	// BlockNumber is the last block in the snapshot
+	BlockNumber uint64 `json:"blockNumber"`
+	// Path is the snapshot directory on the peer's volume
+	Path string `json:"path"`
+	// Completed is true once the peer has generated the snapshot
+	// +optional
+	Completed bool `json:"completed,omitempty"`
+}
+
+// ChannelConfigRecord describes a config block and what it changed
+type ChannelConfigRecord struct {
+	// Sequence of the channel config in this block
+	Sequence uint64 `json:"sequence"`
+	// BlockNumber of the config block
+	BlockNumber uint64 `json:"blockNumber"`
+	// TxID of the config transaction
+	// +optional
+	TxID string `json:"txID,omitempty"`
+	// Creator is the MSP ID which submitted the config update
+	// +optional
+	Creator string `json:"creator,omitempty"`
+	// Signers are the MSP IDs which signed the config update
+	// +optional
+	Signers []string `json:"signers,omitempty"`
+	// Timestamp of the config transaction
+	// +optional
+	Timestamp metav1.Time `json:"timestamp,omitempty"`
+	// Changes against the previous observed config. At most MaxChannelConfigChanges changes are kept.
+	// +optional
+	Changes []ChannelConfigChange `json:"changes,omitempty"`
+	// Truncated is the number of changes dropped from Changes
+	// +optional
+	Truncated int `json:"truncated,omitempty"`
+}
+
+// ChannelConfigChange is the change of one config item, e.g. Application/Organizations/org1/Policies/Admins
+type ChannelConfigChange struct {
+	Path string `json:"path"`
+	// Old value, empty when the item is added
+	// +optional
+	Old string `json:"old,omitempty"`
+	// New value, empty when the item is removed
+	// +optional
+	New string `json:"new,omitempty"`
+}
+
+// AppliedChannelConfig describes a channel configuration update submitted to the ordering service
+type AppliedChannelConfig struct {
+	// Sequence is the config sequence of the channel after this update
+	Sequence uint64 `json:"sequence"`
+	// TxID of the config update transaction
+	TxID string `json:"txID,omitempty"`
+	// Proposal which adopted this configuration
+	// +optional
+	Proposal string `json:"proposal,omitempty"`
+	// AppliedAt is the time the update was submitted
+	AppliedAt metav1.Time `json:"appliedAt,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -21,7 +21,9 @@ package v1beta1
 import (
 	"context"
 	"fmt"
+	"reflect"
 
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
 	"github.com/pkg/errors"
 	authenticationv1 "k8s.io/api/authentication/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -41,7 +43,11 @@ var (
 	errUpdateChannelNetwork = errors.New("cant update channel's network")
 	errUpdateChannelID      = errors.New("can not update channels' id")
 	errUpdateChannelMember  = errors.New("cant update channel's members directly(must use proposal-vote)")
+	errUpdateChannelConfig  = errors.New("cant update channel's config directly(must use proposal-vote)")
+	errUpdateProvenance     = errors.New("cant update channel's chaincode provenance directly")
 	errChannelHasPeers      = errors.New("channel still have peers joined")
+	errAnchorPeerNotMember  = errors.New("anchor peers can only be set for channel members")
+	errAnchorPeerNotJoined  = errors.New("anchor peer should be one of channel's peers")
 )
 
 // log is for logging in this package.
@@ -75,6 +81,17 @@ func (r *Channel) ValidateCreate(ctx context.Context, c client.Client, user auth
 		return err
 	}
 
+	if r.Spec.Config != nil {
+		if err = r.Spec.Config.Validate(); err != nil {
+			return err
+		}
+	}
+	if r.Spec.ChaincodeProvenance != nil {
+		if err = r.Spec.ChaincodeProvenance.Validate(); err != nil {
+			return err
+		}
+	}
+
 	// managedOrgs which this user can manage
 	managedOrgs, err := filterManagedOrgs(ctx, c, user, r.Spec.Members)
 	if err != nil {
@@ -85,6 +102,10 @@ func (r *Channel) ValidateCreate(ctx context.Context, c client.Client, user auth
 	if err != nil {
 		return err
 	}
+	err = validateAnchorPeers(r.Spec.Members, r.Spec.Peers, r.Spec.AnchorPeers)
+	if err != nil {
+		return err
+	}
 	return validateChannelID(ctx, c, r.Spec.Network, r.Spec.ID)
 }
 
@@ -110,10 +131,35 @@ func (r *Channel) ValidateUpdate(ctx context.Context, c client.Client, old runti
 		}
 	}
 
+	// forbid to update channel config directly
+	if !reflect.DeepEqual(oldChannel.Spec.Config, r.Spec.Config) {
+		if !isSuperUser(ctx, user) {
+			return errUpdateChannelConfig
+		}
+		if r.Spec.Config != nil {
+			if err := r.Spec.Config.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// forbid to update chaincode provenance directly
+	if !reflect.DeepEqual(oldChannel.Spec.ChaincodeProvenance, r.Spec.ChaincodeProvenance) {
+		if !isSuperUser(ctx, user) {
+			return errUpdateProvenance
+		}
+		if r.Spec.ChaincodeProvenance != nil {
+			if err := r.Spec.ChaincodeProvenance.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
 	// forbid to update peers which not belongs to user's organizations
 	addedPeers, removedPeers := DifferChannelPeers(oldChannel.Spec.Peers, r.Spec.Peers)
 	if len(addedPeers) != 0 || len(removedPeers) != 0 {
-		managedOrgs, err := filterManagedOrgs(ctx, c, user, r.Spec.Members)
+		// peers of removed members are removed along with them
+		managedOrgs, err := filterManagedOrgs(ctx, c, user, append(append([]Member{}, r.Spec.Members...), removed...))
 		if err != nil {
 			return err
 		}
@@ -124,6 +170,22 @@ func (r *Channel) ValidateUpdate(ctx context.Context, c client.Client, old runti
 		}
 	}
 
+	// forbid to update anchor peers of organizations not managed by user
+	if err := validateAnchorPeers(r.Spec.Members, r.Spec.Peers, r.Spec.AnchorPeers); err != nil {
+		return err
+	}
+	if updatedOrgs := differAnchorPeers(oldChannel, r); len(updatedOrgs) != 0 {
+		managedOrgs, err := filterManagedOrgs(ctx, c, user, r.Spec.Members)
+		if err != nil {
+			return err
+		}
+		for _, org := range updatedOrgs {
+			if !util.ContainsValue(org, managedOrgs) {
+				return errors.Wrapf(errNoPermOperatePeer, "anchor peers of %s not in %v", org, managedOrgs)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -164,6 +226,49 @@ func validateMemberInNetwork(ctx context.Context, c client.Client, netName strin
 	return nil
 }
 
+// validateAnchorPeers make sure anchor peers are joined peers of channel members
+func validateAnchorPeers(members []Member, peers []NamespacedName, anchorPeers []MemberAnchorPeers) error {
+	if err := ValidateAnchorPeers(anchorPeers); err != nil {
+		return err
+	}
+	for _, anchor := range anchorPeers {
+		isMember := false
+		for _, m := range members {
+			if m.Name == anchor.Organization {
+				isMember = true
+				break
+			}
+		}
+		if !isMember {
+			return errors.Wrap(errAnchorPeerNotMember, anchor.Organization)
+		}
+		for _, anchorPeer := range anchor.Peers {
+			joined := false
+			for _, p := range peers {
+				if p.String() == anchorPeer.String() {
+					joined = true
+					break
+				}
+			}
+			if !joined {
+				return errors.Wrap(errAnchorPeerNotJoined, anchorPeer.String())
+			}
+		}
+	}
+	return nil
+}
+
+// differAnchorPeers returns the organizations whose anchor peers changed
+func differAnchorPeers(oldChannel, newChannel *Channel) []string {
+	orgs := make([]string, 0)
+	for _, m := range newChannel.Spec.Members {
+		if !reflect.DeepEqual(oldChannel.GetAnchorPeers(m.Name), newChannel.GetAnchorPeers(m.Name)) {
+			orgs = append(orgs, m.Name)
+		}
+	}
+	return orgs
+}
+
 // filterManagedOrgs will get the organizations which under user's management
 func filterManagedOrgs(ctx context.Context, c client.Client, user authenticationv1.UserInfo, members []Member) ([]string, error) {
 	var err error
@@ -172,13 +277,21 @@ func filterManagedOrgs(ctx context.Context, c client.Client, user authentication
 	managedOrgs := make([]string, 0)
 	// validate ownership
 	for _, member := range members {
+		// external organizations are managed in their own clusters
+		var external bool
+		if external, err = IsExternalOrganization(ctx, c, member.Name); err != nil {
+			return nil, errors.Wrap(err, "failed to get external organization")
+		}
+		if external {
+			continue
+		}
 		org := &Organization{}
 		org.Name = member.Name
 		err = c.Get(ctx, client.ObjectKeyFromObject(org), org)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to get organization")
 		}
-		if isSuperUser(ctx, user) || org.Spec.Admin == user.Username {
+		if isSuperUser(ctx, user) || org.IsAdmin(user.Username) {
 			managedOrgs = append(managedOrgs, member.Name)
 		}
 	}
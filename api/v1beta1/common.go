@@ -66,3 +66,36 @@ func (nsn NamespacedName) String() string {
 func (policy Policy) String() string {
 	return strings.ToLower(string(policy))
 }
+
+// Validate checks the rule can be evaluated.
+func (rule *VotingRule) Validate() error {
+	if err := rule.Threshold.Validate(); err != nil {
+		return fmt.Errorf("invalid threshold: %w", err)
+	}
+	switch rule.ThresholdBase {
+	case "", ThresholdBaseElectorate, ThresholdBaseCast:
+	default:
+		return fmt.Errorf("invalid threshold base: %s", rule.ThresholdBase)
+	}
+	if rule.Quorum != nil {
+		if err := rule.Quorum.Validate(); err != nil {
+			return fmt.Errorf("invalid quorum: %w", err)
+		}
+	}
+	return nil
+}
+
+// Validate checks the ratio is a fraction above 0 and up to 1.
+// A numerator of 0 would be reached without any weight at all.
+func (ratio Ratio) Validate() error {
+	if ratio.Denominator < 1 {
+		return errors.New("denominator should be at least 1")
+	}
+	if ratio.Numerator < 1 || ratio.Numerator > ratio.Denominator {
+		return errors.New("numerator should be between 1 and denominator")
+	}
+	if ratio.Exclusive && ratio.Numerator == ratio.Denominator {
+		return errors.New("an exclusive ratio can not be reached when numerator equals denominator")
+	}
+	return nil
+}
@@ -394,10 +394,57 @@ const (
 	OneVoteVeto Policy = "OneVoteVeto"
 	Majority    Policy = "Majority"
 	ALL         Policy = "All"
+	// Custom tallies votes with the VotingRule declared next to the policy.
+	Custom Policy = "Custom"
 )
 
 var PolicyMap = map[string]bool{
 	OneVoteVeto.String(): true,
 	Majority.String():    true,
 	ALL.String():         true,
+	Custom.String():      true,
+}
+
+// ThresholdBase is the weight a VotingRule threshold is measured against.
+type ThresholdBase string
+
+const (
+	// ThresholdBaseElectorate measures approvals against the weight of every organization allowed to vote.
+	ThresholdBaseElectorate ThresholdBase = "Electorate"
+	// ThresholdBaseCast measures approvals against the weight of approving and rejecting votes only,
+	// so abstentions neither help nor hurt the proposal.
+	ThresholdBaseCast ThresholdBase = "Cast"
+)
+
+// Ratio is an N-of-M fraction of voting weight.
+// +k8s:deepcopy-gen=true
+type Ratio struct {
+	// Numerator is the N in N-of-M.
+	// +kubebuilder:validation:Minimum=1
+	Numerator int32 `json:"numerator"`
+	// Denominator is the M in N-of-M.
+	// +kubebuilder:validation:Minimum=1
+	Denominator int32 `json:"denominator"`
+	// Exclusive requires strictly more than the fraction instead of at least the fraction.
+	// +optional
+	Exclusive bool `json:"exclusive,omitempty"`
+}
+
+// VotingRule describes how the votes of a proposal are tallied.
+// OneVoteVeto, Majority and All are presets of it, Custom uses it as declared.
+// +k8s:deepcopy-gen=true
+type VotingRule struct {
+	// Threshold is the share of weight that must approve for the proposal to be adopted.
+	Threshold Ratio `json:"threshold"`
+	// ThresholdBase selects the weight the threshold is measured against.
+	// +kubebuilder:validation:Enum=Electorate;Cast
+	// +optional
+	ThresholdBase ThresholdBase `json:"thresholdBase,omitempty"`
+	// Quorum is the minimum share of the electorate weight that must take part,
+	// either by approving, rejecting or abstaining.
+	// +optional
+	Quorum *Ratio `json:"quorum,omitempty"`
+	// Veto makes a single rejection fail the proposal.
+	// +optional
+	Veto bool `json:"veto,omitempty"`
 }
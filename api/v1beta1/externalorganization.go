@@ -0,0 +1,161 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/IBM-Blockchain/fabric-operator/pkg/artifact"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	SchemeBuilder.Register(&ExternalOrganization{}, &ExternalOrganizationList{})
+}
+
+// JoinPayload returns the payload signed by the join package.
+// It is the JSON encoding of the organization name and spec without the join package.
+func (o *ExternalOrganization) JoinPayload() []byte {
+	// json.Marshal of a struct keeps the field order, so the payload is stable
+	b, _ := json.Marshal(struct {
+		Name     string         `json:"name"`
+		MSP      ExternalMSP    `json:"msp"`
+		Peers    []ExternalNode `json:"peers,omitempty"`
+		Orderers []ExternalNode `json:"orderers,omitempty"`
+	}{
+		Name:     o.GetName(),
+		MSP:      o.Spec.MSP,
+		Peers:    o.Spec.Peers,
+		Orderers: o.Spec.Orderers,
+	})
+	return b
+}
+
+// VerifyJoinPackage checks the join package is signed by an admin of the organization
+func (o *ExternalOrganization) VerifyJoinPackage() error {
+	return errors.Wrap(o.verify(o.JoinPayload(), o.Spec.JoinPackage), "invalid join package")
+}
+
+// VerifyUpdate checks the join package is signed by an admin of existing, the definition o replaces.
+// A join package verified against its own MSP proves nothing about who replaces the MSP,
+// so the signer must be an admin of both the existing and the new MSP
+func (o *ExternalOrganization) VerifyUpdate(existing *ExternalOrganization) error {
+	if err := o.VerifyJoinPackage(); err != nil {
+		return err
+	}
+	return errors.Wrap(existing.verify(o.JoinPayload(), o.Spec.JoinPackage), "join package must be signed by an admin of the existing msp")
+}
+
+// VerifyVote checks vote is signed by an admin of the organization for proposal
+func (o *ExternalOrganization) VerifyVote(proposal *Proposal, vote ExternalVote) error {
+	if vote.Organization != o.GetName() {
+		return errors.Errorf("vote of %s can not be verified by %s", vote.Organization, o.GetName())
+	}
+	return errors.Wrapf(o.verify(vote.Payload(proposal), vote.Signed), "invalid vote of %s", vote.Organization)
+}
+
+// verify checks payload is signed by one of the admins, whose certificate is issued by the organization's CAs
+func (o *ExternalOrganization) verify(payload []byte, signed SignedArtifact) error {
+	cert, err := artifact.Verify(payload, signed.Signature, signed.Certificate, o.Spec.MSP.RootCerts, o.Spec.MSP.IntermediateCerts)
+	if err != nil {
+		return err
+	}
+	for _, admin := range o.Spec.MSP.Admins {
+		adminCert, err := artifact.ParseCertificate(admin)
+		if err != nil {
+			return errors.Wrap(err, "invalid admin certificate")
+		}
+		if bytes.Equal(adminCert.Raw, cert.Raw) {
+			return nil
+		}
+	}
+	return errors.New("signer is not an admin of the organization")
+}
+
+// GetTLSCACert returns the TLS CA certificate of node
+func (o *ExternalOrganization) GetTLSCACert(node ExternalNode) string {
+	if node.TLSCACert != "" || len(o.Spec.MSP.TLSRootCerts) == 0 {
+		return node.TLSCACert
+	}
+	return o.Spec.MSP.TLSRootCerts[0]
+}
+
+// Payload returns the payload signed by an external vote.
+// It binds the decision to the federation, the name and UID of proposal and the digest of its spec,
+// so a vote can not be replayed on a proposal recreated under the same name.
+func (v ExternalVote) Payload(proposal *Proposal) []byte {
+	b, _ := json.Marshal(struct {
+		Federation     string      `json:"federation"`
+		Proposal       string      `json:"proposal"`
+		ProposalUID    types.UID   `json:"proposalUID"`
+		ProposalDigest string      `json:"proposalDigest"`
+		Organization   string      `json:"organization"`
+		Decision       *bool       `json:"decision,omitempty"`
+		Abstain        bool        `json:"abstain,omitempty"`
+		Description    string      `json:"description,omitempty"`
+		VoteTime       metav1.Time `json:"voteTime"`
+	}{
+		Federation:     proposal.Spec.Federation,
+		Proposal:       proposal.GetName(),
+		ProposalUID:    proposal.GetUID(),
+		ProposalDigest: proposal.SpecDigest(),
+		Organization:   v.Organization,
+		Decision:       v.Decision,
+		Abstain:        v.Abstain,
+		Description:    v.Description,
+		VoteTime:       v.VoteTime,
+	})
+	return b
+}
+
+// Result returns the vote result recorded in proposal status
+func (v ExternalVote) Result() VoteResult {
+	return VoteResult{
+		Organization: NamespacedName{Name: v.Organization},
+		Decision:     v.Decision,
+		Abstain:      v.Abstain,
+		Description:  v.Description,
+		Phase:        VoteVoted,
+		VoteTime:     v.VoteTime,
+	}
+}
+
+// GetExternalOrganization returns the external organization with name, or nil if name is not an external organization
+func GetExternalOrganization(ctx context.Context, c client.Reader, name string) (*ExternalOrganization, error) {
+	o := &ExternalOrganization{}
+	if err := c.Get(ctx, types.NamespacedName{Name: name}, o); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return o, nil
+}
+
+// IsExternalOrganization returns whether the member name refers to an external organization
+func IsExternalOrganization(ctx context.Context, c client.Reader, name string) (bool, error) {
+	o, err := GetExternalOrganization(ctx, c, name)
+	return o != nil, err
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExternalOrganizationSpec defines an organization hosted in another cluster.
+// The name of ExternalOrganization is the MSP ID of the organization, which is how
+// it is referenced in `Federation`, `Network` and `Channel` members.
+// +k8s:deepcopy-gen=true
+type ExternalOrganizationSpec struct {
+	// DisplayName for this organization
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// MSP is the membership service provider definition of the organization
+	MSP ExternalMSP `json:"msp"`
+
+	// Peers are the endpoints of the organization's peers
+	// +optional
+	Peers []ExternalNode `json:"peers,omitempty"`
+
+	// Orderers are the endpoints of the organization's orderers
+	// +optional
+	Orderers []ExternalNode `json:"orderers,omitempty"`
+
+	// JoinPackage is the signature of an organization admin over the definition above,
+	// see `ExternalOrganization.JoinPayload`
+	JoinPackage SignedArtifact `json:"joinPackage"`
+}
+
+// ExternalMSP holds the certificates of an organization's MSP, all base64 encoded PEM
+type ExternalMSP struct {
+	// RootCerts are the certificates of the organization's root CAs
+	RootCerts []string `json:"rootCerts"`
+	// +optional
+	IntermediateCerts []string `json:"intermediateCerts,omitempty"`
+	// TLSRootCerts are the certificates of the organization's TLS root CAs
+	TLSRootCerts []string `json:"tlsRootCerts"`
+	// +optional
+	TLSIntermediateCerts []string `json:"tlsIntermediateCerts,omitempty"`
+	// Admins are the certificates of the organization's admins
+	Admins []string `json:"admins"`
+	// NodeOUs is the content of the MSP config.yaml, which classifies identities by OU
+	// +optional
+	NodeOUs string `json:"nodeOUs,omitempty"`
+}
+
+// ExternalNode is the endpoint of a peer or orderer in another cluster
+type ExternalNode struct {
+	Name string `json:"name"`
+	// URL is the grpcs address of the node
+	URL string `json:"url"`
+	// TLSCACert is the base64 encoded PEM certificate of the CA which issued the node's TLS certificate,
+	// the first TLS root cert of the MSP is used when it is empty
+	// +optional
+	TLSCACert string `json:"tlsCACert,omitempty"`
+}
+
+// SignedArtifact is the signature of an artifact exchanged out of band with another cluster
+type SignedArtifact struct {
+	// Signature is the base64 encoded ASN.1 ECDSA signature over the SHA-256 digest of the artifact payload
+	Signature string `json:"signature"`
+	// Certificate is the base64 encoded PEM certificate of the signer
+	Certificate string `json:"certificate"`
+}
+
+// ExternalVote is the vote of an external organization on a proposal, signed in its own cluster
+type ExternalVote struct {
+	Organization string `json:"organization"`
+	// +optional
+	Decision *bool `json:"decision,omitempty"`
+	// +optional
+	Abstain bool `json:"abstain,omitempty"`
+	// +optional
+	Description string `json:"description,omitempty"`
+	// VoteTime is when the vote was signed
+	VoteTime metav1.Time `json:"voteTime"`
+	// Signed is the signature of an organization admin over the vote, see `ExternalVote.Payload`
+	Signed SignedArtifact `json:"signed"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=extorg;extorgs
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// ExternalOrganization is the Schema for the externalorganizations API
+type ExternalOrganization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ExternalOrganizationSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// ExternalOrganizationList contains a list of ExternalOrganization
+type ExternalOrganizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExternalOrganization `json:"items"`
+}
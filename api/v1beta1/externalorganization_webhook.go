@@ -0,0 +1,120 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// log is for logging in this package.
+var externalorganizationlog = logf.Log.WithName("externalorganization-resource")
+
+var (
+	errNotOrganizationAdmin       = errors.New("only admins of organizations in this cluster can import external organizations")
+	errOrganizationExists         = errors.New("an organization with the same name exists in this cluster")
+	errExternalOrganizationExists = errors.New("an external organization with the same name exists")
+	errExternalInFederation       = errors.New("the external organization is a member of one federation")
+	errExternalInitiator          = errors.New("an external organization can not be the initiator")
+)
+
+//+kubebuilder:webhook:path=/validate-ibp-com-v1beta1-externalorganization,mutating=false,failurePolicy=fail,sideEffects=None,groups=ibp.com,resources=externalorganizations,verbs=create;update;delete,versions=v1beta1,name=externalorganization.validate.webhook,admissionReviewVersions=v1
+
+var _ validator = &ExternalOrganization{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ExternalOrganization) ValidateCreate(ctx context.Context, c client.Client, user authenticationv1.UserInfo) error {
+	externalorganizationlog.Info("validate create", "name", r.Name, "user", user.String())
+	if err := validateOrganizationAdmin(ctx, c, user); err != nil {
+		return err
+	}
+	org := &Organization{}
+	if err := c.Get(ctx, types.NamespacedName{Name: r.GetName()}, org); err == nil {
+		return errOrganizationExists
+	} else if !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "failed to get organization")
+	}
+	return r.VerifyJoinPackage()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ExternalOrganization) ValidateUpdate(ctx context.Context, c client.Client, old runtime.Object, user authenticationv1.UserInfo) error {
+	externalorganizationlog.Info("validate update", "name", r.Name, "user", user.String())
+	if err := validateOrganizationAdmin(ctx, c, user); err != nil {
+		return err
+	}
+	// an update must come with a join package signed over the new definition by a current admin
+	return r.VerifyUpdate(old.(*ExternalOrganization))
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ExternalOrganization) ValidateDelete(ctx context.Context, c client.Client, user authenticationv1.UserInfo) error {
+	externalorganizationlog.Info("validate delete", "name", r.Name, "user", user.String())
+	if err := validateOrganizationAdmin(ctx, c, user); err != nil {
+		return err
+	}
+	federationList := &FederationList{}
+	if err := c.List(ctx, federationList); err != nil {
+		return errors.Wrap(err, "cant get federation list")
+	}
+	for _, fed := range federationList.Items {
+		for _, m := range fed.Spec.Members {
+			if m.Name == r.Name {
+				return errors.Wrap(errExternalInFederation, fed.GetName())
+			}
+		}
+	}
+	return nil
+}
+
+// validateOrganizationAdmin makes sure user is the admin of an organization in this cluster
+func validateOrganizationAdmin(ctx context.Context, c client.Client, user authenticationv1.UserInfo) error {
+	if isSuperUser(ctx, user) {
+		return nil
+	}
+	orgList := &OrganizationList{}
+	if err := c.List(ctx, orgList); err != nil {
+		return errors.Wrap(err, "cant get organization list")
+	}
+	for _, org := range orgList.Items {
+		if org.IsAdmin(user.Username) {
+			return nil
+		}
+	}
+	return errNotOrganizationAdmin
+}
+
+// validateNotExternal makes sure organization is hosted in this cluster
+func validateNotExternal(ctx context.Context, c client.Client, organization string) error {
+	external, err := IsExternalOrganization(ctx, c, organization)
+	if err != nil {
+		return errors.Wrap(err, "failed to get external organization")
+	}
+	if external {
+		return errors.Wrap(errExternalInitiator, organization)
+	}
+	return nil
+}
@@ -45,6 +45,15 @@ func (federation *Federation) HasInitiator() bool {
 	return false
 }
 
+func (federation *Federation) HasMember(name string) bool {
+	for _, m := range federation.Spec.Members {
+		if m.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (federation *Federation) HasMultiInitiator() bool {
 	var multi bool
 	for _, m := range federation.Spec.Members {
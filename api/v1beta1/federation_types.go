@@ -42,6 +42,17 @@ type FederationSpec struct {
 	// Policy indicates the rules that this Federation make dicisions
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
 	Policy Policy `json:"policy"`
+
+	// VotingRule is required when Policy is Custom.
+	// Proposals of the federation without a policy, or with a Custom policy but no voting rule, use Policy and VotingRule
+	// +optional
+	VotingRule *VotingRule `json:"votingRule,omitempty"`
+
+	// Weights is the voting weight of each member, keyed by organization name.
+	// Members not listed here have a weight of 1. Weights can't be changed once set,
+	// the weight of a member is only dropped when it leaves the federation.
+	// +optional
+	Weights map[string]int32 `json:"weights,omitempty"`
 }
 
 // Member in a Fedeartion
@@ -21,6 +21,7 @@ package v1beta1
 import (
 	"context"
 	"fmt"
+	"reflect"
 
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -40,6 +41,7 @@ var (
 	errNoPermission  = errors.New("the operator is not the admin user of the initiator organization")
 	errInvalidPolicy = errors.New("the policy is invalid")
 	errUpdatePolicy  = errors.New("do not support update policy now")
+	errNoVotingRule  = errors.New("the custom policy should have a voting rule")
 )
 
 //+kubebuilder:webhook:path=/mutate-ibp-com-v1beta1-federation,mutating=true,failurePolicy=fail,sideEffects=None,groups=ibp.com,resources=federations,verbs=create;update,versions=v1beta1,name=federation.mutate.webhook,admissionReviewVersions=v1
@@ -58,8 +60,12 @@ var _ validator = &Federation{}
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (r *Federation) ValidateCreate(ctx context.Context, client client.Client, user authenticationv1.UserInfo) error {
 	federationlog.Info("validate create", "name", r.Name, "user", user.String())
-	if ok := PolicyMap[r.Spec.Policy.String()]; !ok {
-		return errInvalidPolicy
+	if err := validatePolicy(r.Spec.Policy, r.Spec.VotingRule); err != nil {
+		return err
+	}
+
+	if err := validateWeights(r.Spec.Weights, r.Spec.Members); err != nil {
+		return err
 	}
 
 	if err := validateInitiator(ctx, client, user, r.Spec.Members); err != nil {
@@ -83,6 +89,16 @@ func (r *Federation) ValidateUpdate(ctx context.Context, client client.Client, o
 	if r.Spec.Policy.String() != oldFederation.Spec.Policy.String() {
 		return errUpdatePolicy
 	}
+	if !reflect.DeepEqual(r.Spec.VotingRule, oldFederation.Spec.VotingRule) {
+		return errUpdatePolicy
+	}
+	if weightsChanged(oldFederation.Spec.Weights, r.Spec.Weights, r.Spec.Members) {
+		return errUpdatePolicy
+	}
+
+	if err := validateWeights(r.Spec.Weights, r.Spec.Members); err != nil {
+		return err
+	}
 
 	if err := validateInitiator(ctx, client, user, r.Spec.Members); err != nil {
 		return err
@@ -110,6 +126,56 @@ func (r *Federation) ValidateDelete(ctx context.Context, client client.Client, u
 	return nil
 }
 
+// validatePolicy checks policy is known and rule, required by the Custom policy, can be evaluated
+func validatePolicy(policy Policy, rule *VotingRule) error {
+	if ok := PolicyMap[policy.String()]; !ok {
+		return errInvalidPolicy
+	}
+	if policy.String() == Custom.String() && rule == nil {
+		return errNoVotingRule
+	}
+	if rule == nil {
+		return nil
+	}
+	return rule.Validate()
+}
+
+// validateWeights checks every weight is positive and belongs to a member
+func validateWeights(weights map[string]int32, members []Member) error {
+	for org, weight := range weights {
+		if weight < 1 {
+			return fmt.Errorf("the weight of %s should be at least 1", org)
+		}
+		found := false
+		for _, member := range members {
+			if member.Name == org {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("weight is set for %s, which is not a member of the federation", org)
+		}
+	}
+	return nil
+}
+
+// weightsChanged returns true if the weight of any remaining member changed,
+// only weights of members removed from the federation may be dropped
+func weightsChanged(oldWeights, newWeights map[string]int32, members []Member) bool {
+	for org, weight := range newWeights {
+		if oldWeights[org] != weight {
+			return true
+		}
+	}
+	for _, member := range members {
+		if _, ok := oldWeights[member.Name]; ok && newWeights[member.Name] != oldWeights[member.Name] {
+			return true
+		}
+	}
+	return false
+}
+
 // validateFedMemberUpdate ensure the initiator of a network or members of a channel are not allowed to be deleted.
 // Duplicate members are also not allowed among newMembers.
 func validateFedMemberUpdate(ctx context.Context, c client.Client, oldMembers, newMembers []Member, federationName string) error {
@@ -171,12 +237,15 @@ func validateInitiator(ctx context.Context, c client.Client, user authentication
 	if org.Name == "" {
 		return errNoInitiator
 	}
+	if err := validateNotExternal(ctx, c, org.Name); err != nil {
+		return err
+	}
 	if !isSuperUser(ctx, user) {
 		err := c.Get(ctx, client.ObjectKeyFromObject(org), org)
 		if err != nil {
 			return errors.Wrap(err, "failed to get initiator organization")
 		}
-		if org.Spec.Admin != user.Username {
+		if !org.IsAdmin(user.Username) {
 			return errNoPermission
 		}
 	}
@@ -185,9 +254,17 @@ func validateInitiator(ctx context.Context, c client.Client, user authentication
 
 func validateOrganization(ctx context.Context, c client.Client, organization string) error {
 	federationlog.Info("validate organization: %s", organization)
+	// external organizations are verified by their join package upon import
+	external, err := IsExternalOrganization(ctx, c, organization)
+	if err != nil {
+		return errors.Wrap(err, "failed to get external organization")
+	}
+	if external {
+		return nil
+	}
 	org := &Organization{}
 	org.Name = organization
-	err := c.Get(ctx, client.ObjectKeyFromObject(org), org)
+	err = c.Get(ctx, client.ObjectKeyFromObject(org), org)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get organization %s", organization)
 	}
@@ -0,0 +1,41 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package v1beta1
+
+import "testing"
+
+func TestWeightsChanged(t *testing.T) {
+	old := map[string]int32{"org1": 2, "org2": 3}
+	members := []Member{{Name: "org1"}, {Name: "org2"}}
+	if weightsChanged(old, map[string]int32{"org1": 2, "org2": 3}, members) {
+		t.Error("expect unchanged weights")
+	}
+	// weights of removed members are dropped along with them
+	if weightsChanged(old, map[string]int32{"org1": 2}, []Member{{Name: "org1"}}) {
+		t.Error("expect dropping the weight of a removed member allowed")
+	}
+	for name, weights := range map[string]map[string]int32{
+		"raised":  {"org1": 5, "org2": 3},
+		"dropped": {"org1": 2},
+		"added":   {"org1": 2, "org2": 3, "org3": 1},
+	} {
+		if !weightsChanged(old, weights, members) {
+			t.Errorf("%s: expect weights changed", name)
+		}
+	}
+}
@@ -20,10 +20,12 @@ package v1beta1
 
 import (
 	"os"
+	"strings"
 
 	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/ca/config"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/util/image"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // +kubebuilder:object:generate=false
@@ -78,6 +80,26 @@ func (s *IBPCA) isTLSCAHSMEnabled() bool {
 	return configOverride.UsingPKCS11()
 }
 
+// UsingPostgres reports whether the CA or the TLS CA keeps its database in postgres instead of on its volume
+func (s *IBPCA) UsingPostgres() bool {
+	if s.Spec.ConfigOverride == nil {
+		return false
+	}
+	for _, override := range []*runtime.RawExtension{s.Spec.ConfigOverride.CA, s.Spec.ConfigOverride.TLSCA} {
+		if override == nil {
+			continue
+		}
+		configOverride, err := config.ReadFrom(&override.Raw)
+		if err != nil {
+			continue
+		}
+		if db := configOverride.ServerConfig.DB; db != nil && strings.ToLower(db.Type) == "postgres" {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *IBPCA) GetTLSCAConfigOverride() (CAConfig, error) {
 	if s.Spec.ConfigOverride == nil || s.Spec.ConfigOverride.TLSCA == nil {
 		return &config.Config{}, nil
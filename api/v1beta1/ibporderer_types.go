@@ -189,6 +189,11 @@ type IBPOrdererClusterLocation struct {
 type IBPOrdererStatus struct {
 	CRStatus `json:",inline"`
 
+	// ResizingTo is the size a running cluster is being resized to, cleared once the cluster has
+	// as many consenters. Only set on the parent
+	// +optional
+	ResizingTo int `json:"resizingTo,omitempty"`
+
 	// TODO: Networks which utilize this IBPOrderer cluster
 	// Networks []NamespacedName `json:"networks,omitempty"`
 }
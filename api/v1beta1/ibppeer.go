@@ -315,6 +315,23 @@ func (p *IBPPeer) GetNamespacedName() types.NamespacedName {
 	return types.NamespacedName{Namespace: p.Namespace, Name: p.Name}
 }
 
+// SetUnjoinChannel adds channelID into or removes it from the channels to unjoin,
+// returns true if the spec changed
+func (p *IBPPeer) SetUnjoinChannel(channelID string, unjoin bool) bool {
+	for i, ch := range p.Spec.UnjoinChannels {
+		if ch == channelID {
+			if !unjoin {
+				p.Spec.UnjoinChannels = append(p.Spec.UnjoinChannels[:i], p.Spec.UnjoinChannels[i+1:]...)
+			}
+			return !unjoin
+		}
+	}
+	if unjoin {
+		p.Spec.UnjoinChannels = append(p.Spec.UnjoinChannels, channelID)
+	}
+	return unjoin
+}
+
 /* Enrollment when IAM Enabled*/
 
 func (p *IBPPeer) GetEnrollUser() string {
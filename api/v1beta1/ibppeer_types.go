@@ -151,6 +151,11 @@ type IBPPeerSpec struct {
 	// CHAINCODE_AS_A_SERVICE_BUILDER_CONFIG env variable.
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
 	ChaincodeBuilderConfig ChaincodeBuilderConfig `json:"chaincodeBuilderConfig,omitempty"`
+
+	// UnjoinChannels (Optional) are the channels the peer unjoins before it starts.
+	// Managed by the operator when a channel is archived.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	UnjoinChannels []string `json:"unjoinChannels,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -0,0 +1,108 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+func init() {
+	SchemeBuilder.Register(&Identity{}, &IdentityList{})
+}
+
+// Labels on identities to list them by organization, user and type
+const (
+	IdentityOrganizationLabel = "bestchains.identity.organization"
+	IdentityUserLabel         = "bestchains.identity.user"
+	IdentityTypeLabel         = "bestchains.identity.type"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// GetIdentity returns the Identity of enrollmentID in organization's namespace.
+// Enrollment IDs which are not valid object names are sanitized and suffixed with their digest
+func (organization *Organization) GetIdentity(enrollmentID string) types.NamespacedName {
+	return types.NamespacedName{Namespace: organization.GetUserNamespace(), Name: IdentityName(enrollmentID)}
+}
+
+// IdentityName returns the object name of the identity of enrollmentID
+func IdentityName(enrollmentID string) string {
+	if len(validation.IsDNS1123Subdomain(enrollmentID)) == 0 {
+		return enrollmentID
+	}
+	return sanitize(enrollmentID)
+}
+
+// sanitize keeps the valid characters of value and suffixes them with the digest of value,
+// the result is both a valid object name and a valid label value
+func sanitize(value string) string {
+	digest := sha256.Sum256([]byte(value))
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(value), "-"), "-.")
+	if len(name) > 40 {
+		name = strings.TrimRight(name[:40], "-.")
+	}
+	return strings.TrimLeft(name+"-"+hex.EncodeToString(digest[:])[:10], "-")
+}
+
+// IdentityLabels returns the labels of an identity, user is escaped when it's not a valid label value
+func IdentityLabels(spec IdentitySpec) map[string]string {
+	return map[string]string{
+		IdentityOrganizationLabel: spec.Organization,
+		IdentityUserLabel:         IdentityLabelValue(spec.User),
+		IdentityTypeLabel:         string(spec.Type),
+	}
+}
+
+// IdentityLabelValue returns value itself if it's a valid label value, or its sanitized form otherwise
+func IdentityLabelValue(value string) string {
+	if len(validation.IsValidLabelValue(value)) == 0 {
+		return value
+	}
+	return sanitize(value)
+}
+
+// IdentitySelector selects the identities of user, all users if user is empty
+func IdentitySelector(user string, identityTypes ...IdentityType) labels.Selector {
+	set := labels.Set{}
+	if user != "" {
+		set[IdentityUserLabel] = IdentityLabelValue(user)
+	}
+	selector := labels.SelectorFromSet(set)
+	if len(identityTypes) > 0 {
+		values := make([]string, len(identityTypes))
+		for i, t := range identityTypes {
+			values[i] = string(t)
+		}
+		requirement, _ := labels.NewRequirement(IdentityTypeLabel, selection.In, values)
+		selector = selector.Add(*requirement)
+	}
+	return selector
+}
+
+func (identity *Identity) IsRevoked() bool {
+	return identity.Status.Revocation == IdentityRevoked
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IdentityType is the type of an identity registered in an organization's CA
+// +kubebuilder:validation:Enum=admin;client;peer;orderer
+type IdentityType string
+
+const (
+	AdminIdentity   IdentityType = "admin"
+	ClientIdentity  IdentityType = "client"
+	PeerIdentity    IdentityType = "peer"
+	OrdererIdentity IdentityType = "orderer"
+)
+
+// RevocationState of an identity's certificates
+type RevocationState string
+
+const (
+	// IdentityValid identities have no revoked certificates
+	IdentityValid RevocationState = "Valid"
+	// IdentityRevoking identities belong to users removed from the organization and wait to be revoked
+	IdentityRevoking RevocationState = "Revoking"
+	// IdentityRevoked identities are revoked in CA
+	IdentityRevoked RevocationState = "Revoked"
+)
+
+// IdentitySpec defines an identity registered in an organization's CA
+// +k8s:deepcopy-gen=true
+type IdentitySpec struct {
+	// Organization whose CA registered the identity
+	Organization string `json:"organization"`
+
+	// EnrollmentID of the identity in CA
+	EnrollmentID string `json:"enrollmentID"`
+
+	// Type of the identity
+	Type IdentityType `json:"type"`
+
+	// User is the name of the iam.User the identity belongs to
+	User string `json:"user"`
+
+	// Attributes registered with the identity
+	// +optional
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// IdentityStatus defines the observed state of Identity
+type IdentityStatus struct {
+	// Expiry of the identity's enrollment certificate, set once the operator holds the certificate
+	// +optional
+	Expiry *metav1.Time `json:"expiry,omitempty"`
+
+	// Revocation state of the identity
+	// +optional
+	Revocation RevocationState `json:"revocation,omitempty"`
+
+	// RevocationTime is when the identity was revoked
+	// +optional
+	RevocationTime *metav1.Time `json:"revocationTime,omitempty"`
+
+	// Reason of the revocation
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=id;ids
+// +kubebuilder:printcolumn:name="EnrollmentID",type=string,JSONPath=`.spec.enrollmentID`
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="User",type=string,JSONPath=`.spec.user`
+// +kubebuilder:printcolumn:name="Expiry",type=string,JSONPath=`.status.expiry`
+// +kubebuilder:printcolumn:name="Revocation",type=string,JSONPath=`.status.revocation`
+// +genclient
+// Identity is the Schema for the identities API, it lives in the namespace of its organization
+type Identity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IdentitySpec   `json:"spec,omitempty"`
+	Status IdentityStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// IdentityList contains a list of Identity
+type IdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Identity `json:"items"`
+}
@@ -1,6 +1,8 @@
 package v1beta1
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 
@@ -42,6 +44,56 @@ func (organization *Organization) GetMSPCrypto() types.NamespacedName {
 	return types.NamespacedName{Namespace: organization.GetUserNamespace(), Name: fmt.Sprintf("%s-msp-crypto", organization.GetName())}
 }
 
+// GetAdminRole returns the Role granted to organization's admins in its namespace
+func (organization *Organization) GetAdminRole() types.NamespacedName {
+	return types.NamespacedName{Namespace: organization.GetUserNamespace(), Name: fmt.Sprintf("%s-blockchain:admin-role", organization.GetName())}
+}
+
+const (
+	// BundleImportLabel marks a configmap in an organization's namespace which holds a bundle to import
+	BundleImportLabel = "bestchains.organization.bundle"
+	// BundleImportLabelValue is the value of BundleImportLabel
+	BundleImportLabelValue = "import"
+)
+
+// GetBundle returns the configmap which holds the exported bundle of organization
+func (organization *Organization) GetBundle() types.NamespacedName {
+	return types.NamespacedName{Namespace: organization.GetUserNamespace(), Name: fmt.Sprintf("%s-bundle", organization.GetName())}
+}
+
+// CRLKey is the key of the CRL in the secret returned by GetCRL
+const CRLKey = "crl.pem"
+
+// GetCRL returns the secret which holds the latest CRL generated by organization's CA
+func (organization *Organization) GetCRL() types.NamespacedName {
+	return types.NamespacedName{Namespace: organization.GetUserNamespace(), Name: fmt.Sprintf("%s-crl", organization.GetName())}
+}
+
+// CRLDigest identifies a CRL by the first 16 hex characters of its sha256 digest
+func CRLDigest(crl []byte) string {
+	digest := sha256.Sum256(crl)
+	return hex.EncodeToString(digest[:])[:16]
+}
+
+// RevocationApproved checks whether all admins approved the latest CRL
+func (organization *Organization) RevocationApproved() bool {
+	revocation := organization.Status.Revocation
+	approval := organization.Spec.RevocationApproval
+	if revocation == nil || revocation.CRL == "" || approval == nil || approval.CRL != revocation.CRL {
+		return false
+	}
+	approved := make(map[string]bool, len(approval.Admins))
+	for _, admin := range approval.Admins {
+		approved[admin] = true
+	}
+	for _, admin := range organization.GetAdmins() {
+		if !approved[admin] {
+			return false
+		}
+	}
+	return true
+}
+
 func (organization *Organization) GetCA() NamespacedName {
 	return NamespacedName{Namespace: organization.GetUserNamespace(), Name: organization.GetName()}
 }
@@ -54,6 +106,56 @@ func (organization *Organization) HasAdmin() bool {
 	return organization.Spec.Admin != ""
 }
 
+// PredefinedOrganizationRoles can be declared in Organization.Spec.Roles without rules
+var PredefinedOrganizationRoles = []string{"auditor", "chaincode-operator", "voter"}
+
+var (
+	// organizationRoleKinds are the cluster scope kinds whose verbs a role declares
+	organizationRoleKinds = []string{"Federation", "Proposal", "Network", "Channel"}
+	organizationRoleVerbs = []string{"get", "list", "watch", "update", "patch", "delete"}
+)
+
+// GetAdmins returns Admin along with the delegated Admins
+func (organization *Organization) GetAdmins() []string {
+	admins := make([]string, 0, len(organization.Spec.Admins)+1)
+	if organization.Spec.Admin != "" {
+		admins = append(admins, organization.Spec.Admin)
+	}
+	for _, admin := range organization.Spec.Admins {
+		if admin != organization.Spec.Admin {
+			admins = append(admins, admin)
+		}
+	}
+	return admins
+}
+
+// IsAdmin checks whether username is Admin or one of the delegated Admins
+func (organization *Organization) IsAdmin(username string) bool {
+	for _, admin := range organization.GetAdmins() {
+		if admin == username {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRoleUsers returns the role each user is bound to in organization, keyed by user
+func (organization *Organization) GetRoleUsers() map[string]string {
+	users := make(map[string]string)
+	for _, role := range organization.Spec.Roles {
+		for _, u := range role.Users {
+			users[u] = role.Name
+		}
+	}
+	for _, c := range organization.Spec.Clients {
+		users[c] = "client"
+	}
+	for _, admin := range organization.GetAdmins() {
+		users[admin] = "admin"
+	}
+	return users
+}
+
 func (organization *Organization) HasType() bool {
 	return organization.Status.CRStatus.Type != ""
 }
@@ -96,3 +198,24 @@ func (organizationStatus *OrganizationStatus) DeleteFederation(federation string
 
 	return exist
 }
+
+// ChannelCRL returns the digest of the organization's CRL in channel's config
+func (revocation *OrganizationRevocation) ChannelCRL(channel string) string {
+	for _, c := range revocation.Channels {
+		if c.Channel == channel {
+			return c.CRL
+		}
+	}
+	return ""
+}
+
+// SetChannel records the CRL pushed into channel's config
+func (revocation *OrganizationRevocation) SetChannel(channelRevocation ChannelRevocation) {
+	for i, c := range revocation.Channels {
+		if c.Channel == channelRevocation.Channel {
+			revocation.Channels[i] = channelRevocation
+			return
+		}
+	}
+	revocation.Channels = append(revocation.Channels, channelRevocation)
+}
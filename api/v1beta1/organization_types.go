@@ -19,6 +19,7 @@
 package v1beta1
 
 import (
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -45,14 +46,57 @@ type OrganizationSpec struct {
 	Admin      string `json:"admin"`
 	AdminToken string `json:"admintoken,omitempty"`
 
+	// Admins are the delegated administrators who share the `Admin` role with Admin both in kubernetes and in CA.
+	// Admin stays the identity the operator acts as
+	Admins []string `json:"admins,omitempty"`
+
 	// Clients are the Users/ServiceAccounts with `Client` role both in kubernetes and in CA
 	Clients []string `json:"clients,omitempty"`
 
+	// Roles are the roles beyond admin and client bound to Users/ServiceAccounts
+	Roles []OrganizationRole `json:"roles,omitempty"`
+
+	// RevocationApproval approves to push the organization's CRL into the channels it belongs to
+	// +optional
+	RevocationApproval *RevocationApproval `json:"revocationApproval,omitempty"`
+
 	// CASpec is the configurations of organization's related Certificate Authority
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
 	CASpec IBPCASpec `json:"caSpec,omitempty"`
 }
 
+// OrganizationRole declares a role within the organization and the users bound to it.
+// Users bound to a role are registered in CA as clients.
+type OrganizationRole struct {
+	// Name of the role. `auditor`, `chaincode-operator` and `voter` are predefined,
+	// other roles must declare their rules or cluster verbs
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Rules granted in the organization's namespace, which replace the predefined ones
+	// +optional
+	Rules []rbacv1.PolicyRule `json:"rules,omitempty"`
+
+	// ClusterVerbs granted on the Federations, Proposals, Networks and Channels the organization takes part in,
+	// keyed by kind. They replace the predefined ones
+	// +optional
+	ClusterVerbs map[string][]string `json:"clusterVerbs,omitempty"`
+
+	// Users bound to this role
+	Users []string `json:"users,omitempty"`
+}
+
+// RevocationApproval of a CRL by the organization's admins.
+// The CRL is pushed into channels once all admins approved it
+type RevocationApproval struct {
+	// CRL is the digest of the approved CRL, as shown in status.revocation.crl
+	CRL string `json:"crl"`
+
+	// Admins approving the CRL. Each admin can only add itself
+	// +optional
+	Admins []string `json:"admins,omitempty"`
+}
+
 // OrganizationStatus defines the observed state of Organization
 type OrganizationStatus struct {
 	// CRStatus is the custome resource status
@@ -62,6 +106,47 @@ type OrganizationStatus struct {
 	// Federations which this organization has been added
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
 	Federations []string `json:"federations,omitempty"`
+
+	// Revocation of the organization's identities and the distribution of its CRL
+	// +optional
+	Revocation *OrganizationRevocation `json:"revocation,omitempty"`
+}
+
+// OrganizationRevocation shows the identities revoked in the organization's CA
+// and the channels its CRL has been pushed into
+type OrganizationRevocation struct {
+	// Revoked are the enrollment IDs revoked in CA
+	// +optional
+	Revoked []string `json:"revoked,omitempty"`
+
+	// CRL is the digest of the latest CRL generated by CA, which is stored in secret `<organization>-crl`
+	// +optional
+	CRL string `json:"crl,omitempty"`
+
+	// GeneratedAt is when the latest CRL was generated
+	// +optional
+	GeneratedAt *metav1.Time `json:"generatedAt,omitempty"`
+
+	// Approved is true once all admins approved the latest CRL
+	// +optional
+	Approved bool `json:"approved,omitempty"`
+
+	// Channels whose config carries a CRL of the organization
+	// +optional
+	Channels []ChannelRevocation `json:"channels,omitempty"`
+}
+
+// ChannelRevocation records the CRL pushed into the organization's MSP in a channel
+type ChannelRevocation struct {
+	Channel string `json:"channel"`
+	// CRL is the digest of the CRL in the channel config
+	CRL string `json:"crl"`
+	// TxID of the config update
+	// +optional
+	TxID string `json:"txID,omitempty"`
+	// UpdatedAt is when the config update was submitted
+	// +optional
+	UpdatedAt metav1.Time `json:"updatedAt,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -21,10 +21,14 @@ package v1beta1
 import (
 	"context"
 	"fmt"
+	"reflect"
 
 	iam "github.com/IBM-Blockchain/fabric-operator/api/iam/v1alpha1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
 	"github.com/pkg/errors"
 	authenticationv1 "k8s.io/api/authentication/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,10 +38,19 @@ import (
 var (
 	errAdminIsEmpty      = errors.New("the organization's admin is empty")
 	errAdminCantBeClient = errors.New("user can't be admin and client at the same time")
+	errUserHasRoles      = errors.New("user can't be bound to more than one role")
+	errUserRevoked       = errors.New("user's identity is revoked in the organization's CA, it can't be bound again")
+	errRoleReserved      = errors.New("role admin and client are declared by admin(s) and clients")
+	errRoleDuplicated    = errors.New("role is declared more than once")
+	errRoleUndefined     = errors.New("role must declare rules or clusterVerbs unless predefined")
+	errRoleClusterVerbs  = errors.New("role's clusterVerbs are keyed by Federation/Proposal/Network/Channel with verbs get/list/watch/update/patch/delete")
+	errRoleEscalation    = errors.New("role's rules must be granted to admins as well")
 	errUserNotFound      = errors.New("user not found")
 	errUserDuplicated    = errors.New("found more than one user with same username")
 	errHasNetwork        = errors.New("the organization is initiator of one network")
 	errHasFederation     = errors.New("the organization is initiator of one federation")
+
+	errRevocationApproval = errors.New("each admin can only add its own revocation approval")
 )
 
 // log is for logging in this package.
@@ -66,9 +79,17 @@ func (r *Organization) ValidateCreate(ctx context.Context, client client.Client,
 		return errNoPermission
 	}
 
+	external, err := IsExternalOrganization(ctx, client, r.GetName())
+	if err != nil {
+		return errors.Wrap(err, "failed to get external organization")
+	}
+	if external {
+		return errExternalOrganizationExists
+	}
+
 	if len(r.Spec.Clients) != 0 {
 		for _, orgClient := range r.Spec.Clients {
-			if orgClient == r.Spec.Admin {
+			if r.IsAdmin(orgClient) {
 				return errAdminCantBeClient
 			}
 			if err := r.validateUser(ctx, client, orgClient); err != nil {
@@ -77,16 +98,26 @@ func (r *Organization) ValidateCreate(ctx context.Context, client client.Client,
 		}
 	}
 
-	return nil
+	if err := r.validateRevocationApproval(ctx, nil, user); err != nil {
+		return err
+	}
+
+	return r.validateRoles(ctx, client)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *Organization) ValidateUpdate(ctx context.Context, client client.Client, old runtime.Object, user authenticationv1.UserInfo) error {
 	organizationlog.Info("validate update", "name", r.Name, "user", user.String())
 	oldOrg := old.(*Organization)
-	if !isSuperUser(ctx, user) && oldOrg.Spec.Admin != user.Username {
+	if !isSuperUser(ctx, user) && !oldOrg.IsAdmin(user.Username) {
 		return errNoPermission
 	}
+	// delegated admins can't change who administrates the organization
+	if oldOrg.Spec.Admin != r.Spec.Admin || !reflect.DeepEqual(oldOrg.Spec.Admins, r.Spec.Admins) {
+		if !isSuperUser(ctx, user) && oldOrg.Spec.Admin != user.Username {
+			return errNoPermission
+		}
+	}
 	if r.Spec.Admin == "" {
 		return errAdminIsEmpty
 	}
@@ -98,7 +129,7 @@ func (r *Organization) ValidateUpdate(ctx context.Context, client client.Client,
 
 	if len(r.Spec.Clients) != 0 {
 		for _, orgClient := range r.Spec.Clients {
-			if orgClient == r.Spec.Admin {
+			if r.IsAdmin(orgClient) {
 				return errAdminCantBeClient
 			}
 			if err := r.validateUser(ctx, client, orgClient); err != nil {
@@ -107,6 +138,34 @@ func (r *Organization) ValidateUpdate(ctx context.Context, client client.Client,
 		}
 	}
 
+	if err := r.validateRevocationApproval(ctx, oldOrg, user); err != nil {
+		return err
+	}
+
+	return r.validateRoles(ctx, client)
+}
+
+// validateRevocationApproval makes sure the approvals added(all of them when the CRL changes)
+// come from the admins themselves
+func (r *Organization) validateRevocationApproval(ctx context.Context, oldOrg *Organization, user authenticationv1.UserInfo) error {
+	approval := r.Spec.RevocationApproval
+	if approval == nil || isSuperUser(ctx, user) {
+		return nil
+	}
+	approved := make(map[string]bool)
+	if oldOrg != nil && oldOrg.Spec.RevocationApproval != nil && oldOrg.Spec.RevocationApproval.CRL == approval.CRL {
+		for _, admin := range oldOrg.Spec.RevocationApproval.Admins {
+			approved[admin] = true
+		}
+	}
+	for _, admin := range approval.Admins {
+		if approved[admin] {
+			continue
+		}
+		if admin != user.Username || !r.IsAdmin(admin) {
+			return errRevocationApproval
+		}
+	}
 	return nil
 }
 
@@ -143,6 +202,91 @@ func (r *Organization) ValidateDelete(ctx context.Context, c client.Client, user
 	return nil
 }
 
+// validateRoles checks the delegated admins and the declared roles, each user is bound to one role at most
+func (r *Organization) validateRoles(ctx context.Context, c client.Client) error {
+	bound := make(map[string]bool)
+	bind := func(username string) error {
+		if bound[username] {
+			return errors.Wrapf(errUserHasRoles, "user: %s", username)
+		}
+		bound[username] = true
+		// CA revokes identities by enrollment ID, which is the username of admins and clients
+		identity := &Identity{}
+		if err := c.Get(ctx, r.GetIdentity(username), identity); err != nil {
+			if k8serrors.IsNotFound(err) {
+				return nil
+			}
+			return errors.Wrap(err, "get identity")
+		}
+		if identity.IsRevoked() {
+			return errors.Wrapf(errUserRevoked, "user: %s", username)
+		}
+		return nil
+	}
+	for _, admin := range r.GetAdmins() {
+		if err := bind(admin); err != nil {
+			return err
+		}
+		if admin == r.Spec.Admin {
+			continue
+		}
+		if err := r.validateUser(ctx, c, admin); err != nil {
+			return errors.Wrap(err, "admin add")
+		}
+	}
+	for _, orgClient := range r.Spec.Clients {
+		if err := bind(orgClient); err != nil {
+			return err
+		}
+	}
+
+	// the operator creates roles, so admins can't grant more than they hold through them
+	adminRole := &rbacv1.Role{}
+	if err := c.Get(ctx, r.GetAdminRole(), adminRole); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return errors.Wrap(err, "get admin role")
+		}
+		// not created yet, the operator checks again when it creates roles
+		adminRole = nil
+	}
+
+	declared := make(map[string]bool)
+	for _, role := range r.Spec.Roles {
+		if role.Name == "admin" || role.Name == "client" {
+			return errRoleReserved
+		}
+		if declared[role.Name] {
+			return errors.Wrapf(errRoleDuplicated, "role: %s", role.Name)
+		}
+		declared[role.Name] = true
+		if !util.ContainsValue(role.Name, PredefinedOrganizationRoles) && len(role.Rules) == 0 && len(role.ClusterVerbs) == 0 {
+			return errors.Wrapf(errRoleUndefined, "role: %s", role.Name)
+		}
+		if adminRole != nil && !util.PolicyRulesCover(adminRole.Rules, role.Rules...) {
+			return errors.Wrapf(errRoleEscalation, "role: %s", role.Name)
+		}
+		for kind, verbs := range role.ClusterVerbs {
+			if !util.ContainsValue(kind, organizationRoleKinds) {
+				return errors.Wrapf(errRoleClusterVerbs, "role: %s", role.Name)
+			}
+			for _, verb := range verbs {
+				if !util.ContainsValue(verb, organizationRoleVerbs) {
+					return errors.Wrapf(errRoleClusterVerbs, "role: %s", role.Name)
+				}
+			}
+		}
+		for _, u := range role.Users {
+			if err := bind(u); err != nil {
+				return err
+			}
+			if err := r.validateUser(ctx, c, u); err != nil {
+				return errors.Wrapf(err, "role %s add", role.Name)
+			}
+		}
+	}
+	return nil
+}
+
 func (r *Organization) validateUser(ctx context.Context, c client.Client, username string) error {
 	_, err := r.getUser(ctx, c, username)
 	if err != nil {
@@ -2,13 +2,16 @@ package v1beta1
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os"
 
-	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func (p *Proposal) GetVoteName(orgName string) string {
@@ -32,7 +35,7 @@ func (p *Proposal) GetVoteLabel() map[string]string {
 	}
 }
 
-func (p *Proposal) GetCandidateOrganizations(ctx context.Context, client k8sclient.Client) ([]string, error) {
+func (p *Proposal) GetCandidateOrganizations(ctx context.Context, client k8sclient.Reader) ([]string, error) {
 	federation := &Federation{}
 	if err := client.Get(ctx, types.NamespacedName{Name: p.Spec.Federation}, federation); err != nil {
 		return nil, err
@@ -96,6 +99,10 @@ func (p *Proposal) GetCandidateOrganizations(ctx context.Context, client k8sclie
 		for _, member := range p.Spec.UpgradeChaincode.Members {
 			orgs = append(orgs, member.Name)
 		}
+	case RollbackChaincodeProposal:
+		for _, member := range p.Spec.RollbackChaincode.Members {
+			orgs = append(orgs, member.Name)
+		}
 	case UpdateChannelMemberProposal:
 		channel := p.Spec.ProposalSource.UpdateChannelMember.Channel
 		ch := &Channel{}
@@ -108,6 +115,15 @@ func (p *Proposal) GetCandidateOrganizations(ctx context.Context, client k8sclie
 		for _, member := range p.Spec.UpdateChannelMember.Members {
 			orgs = append(orgs, member.Name)
 		}
+	case UpdateChannelConfigProposal:
+		channel := p.Spec.ProposalSource.UpdateChannelConfig.Channel
+		ch := &Channel{}
+		if err := client.Get(ctx, types.NamespacedName{Name: channel}, ch); err != nil {
+			return nil, err
+		}
+		for _, member := range ch.Spec.Members {
+			orgs = append(orgs, member.Name)
+		}
 	}
 	return orgs, nil
 }
@@ -123,6 +139,8 @@ const (
 	DeployChaincodeProposal
 	UpgradeChaincodeProposal
 	UpdateChannelMemberProposal
+	UpdateChannelConfigProposal
+	RollbackChaincodeProposal
 )
 
 func (p *Proposal) GetPurpose() uint {
@@ -158,12 +176,63 @@ func (p *ProposalSource) GetPurpose() uint {
 	if p.UpgradeChaincode != nil {
 		t = t | UpgradeChaincodeProposal
 	}
+	if p.RollbackChaincode != nil {
+		t = t | RollbackChaincodeProposal
+	}
 	if p.UpdateChannelMember != nil {
 		t = t | UpdateChannelMemberProposal
 	}
+	if p.UpdateChannelConfig != nil {
+		t = t | UpdateChannelConfigProposal
+	}
 	return t
 }
 
+// SpecDigest returns the hex SHA-256 digest of the JSON encoded spec without the external votes.
+// External votes sign it, so a vote counts only for the content it was made on.
+func (p *Proposal) SpecDigest() string {
+	spec := p.Spec.DeepCopy()
+	spec.ExternalVotes = nil
+	b, _ := json.Marshal(spec)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// VotingPolicy returns the policy and voting rule the proposal is tallied with.
+// A proposal without a policy follows its federation's, and a Custom policy without a voting rule uses the federation's rule
+func (p *Proposal) VotingPolicy(federation *Federation) (Policy, *VotingRule) {
+	policy, rule := p.Spec.Policy, p.Spec.VotingRule
+	if federation == nil {
+		return policy, rule
+	}
+	if policy == "" {
+		policy = federation.Spec.Policy
+	}
+	if rule == nil && policy.String() == Custom.String() {
+		rule = federation.Spec.VotingRule
+	}
+	return policy, rule
+}
+
+// VotingWeights returns the weights of members the proposal is tallied with, the federation's
+// for proposals created before weights were copied into them
+func (p *Proposal) VotingWeights(federation *Federation) map[string]int32 {
+	if p.Spec.Weights != nil || federation == nil {
+		return p.Spec.Weights
+	}
+	return federation.Spec.Weights
+}
+
+// GetExternalVote returns the signed vote of an external organization, or nil if it has not voted
+func (p *Proposal) GetExternalVote(organization string) *ExternalVote {
+	for i := range p.Spec.ExternalVotes {
+		if p.Spec.ExternalVotes[i].Organization == organization {
+			return &p.Spec.ExternalVotes[i]
+		}
+	}
+	return nil
+}
+
 func (p *Proposal) IsPurpose(purpose uint) bool {
 	return (p.GetPurpose() & purpose) != 0
 }
@@ -190,6 +259,10 @@ func (p *Proposal) SelfType() string {
 		return "UpgradeChaincodeProposal"
 	case UpdateChannelMemberProposal:
 		return "UpdateChannelMemberProposal"
+	case UpdateChannelConfigProposal:
+		return "UpdateChannelConfigProposal"
+	case RollbackChaincodeProposal:
+		return "RollbackChaincodeProposal"
 	default:
 		return ""
 	}
@@ -89,6 +89,15 @@ type ProposalSpec struct {
 	EndAt metav1.Time `json:"endAt,omitempty"`
 	// +kubebuilder:default=false
 	Deprecated bool `json:"deprecated,omitempty"`
+	// VotingRule is required when Policy is Custom, the federation's is used when not set
+	// +optional
+	VotingRule *VotingRule `json:"votingRule,omitempty"`
+	// Weights are the voting weights of members, copied from the federation when the proposal is created
+	// +optional
+	Weights map[string]int32 `json:"weights,omitempty"`
+	// ExternalVotes are the signed votes of external organizations, collected out of band
+	// +optional
+	ExternalVotes []ExternalVote `json:"externalVotes,omitempty"`
 }
 
 type ProposalSource struct {
@@ -111,7 +120,11 @@ type ProposalSource struct {
 	// +optional
 	UpgradeChaincode *DeployChaincode `json:"upgradeChaincode,omitempty"`
 	// +optional
+	RollbackChaincode *RollbackChaincode `json:"rollbackChaincode,omitempty"`
+	// +optional
 	UpdateChannelMember *UpdateChannelMember `json:"updateChannelMember,omitempty"`
+	// +optional
+	UpdateChannelConfig *UpdateChannelConfig `json:"updateChannelConfig,omitempty"`
 }
 
 type AddMember struct {
@@ -135,6 +148,24 @@ type DeployChaincode struct {
 	Chaincode       string   `json:"chaincode"`
 	ExternalBuilder string   `json:"externalBuilder,omitempty"`
 	Members         []Member `json:"members"`
+	// Collections replace the private data collections of chaincode once the proposal succeeds.
+	// Keep the current collections when nil.
+	// +optional
+	Collections []Collection `json:"collections,omitempty"`
+	// Images replaces the image of a ccaas chaincode without external builder
+	// +optional
+	Images *ChaincodeImage `json:"images,omitempty"`
+	// Version of Images
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// RollbackChaincode redeploys a version recorded in the chaincode history at a new sequence
+type RollbackChaincode struct {
+	Chaincode string `json:"chaincode"`
+	// Version of the history entry to roll back to, the latest one wins if several entries share it
+	Version string   `json:"version"`
+	Members []Member `json:"members"`
 }
 
 type ArchiveChannel struct {
@@ -148,8 +179,23 @@ type UnarchiveChannel struct {
 }
 
 type UpdateChannelMember struct {
-	Channel string   `json:"channel"`
-	Members []Member `json:"members"`
+	Channel string `json:"channel"`
+	// Members to add into the channel
+	// +optional
+	Members []Member `json:"members,omitempty"`
+	// RemoveMembers are organizations to remove from the channel,
+	// along with their peers and anchor peers
+	// +optional
+	RemoveMembers []string `json:"removeMembers,omitempty"`
+}
+
+type UpdateChannelConfig struct {
+	Channel string `json:"channel"`
+	// +optional
+	Config ChannelConfig `json:"config,omitempty"`
+	// AnchorPeers replaces the anchor peers of each listed member
+	// +optional
+	AnchorPeers []MemberAnchorPeers `json:"anchorPeers,omitempty"`
 }
 
 type VoteResult struct {
@@ -157,6 +203,7 @@ type VoteResult struct {
 	Organization   NamespacedName `json:"organization"`
 	// +optional
 	Decision    *bool       `json:"decision"`
+	Abstain     bool        `json:"abstain,omitempty"`
 	Description string      `json:"description"`
 	Phase       VotePhase   `json:"phase,omitempty"`
 	VoteTime    metav1.Time `json:"voteTime,omitempty"`
@@ -182,6 +229,20 @@ type ProposalStatus struct {
 	// startTime set.
 	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#pod-and-container-status
 	Votes []VoteResult `json:"votes,omitempty"`
+	// Tally is the weighted count of the votes above.
+	// +optional
+	Tally *VoteTally `json:"tally,omitempty"`
+}
+
+// VoteTally sums the voting weight of a proposal by decision.
+type VoteTally struct {
+	// Electorate is the weight of every organization allowed to vote.
+	Electorate int64 `json:"electorate"`
+	Approved   int64 `json:"approved"`
+	Rejected   int64 `json:"rejected"`
+	Abstained  int64 `json:"abstained"`
+	// Pending is the weight of organizations which have not voted yet.
+	Pending int64 `json:"pending"`
 }
 
 func init() {
@@ -22,8 +22,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
 	authenticationv1 "k8s.io/api/authentication/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -43,7 +45,14 @@ var (
 	errChannelInError          = errors.New("the relevant channel in the proposal is in Error status")
 	errChannelAlreadyArchived  = errors.New("the relevant channel in the proposal is already archived")
 	errChannelNotArchivedYet   = errors.New("the relevant channel in the proposal not archived yet")
+	errChannelArchiving        = errors.New("the relevant channel in the proposal is being archived or unarchived")
 	errChannelHasMemberAlready = errors.New("the relevant channel already has members to add")
+	errEmptyChannelMember      = errors.New("the proposal should add or remove at least one channel member")
+	errRemoveAllChannelMember  = errors.New("at least one existing member must stay in the channel")
+	errEmptyChannelConfig      = errors.New("the proposal should update at least one channel config")
+	errExternalVoteChanged     = errors.New("the recorded vote of an external organization cannot be changed")
+	errExternalVoteNotExternal = errors.New("only external organizations vote with signed votes")
+	errExternalVoteFinished    = errors.New("the proposal is finished")
 )
 
 // log is for logging in this package.
@@ -62,6 +71,24 @@ func (r *Proposal) Default(ctx context.Context, client client.Client, user authe
 	if r.Spec.EndAt.IsZero() {
 		r.Spec.EndAt = metav1.NewTime(time.Now().Add(time.Hour * 24))
 	}
+	// weights are only copied on creation, they can't be changed afterwards
+	copyWeights := r.CreationTimestamp.IsZero() && r.Spec.Weights == nil
+	if copyWeights || r.Spec.Policy == "" || (r.Spec.Policy.String() == Custom.String() && r.Spec.VotingRule == nil) {
+		federation := &Federation{}
+		if err := client.Get(ctx, types.NamespacedName{Name: r.Spec.Federation}, federation); err != nil {
+			proposallog.Error(err, "failed to get federation for the default policy", "federation", r.Spec.Federation)
+			return
+		}
+		policy, rule := r.VotingPolicy(federation)
+		r.Spec.Policy = policy
+		r.Spec.VotingRule = rule.DeepCopy()
+		if copyWeights && len(federation.Spec.Weights) != 0 {
+			r.Spec.Weights = make(map[string]int32, len(federation.Spec.Weights))
+			for org, weight := range federation.Spec.Weights {
+				r.Spec.Weights[org] = weight
+			}
+		}
+	}
 }
 
 //+kubebuilder:webhook:path=/validate-ibp-com-v1beta1-proposal,mutating=false,failurePolicy=fail,sideEffects=None,groups=ibp.com,resources=proposals,verbs=create;update;delete,versions=v1beta1,name=proposal.validate.webhook,admissionReviewVersions=v1
@@ -78,8 +105,8 @@ func (r *Proposal) ValidateCreate(ctx context.Context, client client.Client, use
 	if purpose&(purpose-1) != 0 {
 		return errMoreProposalPurpose
 	}
-	if ok := PolicyMap[r.Spec.Policy.String()]; !ok {
-		return errInvalidPolicy
+	if err := validatePolicy(r.Spec.Policy, r.Spec.VotingRule); err != nil {
+		return err
 	}
 
 	fakeMembers := []Member{{Name: r.Spec.InitiatorOrganization, Initiator: true}}
@@ -91,7 +118,11 @@ func (r *Proposal) ValidateCreate(ctx context.Context, client client.Client, use
 		return err
 	}
 
-	return validateProposalSource(ctx, client, r.Spec.ProposalSource, r.Spec.Federation)
+	if err := validateProposalSource(ctx, client, r.Spec.ProposalSource, r.Spec.Federation); err != nil {
+		return err
+	}
+
+	return validateExternalVotes(ctx, client, r, nil)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -110,6 +141,12 @@ func (r *Proposal) ValidateUpdate(ctx context.Context, client client.Client, old
 	if r.Spec.Policy.String() != oldProposal.Spec.Policy.String() {
 		return errUpdatePolicy
 	}
+	if !reflect.DeepEqual(r.Spec.VotingRule, oldProposal.Spec.VotingRule) {
+		return errUpdatePolicy
+	}
+	if !reflect.DeepEqual(r.Spec.Weights, oldProposal.Spec.Weights) {
+		return errUpdatePolicy
+	}
 
 	fakeMembers := []Member{{Name: r.Spec.InitiatorOrganization, Initiator: true}}
 	if err := validateMemberInFederation(ctx, client, r.Spec.Federation, fakeMembers); err != nil {
@@ -124,7 +161,7 @@ func (r *Proposal) ValidateUpdate(ctx context.Context, client client.Client, old
 		return err
 	}
 
-	return nil
+	return validateExternalVotes(ctx, client, r, oldProposal)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -147,6 +184,53 @@ func (r *Proposal) ValidateDelete(ctx context.Context, client client.Client, use
 	return nil
 }
 
+// validateExternalVotes makes sure each signed vote is made by an admin of an external candidate organization.
+// Votes recorded before can not be changed or withdrawn.
+func validateExternalVotes(ctx context.Context, c client.Client, p *Proposal, old *Proposal) error {
+	if old != nil {
+		for _, recorded := range old.Spec.ExternalVotes {
+			vote := p.GetExternalVote(recorded.Organization)
+			if vote == nil || !reflect.DeepEqual(*vote, recorded) {
+				return fmt.Errorf("%w: %s", errExternalVoteChanged, recorded.Organization)
+			}
+		}
+		if len(p.Spec.ExternalVotes) != len(old.Spec.ExternalVotes) && p.Status.Phase == ProposalFinished {
+			return errExternalVoteFinished
+		}
+	}
+	if len(p.Spec.ExternalVotes) == 0 {
+		return nil
+	}
+	candidates, err := p.GetCandidateOrganizations(ctx, c)
+	if err != nil {
+		return err
+	}
+	voted := make(map[string]bool, len(p.Spec.ExternalVotes))
+	for _, vote := range p.Spec.ExternalVotes {
+		if voted[vote.Organization] {
+			return fmt.Errorf("organization %s has voted more than once", vote.Organization)
+		}
+		voted[vote.Organization] = true
+		if vote.Abstain && vote.Decision != nil {
+			return errAbstainWithDecision
+		}
+		if !util.ContainsValue(vote.Organization, candidates) {
+			return fmt.Errorf("organization %s is not a candidate of the proposal", vote.Organization)
+		}
+		org, err := GetExternalOrganization(ctx, c, vote.Organization)
+		if err != nil {
+			return err
+		}
+		if org == nil {
+			return fmt.Errorf("%w: %s", errExternalVoteNotExternal, vote.Organization)
+		}
+		if err = org.VerifyVote(p, vote); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func validateProposalSource(ctx context.Context, c client.Client, proposalSource ProposalSource, federationName string) (err error) {
 	switch proposalSource.GetPurpose() {
 	case ArchiveChannelProposal:
@@ -155,12 +239,30 @@ func validateProposalSource(ctx context.Context, c client.Client, proposalSource
 		err = validateChannel(ctx, c, proposalSource.UnarchiveChannel.Channel, proposalSource)
 	case UpdateChannelMemberProposal:
 		err = validateChannel(ctx, c, proposalSource.UpdateChannelMember.Channel, proposalSource)
+	case UpdateChannelConfigProposal:
+		err = validateChannel(ctx, c, proposalSource.UpdateChannelConfig.Channel, proposalSource)
+	case DeployChaincodeProposal:
+		err = validateChaincodeProvenance(ctx, c, proposalSource.DeployChaincode)
+		if err != nil {
+			return err
+		}
+		err = validateChaincodeCollections(ctx, c, proposalSource.DeployChaincode)
 	case UpgradeChaincodeProposal:
 		err = validateChaincodePhase(ctx, c, proposalSource.UpgradeChaincode.Chaincode)
 		if err != nil {
 			return err
 		}
-		err = checkChaincodeBuildImage(ctx, c, proposalSource.UpgradeChaincode.ExternalBuilder)
+		err = validateChaincodeUpgradeImage(ctx, c, proposalSource.UpgradeChaincode)
+		if err != nil {
+			return err
+		}
+		err = validateChaincodeProvenance(ctx, c, proposalSource.UpgradeChaincode)
+		if err != nil {
+			return err
+		}
+		err = validateChaincodeCollections(ctx, c, proposalSource.UpgradeChaincode)
+	case RollbackChaincodeProposal:
+		err = validateChaincodeRollback(ctx, c, proposalSource.RollbackChaincode)
 	case DeleteMemberProposal:
 		err = validateDeleteFedMember(ctx, c, proposalSource.DeleteMember.Member, federationName)
 	}
@@ -182,6 +284,10 @@ func validateChannel(ctx context.Context, c client.Client, channel string, propo
 		return errChannelInError
 	}
 
+	if ch.ArchiveInProgress() {
+		return errChannelArchiving
+	}
+
 	switch proposalSource.GetPurpose() {
 	case ArchiveChannelProposal:
 		if ch.Status.Type == ChannelArchived {
@@ -195,14 +301,38 @@ func validateChannel(ctx context.Context, c client.Client, channel string, propo
 		if ch.Status.Type == ChannelArchived {
 			return errChannelAlreadyArchived
 		}
-		for _, t := range proposalSource.UpdateChannelMember.Members {
+		update := proposalSource.UpdateChannelMember
+		if len(update.Members) == 0 && len(update.RemoveMembers) == 0 {
+			return errEmptyChannelMember
+		}
+		for _, t := range update.Members {
 			for _, m := range ch.Spec.Members {
 				if t.Name == m.Name {
 					return errChannelHasMemberAlready
 				}
 			}
 		}
-		if err := validateMemberInNetwork(ctx, c, ch.Spec.Network, proposalSource.UpdateChannelMember.Members); err != nil {
+		if len(update.Members) != 0 {
+			if err := validateMemberInNetwork(ctx, c, ch.Spec.Network, update.Members); err != nil {
+				return err
+			}
+		}
+		if err := validateRemoveChannelMembers(ctx, c, ch, update.RemoveMembers); err != nil {
+			return err
+		}
+	case UpdateChannelConfigProposal:
+		if ch.Status.Type == ChannelArchived {
+			return errChannelAlreadyArchived
+		}
+		config := proposalSource.UpdateChannelConfig.Config
+		anchorPeers := proposalSource.UpdateChannelConfig.AnchorPeers
+		if config.IsEmpty() && len(anchorPeers) == 0 {
+			return errEmptyChannelConfig
+		}
+		if err := config.Validate(); err != nil {
+			return err
+		}
+		if err := validateAnchorPeers(ch.Spec.Members, ch.Spec.Peers, anchorPeers); err != nil {
 			return err
 		}
 	}
@@ -224,6 +354,88 @@ func validateChaincodePhase(ctx context.Context, c client.Client, chaincode stri
 	return fmt.Errorf("you can only upgrade when the phase of the chaincode is %s, Or the chaincode was successfully committed, but the service did not start properly at the end. current phase is %s", ChaincodePhaseRunning, cc.Status.Phase)
 }
 
+func validateChaincodeRollback(ctx context.Context, c client.Client, rollback *RollbackChaincode) error {
+	cc := &Chaincode{}
+	if err := c.Get(ctx, types.NamespacedName{Name: rollback.Chaincode}, cc); err != nil {
+		return err
+	}
+
+	// besides a running chaincode, an upgrade stuck in error can be rolled back as well
+	conditions := cc.Status.Conditions
+	if cc.Status.Phase != ChaincodePhaseRunning &&
+		(cc.Status.Phase != ChaincodePhaseApproved || len(conditions) == 0 || conditions[len(conditions)-1].Type != ChaincodeCondError) {
+		return fmt.Errorf("you can only rollback when the phase of the chaincode is %s or the chaincode process failed. current phase is %s", ChaincodePhaseRunning, cc.Status.Phase)
+	}
+	history, ok := cc.GetHistory(rollback.Version)
+	if !ok {
+		return fmt.Errorf("version %s not found in the history of chaincode %s", rollback.Version, rollback.Chaincode)
+	}
+	if history.Version == cc.Spec.Version && reflect.DeepEqual(history.Image, cc.Spec.Images) {
+		return fmt.Errorf("chaincode %s is already at version %s", rollback.Chaincode, rollback.Version)
+	}
+	if err := verifyChaincodeBuild(ctx, c, cc.Spec.Channel, history.ExternalBuilder, history.Image.Digest); err != nil {
+		return err
+	}
+	if history.ExternalBuilder == "" && cc.IsCCaaS() {
+		return nil
+	}
+	return checkChaincodeBuildImage(ctx, c, history.ExternalBuilder)
+}
+
+// validateChaincodeProvenance enforces the chaincode provenance policy of the channel on the image voted on
+func validateChaincodeProvenance(ctx context.Context, c client.Client, deploy *DeployChaincode) error {
+	cc := &Chaincode{}
+	if err := c.Get(ctx, types.NamespacedName{Name: deploy.Chaincode}, cc); err != nil {
+		return err
+	}
+	build, digest := cc.Spec.ExternalBuilder, cc.Spec.Images.Digest
+	if deploy.ExternalBuilder != "" {
+		build, digest = deploy.ExternalBuilder, ""
+	} else if deploy.Images != nil {
+		build, digest = "", deploy.Images.Digest
+	}
+	return verifyChaincodeBuild(ctx, c, cc.Spec.Channel, build, digest)
+}
+
+// validateChaincodeUpgradeImage checks the new image of chaincode, a ccaas chaincode can be
+// upgraded to an image built outside of the cluster
+func validateChaincodeUpgradeImage(ctx context.Context, c client.Client, upgrade *DeployChaincode) error {
+	if upgrade.ExternalBuilder != "" || upgrade.Images == nil {
+		return checkChaincodeBuildImage(ctx, c, upgrade.ExternalBuilder)
+	}
+	cc := &Chaincode{}
+	if err := c.Get(ctx, types.NamespacedName{Name: upgrade.Chaincode}, cc); err != nil {
+		return err
+	}
+	if !cc.IsCCaaS() {
+		return fmt.Errorf("images can only be upgraded without external builder in %s mode", ChaincodeModeCCaaS)
+	}
+	if upgrade.Images.Name == "" || upgrade.Version == "" {
+		return fmt.Errorf("images.name and version are required to upgrade without external builder")
+	}
+	return nil
+}
+
+func validateChaincodeCollections(ctx context.Context, c client.Client, deploy *DeployChaincode) error {
+	if len(deploy.Collections) == 0 {
+		return nil
+	}
+	cc := &Chaincode{}
+	if err := c.Get(ctx, types.NamespacedName{Name: deploy.Chaincode}, cc); err != nil {
+		return err
+	}
+	if cc.Committed() {
+		if err := ValidateCollectionsUpgrade(cc.Spec.Collections, deploy.Collections); err != nil {
+			return err
+		}
+	}
+	ch, err := cc.GetChannel(c)
+	if err != nil {
+		return err
+	}
+	return ValidateCollections(deploy.Collections, ch.Spec.Members)
+}
+
 func validateDeleteFedMember(ctx context.Context, c client.Client, deleteMember, federationName string) error {
 	chList := &ChannelList{}
 	if err := c.List(ctx, chList); err != nil && !apierrors.IsNotFound(err) {
@@ -259,3 +471,40 @@ func validateDeleteFedMember(ctx context.Context, c client.Client, deleteMember,
 	}
 	return nil
 }
+
+// validateRemoveChannelMembers make sure removed members are in channel, and neither the network initiator nor all existing members are removed
+func validateRemoveChannelMembers(ctx context.Context, c client.Client, ch *Channel, removeMembers []string) error {
+	if len(removeMembers) == 0 {
+		return nil
+	}
+	members := make(map[string]bool, len(ch.Spec.Members))
+	for _, m := range ch.Spec.Members {
+		members[m.Name] = true
+	}
+	for _, org := range removeMembers {
+		if !members[org] {
+			return fmt.Errorf("organization %s is not a member of channel %s", org, ch.Name)
+		}
+		delete(members, org)
+	}
+	if len(members) == 0 {
+		return errRemoveAllChannelMember
+	}
+
+	network := &Network{}
+	network.Name = ch.Spec.Network
+	if err := c.Get(ctx, client.ObjectKeyFromObject(network), network); err != nil {
+		return fmt.Errorf("failed to get network: %w", err)
+	}
+	for _, m := range network.Spec.Members {
+		if !m.Initiator {
+			continue
+		}
+		for _, org := range removeMembers {
+			if m.Name == org {
+				return fmt.Errorf("network initiator %s can not be removed from channel", m.Name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// GetRenewBefore returns how long before expiry certificates are renewed
+func (p *RenewalPolicy) GetRenewBefore() time.Duration {
+	if p.Spec.RenewBefore != nil {
+		return p.Spec.RenewBefore.Duration
+	}
+	d, _ := time.ParseDuration(DefaultRenewBefore)
+	return d
+}
+
+// GetKinds returns the kinds of nodes renewed
+func (p *RenewalPolicy) GetKinds() []RenewalKind {
+	if len(p.Spec.Kinds) == 0 {
+		return []RenewalKind{"IBPPeer", "IBPOrderer"}
+	}
+	return p.Spec.Kinds
+}
+
+// GetCertTypes returns the certificate types renewed
+func (p *RenewalPolicy) GetCertTypes() []CertificateType {
+	if len(p.Spec.CertTypes) == 0 {
+		return []CertificateType{CertificateEcert, CertificateTLS}
+	}
+	return p.Spec.CertTypes
+}
+
+// GetMaxConcurrent returns how many renewals may run at the same time
+func (p *RenewalPolicy) GetMaxConcurrent() int {
+	if p.Spec.MaxConcurrent < 1 {
+		return 1
+	}
+	return p.Spec.MaxConcurrent
+}
+
+// Covers returns true if the certificate of type certType of a node is renewed by the policy.
+// A CA only renews its TLS certificate
+func (p *RenewalPolicy) Covers(kind string, certType CertificateType, node metav1.Object) (bool, error) {
+	if kind == "IBPCA" && certType != CertificateTLS {
+		return false, nil
+	}
+	if !hasKind(p.GetKinds(), kind) || !hasCertType(p.GetCertTypes(), certType) {
+		return false, nil
+	}
+	if len(p.Spec.Namespaces) > 0 && !hasNamespace(p.Spec.Namespaces, node.GetNamespace()) {
+		return false, nil
+	}
+	if p.Spec.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(p.Spec.Selector)
+		if err != nil {
+			return false, err
+		}
+		if !selector.Matches(labels.Set(node.GetLabels())) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Running returns true if a renewal of target is in progress
+func (p *RenewalPolicy) Running(target RenewalTarget) bool {
+	for _, run := range p.Status.InProgress {
+		if run.RenewalTarget == target {
+			return true
+		}
+	}
+	return false
+}
+
+// Record appends a finished renewal to the history, dropping the oldest beyond MaxRenewalHistory
+func (p *RenewalPolicy) Record(result RenewalResult) {
+	p.Status.History = append(p.Status.History, result)
+	if n := len(p.Status.History); n > MaxRenewalHistory {
+		p.Status.History = p.Status.History[n-MaxRenewalHistory:]
+	}
+}
+
+func hasKind(kinds []RenewalKind, kind string) bool {
+	for _, k := range kinds {
+		if string(k) == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func hasCertType(types []CertificateType, certType CertificateType) bool {
+	for _, t := range types {
+		if t == certType {
+			return true
+		}
+	}
+	return false
+}
+
+func hasNamespace(namespaces []string, namespace string) bool {
+	for _, ns := range namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	DefaultRenewBefore = "720h"
+	// MaxRenewalHistory is how many finished renewals a policy keeps in status
+	MaxRenewalHistory = 20
+)
+
+// RenewalKind is a kind of node a policy renews
+// +kubebuilder:validation:Enum=IBPPeer;IBPOrderer;IBPCA
+type RenewalKind string
+
+// MaintenanceWindow is a recurring period in which renewals may start
+type MaintenanceWindow struct {
+	// Schedule of the window's start in cron format like `0 2 * * 6` or descriptors like `@daily`.
+	// Intervals like `@every 24h` have no fixed start and are not supported
+	Schedule string `json:"schedule"`
+	// Duration of the window
+	Duration metav1.Duration `json:"duration"`
+}
+
+// RenewalPolicySpec defines which certificates are renewed and when
+type RenewalPolicySpec struct {
+	// Namespaces of the nodes, all namespaces when empty
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Selector of the nodes by labels, all nodes when empty
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Kinds of the nodes, default to IBPPeer and IBPOrderer. Only the TLS certificate of IBPCA is renewed
+	// +optional
+	Kinds []RenewalKind `json:"kinds,omitempty"`
+	// CertTypes to renew, default to ecert and tls
+	// +optional
+	CertTypes []CertificateType `json:"certTypes,omitempty"`
+	// RenewBefore renews certificates expiring within it, default to 720h
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+	// NewKey reenrolls with a new private key
+	// +optional
+	NewKey bool `json:"newKey,omitempty"`
+	// MaintenanceWindows in which renewals may start, any time when empty
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+	// MaxConcurrent is how many renewals may run at the same time, default to 1
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrent int `json:"maxConcurrent,omitempty"`
+	// Suspend stops starting new renewals, running ones are still followed
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// RenewalTarget is a certificate of a node
+type RenewalTarget struct {
+	Kind      RenewalKind     `json:"kind"`
+	Namespace string          `json:"namespace"`
+	Name      string          `json:"name"`
+	CertType  CertificateType `json:"certType"`
+}
+
+// RenewalRun is a renewal in progress
+type RenewalRun struct {
+	RenewalTarget `json:",inline"`
+	StartTime     metav1.Time `json:"startTime"`
+	// PreviousNotAfter is the expiry of the certificate being renewed
+	PreviousNotAfter metav1.Time `json:"previousNotAfter"`
+}
+
+// RenewalResult is a finished renewal
+type RenewalResult struct {
+	RenewalTarget `json:",inline"`
+	Time          metav1.Time `json:"time"`
+	Succeeded     bool        `json:"succeeded"`
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// RenewalPolicyStatus defines the observed state of RenewalPolicy
+type RenewalPolicyStatus struct {
+	CRStatus `json:",inline"`
+
+	// InProgress are renewals started and not finished yet
+	// +optional
+	InProgress []RenewalRun `json:"inProgress,omitempty"`
+	// Pending is how many certificates are due and wait for a window or a free slot
+	// +optional
+	Pending int `json:"pending,omitempty"`
+	// WindowOpen is set while a maintenance window is open
+	// +optional
+	WindowOpen bool `json:"windowOpen,omitempty"`
+	// NextWindow is when the next maintenance window opens
+	// +optional
+	NextWindow *metav1.Time `json:"nextWindow,omitempty"`
+	// History of finished renewals, latest last
+	// +optional
+	History []RenewalResult `json:"history,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:deepcopy-gen=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=rp
+// +kubebuilder:printcolumn:name="Window Open",type=boolean,JSONPath=`.status.windowOpen`
+// +kubebuilder:printcolumn:name="Pending",type=integer,JSONPath=`.status.pending`
+// +kubebuilder:printcolumn:name="Next Window",type=date,JSONPath=`.status.nextWindow`
+// +genclient
+// +genclient:nonNamespaced
+// RenewalPolicy renews certificates of nodes before they expire, in maintenance windows and with a concurrency cap
+type RenewalPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	Spec RenewalPolicySpec `json:"spec,omitempty"`
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	Status RenewalPolicyStatus `json:"status,omitempty"`
+}
+
+// RenewalPolicyList contains a list of RenewalPolicy
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:deepcopy-gen=true
+type RenewalPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []RenewalPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RenewalPolicy{}, &RenewalPolicyList{})
+}
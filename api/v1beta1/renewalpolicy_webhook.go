@@ -0,0 +1,92 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"context"
+	"strings"
+
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util/cron"
+	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// log is for logging in this package.
+var renewalpolicyLogger = logf.Log.WithName("renewalpolicy-resource")
+
+var (
+	errRenewalUnsupportedCertType = errors.New("only ecert and tls certificates of nodes can be renewed")
+	errRenewalWindowDuration      = errors.New("maintenance window duration must be positive")
+	errRenewalCAWithoutTLS        = errors.New("kind IBPCA only renews the tls certificate")
+	errRenewalWindowInterval      = errors.New("maintenance window schedule must have fixed start times")
+)
+
+//+kubebuilder:webhook:path=/validate-ibp-com-v1beta1-renewalpolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=ibp.com,resources=renewalpolicies,verbs=create;update,versions=v1beta1,name=renewalpolicy.validate.webhook,admissionReviewVersions=v1
+
+var _ validator = &RenewalPolicy{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *RenewalPolicy) ValidateCreate(ctx context.Context, c client.Client, user authenticationv1.UserInfo) error {
+	renewalpolicyLogger.Info("validate create", "name", r.Name, "user", user.String())
+	return r.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *RenewalPolicy) ValidateUpdate(ctx context.Context, c client.Client, old runtime.Object, user authenticationv1.UserInfo) error {
+	renewalpolicyLogger.Info("validate update", "name", r.Name, "user", user.String())
+	return r.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *RenewalPolicy) ValidateDelete(ctx context.Context, c client.Client, user authenticationv1.UserInfo) error {
+	renewalpolicyLogger.Info("validate delete", "name", r.Name, "user", user.String())
+	return nil
+}
+
+func (r *RenewalPolicy) validate() error {
+	for _, t := range r.GetCertTypes() {
+		if t != CertificateEcert && t != CertificateTLS {
+			return errors.Wrapf(errRenewalUnsupportedCertType, "certType %s", t)
+		}
+	}
+	if hasKind(r.GetKinds(), "IBPCA") && !hasCertType(r.GetCertTypes(), CertificateTLS) {
+		return errRenewalCAWithoutTLS
+	}
+	if r.Spec.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(r.Spec.Selector); err != nil {
+			return errors.Wrap(err, "invalid selector")
+		}
+	}
+	for _, w := range r.Spec.MaintenanceWindows {
+		if strings.HasPrefix(strings.TrimSpace(w.Schedule), "@every") {
+			return errors.Wrapf(errRenewalWindowInterval, "schedule %s", w.Schedule)
+		}
+		if _, err := cron.Parse(w.Schedule); err != nil {
+			return errors.Wrapf(err, "invalid maintenance window schedule %s", w.Schedule)
+		}
+		if w.Duration.Duration <= 0 {
+			return errors.Wrapf(errRenewalWindowDuration, "schedule %s", w.Schedule)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// RestoreSpec defines which archive recreates which node
+type RestoreSpec struct {
+	// Backup in the same namespace whose target and storage are used when they are not given
+	// +optional
+	Backup string `json:"backup,omitempty"`
+	// Archive to restore, default to the latest archive of the backup
+	// +optional
+	Archive string `json:"archive,omitempty"`
+	// Target node to restore, it is recreated from the archive when missing
+	// +optional
+	Target *BackupTarget `json:"target,omitempty"`
+	// +optional
+	Storage *BackupStorage `json:"storage,omitempty"`
+}
+
+type RestorePhase string
+
+const (
+	RestorePending RestorePhase = "Pending"
+	// RestoreWaitingForNode waits for the recreated node's deployment and volumes
+	RestoreWaitingForNode RestorePhase = "WaitingForNode"
+	// RestoreQuiescing scales the node down to zero replicas
+	RestoreQuiescing RestorePhase = "Quiescing"
+	// RestoreRestoring downloads the volumes with a job
+	RestoreRestoring RestorePhase = "Restoring"
+	// RestoreResuming scales the node back
+	RestoreResuming  RestorePhase = "Resuming"
+	RestoreSucceeded RestorePhase = "Succeeded"
+	RestoreFailed    RestorePhase = "Failed"
+)
+
+// RestoreStatus defines the observed state of Restore
+type RestoreStatus struct {
+	// +optional
+	Phase RestorePhase `json:"phase,omitempty"`
+	// Replicas of the node to resume with
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// Volumes restored from the archive
+	// +optional
+	Volumes []string `json:"volumes,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// LastTransitionTime is when the current phase started
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:deepcopy-gen=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=rst
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.target.name`
+// +kubebuilder:printcolumn:name="Archive",type=string,JSONPath=`.spec.archive`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +genclient
+// Restore recreates a node from an archive of Backup
+type Restore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	Spec RestoreSpec `json:"spec"`
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	Status RestoreStatus `json:"status,omitempty"`
+}
+
+// RestoreList contains a list of Restore
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:deepcopy-gen=true
+type RestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Restore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Restore{}, &RestoreList{})
+}
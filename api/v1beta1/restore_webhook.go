@@ -0,0 +1,122 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// log is for logging in this package.
+var restoreLogger = logf.Log.WithName("restore-resource")
+
+var (
+	errRestoreMissingSource  = errors.New("target, storage and archive are required when no backup is given")
+	errRestoreMissingArchive = errors.New("backup has no archive to restore")
+	errRestoreImmutable      = errors.New("restore can not be updated")
+	errRestoreRunning        = errors.New("restore is running, the node is resumed when it finishes")
+)
+
+//+kubebuilder:webhook:path=/mutate-ibp-com-v1beta1-restore,mutating=true,failurePolicy=fail,sideEffects=None,groups=ibp.com,resources=restores,verbs=create;update,versions=v1beta1,name=restore.mutate.webhook,admissionReviewVersions=v1
+
+var _ defaulter = &Restore{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *Restore) Default(ctx context.Context, c client.Client, user authenticationv1.UserInfo) {
+	restoreLogger.Info("default", "name", r.Name, "user", user.String())
+	if r.Spec.Backup == "" || (r.Spec.Target != nil && r.Spec.Storage != nil && r.Spec.Archive != "") {
+		return
+	}
+	backup := &Backup{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: r.GetNamespace(), Name: r.Spec.Backup}, backup); err != nil {
+		// validation reports the missing backup
+		return
+	}
+	if r.Spec.Target == nil {
+		r.Spec.Target = backup.Spec.Target.DeepCopy()
+	}
+	if r.Spec.Storage == nil {
+		r.Spec.Storage = backup.Spec.Storage.DeepCopy()
+		r.Spec.Storage.Prefix = backup.Spec.Storage.GetPrefix(backup.GetNamespace(), backup.Spec.Target)
+	}
+	if r.Spec.Archive == "" {
+		if latest := backup.LatestArchive(); latest != nil {
+			r.Spec.Archive = latest.Name
+		}
+	}
+}
+
+//+kubebuilder:webhook:path=/validate-ibp-com-v1beta1-restore,mutating=false,failurePolicy=fail,sideEffects=None,groups=ibp.com,resources=restores,verbs=create;update;delete,versions=v1beta1,name=restore.validate.webhook,admissionReviewVersions=v1
+
+var _ validator = &Restore{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Restore) ValidateCreate(ctx context.Context, c client.Client, user authenticationv1.UserInfo) error {
+	restoreLogger.Info("validate create", "name", r.Name, "user", user.String())
+	if r.Spec.Backup != "" {
+		backup := &Backup{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: r.GetNamespace(), Name: r.Spec.Backup}, backup); err != nil {
+			return err
+		}
+		if r.Spec.Archive == "" {
+			return errors.Wrapf(errRestoreMissingArchive, "backup %s", r.Spec.Backup)
+		}
+	}
+	if r.Spec.Target == nil || r.Spec.Storage == nil || r.Spec.Archive == "" {
+		return errRestoreMissingSource
+	}
+	if err := validateBackupStorage(*r.Spec.Storage); err != nil {
+		return err
+	}
+	err := validateBackupTarget(ctx, c, r.GetNamespace(), *r.Spec.Target)
+	// a missing node is recreated from the archive
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Restore) ValidateUpdate(ctx context.Context, c client.Client, old runtime.Object, user authenticationv1.UserInfo) error {
+	restoreLogger.Info("validate update", "name", r.Name, "user", user.String())
+	oldRestore := old.(*Restore)
+	if !reflect.DeepEqual(r.Spec, oldRestore.Spec) {
+		return errRestoreImmutable
+	}
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Restore) ValidateDelete(ctx context.Context, c client.Client, user authenticationv1.UserInfo) error {
+	restoreLogger.Info("validate delete", "name", r.Name, "user", user.String())
+	// deleting a running restore would leave the node scaled down
+	switch r.Status.Phase {
+	case RestoreQuiescing, RestoreRestoring, RestoreResuming:
+		return errors.Wrapf(errRestoreRunning, "phase %s", r.Status.Phase)
+	}
+	return nil
+}
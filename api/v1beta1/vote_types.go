@@ -46,6 +46,10 @@ type VoteSpec struct {
 	OrganizationName string `json:"organizationName"`
 	// +optional
 	Decision *bool `json:"decision,omitempty"`
+	// Abstain records that the organization takes part in the vote without approving or rejecting.
+	// It can not be set together with Decision.
+	// +optional
+	Abstain bool `json:"abstain,omitempty"`
 	// +optional
 	Description string `json:"description"`
 }
@@ -31,7 +31,8 @@ import (
 )
 
 var (
-	errChangeVoteDecision = errors.New("decision can not change after vote")
+	errChangeVoteDecision  = errors.New("decision can not change after vote")
+	errAbstainWithDecision = errors.New("can not abstain and make a decision at the same time")
 )
 
 // log is for logging in this package.
@@ -54,6 +55,9 @@ var _ validator = &Vote{}
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (v *Vote) ValidateCreate(ctx context.Context, client client.Client, user authenticationv1.UserInfo) error {
 	votelog.Info("validate create", "name", v.Name, "user", user.String())
+	if v.Spec.Abstain && v.Spec.Decision != nil {
+		return errAbstainWithDecision
+	}
 
 	if err := validateVoteProAndOrg(ctx, client, v.Spec.ProposalName, v.Spec.OrganizationName, v.Namespace); err != nil {
 		return err
@@ -66,11 +70,17 @@ func (v *Vote) ValidateCreate(ctx context.Context, client client.Client, user au
 func (v *Vote) ValidateUpdate(ctx context.Context, client client.Client, old runtime.Object, user authenticationv1.UserInfo) error {
 	votelog.Info("validate update", "name", v.Name, "user", user.String())
 	instance := old.(*Vote)
+	if v.Spec.Abstain && v.Spec.Decision != nil {
+		return errAbstainWithDecision
+	}
 	if instance.Spec.Decision != nil {
 		if v.Spec.Decision == nil || *instance.Spec.Decision != *v.Spec.Decision {
 			return errChangeVoteDecision
 		}
 	}
+	if instance.Spec.Abstain && !v.Spec.Abstain {
+		return errChangeVoteDecision
+	}
 
 	if err := validateVoteProAndOrg(ctx, client, v.Spec.ProposalName, v.Spec.OrganizationName, v.Namespace); err != nil {
 		return err
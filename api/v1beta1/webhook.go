@@ -69,6 +69,10 @@ func AddWebhooks(mgr ctrl.Manager, setupLog logr.Logger) (err error) {
 		setupLog.Error(err, "unable to create webhook", "webhook", "Organization")
 		return err
 	}
+	if err = registerCustomWebhook(mgr, &ExternalOrganization{}, operatorUser); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ExternalOrganization")
+		return err
+	}
 	if err = registerCustomWebhook(mgr, &Channel{}, operatorUser); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "Channel")
 		return err
@@ -82,6 +86,21 @@ func AddWebhooks(mgr ctrl.Manager, setupLog logr.Logger) (err error) {
 	if err = registerCustomWebhook(mgr, &ChaincodeBuild{}, operatorUser); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "ChaincodeBuild")
 	}
+	if err = registerCustomWebhook(mgr, &ChaincodeInvocation{}, operatorUser); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ChaincodeInvocation")
+	}
+	if err = registerCustomWebhook(mgr, &Backup{}, operatorUser); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "Backup")
+	}
+	if err = registerCustomWebhook(mgr, &Restore{}, operatorUser); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "Restore")
+	}
+	if err = registerCustomWebhook(mgr, &RenewalPolicy{}, operatorUser); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "RenewalPolicy")
+	}
+	mgr.GetWebhookServer().Register(bundleValidatePath, &admission.Webhook{
+		Handler: &bundleHandler{operatorUser: operatorUser, client: mgr.GetClient()},
+	})
 	return nil
 }
 
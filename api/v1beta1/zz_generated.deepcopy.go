@@ -26,7 +26,9 @@ package v1beta1
 import (
 	consolev1 "github.com/IBM-Blockchain/fabric-operator/pkg/apis/console/v1"
 	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
-	"k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -50,6 +52,22 @@ func (in *AddMember) DeepCopy() *AddMember {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedChannelConfig) DeepCopyInto(out *AppliedChannelConfig) {
+	*out = *in
+	in.AppliedAt.DeepCopyInto(&out.AppliedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedChannelConfig.
+func (in *AppliedChannelConfig) DeepCopy() *AppliedChannelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedChannelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArchiveChannel) DeepCopyInto(out *ArchiveChannel) {
 	*out = *in
@@ -65,6 +83,225 @@ func (in *ArchiveChannel) DeepCopy() *ArchiveChannel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Backup) DeepCopyInto(out *Backup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backup.
+func (in *Backup) DeepCopy() *Backup {
+	if in == nil {
+		return nil
+	}
+	out := new(Backup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Backup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupArchive) DeepCopyInto(out *BackupArchive) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupArchive.
+func (in *BackupArchive) DeepCopy() *BackupArchive {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupArchive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupList) DeepCopyInto(out *BackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Backup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupList.
+func (in *BackupList) DeepCopy() *BackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRun) DeepCopyInto(out *BackupRun) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRun.
+func (in *BackupRun) DeepCopy() *BackupRun {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
+	*out = *in
+	out.Target = in.Target
+	out.Storage = in.Storage
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSpec.
+func (in *BackupSpec) DeepCopy() *BackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
+	*out = *in
+	in.CRStatus.DeepCopyInto(&out.CRStatus)
+	if in.Run != nil {
+		in, out := &in.Run, &out.Run
+		*out = new(BackupRun)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Archives != nil {
+		in, out := &in.Archives, &out.Archives
+		*out = make([]BackupArchive, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStatus.
+func (in *BackupStatus) DeepCopy() *BackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStorage) DeepCopyInto(out *BackupStorage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStorage.
+func (in *BackupStorage) DeepCopy() *BackupStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupTarget) DeepCopyInto(out *BackupTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupTarget.
+func (in *BackupTarget) DeepCopy() *BackupTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchSize) DeepCopyInto(out *BatchSize) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchSize.
+func (in *BatchSize) DeepCopy() *BatchSize {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchSize)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildProvenance) DeepCopyInto(out *BuildProvenance) {
+	*out = *in
+	if in.SBOM != nil {
+		in, out := &in.SBOM, &out.SBOM
+		*out = new(SBOM)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildProvenance.
+func (in *BuildProvenance) DeepCopy() *BuildProvenance {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildProvenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CAAction) DeepCopyInto(out *CAAction) {
 	*out = *in
@@ -193,22 +430,22 @@ func (in *CAResources) DeepCopyInto(out *CAResources) {
 	*out = *in
 	if in.Init != nil {
 		in, out := &in.Init, &out.Init
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.CA != nil {
 		in, out := &in.CA, &out.CA
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.EnrollJob != nil {
 		in, out := &in.EnrollJob, &out.EnrollJob
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.HSMDaemon != nil {
 		in, out := &in.HSMDaemon, &out.HSMDaemon
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -326,34 +563,23 @@ func (in *CSR) DeepCopy() *CSR {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Chaincode) DeepCopyInto(out *Chaincode) {
+func (in *CertificateInfo) DeepCopyInto(out *CertificateInfo) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
+	in.NotAfter.DeepCopyInto(&out.NotAfter)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Chaincode.
-func (in *Chaincode) DeepCopy() *Chaincode {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateInfo.
+func (in *CertificateInfo) DeepCopy() *CertificateInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(Chaincode)
+	out := new(CertificateInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Chaincode) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChaincodeBuild) DeepCopyInto(out *ChaincodeBuild) {
+func (in *CertificateInventory) DeepCopyInto(out *CertificateInventory) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -361,18 +587,18 @@ func (in *ChaincodeBuild) DeepCopyInto(out *ChaincodeBuild) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeBuild.
-func (in *ChaincodeBuild) DeepCopy() *ChaincodeBuild {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateInventory.
+func (in *CertificateInventory) DeepCopy() *CertificateInventory {
 	if in == nil {
 		return nil
 	}
-	out := new(ChaincodeBuild)
+	out := new(CertificateInventory)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ChaincodeBuild) DeepCopyObject() runtime.Object {
+func (in *CertificateInventory) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -380,31 +606,31 @@ func (in *ChaincodeBuild) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChaincodeBuildList) DeepCopyInto(out *ChaincodeBuildList) {
+func (in *CertificateInventoryList) DeepCopyInto(out *CertificateInventoryList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ChaincodeBuild, len(*in))
+		*out = make([]CertificateInventory, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeBuildList.
-func (in *ChaincodeBuildList) DeepCopy() *ChaincodeBuildList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateInventoryList.
+func (in *CertificateInventoryList) DeepCopy() *CertificateInventoryList {
 	if in == nil {
 		return nil
 	}
-	out := new(ChaincodeBuildList)
+	out := new(CertificateInventoryList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ChaincodeBuildList) DeepCopyObject() runtime.Object {
+func (in *CertificateInventoryList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -412,138 +638,158 @@ func (in *ChaincodeBuildList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChaincodeBuildSpec) DeepCopyInto(out *ChaincodeBuildSpec) {
+func (in *CertificateInventorySpec) DeepCopyInto(out *CertificateInventorySpec) {
 	*out = *in
-	out.License = in.License
-	in.PipelineRunSpec.DeepCopyInto(&out.PipelineRunSpec)
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpiringWithin != nil {
+		in, out := &in.ExpiringWithin, &out.ExpiringWithin
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeBuildSpec.
-func (in *ChaincodeBuildSpec) DeepCopy() *ChaincodeBuildSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateInventorySpec.
+func (in *CertificateInventorySpec) DeepCopy() *CertificateInventorySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ChaincodeBuildSpec)
+	out := new(CertificateInventorySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChaincodeBuildStatus) DeepCopyInto(out *ChaincodeBuildStatus) {
+func (in *CertificateInventoryStatus) DeepCopyInto(out *CertificateInventoryStatus) {
 	*out = *in
 	in.CRStatus.DeepCopyInto(&out.CRStatus)
-	if in.PipelineRunResults != nil {
-		in, out := &in.PipelineRunResults, &out.PipelineRunResults
-		*out = make([]pipelinev1beta1.PipelineRunResult, len(*in))
-		copy(*out, *in)
+	if in.LastRefreshTime != nil {
+		in, out := &in.LastRefreshTime, &out.LastRefreshTime
+		*out = (*in).DeepCopy()
+	}
+	out.Summary = in.Summary
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]CertificateInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeBuildStatus.
-func (in *ChaincodeBuildStatus) DeepCopy() *ChaincodeBuildStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateInventoryStatus.
+func (in *CertificateInventoryStatus) DeepCopy() *CertificateInventoryStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ChaincodeBuildStatus)
+	out := new(CertificateInventoryStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in ChaincodeBuilderConfig) DeepCopyInto(out *ChaincodeBuilderConfig) {
-	{
-		in := &in
-		*out = make(ChaincodeBuilderConfig, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
+func (in *CertificateSummary) DeepCopyInto(out *CertificateSummary) {
+	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeBuilderConfig.
-func (in ChaincodeBuilderConfig) DeepCopy() ChaincodeBuilderConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSummary.
+func (in *CertificateSummary) DeepCopy() *CertificateSummary {
 	if in == nil {
 		return nil
 	}
-	out := new(ChaincodeBuilderConfig)
+	out := new(CertificateSummary)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChaincodeCondition) DeepCopyInto(out *ChaincodeCondition) {
+func (in *Chaincode) DeepCopyInto(out *Chaincode) {
 	*out = *in
-	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeCondition.
-func (in *ChaincodeCondition) DeepCopy() *ChaincodeCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Chaincode.
+func (in *Chaincode) DeepCopy() *Chaincode {
 	if in == nil {
 		return nil
 	}
-	out := new(ChaincodeCondition)
+	out := new(Chaincode)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Chaincode) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChaincodeHistory) DeepCopyInto(out *ChaincodeHistory) {
+func (in *ChaincodeBuild) DeepCopyInto(out *ChaincodeBuild) {
 	*out = *in
-	out.Image = in.Image
-	in.UpgradeTime.DeepCopyInto(&out.UpgradeTime)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeHistory.
-func (in *ChaincodeHistory) DeepCopy() *ChaincodeHistory {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeBuild.
+func (in *ChaincodeBuild) DeepCopy() *ChaincodeBuild {
 	if in == nil {
 		return nil
 	}
-	out := new(ChaincodeHistory)
+	out := new(ChaincodeBuild)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChaincodeImage) DeepCopyInto(out *ChaincodeImage) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeImage.
-func (in *ChaincodeImage) DeepCopy() *ChaincodeImage {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaincodeBuild) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(ChaincodeImage)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChaincodeList) DeepCopyInto(out *ChaincodeList) {
+func (in *ChaincodeBuildList) DeepCopyInto(out *ChaincodeBuildList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Chaincode, len(*in))
+		*out = make([]ChaincodeBuild, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeList.
-func (in *ChaincodeList) DeepCopy() *ChaincodeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeBuildList.
+func (in *ChaincodeBuildList) DeepCopy() *ChaincodeBuildList {
 	if in == nil {
 		return nil
 	}
-	out := new(ChaincodeList)
+	out := new(ChaincodeBuildList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ChaincodeList) DeepCopyObject() runtime.Object {
+func (in *ChaincodeBuildList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -551,55 +797,146 @@ func (in *ChaincodeList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChaincodeSpec) DeepCopyInto(out *ChaincodeSpec) {
+func (in *ChaincodeBuildSpec) DeepCopyInto(out *ChaincodeBuildSpec) {
 	*out = *in
 	out.License = in.License
-	out.EndorsePolicyRef = in.EndorsePolicyRef
-	out.Images = in.Images
+	in.PipelineRunSpec.DeepCopyInto(&out.PipelineRunSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeSpec.
-func (in *ChaincodeSpec) DeepCopy() *ChaincodeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeBuildSpec.
+func (in *ChaincodeBuildSpec) DeepCopy() *ChaincodeBuildSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ChaincodeSpec)
+	out := new(ChaincodeBuildSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChaincodeStatus) DeepCopyInto(out *ChaincodeStatus) {
+func (in *ChaincodeBuildStatus) DeepCopyInto(out *ChaincodeBuildStatus) {
 	*out = *in
-	if in.History != nil {
-		in, out := &in.History, &out.History
-		*out = make([]ChaincodeHistory, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	in.CRStatus.DeepCopyInto(&out.CRStatus)
+	if in.PipelineRunResults != nil {
+		in, out := &in.PipelineRunResults, &out.PipelineRunResults
+		*out = make([]pipelinev1beta1.PipelineRunResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.Provenance != nil {
+		in, out := &in.Provenance, &out.Provenance
+		*out = new(BuildProvenance)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeBuildStatus.
+func (in *ChaincodeBuildStatus) DeepCopy() *ChaincodeBuildStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaincodeBuildStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ChaincodeBuilderConfig) DeepCopyInto(out *ChaincodeBuilderConfig) {
+	{
+		in := &in
+		*out = make(ChaincodeBuilderConfig, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]ChaincodeCondition, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeBuilderConfig.
+func (in ChaincodeBuilderConfig) DeepCopy() ChaincodeBuilderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaincodeBuilderConfig)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaincodeCanary) DeepCopyInto(out *ChaincodeCanary) {
+	*out = *in
+	if in.Organizations != nil {
+		in, out := &in.Organizations, &out.Organizations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeCanary.
+func (in *ChaincodeCanary) DeepCopy() *ChaincodeCanary {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaincodeCanary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaincodeCondition) DeepCopyInto(out *ChaincodeCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeCondition.
+func (in *ChaincodeCondition) DeepCopy() *ChaincodeCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaincodeCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaincodeHistory) DeepCopyInto(out *ChaincodeHistory) {
+	*out = *in
+	out.Image = in.Image
+	if in.Collections != nil {
+		in, out := &in.Collections, &out.Collections
+		*out = make([]Collection, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	in.LastHeartbeatTime.DeepCopyInto(&out.LastHeartbeatTime)
+	in.UpgradeTime.DeepCopyInto(&out.UpgradeTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeStatus.
-func (in *ChaincodeStatus) DeepCopy() *ChaincodeStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeHistory.
+func (in *ChaincodeHistory) DeepCopy() *ChaincodeHistory {
 	if in == nil {
 		return nil
 	}
-	out := new(ChaincodeStatus)
+	out := new(ChaincodeHistory)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Channel) DeepCopyInto(out *Channel) {
+func (in *ChaincodeImage) DeepCopyInto(out *ChaincodeImage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeImage.
+func (in *ChaincodeImage) DeepCopy() *ChaincodeImage {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaincodeImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaincodeInvocation) DeepCopyInto(out *ChaincodeInvocation) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -607,18 +944,18 @@ func (in *Channel) DeepCopyInto(out *Channel) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Channel.
-func (in *Channel) DeepCopy() *Channel {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeInvocation.
+func (in *ChaincodeInvocation) DeepCopy() *ChaincodeInvocation {
 	if in == nil {
 		return nil
 	}
-	out := new(Channel)
+	out := new(ChaincodeInvocation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Channel) DeepCopyObject() runtime.Object {
+func (in *ChaincodeInvocation) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -626,31 +963,31 @@ func (in *Channel) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChannelList) DeepCopyInto(out *ChannelList) {
+func (in *ChaincodeInvocationList) DeepCopyInto(out *ChaincodeInvocationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Channel, len(*in))
+		*out = make([]ChaincodeInvocation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelList.
-func (in *ChannelList) DeepCopy() *ChannelList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeInvocationList.
+func (in *ChaincodeInvocationList) DeepCopy() *ChaincodeInvocationList {
 	if in == nil {
 		return nil
 	}
-	out := new(ChannelList)
+	out := new(ChaincodeInvocationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ChannelList) DeepCopyObject() runtime.Object {
+func (in *ChaincodeInvocationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -658,842 +995,922 @@ func (in *ChannelList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChannelSpec) DeepCopyInto(out *ChannelSpec) {
+func (in *ChaincodeInvocationSpec) DeepCopyInto(out *ChaincodeInvocationSpec) {
 	*out = *in
-	out.License = in.License
-	if in.Members != nil {
-		in, out := &in.Members, &out.Members
-		*out = make([]Member, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Peers != nil {
-		in, out := &in.Peers, &out.Peers
-		*out = make([]NamespacedName, len(*in))
+	if in.TargetOrganizations != nil {
+		in, out := &in.TargetOrganizations, &out.TargetOrganizations
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelSpec.
-func (in *ChannelSpec) DeepCopy() *ChannelSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeInvocationSpec.
+func (in *ChaincodeInvocationSpec) DeepCopy() *ChaincodeInvocationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ChannelSpec)
+	out := new(ChaincodeInvocationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChannelStatus) DeepCopyInto(out *ChannelStatus) {
+func (in *ChaincodeInvocationStatus) DeepCopyInto(out *ChaincodeInvocationStatus) {
 	*out = *in
-	in.CRStatus.DeepCopyInto(&out.CRStatus)
-	in.ArchivedStatus.DeepCopyInto(&out.ArchivedStatus)
-	if in.PeerConditions != nil {
-		in, out := &in.PeerConditions, &out.PeerConditions
-		*out = make([]PeerCondition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Endorsements != nil {
+		in, out := &in.Endorsements, &out.Endorsements
+		*out = make([]EndorsementResponse, len(*in))
+		copy(*out, *in)
 	}
+	in.SubmitTime.DeepCopyInto(&out.SubmitTime)
+	in.CompletionTime.DeepCopyInto(&out.CompletionTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelStatus.
-func (in *ChannelStatus) DeepCopy() *ChannelStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeInvocationStatus.
+func (in *ChaincodeInvocationStatus) DeepCopy() *ChaincodeInvocationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ChannelStatus)
+	out := new(ChaincodeInvocationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigOverride) DeepCopyInto(out *ConfigOverride) {
+func (in *ChaincodeList) DeepCopyInto(out *ChaincodeList) {
 	*out = *in
-	if in.CA != nil {
-		in, out := &in.CA, &out.CA
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TLSCA != nil {
-		in, out := &in.TLSCA, &out.TLSCA
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MaxNameLength != nil {
-		in, out := &in.MaxNameLength, &out.MaxNameLength
-		*out = new(int)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Chaincode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigOverride.
-func (in *ConfigOverride) DeepCopy() *ConfigOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeList.
+func (in *ChaincodeList) DeepCopy() *ChaincodeList {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigOverride)
+	out := new(ChaincodeList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionProfileTLS) DeepCopyInto(out *ConnectionProfileTLS) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionProfileTLS.
-func (in *ConnectionProfileTLS) DeepCopy() *ConnectionProfileTLS {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaincodeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(ConnectionProfileTLS)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConsoleAction) DeepCopyInto(out *ConsoleAction) {
+func (in *ChaincodeOrganizationStatus) DeepCopyInto(out *ChaincodeOrganizationStatus) {
 	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleAction.
-func (in *ConsoleAction) DeepCopy() *ConsoleAction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeOrganizationStatus.
+func (in *ChaincodeOrganizationStatus) DeepCopy() *ChaincodeOrganizationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConsoleAction)
+	out := new(ChaincodeOrganizationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConsoleImages) DeepCopyInto(out *ConsoleImages) {
+func (in *ChaincodeRollback) DeepCopyInto(out *ChaincodeRollback) {
 	*out = *in
+	in.RollbackTime.DeepCopyInto(&out.RollbackTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleImages.
-func (in *ConsoleImages) DeepCopy() *ConsoleImages {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeRollback.
+func (in *ChaincodeRollback) DeepCopy() *ChaincodeRollback {
 	if in == nil {
 		return nil
 	}
-	out := new(ConsoleImages)
+	out := new(ChaincodeRollback)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConsoleOverrides) DeepCopyInto(out *ConsoleOverrides) {
+func (in *ChaincodeServer) DeepCopyInto(out *ChaincodeServer) {
 	*out = *in
-	if in.Console != nil {
-		in, out := &in.Console, &out.Console
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
 	}
-	if in.Deployer != nil {
-		in, out := &in.Deployer, &out.Deployer
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.MaxNameLength != nil {
-		in, out := &in.MaxNameLength, &out.MaxNameLength
-		*out = new(int)
-		**out = **in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleOverrides.
-func (in *ConsoleOverrides) DeepCopy() *ConsoleOverrides {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeServer.
+func (in *ChaincodeServer) DeepCopy() *ChaincodeServer {
 	if in == nil {
 		return nil
 	}
-	out := new(ConsoleOverrides)
+	out := new(ChaincodeServer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConsoleOverridesConsole) DeepCopyInto(out *ConsoleOverridesConsole) {
+func (in *ChaincodeSpec) DeepCopyInto(out *ChaincodeSpec) {
 	*out = *in
+	out.License = in.License
+	out.EndorsePolicyRef = in.EndorsePolicyRef
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(ChaincodeServer)
+		(*in).DeepCopyInto(*out)
+	}
+	out.Images = in.Images
+	if in.Collections != nil {
+		in, out := &in.Collections, &out.Collections
+		*out = make([]Collection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(ChaincodeCanary)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleOverridesConsole.
-func (in *ConsoleOverridesConsole) DeepCopy() *ConsoleOverridesConsole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeSpec.
+func (in *ChaincodeSpec) DeepCopy() *ChaincodeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConsoleOverridesConsole)
+	out := new(ChaincodeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConsoleOverridesDeployer) DeepCopyInto(out *ConsoleOverridesDeployer) {
+func (in *ChaincodeStatus) DeepCopyInto(out *ChaincodeStatus) {
 	*out = *in
-	if in.Timeouts != nil {
-		in, out := &in.Timeouts, &out.Timeouts
-		*out = new(DeployerTimeouts)
-		**out = **in
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]ChaincodeHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ChaincodeCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastHeartbeatTime.DeepCopyInto(&out.LastHeartbeatTime)
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(ChaincodeRollback)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Organizations != nil {
+		in, out := &in.Organizations, &out.Organizations
+		*out = make([]ChaincodeOrganizationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleOverridesDeployer.
-func (in *ConsoleOverridesDeployer) DeepCopy() *ConsoleOverridesDeployer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaincodeStatus.
+func (in *ChaincodeStatus) DeepCopy() *ChaincodeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConsoleOverridesDeployer)
+	out := new(ChaincodeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConsoleResources) DeepCopyInto(out *ConsoleResources) {
+func (in *Channel) DeepCopyInto(out *Channel) {
 	*out = *in
-	if in.Init != nil {
-		in, out := &in.Init, &out.Init
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Channel.
+func (in *Channel) DeepCopy() *Channel {
+	if in == nil {
+		return nil
 	}
-	if in.CouchDB != nil {
-		in, out := &in.CouchDB, &out.CouchDB
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	out := new(Channel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Channel) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.Console != nil {
-		in, out := &in.Console, &out.Console
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelArchive) DeepCopyInto(out *ChannelArchive) {
+	*out = *in
+	if in.Snapshots != nil {
+		in, out := &in.Snapshots, &out.Snapshots
+		*out = make([]PeerSnapshot, len(*in))
+		copy(*out, *in)
 	}
-	if in.Deployer != nil {
-		in, out := &in.Deployer, &out.Deployer
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.Keeper != nil {
+		in, out := &in.Keeper, &out.Keeper
+		*out = new(NamespacedName)
+		**out = **in
 	}
-	if in.Configtxlator != nil {
-		in, out := &in.Configtxlator, &out.Configtxlator
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.RemovedOrderers != nil {
+		in, out := &in.RemovedOrderers, &out.RemovedOrderers
+		*out = make([]NamespacedName, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConfigBlock != nil {
+		in, out := &in.ConfigBlock, &out.ConfigBlock
+		*out = new(NamespacedName)
+		**out = **in
 	}
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleResources.
-func (in *ConsoleResources) DeepCopy() *ConsoleResources {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelArchive.
+func (in *ChannelArchive) DeepCopy() *ChannelArchive {
 	if in == nil {
 		return nil
 	}
-	out := new(ConsoleResources)
+	out := new(ChannelArchive)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConsoleStorage) DeepCopyInto(out *ConsoleStorage) {
+func (in *ChannelConfig) DeepCopyInto(out *ChannelConfig) {
 	*out = *in
-	if in.Console != nil {
-		in, out := &in.Console, &out.Console
-		*out = new(StorageSpec)
-		**out = **in
+	if in.Orderer != nil {
+		in, out := &in.Orderer, &out.Orderer
+		*out = new(ChannelOrdererConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplicationCapabilities != nil {
+		in, out := &in.ApplicationCapabilities, &out.ApplicationCapabilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ChannelCapabilities != nil {
+		in, out := &in.ChannelCapabilities, &out.ChannelCapabilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ACLs != nil {
+		in, out := &in.ACLs, &out.ACLs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleStorage.
-func (in *ConsoleStorage) DeepCopy() *ConsoleStorage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelConfig.
+func (in *ChannelConfig) DeepCopy() *ChannelConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ConsoleStorage)
+	out := new(ChannelConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CreateFederation) DeepCopyInto(out *CreateFederation) {
+func (in *ChannelConfigChange) DeepCopyInto(out *ChannelConfigChange) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreateFederation.
-func (in *CreateFederation) DeepCopy() *CreateFederation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelConfigChange.
+func (in *ChannelConfigChange) DeepCopy() *ChannelConfigChange {
 	if in == nil {
 		return nil
 	}
-	out := new(CreateFederation)
+	out := new(ChannelConfigChange)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeleteMember) DeepCopyInto(out *DeleteMember) {
+func (in *ChannelConfigRecord) DeepCopyInto(out *ChannelConfigRecord) {
 	*out = *in
+	if in.Signers != nil {
+		in, out := &in.Signers, &out.Signers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]ChannelConfigChange, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteMember.
-func (in *DeleteMember) DeepCopy() *DeleteMember {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelConfigRecord.
+func (in *ChannelConfigRecord) DeepCopy() *ChannelConfigRecord {
 	if in == nil {
 		return nil
 	}
-	out := new(DeleteMember)
+	out := new(ChannelConfigRecord)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployChaincode) DeepCopyInto(out *DeployChaincode) {
+func (in *ChannelList) DeepCopyInto(out *ChannelList) {
 	*out = *in
-	if in.Members != nil {
-		in, out := &in.Members, &out.Members
-		*out = make([]Member, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Channel, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployChaincode.
-func (in *DeployChaincode) DeepCopy() *DeployChaincode {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelList.
+func (in *ChannelList) DeepCopy() *ChannelList {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployChaincode)
+	out := new(ChannelList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChannelList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Deployer) DeepCopyInto(out *Deployer) {
+func (in *ChannelOrdererConfig) DeepCopyInto(out *ChannelOrdererConfig) {
 	*out = *in
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(BatchSize)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Deployer.
-func (in *Deployer) DeepCopy() *Deployer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelOrdererConfig.
+func (in *ChannelOrdererConfig) DeepCopy() *ChannelOrdererConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(Deployer)
+	out := new(ChannelOrdererConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeployerTimeouts) DeepCopyInto(out *DeployerTimeouts) {
+func (in *ChannelRevocation) DeepCopyInto(out *ChannelRevocation) {
 	*out = *in
+	in.UpdatedAt.DeepCopyInto(&out.UpdatedAt)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployerTimeouts.
-func (in *DeployerTimeouts) DeepCopy() *DeployerTimeouts {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelRevocation.
+func (in *ChannelRevocation) DeepCopy() *ChannelRevocation {
 	if in == nil {
 		return nil
 	}
-	out := new(DeployerTimeouts)
+	out := new(ChannelRevocation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DissolveFederation) DeepCopyInto(out *DissolveFederation) {
+func (in *ChannelSpec) DeepCopyInto(out *ChannelSpec) {
 	*out = *in
+	out.License = in.License
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]Member, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]NamespacedName, len(*in))
+		copy(*out, *in)
+	}
+	if in.AnchorPeers != nil {
+		in, out := &in.AnchorPeers, &out.AnchorPeers
+		*out = make([]MemberAnchorPeers, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(ChannelConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChaincodeProvenance != nil {
+		in, out := &in.ChaincodeProvenance, &out.ChaincodeProvenance
+		*out = new(ProvenancePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DissolveFederation.
-func (in *DissolveFederation) DeepCopy() *DissolveFederation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelSpec.
+func (in *ChannelSpec) DeepCopy() *ChannelSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DissolveFederation)
+	out := new(ChannelSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DissolveNetwork) DeepCopyInto(out *DissolveNetwork) {
+func (in *ChannelStatus) DeepCopyInto(out *ChannelStatus) {
 	*out = *in
+	in.CRStatus.DeepCopyInto(&out.CRStatus)
+	in.ArchivedStatus.DeepCopyInto(&out.ArchivedStatus)
+	if in.PeerConditions != nil {
+		in, out := &in.PeerConditions, &out.PeerConditions
+		*out = make([]PeerCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AppliedConfig != nil {
+		in, out := &in.AppliedConfig, &out.AppliedConfig
+		*out = new(AppliedChannelConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigHistory != nil {
+		in, out := &in.ConfigHistory, &out.ConfigHistory
+		*out = make([]ChannelConfigRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedConfig != nil {
+		in, out := &in.ObservedConfig, &out.ObservedConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Archive != nil {
+		in, out := &in.Archive, &out.Archive
+		*out = new(ChannelArchive)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DissolveNetwork.
-func (in *DissolveNetwork) DeepCopy() *DissolveNetwork {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelStatus.
+func (in *ChannelStatus) DeepCopy() *ChannelStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DissolveNetwork)
+	out := new(ChannelStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Dockerbuild) DeepCopyInto(out *Dockerbuild) {
+func (in *Collection) DeepCopyInto(out *Collection) {
 	*out = *in
+	if in.EndorsementPolicy != nil {
+		in, out := &in.EndorsementPolicy, &out.EndorsementPolicy
+		*out = new(CollectionEndorsementPolicy)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dockerbuild.
-func (in *Dockerbuild) DeepCopy() *Dockerbuild {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Collection.
+func (in *Collection) DeepCopy() *Collection {
 	if in == nil {
 		return nil
 	}
-	out := new(Dockerbuild)
+	out := new(Collection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndorsePolicy) DeepCopyInto(out *EndorsePolicy) {
+func (in *CollectionEndorsementPolicy) DeepCopyInto(out *CollectionEndorsementPolicy) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndorsePolicy.
-func (in *EndorsePolicy) DeepCopy() *EndorsePolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectionEndorsementPolicy.
+func (in *CollectionEndorsementPolicy) DeepCopy() *CollectionEndorsementPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(EndorsePolicy)
+	out := new(CollectionEndorsementPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EndorsePolicy) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndorsePolicyList) DeepCopyInto(out *EndorsePolicyList) {
+func (in *ConfigOverride) DeepCopyInto(out *ConfigOverride) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]EndorsePolicy, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndorsePolicyList.
-func (in *EndorsePolicyList) DeepCopy() *EndorsePolicyList {
-	if in == nil {
-		return nil
+	if in.TLSCA != nil {
+		in, out := &in.TLSCA, &out.TLSCA
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(EndorsePolicyList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EndorsePolicyList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	if in.MaxNameLength != nil {
+		in, out := &in.MaxNameLength, &out.MaxNameLength
+		*out = new(int)
+		**out = **in
 	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndorsePolicyRef) DeepCopyInto(out *EndorsePolicyRef) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndorsePolicyRef.
-func (in *EndorsePolicyRef) DeepCopy() *EndorsePolicyRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigOverride.
+func (in *ConfigOverride) DeepCopy() *ConfigOverride {
 	if in == nil {
 		return nil
 	}
-	out := new(EndorsePolicyRef)
+	out := new(ConfigOverride)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndorsePolicySpec) DeepCopyInto(out *EndorsePolicySpec) {
+func (in *ConnectionProfileTLS) DeepCopyInto(out *ConnectionProfileTLS) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndorsePolicySpec.
-func (in *EndorsePolicySpec) DeepCopy() *EndorsePolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionProfileTLS.
+func (in *ConnectionProfileTLS) DeepCopy() *ConnectionProfileTLS {
 	if in == nil {
 		return nil
 	}
-	out := new(EndorsePolicySpec)
+	out := new(ConnectionProfileTLS)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndorsePolicyStatus) DeepCopyInto(out *EndorsePolicyStatus) {
+func (in *ConsoleAction) DeepCopyInto(out *ConsoleAction) {
 	*out = *in
-	in.LastHeartbeatTime.DeepCopyInto(&out.LastHeartbeatTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndorsePolicyStatus.
-func (in *EndorsePolicyStatus) DeepCopy() *EndorsePolicyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleAction.
+func (in *ConsoleAction) DeepCopy() *ConsoleAction {
 	if in == nil {
 		return nil
 	}
-	out := new(EndorsePolicyStatus)
+	out := new(ConsoleAction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Enrollment) DeepCopyInto(out *Enrollment) {
+func (in *ConsoleImages) DeepCopyInto(out *ConsoleImages) {
 	*out = *in
-	if in.CATLS != nil {
-		in, out := &in.CATLS, &out.CATLS
-		*out = new(CATLS)
-		**out = **in
-	}
-	if in.AdminCerts != nil {
-		in, out := &in.AdminCerts, &out.AdminCerts
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.CSR != nil {
-		in, out := &in.CSR, &out.CSR
-		*out = new(CSR)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Enrollment.
-func (in *Enrollment) DeepCopy() *Enrollment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleImages.
+func (in *ConsoleImages) DeepCopy() *ConsoleImages {
 	if in == nil {
 		return nil
 	}
-	out := new(Enrollment)
+	out := new(ConsoleImages)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnrollmentSpec) DeepCopyInto(out *EnrollmentSpec) {
+func (in *ConsoleOverrides) DeepCopyInto(out *ConsoleOverrides) {
 	*out = *in
-	if in.Component != nil {
-		in, out := &in.Component, &out.Component
-		*out = new(Enrollment)
+	if in.Console != nil {
+		in, out := &in.Console, &out.Console
+		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(Enrollment)
+	if in.Deployer != nil {
+		in, out := &in.Deployer, &out.Deployer
+		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ClientAuth != nil {
-		in, out := &in.ClientAuth, &out.ClientAuth
-		*out = new(Enrollment)
-		(*in).DeepCopyInto(*out)
+	if in.MaxNameLength != nil {
+		in, out := &in.MaxNameLength, &out.MaxNameLength
+		*out = new(int)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnrollmentSpec.
-func (in *EnrollmentSpec) DeepCopy() *EnrollmentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleOverrides.
+func (in *ConsoleOverrides) DeepCopy() *ConsoleOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(EnrollmentSpec)
+	out := new(ConsoleOverrides)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Federation) DeepCopyInto(out *Federation) {
+func (in *ConsoleOverridesConsole) DeepCopyInto(out *ConsoleOverridesConsole) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Federation.
-func (in *Federation) DeepCopy() *Federation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleOverridesConsole.
+func (in *ConsoleOverridesConsole) DeepCopy() *ConsoleOverridesConsole {
 	if in == nil {
 		return nil
 	}
-	out := new(Federation)
+	out := new(ConsoleOverridesConsole)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Federation) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FederationList) DeepCopyInto(out *FederationList) {
+func (in *ConsoleOverridesDeployer) DeepCopyInto(out *ConsoleOverridesDeployer) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Federation, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(DeployerTimeouts)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederationList.
-func (in *FederationList) DeepCopy() *FederationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleOverridesDeployer.
+func (in *ConsoleOverridesDeployer) DeepCopy() *ConsoleOverridesDeployer {
 	if in == nil {
 		return nil
 	}
-	out := new(FederationList)
+	out := new(ConsoleOverridesDeployer)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *FederationList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FederationSpec) DeepCopyInto(out *FederationSpec) {
+func (in *ConsoleResources) DeepCopyInto(out *ConsoleResources) {
 	*out = *in
-	out.License = in.License
-	if in.Members != nil {
-		in, out := &in.Members, &out.Members
-		*out = make([]Member, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Init != nil {
+		in, out := &in.Init, &out.Init
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CouchDB != nil {
+		in, out := &in.CouchDB, &out.CouchDB
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Console != nil {
+		in, out := &in.Console, &out.Console
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Deployer != nil {
+		in, out := &in.Deployer, &out.Deployer
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Configtxlator != nil {
+		in, out := &in.Configtxlator, &out.Configtxlator
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederationSpec.
-func (in *FederationSpec) DeepCopy() *FederationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleResources.
+func (in *ConsoleResources) DeepCopy() *ConsoleResources {
 	if in == nil {
 		return nil
 	}
-	out := new(FederationSpec)
+	out := new(ConsoleResources)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FederationStatus) DeepCopyInto(out *FederationStatus) {
+func (in *ConsoleStorage) DeepCopyInto(out *ConsoleStorage) {
 	*out = *in
-	in.CRStatus.DeepCopyInto(&out.CRStatus)
-	if in.Networks != nil {
-		in, out := &in.Networks, &out.Networks
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Console != nil {
+		in, out := &in.Console, &out.Console
+		*out = new(StorageSpec)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederationStatus.
-func (in *FederationStatus) DeepCopy() *FederationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleStorage.
+func (in *ConsoleStorage) DeepCopy() *ConsoleStorage {
 	if in == nil {
 		return nil
 	}
-	out := new(FederationStatus)
+	out := new(ConsoleStorage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Git) DeepCopyInto(out *Git) {
+func (in *CreateFederation) DeepCopyInto(out *CreateFederation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Git.
-func (in *Git) DeepCopy() *Git {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreateFederation.
+func (in *CreateFederation) DeepCopy() *CreateFederation {
 	if in == nil {
 		return nil
 	}
-	out := new(Git)
+	out := new(CreateFederation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HSM) DeepCopyInto(out *HSM) {
+func (in *DeleteMember) DeepCopyInto(out *DeleteMember) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HSM.
-func (in *HSM) DeepCopy() *HSM {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteMember.
+func (in *DeleteMember) DeepCopy() *DeleteMember {
 	if in == nil {
 		return nil
 	}
-	out := new(HSM)
+	out := new(DeleteMember)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPCA) DeepCopyInto(out *IBPCA) {
+func (in *DeployChaincode) DeepCopyInto(out *DeployChaincode) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]Member, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Collections != nil {
+		in, out := &in.Collections, &out.Collections
+		*out = make([]Collection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = new(ChaincodeImage)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPCA.
-func (in *IBPCA) DeepCopy() *IBPCA {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployChaincode.
+func (in *DeployChaincode) DeepCopy() *DeployChaincode {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPCA)
+	out := new(DeployChaincode)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBPCA) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Deployer) DeepCopyInto(out *Deployer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Deployer.
+func (in *Deployer) DeepCopy() *Deployer {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(Deployer)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPCAList) DeepCopyInto(out *IBPCAList) {
+func (in *DeployerTimeouts) DeepCopyInto(out *DeployerTimeouts) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]IBPCA, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPCAList.
-func (in *IBPCAList) DeepCopy() *IBPCAList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployerTimeouts.
+func (in *DeployerTimeouts) DeepCopy() *DeployerTimeouts {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPCAList)
+	out := new(DeployerTimeouts)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBPCAList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DissolveFederation) DeepCopyInto(out *DissolveFederation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DissolveFederation.
+func (in *DissolveFederation) DeepCopy() *DissolveFederation {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(DissolveFederation)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPCASpec) DeepCopyInto(out *IBPCASpec) {
+func (in *DissolveNetwork) DeepCopyInto(out *DissolveNetwork) {
 	*out = *in
-	out.License = in.License
-	if in.Images != nil {
-		in, out := &in.Images, &out.Images
-		*out = new(CAImages)
-		**out = **in
-	}
-	if in.ImagePullSecrets != nil {
-		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(CAResources)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Service != nil {
-		in, out := &in.Service, &out.Service
-		*out = new(Service)
-		**out = **in
-	}
-	if in.Storage != nil {
-		in, out := &in.Storage, &out.Storage
-		*out = new(CAStorages)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ConfigOverride != nil {
-		in, out := &in.ConfigOverride, &out.ConfigOverride
-		*out = new(ConfigOverride)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.HSM != nil {
-		in, out := &in.HSM, &out.HSM
-		*out = new(HSM)
-		**out = **in
-	}
-	out.CustomNames = in.CustomNames
-	out.Ingress = in.Ingress
-	if in.Arch != nil {
-		in, out := &in.Arch, &out.Arch
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	out.Action = in.Action
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPCASpec.
-func (in *IBPCASpec) DeepCopy() *IBPCASpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DissolveNetwork.
+func (in *DissolveNetwork) DeepCopy() *DissolveNetwork {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPCASpec)
+	out := new(DissolveNetwork)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPCAStatus) DeepCopyInto(out *IBPCAStatus) {
+func (in *Dockerbuild) DeepCopyInto(out *Dockerbuild) {
 	*out = *in
-	in.CRStatus.DeepCopyInto(&out.CRStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPCAStatus.
-func (in *IBPCAStatus) DeepCopy() *IBPCAStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dockerbuild.
+func (in *Dockerbuild) DeepCopy() *Dockerbuild {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPCAStatus)
+	out := new(Dockerbuild)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPConsole) DeepCopyInto(out *IBPConsole) {
+func (in *EndorsePolicy) DeepCopyInto(out *EndorsePolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPConsole.
-func (in *IBPConsole) DeepCopy() *IBPConsole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndorsePolicy.
+func (in *EndorsePolicy) DeepCopy() *EndorsePolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPConsole)
+	out := new(EndorsePolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBPConsole) DeepCopyObject() runtime.Object {
+func (in *EndorsePolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1501,31 +1918,31 @@ func (in *IBPConsole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPConsoleList) DeepCopyInto(out *IBPConsoleList) {
+func (in *EndorsePolicyList) DeepCopyInto(out *EndorsePolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IBPConsole, len(*in))
+		*out = make([]EndorsePolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPConsoleList.
-func (in *IBPConsoleList) DeepCopy() *IBPConsoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndorsePolicyList.
+func (in *EndorsePolicyList) DeepCopy() *EndorsePolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPConsoleList)
+	out := new(EndorsePolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBPConsoleList) DeepCopyObject() runtime.Object {
+func (in *EndorsePolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1533,154 +1950,201 @@ func (in *IBPConsoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPConsoleSpec) DeepCopyInto(out *IBPConsoleSpec) {
+func (in *EndorsePolicyRef) DeepCopyInto(out *EndorsePolicyRef) {
 	*out = *in
-	out.License = in.License
-	if in.Images != nil {
-		in, out := &in.Images, &out.Images
-		*out = new(ConsoleImages)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndorsePolicyRef.
+func (in *EndorsePolicyRef) DeepCopy() *EndorsePolicyRef {
+	if in == nil {
+		return nil
 	}
-	if in.ImagePullSecrets != nil {
-		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out := new(EndorsePolicyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndorsePolicySpec) DeepCopyInto(out *EndorsePolicySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndorsePolicySpec.
+func (in *EndorsePolicySpec) DeepCopy() *EndorsePolicySpec {
+	if in == nil {
+		return nil
 	}
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
-		**out = **in
+	out := new(EndorsePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndorsePolicyStatus) DeepCopyInto(out *EndorsePolicyStatus) {
+	*out = *in
+	in.LastHeartbeatTime.DeepCopyInto(&out.LastHeartbeatTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndorsePolicyStatus.
+func (in *EndorsePolicyStatus) DeepCopy() *EndorsePolicyStatus {
+	if in == nil {
+		return nil
 	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(ConsoleResources)
-		(*in).DeepCopyInto(*out)
+	out := new(EndorsePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndorsementResponse) DeepCopyInto(out *EndorsementResponse) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndorsementResponse.
+func (in *EndorsementResponse) DeepCopy() *EndorsementResponse {
+	if in == nil {
+		return nil
 	}
-	if in.Service != nil {
-		in, out := &in.Service, &out.Service
-		*out = new(Service)
+	out := new(EndorsementResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Enrollment) DeepCopyInto(out *Enrollment) {
+	*out = *in
+	if in.CATLS != nil {
+		in, out := &in.CATLS, &out.CATLS
+		*out = new(CATLS)
 		**out = **in
 	}
-	if in.Storage != nil {
-		in, out := &in.Storage, &out.Storage
-		*out = new(ConsoleStorage)
+	if in.AdminCerts != nil {
+		in, out := &in.AdminCerts, &out.AdminCerts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CSR != nil {
+		in, out := &in.CSR, &out.CSR
+		*out = new(CSR)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.NetworkInfo != nil {
-		in, out := &in.NetworkInfo, &out.NetworkInfo
-		*out = new(NetworkInfo)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Enrollment.
+func (in *Enrollment) DeepCopy() *Enrollment {
+	if in == nil {
+		return nil
 	}
-	out.Ingress = in.Ingress
-	if in.ClusterData != nil {
-		in, out := &in.ClusterData, &out.ClusterData
-		*out = new(consolev1.IBPConsoleClusterData)
+	out := new(Enrollment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnrollmentSpec) DeepCopyInto(out *EnrollmentSpec) {
+	*out = *in
+	if in.Component != nil {
+		in, out := &in.Component, &out.Component
+		*out = new(Enrollment)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.FeatureFlags != nil {
-		in, out := &in.FeatureFlags, &out.FeatureFlags
-		*out = new(consolev1.FeatureFlags)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(Enrollment)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.IBMID != nil {
-		in, out := &in.IBMID, &out.IBMID
-		*out = new(consolev1.IBMID)
-		**out = **in
-	}
-	if in.Proxying != nil {
-		in, out := &in.Proxying, &out.Proxying
-		*out = new(bool)
-		**out = **in
-	}
-	if in.CRN != nil {
-		in, out := &in.CRN, &out.CRN
-		*out = new(CRN)
-		**out = **in
+	if in.ClientAuth != nil {
+		in, out := &in.ClientAuth, &out.ClientAuth
+		*out = new(Enrollment)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Kubeconfig != nil {
-		in, out := &in.Kubeconfig, &out.Kubeconfig
-		*out = new([]byte)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make([]byte, len(*in))
-			copy(*out, *in)
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnrollmentSpec.
+func (in *EnrollmentSpec) DeepCopy() *EnrollmentSpec {
+	if in == nil {
+		return nil
 	}
-	if in.Versions != nil {
-		in, out := &in.Versions, &out.Versions
-		*out = new(Versions)
-		(*in).DeepCopyInto(*out)
+	out := new(EnrollmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalMSP) DeepCopyInto(out *ExternalMSP) {
+	*out = *in
+	if in.RootCerts != nil {
+		in, out := &in.RootCerts, &out.RootCerts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Deployer != nil {
-		in, out := &in.Deployer, &out.Deployer
-		*out = new(Deployer)
-		**out = **in
+	if in.IntermediateCerts != nil {
+		in, out := &in.IntermediateCerts, &out.IntermediateCerts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Arch != nil {
-		in, out := &in.Arch, &out.Arch
+	if in.TLSRootCerts != nil {
+		in, out := &in.TLSRootCerts, &out.TLSRootCerts
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.ConfigOverride != nil {
-		in, out := &in.ConfigOverride, &out.ConfigOverride
-		*out = new(ConsoleOverrides)
-		(*in).DeepCopyInto(*out)
+	if in.TLSIntermediateCerts != nil {
+		in, out := &in.TLSIntermediateCerts, &out.TLSIntermediateCerts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	out.Action = in.Action
-	if in.UseTags != nil {
-		in, out := &in.UseTags, &out.UseTags
-		*out = new(bool)
-		**out = **in
+	if in.Admins != nil {
+		in, out := &in.Admins, &out.Admins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPConsoleSpec.
-func (in *IBPConsoleSpec) DeepCopy() *IBPConsoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalMSP.
+func (in *ExternalMSP) DeepCopy() *ExternalMSP {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPConsoleSpec)
+	out := new(ExternalMSP)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPConsoleStatus) DeepCopyInto(out *IBPConsoleStatus) {
+func (in *ExternalNode) DeepCopyInto(out *ExternalNode) {
 	*out = *in
-	in.CRStatus.DeepCopyInto(&out.CRStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPConsoleStatus.
-func (in *IBPConsoleStatus) DeepCopy() *IBPConsoleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalNode.
+func (in *ExternalNode) DeepCopy() *ExternalNode {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPConsoleStatus)
+	out := new(ExternalNode)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPOrderer) DeepCopyInto(out *IBPOrderer) {
+func (in *ExternalOrganization) DeepCopyInto(out *ExternalOrganization) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPOrderer.
-func (in *IBPOrderer) DeepCopy() *IBPOrderer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalOrganization.
+func (in *ExternalOrganization) DeepCopy() *ExternalOrganization {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPOrderer)
+	out := new(ExternalOrganization)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBPOrderer) DeepCopyObject() runtime.Object {
+func (in *ExternalOrganization) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1688,46 +2152,31 @@ func (in *IBPOrderer) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPOrdererClusterLocation) DeepCopyInto(out *IBPOrdererClusterLocation) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPOrdererClusterLocation.
-func (in *IBPOrdererClusterLocation) DeepCopy() *IBPOrdererClusterLocation {
-	if in == nil {
-		return nil
-	}
-	out := new(IBPOrdererClusterLocation)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPOrdererList) DeepCopyInto(out *IBPOrdererList) {
+func (in *ExternalOrganizationList) DeepCopyInto(out *ExternalOrganizationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IBPOrderer, len(*in))
+		*out = make([]ExternalOrganization, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPOrdererList.
-func (in *IBPOrdererList) DeepCopy() *IBPOrdererList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalOrganizationList.
+func (in *ExternalOrganizationList) DeepCopy() *ExternalOrganizationList {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPOrdererList)
+	out := new(ExternalOrganizationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBPOrdererList) DeepCopyObject() runtime.Object {
+func (in *ExternalOrganizationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1735,139 +2184,56 @@ func (in *IBPOrdererList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPOrdererSpec) DeepCopyInto(out *IBPOrdererSpec) {
+func (in *ExternalOrganizationSpec) DeepCopyInto(out *ExternalOrganizationSpec) {
 	*out = *in
-	out.License = in.License
-	if in.Images != nil {
-		in, out := &in.Images, &out.Images
-		*out = new(OrdererImages)
-		**out = **in
-	}
-	if in.ImagePullSecrets != nil {
-		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(OrdererResources)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Service != nil {
-		in, out := &in.Service, &out.Service
-		*out = new(Service)
-		**out = **in
-	}
-	if in.Storage != nil {
-		in, out := &in.Storage, &out.Storage
-		*out = new(OrdererStorages)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.UseChannelLess != nil {
-		in, out := &in.UseChannelLess, &out.UseChannelLess
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Secret != nil {
-		in, out := &in.Secret, &out.Secret
-		*out = new(SecretSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ConfigOverride != nil {
-		in, out := &in.ConfigOverride, &out.ConfigOverride
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.HSM != nil {
-		in, out := &in.HSM, &out.HSM
-		*out = new(HSM)
-		**out = **in
-	}
-	if in.IsPrecreate != nil {
-		in, out := &in.IsPrecreate, &out.IsPrecreate
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ClusterLocation != nil {
-		in, out := &in.ClusterLocation, &out.ClusterLocation
-		*out = make([]IBPOrdererClusterLocation, len(*in))
+	in.MSP.DeepCopyInto(&out.MSP)
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]ExternalNode, len(*in))
 		copy(*out, *in)
 	}
-	if in.ClusterConfigOverride != nil {
-		in, out := &in.ClusterConfigOverride, &out.ClusterConfigOverride
-		*out = make([]*runtime.RawExtension, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(runtime.RawExtension)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.ClusterSecret != nil {
-		in, out := &in.ClusterSecret, &out.ClusterSecret
-		*out = make([]*SecretSpec, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(SecretSpec)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.NodeNumber != nil {
-		in, out := &in.NodeNumber, &out.NodeNumber
-		*out = new(int)
-		**out = **in
-	}
-	out.Ingress = in.Ingress
-	if in.Arch != nil {
-		in, out := &in.Arch, &out.Arch
-		*out = make([]string, len(*in))
+	if in.Orderers != nil {
+		in, out := &in.Orderers, &out.Orderers
+		*out = make([]ExternalNode, len(*in))
 		copy(*out, *in)
 	}
-	if in.DisableNodeOU != nil {
-		in, out := &in.DisableNodeOU, &out.DisableNodeOU
-		*out = new(bool)
-		**out = **in
-	}
-	out.CustomNames = in.CustomNames
-	out.Action = in.Action
+	out.JoinPackage = in.JoinPackage
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPOrdererSpec.
-func (in *IBPOrdererSpec) DeepCopy() *IBPOrdererSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalOrganizationSpec.
+func (in *ExternalOrganizationSpec) DeepCopy() *ExternalOrganizationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPOrdererSpec)
+	out := new(ExternalOrganizationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPOrdererStatus) DeepCopyInto(out *IBPOrdererStatus) {
+func (in *ExternalVote) DeepCopyInto(out *ExternalVote) {
 	*out = *in
-	in.CRStatus.DeepCopyInto(&out.CRStatus)
+	if in.Decision != nil {
+		in, out := &in.Decision, &out.Decision
+		*out = new(bool)
+		**out = **in
+	}
+	in.VoteTime.DeepCopyInto(&out.VoteTime)
+	out.Signed = in.Signed
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPOrdererStatus.
-func (in *IBPOrdererStatus) DeepCopy() *IBPOrdererStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalVote.
+func (in *ExternalVote) DeepCopy() *ExternalVote {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPOrdererStatus)
+	out := new(ExternalVote)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPPeer) DeepCopyInto(out *IBPPeer) {
+func (in *Federation) DeepCopyInto(out *Federation) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1875,18 +2241,18 @@ func (in *IBPPeer) DeepCopyInto(out *IBPPeer) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPPeer.
-func (in *IBPPeer) DeepCopy() *IBPPeer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Federation.
+func (in *Federation) DeepCopy() *Federation {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPPeer)
+	out := new(Federation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBPPeer) DeepCopyObject() runtime.Object {
+func (in *Federation) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1894,31 +2260,31 @@ func (in *IBPPeer) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPPeerList) DeepCopyInto(out *IBPPeerList) {
+func (in *FederationList) DeepCopyInto(out *FederationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IBPPeer, len(*in))
+		*out = make([]Federation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPPeerList.
-func (in *IBPPeerList) DeepCopy() *IBPPeerList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederationList.
+func (in *FederationList) DeepCopy() *FederationList {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPPeerList)
+	out := new(FederationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBPPeerList) DeepCopyObject() runtime.Object {
+func (in *FederationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1926,12 +2292,172 @@ func (in *IBPPeerList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPPeerSpec) DeepCopyInto(out *IBPPeerSpec) {
+func (in *FederationSpec) DeepCopyInto(out *FederationSpec) {
+	*out = *in
+	out.License = in.License
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]Member, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VotingRule != nil {
+		in, out := &in.VotingRule, &out.VotingRule
+		*out = new(VotingRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Weights != nil {
+		in, out := &in.Weights, &out.Weights
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederationSpec.
+func (in *FederationSpec) DeepCopy() *FederationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FederationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederationStatus) DeepCopyInto(out *FederationStatus) {
+	*out = *in
+	in.CRStatus.DeepCopyInto(&out.CRStatus)
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederationStatus.
+func (in *FederationStatus) DeepCopy() *FederationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FederationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Git) DeepCopyInto(out *Git) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Git.
+func (in *Git) DeepCopy() *Git {
+	if in == nil {
+		return nil
+	}
+	out := new(Git)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HSM) DeepCopyInto(out *HSM) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HSM.
+func (in *HSM) DeepCopy() *HSM {
+	if in == nil {
+		return nil
+	}
+	out := new(HSM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTP) DeepCopyInto(out *HTTP) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTP.
+func (in *HTTP) DeepCopy() *HTTP {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPCA) DeepCopyInto(out *IBPCA) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPCA.
+func (in *IBPCA) DeepCopy() *IBPCA {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPCA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBPCA) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPCAList) DeepCopyInto(out *IBPCAList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IBPCA, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPCAList.
+func (in *IBPCAList) DeepCopy() *IBPCAList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPCAList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBPCAList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPCASpec) DeepCopyInto(out *IBPCASpec) {
 	*out = *in
 	out.License = in.License
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
-		*out = new(PeerImages)
+		*out = new(CAImages)
 		**out = **in
 	}
 	if in.ImagePullSecrets != nil {
@@ -1946,7 +2472,7 @@ func (in *IBPPeerSpec) DeepCopyInto(out *IBPPeerSpec) {
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(PeerResources)
+		*out = new(CAResources)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Service != nil {
@@ -1956,12 +2482,12 @@ func (in *IBPPeerSpec) DeepCopyInto(out *IBPPeerSpec) {
 	}
 	if in.Storage != nil {
 		in, out := &in.Storage, &out.Storage
-		*out = new(PeerStorages)
+		*out = new(CAStorages)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ConfigOverride != nil {
 		in, out := &in.ConfigOverride, &out.ConfigOverride
-		*out = new(runtime.RawExtension)
+		*out = new(ConfigOverride)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.HSM != nil {
@@ -1969,205 +2495,1225 @@ func (in *IBPPeerSpec) DeepCopyInto(out *IBPPeerSpec) {
 		*out = new(HSM)
 		**out = **in
 	}
-	if in.DisableNodeOU != nil {
-		in, out := &in.DisableNodeOU, &out.DisableNodeOU
-		*out = new(bool)
-		**out = **in
-	}
 	out.CustomNames = in.CustomNames
-	if in.Secret != nil {
-		in, out := &in.Secret, &out.Secret
-		*out = new(SecretSpec)
-		(*in).DeepCopyInto(*out)
-	}
 	out.Ingress = in.Ingress
 	if in.Arch != nil {
 		in, out := &in.Arch, &out.Arch
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.DindArgs != nil {
-		in, out := &in.DindArgs, &out.DindArgs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	out.Action = in.Action
-	if in.ChaincodeBuilderConfig != nil {
-		in, out := &in.ChaincodeBuilderConfig, &out.ChaincodeBuilderConfig
-		*out = make(ChaincodeBuilderConfig, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPCASpec.
+func (in *IBPCASpec) DeepCopy() *IBPCASpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPCASpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPCAStatus) DeepCopyInto(out *IBPCAStatus) {
+	*out = *in
+	in.CRStatus.DeepCopyInto(&out.CRStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPCAStatus.
+func (in *IBPCAStatus) DeepCopy() *IBPCAStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPCAStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPConsole) DeepCopyInto(out *IBPConsole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPConsole.
+func (in *IBPConsole) DeepCopy() *IBPConsole {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPConsole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBPConsole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPConsoleList) DeepCopyInto(out *IBPConsoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IBPConsole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPConsoleList.
+func (in *IBPConsoleList) DeepCopy() *IBPConsoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPConsoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBPConsoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPConsoleSpec) DeepCopyInto(out *IBPConsoleSpec) {
+	*out = *in
+	out.License = in.License
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = new(ConsoleImages)
+		**out = **in
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ConsoleResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(Service)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(ConsoleStorage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkInfo != nil {
+		in, out := &in.NetworkInfo, &out.NetworkInfo
+		*out = new(NetworkInfo)
+		**out = **in
+	}
+	out.Ingress = in.Ingress
+	if in.ClusterData != nil {
+		in, out := &in.ClusterData, &out.ClusterData
+		*out = new(consolev1.IBPConsoleClusterData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FeatureFlags != nil {
+		in, out := &in.FeatureFlags, &out.FeatureFlags
+		*out = new(consolev1.FeatureFlags)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IBMID != nil {
+		in, out := &in.IBMID, &out.IBMID
+		*out = new(consolev1.IBMID)
+		**out = **in
+	}
+	if in.Proxying != nil {
+		in, out := &in.Proxying, &out.Proxying
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CRN != nil {
+		in, out := &in.CRN, &out.CRN
+		*out = new(CRN)
+		**out = **in
+	}
+	if in.Kubeconfig != nil {
+		in, out := &in.Kubeconfig, &out.Kubeconfig
+		*out = new([]byte)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]byte, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = new(Versions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Deployer != nil {
+		in, out := &in.Deployer, &out.Deployer
+		*out = new(Deployer)
+		**out = **in
+	}
+	if in.Arch != nil {
+		in, out := &in.Arch, &out.Arch
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConfigOverride != nil {
+		in, out := &in.ConfigOverride, &out.ConfigOverride
+		*out = new(ConsoleOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	out.Action = in.Action
+	if in.UseTags != nil {
+		in, out := &in.UseTags, &out.UseTags
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPConsoleSpec.
+func (in *IBPConsoleSpec) DeepCopy() *IBPConsoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPConsoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPConsoleStatus) DeepCopyInto(out *IBPConsoleStatus) {
+	*out = *in
+	in.CRStatus.DeepCopyInto(&out.CRStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPConsoleStatus.
+func (in *IBPConsoleStatus) DeepCopy() *IBPConsoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPConsoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPOrderer) DeepCopyInto(out *IBPOrderer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPOrderer.
+func (in *IBPOrderer) DeepCopy() *IBPOrderer {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPOrderer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBPOrderer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPOrdererClusterLocation) DeepCopyInto(out *IBPOrdererClusterLocation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPOrdererClusterLocation.
+func (in *IBPOrdererClusterLocation) DeepCopy() *IBPOrdererClusterLocation {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPOrdererClusterLocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPOrdererList) DeepCopyInto(out *IBPOrdererList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IBPOrderer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPOrdererList.
+func (in *IBPOrdererList) DeepCopy() *IBPOrdererList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPOrdererList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBPOrdererList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPOrdererSpec) DeepCopyInto(out *IBPOrdererSpec) {
+	*out = *in
+	out.License = in.License
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = new(OrdererImages)
+		**out = **in
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(OrdererResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(Service)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(OrdererStorages)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UseChannelLess != nil {
+		in, out := &in.UseChannelLess, &out.UseChannelLess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(SecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigOverride != nil {
+		in, out := &in.ConfigOverride, &out.ConfigOverride
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HSM != nil {
+		in, out := &in.HSM, &out.HSM
+		*out = new(HSM)
+		**out = **in
+	}
+	if in.IsPrecreate != nil {
+		in, out := &in.IsPrecreate, &out.IsPrecreate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClusterLocation != nil {
+		in, out := &in.ClusterLocation, &out.ClusterLocation
+		*out = make([]IBPOrdererClusterLocation, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterConfigOverride != nil {
+		in, out := &in.ClusterConfigOverride, &out.ClusterConfigOverride
+		*out = make([]*runtime.RawExtension, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(runtime.RawExtension)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ClusterSecret != nil {
+		in, out := &in.ClusterSecret, &out.ClusterSecret
+		*out = make([]*SecretSpec, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SecretSpec)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.NodeNumber != nil {
+		in, out := &in.NodeNumber, &out.NodeNumber
+		*out = new(int)
+		**out = **in
+	}
+	out.Ingress = in.Ingress
+	if in.Arch != nil {
+		in, out := &in.Arch, &out.Arch
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DisableNodeOU != nil {
+		in, out := &in.DisableNodeOU, &out.DisableNodeOU
+		*out = new(bool)
+		**out = **in
+	}
+	out.CustomNames = in.CustomNames
+	out.Action = in.Action
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPOrdererSpec.
+func (in *IBPOrdererSpec) DeepCopy() *IBPOrdererSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPOrdererSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPOrdererStatus) DeepCopyInto(out *IBPOrdererStatus) {
+	*out = *in
+	in.CRStatus.DeepCopyInto(&out.CRStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPOrdererStatus.
+func (in *IBPOrdererStatus) DeepCopy() *IBPOrdererStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPOrdererStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPPeer) DeepCopyInto(out *IBPPeer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPPeer.
+func (in *IBPPeer) DeepCopy() *IBPPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBPPeer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPPeerList) DeepCopyInto(out *IBPPeerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IBPPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPPeerList.
+func (in *IBPPeerList) DeepCopy() *IBPPeerList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPPeerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBPPeerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPPeerSpec) DeepCopyInto(out *IBPPeerSpec) {
+	*out = *in
+	out.License = in.License
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = new(PeerImages)
+		**out = **in
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(PeerResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(Service)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(PeerStorages)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigOverride != nil {
+		in, out := &in.ConfigOverride, &out.ConfigOverride
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HSM != nil {
+		in, out := &in.HSM, &out.HSM
+		*out = new(HSM)
+		**out = **in
+	}
+	if in.DisableNodeOU != nil {
+		in, out := &in.DisableNodeOU, &out.DisableNodeOU
+		*out = new(bool)
+		**out = **in
+	}
+	out.CustomNames = in.CustomNames
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(SecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	out.Ingress = in.Ingress
+	if in.Arch != nil {
+		in, out := &in.Arch, &out.Arch
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DindArgs != nil {
+		in, out := &in.DindArgs, &out.DindArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Action = in.Action
+	if in.ChaincodeBuilderConfig != nil {
+		in, out := &in.ChaincodeBuilderConfig, &out.ChaincodeBuilderConfig
+		*out = make(ChaincodeBuilderConfig, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.UnjoinChannels != nil {
+		in, out := &in.UnjoinChannels, &out.UnjoinChannels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPPeerSpec.
+func (in *IBPPeerSpec) DeepCopy() *IBPPeerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPPeerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBPPeerStatus) DeepCopyInto(out *IBPPeerStatus) {
+	*out = *in
+	in.CRStatus.DeepCopyInto(&out.CRStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPPeerStatus.
+func (in *IBPPeerStatus) DeepCopy() *IBPPeerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBPPeerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Identity) DeepCopyInto(out *Identity) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Identity.
+func (in *Identity) DeepCopy() *Identity {
+	if in == nil {
+		return nil
+	}
+	out := new(Identity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Identity) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityList) DeepCopyInto(out *IdentityList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Identity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityList.
+func (in *IdentityList) DeepCopy() *IdentityList {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IdentityList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentitySpec) DeepCopyInto(out *IdentitySpec) {
+	*out = *in
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentitySpec.
+func (in *IdentitySpec) DeepCopy() *IdentitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityStatus) DeepCopyInto(out *IdentityStatus) {
+	*out = *in
+	if in.Expiry != nil {
+		in, out := &in.Expiry, &out.Expiry
+		*out = (*in).DeepCopy()
+	}
+	if in.RevocationTime != nil {
+		in, out := &in.RevocationTime, &out.RevocationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityStatus.
+func (in *IdentityStatus) DeepCopy() *IdentityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ingress) DeepCopyInto(out *Ingress) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ingress.
+func (in *Ingress) DeepCopy() *Ingress {
+	if in == nil {
+		return nil
+	}
+	out := new(Ingress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LanguageBuild) DeepCopyInto(out *LanguageBuild) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LanguageBuild.
+func (in *LanguageBuild) DeepCopy() *LanguageBuild {
+	if in == nil {
+		return nil
+	}
+	out := new(LanguageBuild)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *License) DeepCopyInto(out *License) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new License.
+func (in *License) DeepCopy() *License {
+	if in == nil {
+		return nil
+	}
+	out := new(License)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSP) DeepCopyInto(out *MSP) {
+	*out = *in
+	if in.CACerts != nil {
+		in, out := &in.CACerts, &out.CACerts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IntermediateCerts != nil {
+		in, out := &in.IntermediateCerts, &out.IntermediateCerts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdminCerts != nil {
+		in, out := &in.AdminCerts, &out.AdminCerts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSP.
+func (in *MSP) DeepCopy() *MSP {
+	if in == nil {
+		return nil
+	}
+	out := new(MSP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSPSpec) DeepCopyInto(out *MSPSpec) {
+	*out = *in
+	if in.Component != nil {
+		in, out := &in.Component, &out.Component
+		*out = new(MSP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(MSP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientAuth != nil {
+		in, out := &in.ClientAuth, &out.ClientAuth
+		*out = new(MSP)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSPSpec.
+func (in *MSPSpec) DeepCopy() *MSPSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MSPSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Member) DeepCopyInto(out *Member) {
+	*out = *in
+	if in.JoinedAt != nil {
+		in, out := &in.JoinedAt, &out.JoinedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
+func (in *Member) DeepCopy() *Member {
+	if in == nil {
+		return nil
+	}
+	out := new(Member)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberAnchorPeers) DeepCopyInto(out *MemberAnchorPeers) {
+	*out = *in
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]NamespacedName, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberAnchorPeers.
+func (in *MemberAnchorPeers) DeepCopy() *MemberAnchorPeers {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberAnchorPeers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Minio) DeepCopyInto(out *Minio) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Minio.
+func (in *Minio) DeepCopy() *Minio {
+	if in == nil {
+		return nil
+	}
+	out := new(Minio)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedName) DeepCopyInto(out *NamespacedName) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedName.
+func (in *NamespacedName) DeepCopy() *NamespacedName {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Network) DeepCopyInto(out *Network) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Network.
+func (in *Network) DeepCopy() *Network {
+	if in == nil {
+		return nil
+	}
+	out := new(Network)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Network) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInfo) DeepCopyInto(out *NetworkInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInfo.
+func (in *NetworkInfo) DeepCopy() *NetworkInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkList) DeepCopyInto(out *NetworkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Network, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkList.
+func (in *NetworkList) DeepCopy() *NetworkList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
+	*out = *in
+	out.License = in.License
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]Member, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.OrderSpec.DeepCopyInto(&out.OrderSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPPeerSpec.
-func (in *IBPPeerSpec) DeepCopy() *IBPPeerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
+func (in *NetworkSpec) DeepCopy() *NetworkSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPPeerSpec)
+	out := new(NetworkSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBPPeerStatus) DeepCopyInto(out *IBPPeerStatus) {
+func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
 	*out = *in
 	in.CRStatus.DeepCopyInto(&out.CRStatus)
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBPPeerStatus.
-func (in *IBPPeerStatus) DeepCopy() *IBPPeerStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
+func (in *NetworkStatus) DeepCopy() *NetworkStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IBPPeerStatus)
+	out := new(NetworkStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Ingress) DeepCopyInto(out *Ingress) {
+func (in *OCI) DeepCopyInto(out *OCI) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ingress.
-func (in *Ingress) DeepCopy() *Ingress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCI.
+func (in *OCI) DeepCopy() *OCI {
 	if in == nil {
 		return nil
 	}
-	out := new(Ingress)
+	out := new(OCI)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *License) DeepCopyInto(out *License) {
+func (in *OrdererAction) DeepCopyInto(out *OrdererAction) {
 	*out = *in
+	out.Reenroll = in.Reenroll
+	out.Enroll = in.Enroll
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new License.
-func (in *License) DeepCopy() *License {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererAction.
+func (in *OrdererAction) DeepCopy() *OrdererAction {
 	if in == nil {
 		return nil
 	}
-	out := new(License)
+	out := new(OrdererAction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MSP) DeepCopyInto(out *MSP) {
+func (in *OrdererConnectionProfile) DeepCopyInto(out *OrdererConnectionProfile) {
 	*out = *in
-	if in.CACerts != nil {
-		in, out := &in.CACerts, &out.CACerts
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.IntermediateCerts != nil {
-		in, out := &in.IntermediateCerts, &out.IntermediateCerts
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out.Endpoints = in.Endpoints
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(MSP)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.AdminCerts != nil {
-		in, out := &in.AdminCerts, &out.AdminCerts
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Component != nil {
+		in, out := &in.Component, &out.Component
+		*out = new(MSP)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSP.
-func (in *MSP) DeepCopy() *MSP {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererConnectionProfile.
+func (in *OrdererConnectionProfile) DeepCopy() *OrdererConnectionProfile {
 	if in == nil {
 		return nil
 	}
-	out := new(MSP)
+	out := new(OrdererConnectionProfile)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MSPSpec) DeepCopyInto(out *MSPSpec) {
+func (in *OrdererCustomNames) DeepCopyInto(out *OrdererCustomNames) {
 	*out = *in
-	if in.Component != nil {
-		in, out := &in.Component, &out.Component
-		*out = new(MSP)
-		(*in).DeepCopyInto(*out)
+	out.PVC = in.PVC
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererCustomNames.
+func (in *OrdererCustomNames) DeepCopy() *OrdererCustomNames {
+	if in == nil {
+		return nil
 	}
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(MSP)
-		(*in).DeepCopyInto(*out)
+	out := new(OrdererCustomNames)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrdererEndpoints) DeepCopyInto(out *OrdererEndpoints) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererEndpoints.
+func (in *OrdererEndpoints) DeepCopy() *OrdererEndpoints {
+	if in == nil {
+		return nil
 	}
-	if in.ClientAuth != nil {
-		in, out := &in.ClientAuth, &out.ClientAuth
-		*out = new(MSP)
-		(*in).DeepCopyInto(*out)
+	out := new(OrdererEndpoints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrdererEnrollAction) DeepCopyInto(out *OrdererEnrollAction) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererEnrollAction.
+func (in *OrdererEnrollAction) DeepCopy() *OrdererEnrollAction {
+	if in == nil {
+		return nil
 	}
+	out := new(OrdererEnrollAction)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSPSpec.
-func (in *MSPSpec) DeepCopy() *MSPSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrdererImages) DeepCopyInto(out *OrdererImages) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererImages.
+func (in *OrdererImages) DeepCopy() *OrdererImages {
 	if in == nil {
 		return nil
 	}
-	out := new(MSPSpec)
+	out := new(OrdererImages)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Member) DeepCopyInto(out *Member) {
+func (in *OrdererPVCNames) DeepCopyInto(out *OrdererPVCNames) {
 	*out = *in
-	if in.JoinedAt != nil {
-		in, out := &in.JoinedAt, &out.JoinedAt
-		*out = (*in).DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererPVCNames.
+func (in *OrdererPVCNames) DeepCopy() *OrdererPVCNames {
+	if in == nil {
+		return nil
 	}
+	out := new(OrdererPVCNames)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Member.
-func (in *Member) DeepCopy() *Member {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrdererReenrollAction) DeepCopyInto(out *OrdererReenrollAction) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererReenrollAction.
+func (in *OrdererReenrollAction) DeepCopy() *OrdererReenrollAction {
 	if in == nil {
 		return nil
 	}
-	out := new(Member)
+	out := new(OrdererReenrollAction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Minio) DeepCopyInto(out *Minio) {
+func (in *OrdererResources) DeepCopyInto(out *OrdererResources) {
 	*out = *in
+	if in.Init != nil {
+		in, out := &in.Init, &out.Init
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Orderer != nil {
+		in, out := &in.Orderer, &out.Orderer
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPCProxy != nil {
+		in, out := &in.GRPCProxy, &out.GRPCProxy
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Enroller != nil {
+		in, out := &in.Enroller, &out.Enroller
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HSMDaemon != nil {
+		in, out := &in.HSMDaemon, &out.HSMDaemon
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Minio.
-func (in *Minio) DeepCopy() *Minio {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererResources.
+func (in *OrdererResources) DeepCopy() *OrdererResources {
 	if in == nil {
 		return nil
 	}
-	out := new(Minio)
+	out := new(OrdererResources)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespacedName) DeepCopyInto(out *NamespacedName) {
+func (in *OrdererStorages) DeepCopyInto(out *OrdererStorages) {
 	*out = *in
+	if in.Orderer != nil {
+		in, out := &in.Orderer, &out.Orderer
+		*out = new(StorageSpec)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedName.
-func (in *NamespacedName) DeepCopy() *NamespacedName {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererStorages.
+func (in *OrdererStorages) DeepCopy() *OrdererStorages {
 	if in == nil {
 		return nil
 	}
-	out := new(NamespacedName)
+	out := new(OrdererStorages)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Network) DeepCopyInto(out *Network) {
+func (in *Organization) DeepCopyInto(out *Organization) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2175,18 +3721,18 @@ func (in *Network) DeepCopyInto(out *Network) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Network.
-func (in *Network) DeepCopy() *Network {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Organization.
+func (in *Organization) DeepCopy() *Organization {
 	if in == nil {
 		return nil
 	}
-	out := new(Network)
+	out := new(Organization)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Network) DeepCopyObject() runtime.Object {
+func (in *Organization) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2194,116 +3740,211 @@ func (in *Network) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkInfo) DeepCopyInto(out *NetworkInfo) {
+func (in *OrganizationList) DeepCopyInto(out *OrganizationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Organization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationList.
+func (in *OrganizationList) DeepCopy() *OrganizationList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRevocation) DeepCopyInto(out *OrganizationRevocation) {
 	*out = *in
+	if in.Revoked != nil {
+		in, out := &in.Revoked, &out.Revoked
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GeneratedAt != nil {
+		in, out := &in.GeneratedAt, &out.GeneratedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]ChannelRevocation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInfo.
-func (in *NetworkInfo) DeepCopy() *NetworkInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRevocation.
+func (in *OrganizationRevocation) DeepCopy() *OrganizationRevocation {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkInfo)
+	out := new(OrganizationRevocation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkList) DeepCopyInto(out *NetworkList) {
+func (in *OrganizationRole) DeepCopyInto(out *OrganizationRole) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Network, len(*in))
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]rbacv1.PolicyRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ClusterVerbs != nil {
+		in, out := &in.ClusterVerbs, &out.ClusterVerbs
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkList.
-func (in *NetworkList) DeepCopy() *NetworkList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRole.
+func (in *OrganizationRole) DeepCopy() *OrganizationRole {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkList)
+	out := new(OrganizationRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NetworkList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
+func (in *OrganizationSpec) DeepCopyInto(out *OrganizationSpec) {
 	*out = *in
 	out.License = in.License
-	if in.Members != nil {
-		in, out := &in.Members, &out.Members
-		*out = make([]Member, len(*in))
+	if in.Admins != nil {
+		in, out := &in.Admins, &out.Admins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Clients != nil {
+		in, out := &in.Clients, &out.Clients
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]OrganizationRole, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	in.OrderSpec.DeepCopyInto(&out.OrderSpec)
+	if in.RevocationApproval != nil {
+		in, out := &in.RevocationApproval, &out.RevocationApproval
+		*out = new(RevocationApproval)
+		(*in).DeepCopyInto(*out)
+	}
+	in.CASpec.DeepCopyInto(&out.CASpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
-func (in *NetworkSpec) DeepCopy() *NetworkSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSpec.
+func (in *OrganizationSpec) DeepCopy() *OrganizationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkSpec)
+	out := new(OrganizationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+func (in *OrganizationStatus) DeepCopyInto(out *OrganizationStatus) {
 	*out = *in
 	in.CRStatus.DeepCopyInto(&out.CRStatus)
-	if in.Channels != nil {
-		in, out := &in.Channels, &out.Channels
+	if in.Federations != nil {
+		in, out := &in.Federations, &out.Federations
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Revocation != nil {
+		in, out := &in.Revocation, &out.Revocation
+		*out = new(OrganizationRevocation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
-func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationStatus.
+func (in *OrganizationStatus) DeepCopy() *OrganizationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkStatus)
+	out := new(OrganizationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrdererAction) DeepCopyInto(out *OrdererAction) {
+func (in *PeerAction) DeepCopyInto(out *PeerAction) {
 	*out = *in
 	out.Reenroll = in.Reenroll
 	out.Enroll = in.Enroll
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererAction.
-func (in *OrdererAction) DeepCopy() *OrdererAction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerAction.
+func (in *PeerAction) DeepCopy() *PeerAction {
 	if in == nil {
 		return nil
 	}
-	out := new(OrdererAction)
+	out := new(PeerAction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrdererConnectionProfile) DeepCopyInto(out *OrdererConnectionProfile) {
+func (in *PeerCondition) DeepCopyInto(out *PeerCondition) {
+	*out = *in
+	out.NamespacedName = in.NamespacedName
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerCondition.
+func (in *PeerCondition) DeepCopy() *PeerCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(PeerCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PeerConnectionProfile) DeepCopyInto(out *PeerConnectionProfile) {
 	*out = *in
 	out.Endpoints = in.Endpoints
 	if in.TLS != nil {
@@ -2318,220 +3959,274 @@ func (in *OrdererConnectionProfile) DeepCopyInto(out *OrdererConnectionProfile)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererConnectionProfile.
-func (in *OrdererConnectionProfile) DeepCopy() *OrdererConnectionProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerConnectionProfile.
+func (in *PeerConnectionProfile) DeepCopy() *PeerConnectionProfile {
 	if in == nil {
 		return nil
 	}
-	out := new(OrdererConnectionProfile)
+	out := new(PeerConnectionProfile)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrdererCustomNames) DeepCopyInto(out *OrdererCustomNames) {
+func (in *PeerCustomNames) DeepCopyInto(out *PeerCustomNames) {
 	*out = *in
 	out.PVC = in.PVC
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererCustomNames.
-func (in *OrdererCustomNames) DeepCopy() *OrdererCustomNames {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerCustomNames.
+func (in *PeerCustomNames) DeepCopy() *PeerCustomNames {
 	if in == nil {
 		return nil
 	}
-	out := new(OrdererCustomNames)
+	out := new(PeerCustomNames)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrdererEndpoints) DeepCopyInto(out *OrdererEndpoints) {
+func (in *PeerEndpoints) DeepCopyInto(out *PeerEndpoints) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererEndpoints.
-func (in *OrdererEndpoints) DeepCopy() *OrdererEndpoints {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerEndpoints.
+func (in *PeerEndpoints) DeepCopy() *PeerEndpoints {
 	if in == nil {
 		return nil
 	}
-	out := new(OrdererEndpoints)
+	out := new(PeerEndpoints)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrdererEnrollAction) DeepCopyInto(out *OrdererEnrollAction) {
+func (in *PeerEnrollAction) DeepCopyInto(out *PeerEnrollAction) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererEnrollAction.
-func (in *OrdererEnrollAction) DeepCopy() *OrdererEnrollAction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerEnrollAction.
+func (in *PeerEnrollAction) DeepCopy() *PeerEnrollAction {
 	if in == nil {
 		return nil
 	}
-	out := new(OrdererEnrollAction)
+	out := new(PeerEnrollAction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrdererImages) DeepCopyInto(out *OrdererImages) {
+func (in *PeerImages) DeepCopyInto(out *PeerImages) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererImages.
-func (in *OrdererImages) DeepCopy() *OrdererImages {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerImages.
+func (in *PeerImages) DeepCopy() *PeerImages {
 	if in == nil {
 		return nil
 	}
-	out := new(OrdererImages)
+	out := new(PeerImages)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrdererPVCNames) DeepCopyInto(out *OrdererPVCNames) {
+func (in *PeerPVCNames) DeepCopyInto(out *PeerPVCNames) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererPVCNames.
-func (in *OrdererPVCNames) DeepCopy() *OrdererPVCNames {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerPVCNames.
+func (in *PeerPVCNames) DeepCopy() *PeerPVCNames {
 	if in == nil {
 		return nil
 	}
-	out := new(OrdererPVCNames)
+	out := new(PeerPVCNames)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrdererReenrollAction) DeepCopyInto(out *OrdererReenrollAction) {
+func (in *PeerReenrollAction) DeepCopyInto(out *PeerReenrollAction) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererReenrollAction.
-func (in *OrdererReenrollAction) DeepCopy() *OrdererReenrollAction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerReenrollAction.
+func (in *PeerReenrollAction) DeepCopy() *PeerReenrollAction {
 	if in == nil {
 		return nil
 	}
-	out := new(OrdererReenrollAction)
+	out := new(PeerReenrollAction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrdererResources) DeepCopyInto(out *OrdererResources) {
+func (in *PeerResources) DeepCopyInto(out *PeerResources) {
 	*out = *in
 	if in.Init != nil {
 		in, out := &in.Init, &out.Init
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Orderer != nil {
-		in, out := &in.Orderer, &out.Orderer
-		*out = new(v1.ResourceRequirements)
+	if in.Peer != nil {
+		in, out := &in.Peer, &out.Peer
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.GRPCProxy != nil {
 		in, out := &in.GRPCProxy, &out.GRPCProxy
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FluentD != nil {
+		in, out := &in.FluentD, &out.FluentD
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DinD != nil {
+		in, out := &in.DinD, &out.DinD
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CouchDB != nil {
+		in, out := &in.CouchDB, &out.CouchDB
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CCLauncher != nil {
+		in, out := &in.CCLauncher, &out.CCLauncher
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Enroller != nil {
 		in, out := &in.Enroller, &out.Enroller
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.HSMDaemon != nil {
 		in, out := &in.HSMDaemon, &out.HSMDaemon
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererResources.
-func (in *OrdererResources) DeepCopy() *OrdererResources {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerResources.
+func (in *PeerResources) DeepCopy() *PeerResources {
 	if in == nil {
 		return nil
 	}
-	out := new(OrdererResources)
+	out := new(PeerResources)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrdererStorages) DeepCopyInto(out *OrdererStorages) {
+func (in *PeerSnapshot) DeepCopyInto(out *PeerSnapshot) {
 	*out = *in
-	if in.Orderer != nil {
-		in, out := &in.Orderer, &out.Orderer
-		*out = new(StorageSpec)
-		**out = **in
-	}
+	out.NamespacedName = in.NamespacedName
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdererStorages.
-func (in *OrdererStorages) DeepCopy() *OrdererStorages {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerSnapshot.
+func (in *PeerSnapshot) DeepCopy() *PeerSnapshot {
 	if in == nil {
 		return nil
 	}
-	out := new(OrdererStorages)
+	out := new(PeerSnapshot)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Organization) DeepCopyInto(out *Organization) {
+func (in *PeerStorages) DeepCopyInto(out *PeerStorages) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.StateDB != nil {
+		in, out := &in.StateDB, &out.StateDB
+		*out = new(StorageSpec)
+		**out = **in
+	}
+	if in.Peer != nil {
+		in, out := &in.Peer, &out.Peer
+		*out = new(StorageSpec)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Organization.
-func (in *Organization) DeepCopy() *Organization {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerStorages.
+func (in *PeerStorages) DeepCopy() *PeerStorages {
 	if in == nil {
 		return nil
 	}
-	out := new(Organization)
+	out := new(PeerStorages)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Organization) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineRunSpec) DeepCopyInto(out *PipelineRunSpec) {
+	*out = *in
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(Git)
+		**out = **in
+	}
+	if in.Minio != nil {
+		in, out := &in.Minio, &out.Minio
+		*out = new(Minio)
+		**out = **in
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCI)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTP)
+		**out = **in
+	}
+	if in.Dockerbuild != nil {
+		in, out := &in.Dockerbuild, &out.Dockerbuild
+		*out = new(Dockerbuild)
+		**out = **in
+	}
+	if in.LanguageBuild != nil {
+		in, out := &in.LanguageBuild, &out.LanguageBuild
+		*out = new(LanguageBuild)
+		**out = **in
 	}
-	return nil
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationList) DeepCopyInto(out *OrganizationList) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Organization, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineRunSpec.
+func (in *PipelineRunSpec) DeepCopy() *PipelineRunSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(PipelineRunSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Proposal) DeepCopyInto(out *Proposal) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationList.
-func (in *OrganizationList) DeepCopy() *OrganizationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Proposal.
+func (in *Proposal) DeepCopy() *Proposal {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationList)
+	out := new(Proposal)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrganizationList) DeepCopyObject() runtime.Object {
+func (in *Proposal) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2539,383 +4234,495 @@ func (in *OrganizationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationSpec) DeepCopyInto(out *OrganizationSpec) {
+func (in *ProposalCondition) DeepCopyInto(out *ProposalCondition) {
 	*out = *in
-	out.License = in.License
-	if in.Clients != nil {
-		in, out := &in.Clients, &out.Clients
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	in.CASpec.DeepCopyInto(&out.CASpec)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSpec.
-func (in *OrganizationSpec) DeepCopy() *OrganizationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProposalCondition.
+func (in *ProposalCondition) DeepCopy() *ProposalCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationSpec)
+	out := new(ProposalCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationStatus) DeepCopyInto(out *OrganizationStatus) {
+func (in *ProposalList) DeepCopyInto(out *ProposalList) {
 	*out = *in
-	in.CRStatus.DeepCopyInto(&out.CRStatus)
-	if in.Federations != nil {
-		in, out := &in.Federations, &out.Federations
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Proposal, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationStatus.
-func (in *OrganizationStatus) DeepCopy() *OrganizationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProposalList.
+func (in *ProposalList) DeepCopy() *ProposalList {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationStatus)
+	out := new(ProposalList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PeerAction) DeepCopyInto(out *PeerAction) {
-	*out = *in
-	out.Reenroll = in.Reenroll
-	out.Enroll = in.Enroll
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerAction.
-func (in *PeerAction) DeepCopy() *PeerAction {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProposalList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(PeerAction)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PeerCondition) DeepCopyInto(out *PeerCondition) {
+func (in *ProposalSource) DeepCopyInto(out *ProposalSource) {
 	*out = *in
-	out.NamespacedName = in.NamespacedName
-	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.CreateFederation != nil {
+		in, out := &in.CreateFederation, &out.CreateFederation
+		*out = new(CreateFederation)
+		**out = **in
+	}
+	if in.AddMember != nil {
+		in, out := &in.AddMember, &out.AddMember
+		*out = new(AddMember)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeleteMember != nil {
+		in, out := &in.DeleteMember, &out.DeleteMember
+		*out = new(DeleteMember)
+		**out = **in
+	}
+	if in.DissolveFederation != nil {
+		in, out := &in.DissolveFederation, &out.DissolveFederation
+		*out = new(DissolveFederation)
+		**out = **in
+	}
+	if in.DissolveNetwork != nil {
+		in, out := &in.DissolveNetwork, &out.DissolveNetwork
+		*out = new(DissolveNetwork)
+		**out = **in
+	}
+	if in.ArchiveChannel != nil {
+		in, out := &in.ArchiveChannel, &out.ArchiveChannel
+		*out = new(ArchiveChannel)
+		**out = **in
+	}
+	if in.UnarchiveChannel != nil {
+		in, out := &in.UnarchiveChannel, &out.UnarchiveChannel
+		*out = new(UnarchiveChannel)
+		**out = **in
+	}
+	if in.DeployChaincode != nil {
+		in, out := &in.DeployChaincode, &out.DeployChaincode
+		*out = new(DeployChaincode)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeChaincode != nil {
+		in, out := &in.UpgradeChaincode, &out.UpgradeChaincode
+		*out = new(DeployChaincode)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RollbackChaincode != nil {
+		in, out := &in.RollbackChaincode, &out.RollbackChaincode
+		*out = new(RollbackChaincode)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpdateChannelMember != nil {
+		in, out := &in.UpdateChannelMember, &out.UpdateChannelMember
+		*out = new(UpdateChannelMember)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpdateChannelConfig != nil {
+		in, out := &in.UpdateChannelConfig, &out.UpdateChannelConfig
+		*out = new(UpdateChannelConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerCondition.
-func (in *PeerCondition) DeepCopy() *PeerCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProposalSource.
+func (in *ProposalSource) DeepCopy() *ProposalSource {
 	if in == nil {
 		return nil
 	}
-	out := new(PeerCondition)
+	out := new(ProposalSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PeerConnectionProfile) DeepCopyInto(out *PeerConnectionProfile) {
+func (in *ProposalSpec) DeepCopyInto(out *ProposalSpec) {
 	*out = *in
-	out.Endpoints = in.Endpoints
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(MSP)
+	in.ProposalSource.DeepCopyInto(&out.ProposalSource)
+	in.StartAt.DeepCopyInto(&out.StartAt)
+	in.EndAt.DeepCopyInto(&out.EndAt)
+	if in.VotingRule != nil {
+		in, out := &in.VotingRule, &out.VotingRule
+		*out = new(VotingRule)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Component != nil {
-		in, out := &in.Component, &out.Component
-		*out = new(MSP)
-		(*in).DeepCopyInto(*out)
+	if in.Weights != nil {
+		in, out := &in.Weights, &out.Weights
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExternalVotes != nil {
+		in, out := &in.ExternalVotes, &out.ExternalVotes
+		*out = make([]ExternalVote, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerConnectionProfile.
-func (in *PeerConnectionProfile) DeepCopy() *PeerConnectionProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProposalSpec.
+func (in *ProposalSpec) DeepCopy() *ProposalSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PeerConnectionProfile)
+	out := new(ProposalSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PeerCustomNames) DeepCopyInto(out *PeerCustomNames) {
+func (in *ProposalStatus) DeepCopyInto(out *ProposalStatus) {
 	*out = *in
-	out.PVC = in.PVC
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ProposalCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Votes != nil {
+		in, out := &in.Votes, &out.Votes
+		*out = make([]VoteResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tally != nil {
+		in, out := &in.Tally, &out.Tally
+		*out = new(VoteTally)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerCustomNames.
-func (in *PeerCustomNames) DeepCopy() *PeerCustomNames {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProposalStatus.
+func (in *ProposalStatus) DeepCopy() *ProposalStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PeerCustomNames)
+	out := new(ProposalStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PeerEndpoints) DeepCopyInto(out *PeerEndpoints) {
+func (in *ProvenancePolicy) DeepCopyInto(out *ProvenancePolicy) {
 	*out = *in
+	if in.TrustedKeys != nil {
+		in, out := &in.TrustedKeys, &out.TrustedKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerEndpoints.
-func (in *PeerEndpoints) DeepCopy() *PeerEndpoints {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvenancePolicy.
+func (in *ProvenancePolicy) DeepCopy() *ProvenancePolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(PeerEndpoints)
+	out := new(ProvenancePolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PeerEnrollAction) DeepCopyInto(out *PeerEnrollAction) {
+func (in *Ratio) DeepCopyInto(out *Ratio) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerEnrollAction.
-func (in *PeerEnrollAction) DeepCopy() *PeerEnrollAction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ratio.
+func (in *Ratio) DeepCopy() *Ratio {
 	if in == nil {
 		return nil
 	}
-	out := new(PeerEnrollAction)
+	out := new(Ratio)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PeerImages) DeepCopyInto(out *PeerImages) {
+func (in *Renew) DeepCopyInto(out *Renew) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerImages.
-func (in *PeerImages) DeepCopy() *PeerImages {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Renew.
+func (in *Renew) DeepCopy() *Renew {
 	if in == nil {
 		return nil
 	}
-	out := new(PeerImages)
+	out := new(Renew)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PeerPVCNames) DeepCopyInto(out *PeerPVCNames) {
+func (in *RenewalPolicy) DeepCopyInto(out *RenewalPolicy) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerPVCNames.
-func (in *PeerPVCNames) DeepCopy() *PeerPVCNames {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenewalPolicy.
+func (in *RenewalPolicy) DeepCopy() *RenewalPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(PeerPVCNames)
+	out := new(RenewalPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RenewalPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PeerReenrollAction) DeepCopyInto(out *PeerReenrollAction) {
+func (in *RenewalPolicyList) DeepCopyInto(out *RenewalPolicyList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RenewalPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerReenrollAction.
-func (in *PeerReenrollAction) DeepCopy() *PeerReenrollAction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenewalPolicyList.
+func (in *RenewalPolicyList) DeepCopy() *RenewalPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(PeerReenrollAction)
+	out := new(RenewalPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PeerResources) DeepCopyInto(out *PeerResources) {
-	*out = *in
-	if in.Init != nil {
-		in, out := &in.Init, &out.Init
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Peer != nil {
-		in, out := &in.Peer, &out.Peer
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.GRPCProxy != nil {
-		in, out := &in.GRPCProxy, &out.GRPCProxy
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RenewalPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.FluentD != nil {
-		in, out := &in.FluentD, &out.FluentD
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenewalPolicySpec) DeepCopyInto(out *RenewalPolicySpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.DinD != nil {
-		in, out := &in.DinD, &out.DinD
-		*out = new(v1.ResourceRequirements)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.CouchDB != nil {
-		in, out := &in.CouchDB, &out.CouchDB
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]RenewalKind, len(*in))
+		copy(*out, *in)
 	}
-	if in.CCLauncher != nil {
-		in, out := &in.CCLauncher, &out.CCLauncher
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.CertTypes != nil {
+		in, out := &in.CertTypes, &out.CertTypes
+		*out = make([]CertificateType, len(*in))
+		copy(*out, *in)
 	}
-	if in.Enroller != nil {
-		in, out := &in.Enroller, &out.Enroller
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(v1.Duration)
+		**out = **in
 	}
-	if in.HSMDaemon != nil {
-		in, out := &in.HSMDaemon, &out.HSMDaemon
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerResources.
-func (in *PeerResources) DeepCopy() *PeerResources {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenewalPolicySpec.
+func (in *RenewalPolicySpec) DeepCopy() *RenewalPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PeerResources)
+	out := new(RenewalPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PeerStorages) DeepCopyInto(out *PeerStorages) {
+func (in *RenewalPolicyStatus) DeepCopyInto(out *RenewalPolicyStatus) {
 	*out = *in
-	if in.StateDB != nil {
-		in, out := &in.StateDB, &out.StateDB
-		*out = new(StorageSpec)
-		**out = **in
+	in.CRStatus.DeepCopyInto(&out.CRStatus)
+	if in.InProgress != nil {
+		in, out := &in.InProgress, &out.InProgress
+		*out = make([]RenewalRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Peer != nil {
-		in, out := &in.Peer, &out.Peer
-		*out = new(StorageSpec)
-		**out = **in
+	if in.NextWindow != nil {
+		in, out := &in.NextWindow, &out.NextWindow
+		*out = (*in).DeepCopy()
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]RenewalResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerStorages.
-func (in *PeerStorages) DeepCopy() *PeerStorages {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenewalPolicyStatus.
+func (in *RenewalPolicyStatus) DeepCopy() *RenewalPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PeerStorages)
+	out := new(RenewalPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineRunSpec) DeepCopyInto(out *PipelineRunSpec) {
+func (in *RenewalResult) DeepCopyInto(out *RenewalResult) {
 	*out = *in
-	if in.Git != nil {
-		in, out := &in.Git, &out.Git
-		*out = new(Git)
-		**out = **in
-	}
-	if in.Minio != nil {
-		in, out := &in.Minio, &out.Minio
-		*out = new(Minio)
-		**out = **in
-	}
-	if in.Dockerbuild != nil {
-		in, out := &in.Dockerbuild, &out.Dockerbuild
-		*out = new(Dockerbuild)
-		**out = **in
-	}
+	out.RenewalTarget = in.RenewalTarget
+	in.Time.DeepCopyInto(&out.Time)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineRunSpec.
-func (in *PipelineRunSpec) DeepCopy() *PipelineRunSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenewalResult.
+func (in *RenewalResult) DeepCopy() *RenewalResult {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineRunSpec)
+	out := new(RenewalResult)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Proposal) DeepCopyInto(out *Proposal) {
+func (in *RenewalRun) DeepCopyInto(out *RenewalRun) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.RenewalTarget = in.RenewalTarget
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.PreviousNotAfter.DeepCopyInto(&out.PreviousNotAfter)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Proposal.
-func (in *Proposal) DeepCopy() *Proposal {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenewalRun.
+func (in *RenewalRun) DeepCopy() *RenewalRun {
 	if in == nil {
 		return nil
 	}
-	out := new(Proposal)
+	out := new(RenewalRun)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Proposal) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenewalTarget) DeepCopyInto(out *RenewalTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenewalTarget.
+func (in *RenewalTarget) DeepCopy() *RenewalTarget {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(RenewalTarget)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProposalCondition) DeepCopyInto(out *ProposalCondition) {
+func (in *Restore) DeepCopyInto(out *Restore) {
 	*out = *in
-	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProposalCondition.
-func (in *ProposalCondition) DeepCopy() *ProposalCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Restore.
+func (in *Restore) DeepCopy() *Restore {
 	if in == nil {
 		return nil
 	}
-	out := new(ProposalCondition)
+	out := new(Restore)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Restore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProposalList) DeepCopyInto(out *ProposalList) {
+func (in *RestoreList) DeepCopyInto(out *RestoreList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Proposal, len(*in))
+		*out = make([]Restore, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProposalList.
-func (in *ProposalList) DeepCopy() *ProposalList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreList.
+func (in *RestoreList) DeepCopy() *RestoreList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProposalList)
+	out := new(RestoreList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProposalList) DeepCopyObject() runtime.Object {
+func (in *RestoreList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2923,128 +4730,115 @@ func (in *ProposalList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProposalSource) DeepCopyInto(out *ProposalSource) {
+func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 	*out = *in
-	if in.CreateFederation != nil {
-		in, out := &in.CreateFederation, &out.CreateFederation
-		*out = new(CreateFederation)
-		**out = **in
-	}
-	if in.AddMember != nil {
-		in, out := &in.AddMember, &out.AddMember
-		*out = new(AddMember)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DeleteMember != nil {
-		in, out := &in.DeleteMember, &out.DeleteMember
-		*out = new(DeleteMember)
-		**out = **in
-	}
-	if in.DissolveFederation != nil {
-		in, out := &in.DissolveFederation, &out.DissolveFederation
-		*out = new(DissolveFederation)
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(BackupTarget)
 		**out = **in
 	}
-	if in.DissolveNetwork != nil {
-		in, out := &in.DissolveNetwork, &out.DissolveNetwork
-		*out = new(DissolveNetwork)
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(BackupStorage)
 		**out = **in
 	}
-	if in.ArchiveChannel != nil {
-		in, out := &in.ArchiveChannel, &out.ArchiveChannel
-		*out = new(ArchiveChannel)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSpec.
+func (in *RestoreSpec) DeepCopy() *RestoreSpec {
+	if in == nil {
+		return nil
 	}
-	if in.UnarchiveChannel != nil {
-		in, out := &in.UnarchiveChannel, &out.UnarchiveChannel
-		*out = new(UnarchiveChannel)
-		**out = **in
+	out := new(RestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.DeployChaincode != nil {
-		in, out := &in.DeployChaincode, &out.DeployChaincode
-		*out = new(DeployChaincode)
-		(*in).DeepCopyInto(*out)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
-	if in.UpgradeChaincode != nil {
-		in, out := &in.UpgradeChaincode, &out.UpgradeChaincode
-		*out = new(DeployChaincode)
-		(*in).DeepCopyInto(*out)
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
 	}
-	if in.UpdateChannelMember != nil {
-		in, out := &in.UpdateChannelMember, &out.UpdateChannelMember
-		*out = new(UpdateChannelMember)
-		(*in).DeepCopyInto(*out)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProposalSource.
-func (in *ProposalSource) DeepCopy() *ProposalSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatus.
+func (in *RestoreStatus) DeepCopy() *RestoreStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProposalSource)
+	out := new(RestoreStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProposalSpec) DeepCopyInto(out *ProposalSpec) {
+func (in *RevocationApproval) DeepCopyInto(out *RevocationApproval) {
 	*out = *in
-	in.ProposalSource.DeepCopyInto(&out.ProposalSource)
-	in.StartAt.DeepCopyInto(&out.StartAt)
-	in.EndAt.DeepCopyInto(&out.EndAt)
+	if in.Admins != nil {
+		in, out := &in.Admins, &out.Admins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProposalSpec.
-func (in *ProposalSpec) DeepCopy() *ProposalSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevocationApproval.
+func (in *RevocationApproval) DeepCopy() *RevocationApproval {
 	if in == nil {
 		return nil
 	}
-	out := new(ProposalSpec)
+	out := new(RevocationApproval)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProposalStatus) DeepCopyInto(out *ProposalStatus) {
+func (in *RollbackChaincode) DeepCopyInto(out *RollbackChaincode) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]ProposalCondition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Votes != nil {
-		in, out := &in.Votes, &out.Votes
-		*out = make([]VoteResult, len(*in))
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]Member, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProposalStatus.
-func (in *ProposalStatus) DeepCopy() *ProposalStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackChaincode.
+func (in *RollbackChaincode) DeepCopy() *RollbackChaincode {
 	if in == nil {
 		return nil
 	}
-	out := new(ProposalStatus)
+	out := new(RollbackChaincode)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Renew) DeepCopyInto(out *Renew) {
+func (in *SBOM) DeepCopyInto(out *SBOM) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Renew.
-func (in *Renew) DeepCopy() *Renew {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SBOM.
+func (in *SBOM) DeepCopy() *SBOM {
 	if in == nil {
 		return nil
 	}
-	out := new(Renew)
+	out := new(SBOM)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3089,6 +4883,21 @@ func (in *Service) DeepCopy() *Service {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignedArtifact) DeepCopyInto(out *SignedArtifact) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignedArtifact.
+func (in *SignedArtifact) DeepCopy() *SignedArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(SignedArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageSpec) DeepCopyInto(out *StorageSpec) {
 	*out = *in
@@ -3119,6 +4928,29 @@ func (in *UnarchiveChannel) DeepCopy() *UnarchiveChannel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateChannelConfig) DeepCopyInto(out *UpdateChannelConfig) {
+	*out = *in
+	in.Config.DeepCopyInto(&out.Config)
+	if in.AnchorPeers != nil {
+		in, out := &in.AnchorPeers, &out.AnchorPeers
+		*out = make([]MemberAnchorPeers, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateChannelConfig.
+func (in *UpdateChannelConfig) DeepCopy() *UpdateChannelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateChannelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpdateChannelMember) DeepCopyInto(out *UpdateChannelMember) {
 	*out = *in
@@ -3129,6 +4961,11 @@ func (in *UpdateChannelMember) DeepCopyInto(out *UpdateChannelMember) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RemoveMembers != nil {
+		in, out := &in.RemoveMembers, &out.RemoveMembers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateChannelMember.
@@ -3342,3 +5179,39 @@ func (in *VoteStatus) DeepCopy() *VoteStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VoteTally) DeepCopyInto(out *VoteTally) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VoteTally.
+func (in *VoteTally) DeepCopy() *VoteTally {
+	if in == nil {
+		return nil
+	}
+	out := new(VoteTally)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VotingRule) DeepCopyInto(out *VotingRule) {
+	*out = *in
+	out.Threshold = in.Threshold
+	if in.Quorum != nil {
+		in, out := &in.Quorum, &out.Quorum
+		*out = new(Ratio)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VotingRule.
+func (in *VotingRule) DeepCopy() *VotingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(VotingRule)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package certificateinventory
+
+import (
+	"context"
+	"fmt"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/certificate/inventory"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/global"
+	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/offering"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
+	k8scertificateinventory "github.com/IBM-Blockchain/fabric-operator/pkg/offering/k8s/certificateinventory"
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+var (
+	log = logf.Log.WithName("controller_certificateinventory")
+)
+
+// Add creates a new CertificateInventory Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager, config *config.Config) error {
+	r, err := newReconciler(mgr, config)
+	if err != nil {
+		return err
+	}
+	return add(mgr, r)
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager, cfg *config.Config) (*ReconcileCertificateInventory, error) {
+	c := k8sclient.New(mgr.GetClient(), &global.ConfigSetter{Config: cfg.Operator.Globals})
+	scheme := mgr.GetScheme()
+
+	r := &ReconcileCertificateInventory{
+		client: c,
+		scheme: scheme,
+		Config: cfg,
+	}
+
+	switch cfg.Offering {
+	case offering.K8S:
+		r.Offering = k8scertificateinventory.New(c, scheme, cfg)
+	default:
+		return nil, errors.Errorf("offering %s not supported in CertificateInventory controller", cfg.Offering)
+	}
+
+	return r, nil
+}
+
+// add adds a new Controller to mgr with r as the reconcile Reconciler
+func add(mgr manager.Manager, r *ReconcileCertificateInventory) error {
+	predicateFuncs := predicate.Funcs{
+		CreateFunc: r.CreateFunc,
+		UpdateFunc: r.UpdateFunc,
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+	}
+
+	c, err := controller.New("certificateinventory-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	return c.Watch(&source.Kind{Type: &current.CertificateInventory{}}, &handler.EnqueueRequestForObject{}, predicateFuncs)
+}
+
+var _ reconcile.Reconciler = &ReconcileCertificateInventory{}
+
+type certificateinventoryReconcile interface {
+	Reconcile(*current.CertificateInventory) (common.Result, error)
+}
+
+// ReconcileCertificateInventory reconciles a CertificateInventory object
+type ReconcileCertificateInventory struct {
+	// This client, initialized using mgr.Client() above, is a split client
+	// that reads objects from the cache and writes to the apiserver
+	client k8sclient.Client
+	scheme *runtime.Scheme
+
+	Offering certificateinventoryReconcile
+	Config   *config.Config
+}
+
+// +kubebuilder:rbac:groups=ibp.com,resources=certificateinventories,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ibp.com,resources=certificateinventories/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ibp.com,resources=ibppeers;ibporderers;ibpcas;organizations,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Reconcile refreshes the certificates of peers, orderers, CAs and organizations with their expiry
+func (r *ReconcileCertificateInventory) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := log.WithValues("Request.Name", request.Name)
+	reqLogger.Info("Reconciling CertificateInventory")
+
+	instance := &current.CertificateInventory{}
+	if err := r.client.Get(ctx, request.NamespacedName, instance); err != nil {
+		if k8serrors.IsNotFound(err) {
+			inventory.DefaultMetrics.Delete(request.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	result, err := r.Offering.Reconcile(instance)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "CertificateInventory instance '%s' encountered error", instance.GetName())
+	}
+
+	reqLogger.Info(fmt.Sprintf("Finished reconciling CertificateInventory '%s'", instance.GetName()))
+	return result.Result, nil
+}
+
+func (r *ReconcileCertificateInventory) CreateFunc(e event.CreateEvent) bool {
+	return true
+}
+
+// UpdateFunc reconciles spec changes, status changes are followed by requeues
+func (r *ReconcileCertificateInventory) UpdateFunc(e event.UpdateEvent) bool {
+	return e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration()
+}
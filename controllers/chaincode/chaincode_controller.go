@@ -268,9 +268,19 @@ func (r *ReconcileChaincode) ProposalUpdateFunc(e event.UpdateEvent) bool {
 	if cr.Status.Phase == current.ChaincodePhaseApproved {
 		chaincodeBuildName := cr.Spec.ExternalBuilder
 		upgrade := false
-		if newProposal.Spec.UpgradeChaincode != nil {
+		var (
+			collections []current.Collection
+			rollback    *current.ChaincodeHistory
+		)
+		if newProposal.Spec.DeployChaincode != nil {
+			collections = newProposal.Spec.DeployChaincode.Collections
+		}
+		if newProposal.Spec.UpgradeChaincode != nil || newProposal.Spec.RollbackChaincode != nil {
 			upgrade = true
-			chaincodeBuildName = newProposal.Spec.UpgradeChaincode.ExternalBuilder
+			if newProposal.Spec.UpgradeChaincode != nil {
+				chaincodeBuildName = newProposal.Spec.UpgradeChaincode.ExternalBuilder
+				collections = newProposal.Spec.UpgradeChaincode.Collections
+			}
 
 			// https://github.com/bestchains/fabric-operator/issues/222
 			// If the status of cr is not set to pending,
@@ -291,6 +301,15 @@ func (r *ReconcileChaincode) ProposalUpdateFunc(e event.UpdateEvent) bool {
 				log.Error(err, "the upgraded proposaol, after patch chaincode status, failed to get the chaincode %s.", newProposal.Labels[current.ChaincodeProposalLabel])
 				return false
 			}
+			if newProposal.Spec.RollbackChaincode != nil {
+				history, ok := cr.GetHistory(newProposal.Spec.RollbackChaincode.Version)
+				if !ok {
+					log.Error(fmt.Errorf("version %s not found in history", newProposal.Spec.RollbackChaincode.Version), "the rollback proposal passed, but failed to find the history entry.")
+					return false
+				}
+				rollback = &history
+				chaincodeBuildName = history.ExternalBuilder
+			}
 		}
 
 		if !upgrade && len(cr.Status.History) > 0 {
@@ -298,17 +317,38 @@ func (r *ReconcileChaincode) ProposalUpdateFunc(e event.UpdateEvent) bool {
 			return false
 		}
 
-		image, digest, version, id, err := r.PickUpImageFromBuilder(chaincodeBuildName)
-		if err != nil {
-			log.Error(err, "the proposal passed, but failed to get the mirror information.")
-			return false
-		}
-
 		originSpec := cr.Spec
-		cr.Spec.Images.Name = image
-		cr.Spec.Images.Digest = digest
-		cr.Spec.Version = version
-		cr.Spec.ID = id
+		if rollback != nil {
+			// redeploy the recorded image instead of the latest one of the builder
+			cr.Spec.Images = rollback.Image
+			cr.Spec.Version = rollback.Version
+			cr.Spec.Collections = rollback.Collections
+			if rollback.Label != "" {
+				cr.Spec.Label = rollback.Label
+			}
+		} else if chaincodeBuildName == "" && cr.IsCCaaS() {
+			// a ccaas chaincode may run an image built outside of the cluster
+			if upgradeTo := newProposal.Spec.UpgradeChaincode; upgradeTo != nil && upgradeTo.Images != nil {
+				cr.Spec.Images = *upgradeTo.Images
+				cr.Spec.Version = upgradeTo.Version
+			}
+			if collections != nil {
+				cr.Spec.Collections = collections
+			}
+		} else {
+			image, digest, version, id, err := r.PickUpImageFromBuilder(chaincodeBuildName)
+			if err != nil {
+				log.Error(err, "the proposal passed, but failed to get the mirror information.")
+				return false
+			}
+			cr.Spec.Images.Name = image
+			cr.Spec.Images.Digest = digest
+			cr.Spec.Version = version
+			cr.Spec.ID = id
+			if collections != nil {
+				cr.Spec.Collections = collections
+			}
+		}
 		cr.Spec.ExternalBuilder = chaincodeBuildName
 
 		if err := r.client.Patch(context.TODO(), cr, nil, k8sclient.PatchOption{
@@ -339,7 +379,8 @@ func (r *ReconcileChaincode) ProposalUpdateFunc(e event.UpdateEvent) bool {
 					item.Image.Digest == originSpec.Images.Digest &&
 					item.Image.PullSecret == originSpec.Images.PullSecret &&
 					item.Version == originSpec.Version &&
-					item.ExternalBuilder == originSpec.ExternalBuilder {
+					item.ExternalBuilder == originSpec.ExternalBuilder &&
+					reflect.DeepEqual(item.Collections, originSpec.Collections) {
 					cr.Status.History[idx].UpgradeTime = v1.Now()
 					appendHistory = false
 					break
@@ -350,11 +391,22 @@ func (r *ReconcileChaincode) ProposalUpdateFunc(e event.UpdateEvent) bool {
 					Version:         originSpec.Version,
 					Image:           originSpec.Images,
 					ExternalBuilder: originSpec.ExternalBuilder,
+					Label:           originSpec.Label,
+					Collections:     originSpec.Collections,
 					UpgradeTime:     v1.Now(),
 				})
 			}
 			cr.Status.Conditions = make([]current.ChaincodeCondition, 0)
 			cr.Status.Sequence++
+			cr.Status.Rollback = nil
+			if rollback != nil {
+				cr.Status.Rollback = &current.ChaincodeRollback{
+					Proposal:     newProposal.GetName(),
+					Version:      rollback.Version,
+					Sequence:     cr.Status.Sequence,
+					RollbackTime: v1.Now(),
+				}
+			}
 		}
 	}
 
@@ -415,11 +467,15 @@ func (r *ReconcileChaincode) PickUpImageFromBuilder(builderName string) (string,
 		return image, digest, version, id, err
 	}
 
-	if len(builder.Status.PipelineRunResults) != 2 {
-		return image, digest, version, id, fmt.Errorf("expect only 2 elements, but have %d", len(builder.Status.PipelineRunResults))
+	if err := builder.HasImage(); err != nil {
+		return image, digest, version, id, err
 	}
 	version = builder.Spec.Version
 	id = builder.Spec.ID
+	// the signed provenance pins the image digest
+	if p := builder.Status.Provenance; p != nil && p.Image != "" && p.Digest != "" {
+		return p.Image, p.Digest, version, id, nil
+	}
 	for _, item := range builder.Status.PipelineRunResults {
 		if item.Name == current.IMAGE_URL {
 			image = item.Value
@@ -126,6 +126,7 @@ func (r *ReconcileChaincodeBuild) patchChaincodeBuildStatus(build *current.Chain
 			// retrieve and patch pipelinerun results
 			if newStatus.PipelineResults != nil {
 				build.Status.PipelineRunResults = newStatus.PipelineResults
+				build.RecordProvenance()
 				err := r.client.PatchStatus(context.TODO(), build, nil, k8sclient.PatchOption{
 					Resilient: &k8sclient.ResilientPatch{
 						Retry:    3,
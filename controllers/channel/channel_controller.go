@@ -43,6 +43,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -121,9 +122,40 @@ func add(mgr manager.Manager, r *ReconcileChannel) error {
 		return err
 	}
 
+	organizationFuncs := predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return false },
+		UpdateFunc:  r.OrganizationUpdateFunc,
+		DeleteFunc:  func(e event.DeleteEvent) bool { return false },
+		GenericFunc: func(e event.GenericEvent) bool { return false },
+	}
+
+	err = c.Watch(&source.Kind{Type: &current.Organization{}}, handler.EnqueueRequestsFromMapFunc(r.organization2channelMap), organizationFuncs)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// organization2channelMap enqueues the channels which organization is a member of
+func (r *ReconcileChannel) organization2channelMap(object client.Object) []reconcile.Request {
+	channels := &current.ChannelList{}
+	if err := r.client.List(context.TODO(), channels); err != nil {
+		log.Error(err, "failed to list channels", "organization", object.GetName())
+		return nil
+	}
+	res := make([]reconcile.Request, 0)
+	for _, channel := range channels.Items {
+		for _, m := range channel.Spec.Members {
+			if m.GetName() == object.GetName() {
+				res = append(res, reconcile.Request{NamespacedName: types.NamespacedName{Name: channel.GetName()}})
+				break
+			}
+		}
+	}
+	return res
+}
+
 func proposal2channelMap(object client.Object) []reconcile.Request {
 	proposal := object.(*current.Proposal)
 	targetChannel := ""
@@ -134,6 +166,8 @@ func proposal2channelMap(object client.Object) []reconcile.Request {
 		targetChannel = proposal.Spec.UnarchiveChannel.Channel
 	case current.UpdateChannelMemberProposal:
 		targetChannel = proposal.Spec.UpdateChannelMember.Channel
+	case current.UpdateChannelConfigProposal:
+		targetChannel = proposal.Spec.UpdateChannelConfig.Channel
 	}
 	return []reconcile.Request{
 		{
@@ -280,10 +314,7 @@ func (r *ReconcileChannel) SetStatus(instance *current.Channel, reconcileStatus
 			status.Message = reconcileStatus.Message
 			status.LastHeartbeatTime = metav1.Now()
 
-			instance.Status = current.ChannelStatus{
-				CRStatus:       status,
-				PeerConditions: instance.Status.PeerConditions,
-			}
+			instance.Status.CRStatus = status
 
 			log.Info(fmt.Sprintf("Updating status of Channel custom resource to %s phase", instance.Status.Type))
 			err = r.client.PatchStatus(context.TODO(), instance, nil, k8sclient.PatchOption{
@@ -325,10 +356,7 @@ func (r *ReconcileChannel) SetErrorStatus(instance *current.Channel, reconcileEr
 	status.LastHeartbeatTime = metav1.Now()
 	status.ErrorCode = operatorerrors.GetErrorCode(reconcileErr)
 
-	instance.Status = current.ChannelStatus{
-		CRStatus:       status,
-		PeerConditions: instance.Status.PeerConditions,
-	}
+	instance.Status.CRStatus = status
 
 	log.Info(fmt.Sprintf("Updating status of Channel custom resource to %s phase", instance.Status.Type))
 	if err = r.client.PatchStatus(context.TODO(), instance, nil, k8sclient.PatchOption{
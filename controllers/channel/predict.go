@@ -69,11 +69,6 @@ func (r *ReconcileChannel) CreateFunc(e event.CreateEvent) bool {
 
 		added, removed := current.DifferMembers(existingChannel.Spec.Members, channel.Spec.Members)
 		if len(added) != 0 || len(removed) != 0 {
-			if len(removed) != 0 {
-				// TODO: support deleting members from channel later
-				log.Error(fmt.Errorf("deleting members from a channel is not yet supported"), "Deleting members from a channel is not yet supported.", "channel", channel.GetName())
-				return false
-			}
 			log.Info(fmt.Sprintf("Channel '%s' members was updated while operator was down", channel.GetName()))
 			log.Info(fmt.Sprintf("Difference detected: added members %v", added))
 			log.Info(fmt.Sprintf("Difference detected: removed members %v", removed))
@@ -88,6 +83,16 @@ func (r *ReconcileChannel) CreateFunc(e event.CreateEvent) bool {
 			update.peerUpdated = true
 		}
 
+		if !reflect.DeepEqual(existingChannel.Spec.Config, channel.Spec.Config) {
+			log.Info(fmt.Sprintf("Channel '%s' config was updated while operator was down", channel.GetName()))
+			update.configUpdated = true
+		}
+
+		if !reflect.DeepEqual(existingChannel.Spec.AnchorPeers, channel.Spec.AnchorPeers) {
+			log.Info(fmt.Sprintf("Channel '%s' anchor peers was updated while operator was down", channel.GetName()))
+			update.anchorPeerUpdated = true
+		}
+
 		log.Info(fmt.Sprintf("Create event triggering reconcile for updating Channel '%s'", channel.GetName()))
 		r.PushUpdate(channel.GetName(), update)
 		return true
@@ -97,6 +102,14 @@ func (r *ReconcileChannel) CreateFunc(e event.CreateEvent) bool {
 		update.peerUpdated = true
 	}
 
+	if channel.Spec.Config != nil {
+		update.configUpdated = true
+	}
+
+	if len(channel.Spec.AnchorPeers) != 0 {
+		update.anchorPeerUpdated = true
+	}
+
 	update.specUpdated = true
 	update.memberUpdated = true
 	r.PushUpdate(channel.GetName(), update)
@@ -111,6 +124,11 @@ func (r *ReconcileChannel) UpdateFunc(e event.UpdateEvent) bool {
 
 	update := Update{}
 
+	// archive or unarchive started by a proposal
+	if newChan.ArchiveInProgress() && (!oldChan.ArchiveInProgress() || oldChan.Status.Archive.Proposal != newChan.Status.Archive.Proposal) {
+		return true
+	}
+
 	if reflect.DeepEqual(oldChan.Spec, newChan.Spec) {
 		return false
 	}
@@ -119,10 +137,6 @@ func (r *ReconcileChannel) UpdateFunc(e event.UpdateEvent) bool {
 
 	added, removed := current.DifferMembers(oldChan.GetMembers(), newChan.GetMembers())
 	if len(added) != 0 || len(removed) != 0 {
-		if len(removed) != 0 {
-			log.Error(fmt.Errorf("deleting members from a channel is not yet supported"), "Deleting members from a channel is not yet supported.", "channel", newChan.GetName())
-			return false
-		}
 		log.Info(fmt.Sprintf("Difference detected: added members %v", added))
 		log.Info(fmt.Sprintf("Difference detected: removed members %v", removed))
 		update.memberUpdated = true
@@ -135,6 +149,16 @@ func (r *ReconcileChannel) UpdateFunc(e event.UpdateEvent) bool {
 		update.peerUpdated = true
 	}
 
+	if !reflect.DeepEqual(oldChan.Spec.Config, newChan.Spec.Config) {
+		log.Info("Difference detected: channel config updated")
+		update.configUpdated = true
+	}
+
+	if !reflect.DeepEqual(oldChan.Spec.AnchorPeers, newChan.Spec.AnchorPeers) {
+		log.Info(fmt.Sprintf("Difference detected: anchor peers %v", newChan.Spec.AnchorPeers))
+		update.anchorPeerUpdated = true
+	}
+
 	r.PushUpdate(oldChan.GetName(), update)
 
 	log.Info(fmt.Sprintf("Spec update triggering reconcile on Channel custom resource %s: update [ %+v ]", oldChan.Name, update.GetUpdateStackWithTrues()))
@@ -142,6 +166,21 @@ func (r *ReconcileChannel) UpdateFunc(e event.UpdateEvent) bool {
 	return true
 }
 
+// OrganizationUpdateFunc reconciles the channels of an organization once its CRL is approved
+func (r *ReconcileChannel) OrganizationUpdateFunc(e event.UpdateEvent) bool {
+	oldOrg := e.ObjectOld.(*current.Organization)
+	newOrg := e.ObjectNew.(*current.Organization)
+	revocation := newOrg.Status.Revocation
+	if revocation == nil || !revocation.Approved {
+		return false
+	}
+	if oldOrg.Status.Revocation != nil && oldOrg.Status.Revocation.Approved && oldOrg.Status.Revocation.CRL == revocation.CRL {
+		return false
+	}
+	log.Info(fmt.Sprintf("CRL %s of organization '%s' approved", revocation.CRL, newOrg.GetName()))
+	return true
+}
+
 func (r *ReconcileChannel) ProposalUpdateFunc(e event.UpdateEvent) bool {
 	var err error
 
@@ -163,14 +202,19 @@ func (r *ReconcileChannel) ProposalUpdateFunc(e event.UpdateEvent) bool {
 					targetChannel = newProposal.Spec.ArchiveChannel.Channel
 					err = r.PatchProposalStatus(targetChannel, newProposal.GetName(), current.ArchiveChannelProposal)
 					if err != nil {
-						log.Error(err, "patch channel status with proposal %s succ")
+						log.Error(err, "patch channel status by proposal succ", "proposal", newProposal.GetName())
+						return false
 					}
+					// reconcile channel to archive it
+					return true
 				case current.UnarchiveChannelProposal:
 					targetChannel = newProposal.Spec.UnarchiveChannel.Channel
 					err = r.PatchProposalStatus(targetChannel, newProposal.GetName(), current.UnarchiveChannelProposal)
 					if err != nil {
 						log.Error(err, "patch channel status by proposal succ", "proposal", newProposal.GetName())
+						return false
 					}
+					return true
 				case current.UpdateChannelMemberProposal:
 					targetChannel = newProposal.Spec.UpdateChannelMember.Channel
 					ch := &current.Channel{}
@@ -184,10 +228,36 @@ func (r *ReconcileChannel) ProposalUpdateFunc(e event.UpdateEvent) bool {
 						m.JoinedBy = newProposal.GetName()
 						newProposal.Spec.UpdateChannelMember.Members[i] = m
 					}
-					ch.Spec.Members = append(ch.Spec.Members, newProposal.Spec.UpdateChannelMember.Members...)
+					ch.RemoveMembers(newProposal.Spec.UpdateChannelMember.RemoveMembers)
+					for _, m := range newProposal.Spec.UpdateChannelMember.Members {
+						// skip members already added by this proposal
+						if added, _ := current.DifferMembers(ch.Spec.Members, []current.Member{m}); len(added) == 0 {
+							continue
+						}
+						ch.Spec.Members = append(ch.Spec.Members, m)
+					}
 					if err = r.client.Update(context.TODO(), ch); err != nil {
 						log.Error(err, "update channel memeber error", "proposal", newProposal.GetName())
 					}
+				case current.UpdateChannelConfigProposal:
+					targetChannel = newProposal.Spec.UpdateChannelConfig.Channel
+					ch := &current.Channel{}
+					if err := r.client.Get(context.TODO(), types.NamespacedName{Name: targetChannel}, ch); err != nil {
+						log.Error(err, "get channel error", "proposal", newProposal.GetName())
+						return false
+					}
+					if ch.Spec.Config != nil && ch.Spec.Config.Proposal == newProposal.GetName() {
+						return false
+					}
+					config := newProposal.Spec.UpdateChannelConfig.Config
+					if !config.IsEmpty() {
+						config.Proposal = newProposal.GetName()
+						ch.Spec.Config = ch.Spec.Config.Merge(config)
+					}
+					ch.Spec.AnchorPeers = current.MergeAnchorPeers(ch.Spec.AnchorPeers, newProposal.Spec.UpdateChannelConfig.AnchorPeers)
+					if err = r.client.Update(context.TODO(), ch); err != nil {
+						log.Error(err, "update channel config error", "proposal", newProposal.GetName())
+					}
 				default:
 					return false
 				}
@@ -209,8 +279,15 @@ func (r *ReconcileChannel) PatchProposalStatus(targetChannel string, proposal st
 		return err
 	}
 
+	// proposal already handled
+	if ch.Status.Archive != nil && ch.Status.Archive.Proposal == proposal {
+		return nil
+	}
+
 	switch purpose {
 	case current.ArchiveChannelProposal:
+		ch.Status.Archive = &current.ChannelArchive{Proposal: proposal}
+		ch.Status.Archive.SetPhase(current.ArchiveSnapshotting)
 		ch.Status.ArchivedStatus = ch.Status.CRStatus
 		ch.Status.CRStatus = current.CRStatus{
 			Type:              current.ChannelArchived,
@@ -220,6 +297,12 @@ func (r *ReconcileChannel) PatchProposalStatus(targetChannel string, proposal st
 			LastHeartbeatTime: metav1.Now(),
 		}
 	case current.UnarchiveChannelProposal:
+		// keep what the archive recorded, unarchive needs it
+		if ch.Status.Archive == nil {
+			ch.Status.Archive = &current.ChannelArchive{}
+		}
+		ch.Status.Archive.Proposal = proposal
+		ch.Status.Archive.SetPhase(current.UnarchiveRestoringOrderers)
 		ch.Status.CRStatus = ch.Status.ArchivedStatus
 		ch.Status.ArchivedStatus = current.CRStatus{}
 	}
@@ -26,9 +26,11 @@ import (
 
 // Update defines a list of elements that we detect spec updates on
 type Update struct {
-	specUpdated   bool
-	memberUpdated bool
-	peerUpdated   bool
+	specUpdated       bool
+	memberUpdated     bool
+	peerUpdated       bool
+	configUpdated     bool
+	anchorPeerUpdated bool
 }
 
 func (u *Update) SpecUpdated() bool {
@@ -43,6 +45,14 @@ func (u *Update) PeerUpdated() bool {
 	return u.peerUpdated
 }
 
+func (u *Update) ConfigUpdated() bool {
+	return u.configUpdated
+}
+
+func (u *Update) AnchorPeerUpdated() bool {
+	return u.anchorPeerUpdated
+}
+
 // GetUpdateStackWithTrues is a helper method to print updates that have been detected
 func (u *Update) GetUpdateStackWithTrues() string {
 	stack := ""
@@ -59,6 +69,14 @@ func (u *Update) GetUpdateStackWithTrues() string {
 		stack += "peerUpdated "
 	}
 
+	if u.configUpdated {
+		stack += "configUpdated "
+	}
+
+	if u.anchorPeerUpdated {
+		stack += "anchorPeerUpdated "
+	}
+
 	if len(stack) == 0 {
 		stack = "emptystack "
 	}
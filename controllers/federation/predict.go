@@ -259,6 +259,12 @@ func (r *ReconcileFederation) ProposalUpdateFunc(e event.UpdateEvent) bool {
 	// Update if member changes
 	if len(newMember) != 0 {
 		fed.Spec.Members = newMember
+		// weights of removed members would no longer validate
+		for org := range fed.Spec.Weights {
+			if !fed.HasMember(org) {
+				delete(fed.Spec.Weights, org)
+			}
+		}
 		if err := r.client.Patch(context.TODO(), fed, nil, k8sclient.PatchOption{
 			Resilient: &k8sclient.ResilientPatch{
 				Retry:    3,
@@ -235,10 +235,12 @@ func (r *ReconcileIBPOrderer) Reconcile(ctx context.Context, request reconcile.R
 		// loop needs to be triggered to allow for instance migration
 		if instance.Status.Version != "" {
 			if instance.Status.Type == current.Deployed || instance.Status.Type == current.Warning {
-				// This is cluster's update, we don't want to reconcile.
-				// It should only be status update
-				log.Info(fmt.Sprintf("Update detected on %s cluster spec '%s', not supported", instance.Status.Type, instance.GetName()))
-				return reconcile.Result{}, nil
+				// This is cluster's update, we don't want to reconcile unless
+				// the cluster size updated or a resize is unfinished. Otherwise it should only be status update
+				if !r.GetUpdateStatus(instance).ClusterSizeUpdated() && instance.Status.ResizingTo == 0 {
+					log.Info(fmt.Sprintf("Update detected on %s cluster spec '%s', not supported", instance.Status.Type, instance.GetName()))
+					return reconcile.Result{}, nil
+				}
 			}
 		}
 	}
@@ -313,9 +315,8 @@ func (r *ReconcileIBPOrderer) SetStatus(instance *current.IBPOrderer, result *co
 		status.LastHeartbeatTime = v1.Now()
 		status.ErrorCode = operatorerrors.GetErrorCode(reconcileErr)
 
-		instance.Status = current.IBPOrdererStatus{
-			CRStatus: status,
-		}
+		// keep the resize progress recorded by reconcile
+		instance.Status.CRStatus = status
 
 		log.Info(fmt.Sprintf("Updating status of IBPOrderer custom resource (%s) to %s phase", instance.GetName(), instance.Status.Type))
 		err := r.client.PatchStatus(context.TODO(), instance, nil, k8sclient.PatchOption{
@@ -383,9 +384,7 @@ func (r *ReconcileIBPOrderer) SetStatus(instance *current.IBPOrderer, result *co
 				status.LastHeartbeatTime = v1.Now()
 
 				if result.OverrideUpdateStatus {
-					instance.Status = current.IBPOrdererStatus{
-						CRStatus: status,
-					}
+					instance.Status.CRStatus = status
 
 					log.Info(fmt.Sprintf("Updating status returned by reconcile loop of IBPOrderer custom resource (%s) to %s phase", instance.GetName(), instance.Status.Type))
 					err := r.client.PatchStatus(context.TODO(), instance, nil, k8sclient.PatchOption{
@@ -441,9 +440,7 @@ func (r *ReconcileIBPOrderer) SetStatus(instance *current.IBPOrderer, result *co
 		status.LastHeartbeatTime = v1.Now()
 		log.Info(fmt.Sprintf("Updating status of IBPOrderer custom resource (%s) from %s to %s phase", instance.GetName(), instance.Status.Type, status.Type))
 
-		instance.Status = current.IBPOrdererStatus{
-			CRStatus: status,
-		}
+		instance.Status.CRStatus = status
 
 		err = r.client.PatchStatus(context.TODO(), instance, nil, k8sclient.PatchOption{
 			Resilient: &k8sclient.ResilientPatch{
@@ -657,7 +654,15 @@ func (r *ReconcileIBPOrderer) UpdateFunc(e event.UpdateEvent) bool {
 			}
 
 			if oldOrderer.Status.Type == current.Deployed || oldOrderer.Status.Type == current.Error || oldOrderer.Status.Type == current.Warning {
-				// Parent orderer has been fully deployed by this point
+				// Parent orderer has been fully deployed by this point, only cluster size
+				// update is processed to add or remove consenters
+				if oldOrderer.Spec.ClusterSize != newOrderer.Spec.ClusterSize {
+					log.Info(fmt.Sprintf("Cluster size of %s updated from %d to %d", oldOrderer.GetName(), oldOrderer.Spec.ClusterSize, newOrderer.Spec.ClusterSize))
+					update.clusterSizeUpdated = true
+					update.specUpdated = true
+					r.PushUpdate(oldOrderer.GetName(), update)
+					return true
+				}
 				log.Info(fmt.Sprintf("Ignoring the IBPOrderer cluster (parent) update after %s", oldOrderer.Status.Type))
 				return false
 			}
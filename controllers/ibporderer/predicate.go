@@ -49,6 +49,7 @@ type Update struct {
 	nodeOUUpdated         bool
 	imagesUpdated         bool
 	fabricVersionUpdated  bool
+	clusterSizeUpdated    bool
 	// update GetUpdateStackWithTrues when new fields are added
 }
 
@@ -71,7 +72,8 @@ func (u *Update) Detected() bool {
 		u.migrateToV24 ||
 		u.nodeOUUpdated ||
 		u.imagesUpdated ||
-		u.fabricVersionUpdated
+		u.fabricVersionUpdated ||
+		u.clusterSizeUpdated
 }
 
 func (u *Update) SpecUpdated() bool {
@@ -260,6 +262,9 @@ func (u *Update) GetUpdateStackWithTrues() string {
 	if u.fabricVersionUpdated {
 		stack += "fabricVersionUpdated "
 	}
+	if u.clusterSizeUpdated {
+		stack += "clusterSizeUpdated "
+	}
 
 	if len(stack) == 0 {
 		stack = "emptystack "
@@ -278,6 +283,11 @@ func (u *Update) FabricVersionUpdated() bool {
 	return u.fabricVersionUpdated
 }
 
+// ClusterSizeUpdated returns true if size of a deployed cluster updated
+func (u *Update) ClusterSizeUpdated() bool {
+	return u.clusterSizeUpdated
+}
+
 func imagesUpdated(old, new *current.IBPOrderer) bool {
 	if new.Spec.Images != nil {
 		if old.Spec.Images == nil {
@@ -42,6 +42,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -135,9 +136,45 @@ func add(mgr manager.Manager, r *ReconcileOrganization) error {
 		return err
 	}
 
+	// Watch for bundles to import
+	bundleFuncs := predicate.Funcs{
+		CreateFunc: r.BundleCreateFunc,
+		UpdateFunc: r.BundleUpdateFunc,
+		DeleteFunc: func(e event.DeleteEvent) bool { return false },
+	}
+	err = c.Watch(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(namespace2organizationMap), bundleFuncs)
+	if err != nil {
+		return err
+	}
+
+	// Watch for peers and orderers which are exported in bundle
+	nodeFuncs := predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool { return false },
+		UpdateFunc: r.NodeUpdateFunc,
+		DeleteFunc: func(e event.DeleteEvent) bool { return true },
+	}
+	err = c.Watch(&source.Kind{Type: &current.IBPPeer{}}, handler.EnqueueRequestsFromMapFunc(namespace2organizationMap), nodeFuncs)
+	if err != nil {
+		return err
+	}
+	err = c.Watch(&source.Kind{Type: &current.IBPOrderer{}}, handler.EnqueueRequestsFromMapFunc(namespace2organizationMap), nodeFuncs)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// namespace2organizationMap maps an object in organization's namespace to the organization
+func namespace2organizationMap(object client.Object) []reconcile.Request {
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{
+			Namespace: object.GetNamespace(),
+			Name:      object.GetNamespace(),
+		},
+	}}
+}
+
 func federation2organizationMap(object client.Object) []reconcile.Request {
 	federation := object.(*current.Federation)
 	res := make([]reconcile.Request, len(federation.Spec.Members))
@@ -281,9 +318,8 @@ func (r *ReconcileOrganization) SetStatus(instance *current.Organization, reconc
 			status.Message = reconcileStatus.Message
 			status.LastHeartbeatTime = metav1.Now()
 
-			instance.Status = current.OrganizationStatus{
-				CRStatus: status,
-			}
+			// keep federations and revocation patched by others
+			instance.Status.CRStatus = status
 
 			log.Info(fmt.Sprintf("Updating status of Organization custom resource to %s phase", instance.Status.Type))
 			err = r.client.PatchStatus(context.TODO(), instance, nil, k8sclient.PatchOption{
@@ -313,6 +349,11 @@ func (r *ReconcileOrganization) SetErrorStatus(instance *current.Organization, r
 		return errors.Wrap(err, "failed to save spec state")
 	}
 
+	// status may have been patched during reconcile
+	if err = r.client.Get(context.TODO(), types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}, instance); err != nil {
+		return err
+	}
+
 	status := instance.Status.CRStatus
 	status.Type = current.Error
 	status.Status = current.True
@@ -321,9 +362,7 @@ func (r *ReconcileOrganization) SetErrorStatus(instance *current.Organization, r
 	status.LastHeartbeatTime = metav1.Now()
 	status.ErrorCode = operatorerrors.GetErrorCode(reconcileErr)
 
-	instance.Status = current.OrganizationStatus{
-		CRStatus: status,
-	}
+	instance.Status.CRStatus = status
 
 	log.Info(fmt.Sprintf("Updating status of Organization custom resource to %s phase", instance.Status.Type))
 	if err = r.client.PatchStatus(context.TODO(), instance, nil, k8sclient.PatchOption{
@@ -185,4 +185,24 @@ var _ = Describe("ReconcileOrganization", func() {
 		})
 	})
 
+	Context("predict roles update", func() {
+		It("removes users no longer bound to any role", func() {
+			oldOrg := instance.DeepCopy()
+			oldOrg.Spec.Admins = []string{"admin2"}
+			oldOrg.Spec.Roles = []current.OrganizationRole{
+				{Name: "auditor", Users: []string{"auditor", "client"}},
+				{Name: "voter", Users: []string{"voter"}},
+			}
+			newOrg := oldOrg.DeepCopy()
+			Expect(reconciler.PredictOrganizationUpdate(oldOrg, newOrg)).To(BeFalse())
+
+			newOrg.Spec.Admins = nil
+			newOrg.Spec.Clients = []string{"client"}
+			newOrg.Spec.Roles = []current.OrganizationRole{{Name: "voter", Users: []string{"voter", "admin2"}}}
+			updated, removed := diffRoles(oldOrg, newOrg)
+			Expect(updated).To(BeTrue())
+			Expect(removed).To(Equal("auditor"))
+		})
+	})
+
 })
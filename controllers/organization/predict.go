@@ -22,10 +22,12 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/orgbundle"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/user"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
 	"github.com/go-test/deep"
@@ -103,6 +105,8 @@ func (r *ReconcileOrganization) PredictOrganizationCreate(organization *current.
 			update.clientsRemoved = strings.Join(removed, ",")
 		}
 
+		update.rolesUpdated, update.rolesRemoved = diffRoles(existingOrg, organization)
+
 		r.PushUpdate(organization.GetName(), update)
 		return true
 	}
@@ -110,6 +114,7 @@ func (r *ReconcileOrganization) PredictOrganizationCreate(organization *current.
 	update.specUpdated = true
 	update.adminUpdated = true
 	update.clientsUpdated = true
+	update.rolesUpdated = true
 	if organization.Spec.AdminToken != "" {
 		update.tokenUpdated = true
 	}
@@ -160,6 +165,8 @@ func (r *ReconcileOrganization) PredictOrganizationUpdate(oldOrg *current.Organi
 		update.clientsRemoved = strings.Join(removed, ",")
 	}
 
+	update.rolesUpdated, update.rolesRemoved = diffRoles(oldOrg, newOrg)
+
 	r.PushUpdate(oldOrg.Name, update)
 
 	log.Info(fmt.Sprintf("Spec update triggering reconcile on Organization custom resource %s: update [ %+v ]", oldOrg.Name, update.GetUpdateStackWithTrues()))
@@ -167,6 +174,26 @@ func (r *ReconcileOrganization) PredictOrganizationUpdate(oldOrg *current.Organi
 	return true
 }
 
+// diffRoles compares the delegated admins and declared roles of organization. Removed are the users
+// who were bound to them but no longer to any role, admin transfer and clients remove cover the others
+func diffRoles(oldOrg, newOrg *current.Organization) (bool, string) {
+	if reflect.DeepEqual(oldOrg.Spec.Admins, newOrg.Spec.Admins) && reflect.DeepEqual(oldOrg.Spec.Roles, newOrg.Spec.Roles) {
+		return false, ""
+	}
+	bound := newOrg.GetRoleUsers()
+	removed := make([]string, 0)
+	for u, role := range oldOrg.GetRoleUsers() {
+		if u == oldOrg.Spec.Admin || role == "client" {
+			continue
+		}
+		if _, ok := bound[u]; !ok {
+			removed = append(removed, u)
+		}
+	}
+	sort.Strings(removed)
+	return true, strings.Join(removed, ",")
+}
+
 func (r *ReconcileOrganization) DeleteFunc(e event.DeleteEvent) bool {
 	var err error
 	organization := e.Object.(*current.Organization)
@@ -179,18 +206,14 @@ func (r *ReconcileOrganization) DeleteFunc(e event.DeleteEvent) bool {
 		if err != nil {
 			log.Error(err, fmt.Sprintf("failed to get iam users with organization annotation key %s", organization.GetName()))
 		} else {
-			for i, iamuser := range userList.Items {
-				idType := iamuser.Labels[user.OrganizationLabel.String(organization.Name)]
-				_, err = user.ReconcileRemove(&userList.Items[i], organization.Name, "", user.IDType(idType))
-				if err != nil {
+			// identities are deleted along with the organization, drop them from users right away
+			for i := range userList.Items {
+				iamuser := &userList.Items[i]
+				if err = user.SyncUser(r.client, user.GetUsername(iamuser), organization.Name); err != nil {
 					log.Error(err, fmt.Sprintf("failed to delete annotation %s for %s", organization.GetName(), iamuser.GetName()))
 				}
 			}
 		}
-		err = user.PatchUsers(r.client, userList.Items...)
-		if err != nil {
-			log.Error(err, fmt.Sprintf("failed to patch users for %s", organization.GetName()))
-		}
 	}
 
 	// delete namespace
@@ -313,6 +336,35 @@ func (r *ReconcileOrganization) CAUpdateFunc(e event.UpdateEvent) bool {
 	return true
 }
 
+// BundleCreateFunc triggers import when a bundle is labeled to import
+func (r *ReconcileOrganization) BundleCreateFunc(e event.CreateEvent) bool {
+	return isImportBundle(e.Object)
+}
+
+func (r *ReconcileOrganization) BundleUpdateFunc(e event.UpdateEvent) bool {
+	if !isImportBundle(e.ObjectNew) {
+		return false
+	}
+	oldCM := e.ObjectOld.(*corev1.ConfigMap)
+	newCM := e.ObjectNew.(*corev1.ConfigMap)
+	return !isImportBundle(oldCM) || !reflect.DeepEqual(oldCM.Data, newCM.Data)
+}
+
+func isImportBundle(object client.Object) bool {
+	return object.GetLabels()[orgbundle.ImportLabel] == orgbundle.ImportLabelValue
+}
+
+// NodeUpdateFunc triggers export when a peer or orderer is deployed or stops working
+func (r *ReconcileOrganization) NodeUpdateFunc(e event.UpdateEvent) bool {
+	switch oldNode := e.ObjectOld.(type) {
+	case *current.IBPPeer:
+		return oldNode.Status.Type != e.ObjectNew.(*current.IBPPeer).Status.Type
+	case *current.IBPOrderer:
+		return oldNode.Status.Type != e.ObjectNew.(*current.IBPOrderer).Status.Type
+	}
+	return false
+}
+
 func (r *ReconcileOrganization) UpdateStatus(organization current.NamespacedName, newStatus current.CRStatus) error {
 	var err error
 	org := &current.Organization{ObjectMeta: v1.ObjectMeta{Name: organization.Name}}
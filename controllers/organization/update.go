@@ -38,6 +38,11 @@ type Update struct {
 	clientsUpdated bool
 	// cache remove clients
 	clientsRemoved string
+
+	// delegated admins or declared roles updated
+	rolesUpdated bool
+	// cache users no longer bound to any role
+	rolesRemoved string
 }
 
 func (u *Update) SpecUpdated() bool {
@@ -60,6 +65,14 @@ func (u *Update) ClientsRemoved() string {
 	return u.clientsRemoved
 }
 
+func (u *Update) RolesUpdated() bool {
+	return u.rolesUpdated
+}
+
+func (u *Update) RolesRemoved() string {
+	return u.rolesRemoved
+}
+
 func (u *Update) TokenUpdated() bool {
 	return u.tokenUpdated
 }
@@ -96,6 +109,14 @@ func (u *Update) GetUpdateStackWithTrues() string {
 		stack += "clientsRemoved "
 	}
 
+	if u.RolesUpdated() {
+		stack += "rolesUpdated "
+	}
+
+	if u.RolesRemoved() != "" {
+		stack += "rolesRemoved "
+	}
+
 	if u.CAStatusUpdated() {
 		stack += "caStatusUpdated "
 	}
@@ -28,15 +28,17 @@ import (
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
+	opevent "github.com/IBM-Blockchain/fabric-operator/pkg/event"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/global"
 	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/metrics"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
 	k8sproposal "github.com/IBM-Blockchain/fabric-operator/pkg/offering/k8s/proposal"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/operatorerrors"
 	bcrbac "github.com/IBM-Blockchain/fabric-operator/pkg/rbac"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/voting"
 	"github.com/pkg/errors"
-	"k8s.io/utils/pointer"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -67,6 +69,9 @@ func Add(mgr manager.Manager, config *config.Config) error {
 	if err != nil {
 		return err
 	}
+	if err = metrics.RegisterProposalCollector(r.client); err != nil {
+		return err
+	}
 	return add(mgr, r)
 }
 
@@ -211,6 +216,12 @@ func (r *ReconcileProposal) SetLabels(instance *current.Proposal) bool {
 			update = true
 		}
 	}
+	if instance.Spec.RollbackChaincode != nil {
+		if v, ok := instance.Labels[current.ChaincodeProposalLabel]; !ok || v != instance.Spec.RollbackChaincode.Chaincode {
+			instance.Labels[current.ChaincodeProposalLabel] = instance.Spec.RollbackChaincode.Chaincode
+			update = true
+		}
+	}
 
 	return update
 }
@@ -220,7 +231,7 @@ func (r *ReconcileProposal) SetStatus(ctx context.Context, instance *current.Pro
 	}
 
 	if reconcileErr != nil {
-		_ = r.UpdateCondition(&instance.Status, &current.ProposalCondition{
+		r.setCondition(instance, &current.ProposalCondition{
 			Type:               current.ProposalError,
 			Status:             v1.ConditionTrue,
 			LastTransitionTime: v1.Now(),
@@ -233,7 +244,17 @@ func (r *ReconcileProposal) SetStatus(ctx context.Context, instance *current.Pro
 		return r.PatchStatus(ctx, instance)
 	}
 	if !instance.Spec.EndAt.IsZero() && instance.Spec.EndAt.Time.Before(time.Now()) {
-		_ = r.UpdateCondition(&instance.Status, &current.ProposalCondition{
+		if instance.Status.Phase == current.ProposalVoting {
+			res, err := r.Tally(ctx, instance, true)
+			if err != nil {
+				return err
+			}
+			if res.Outcome == voting.Adopted {
+				r.setSucceeded(instance, res)
+				return r.PatchStatus(ctx, instance)
+			}
+		}
+		r.setCondition(instance, &current.ProposalCondition{
 			Type:               current.ProposalExpired,
 			Status:             v1.ConditionTrue,
 			LastTransitionTime: v1.Now(),
@@ -252,62 +273,28 @@ func (r *ReconcileProposal) SetStatus(ctx context.Context, instance *current.Pro
 	if instance.Status.Phase == "" {
 		instance.Status.Phase = current.ProposalPending
 		log.Info(fmt.Sprintf("Updating status of Proposal custom resource to %s phase", current.ProposalPending))
+		opevent.Normal(r.Config.Recorder(), instance, opevent.ProposalPending, "proposal is pending")
 		return r.PatchStatus(ctx, instance)
 	} else if instance.Status.Phase == current.ProposalPending {
 		instance.Status.Phase = current.ProposalVoting
 		log.Info(fmt.Sprintf("Updating status of Proposal custom resource to %s phase", current.ProposalVoting))
+		opevent.Normal(r.Config.Recorder(), instance, opevent.ProposalVoting, "proposal is open for voting")
 		return r.PatchStatus(ctx, instance)
 	} else if instance.Status.Phase == current.ProposalVoting {
-		res, err := r.GetVoteStatus(ctx, instance)
+		res, err := r.Tally(ctx, instance, false)
 		if err != nil {
 			return err
 		}
-		instance.Status.Votes = res
-		if err = r.PatchStatus(ctx, instance); err != nil {
-			return err
-		}
-		var proposalSuccess *bool
-		switch instance.Spec.Policy.String() {
-		case current.OneVoteVeto.String(), current.ALL.String(): // todo 一票否决 和 全部人都同意 的区别是？
-			for index, i := range res {
-				if i.Decision != nil && !*i.Decision {
-					proposalSuccess = pointer.Bool(false)
-					break
-				} else if i.Decision == nil {
-					break
-				}
-				if index == len(res)-1 {
-					proposalSuccess = pointer.Bool(true)
-				}
-			}
-		case current.Majority.String():
-			sum := len(res)
-			agree := 0
-			for _, i := range res {
-				if pointer.BoolDeref(i.Decision, false) {
-					agree += 1
-				}
-			}
-			if agree*2 >= sum {
-				proposalSuccess = pointer.Bool(true)
-			}
-		}
-		if proposalSuccess != nil && *proposalSuccess {
-			_ = r.UpdateCondition(&instance.Status, &current.ProposalCondition{
-				Type:               current.ProposalSucceeded,
-				Status:             v1.ConditionTrue,
-				LastTransitionTime: v1.Now(),
-				Reason:             "Success",
-				Message:            "Success",
-			})
-			instance.Status.Phase = current.ProposalFinished
-		} else if proposalSuccess != nil && !*proposalSuccess {
-			_ = r.UpdateCondition(&instance.Status, &current.ProposalCondition{
+		switch res.Outcome {
+		case voting.Adopted:
+			r.setSucceeded(instance, res)
+		case voting.Rejected:
+			r.setCondition(instance, &current.ProposalCondition{
 				Type:               current.ProposalFailed,
 				Status:             v1.ConditionTrue,
 				LastTransitionTime: v1.Now(),
 				Reason:             "Failed",
-				Message:            "Failed",
+				Message:            res.String(),
 			})
 			instance.Status.Phase = current.ProposalFinished
 		}
@@ -317,6 +304,52 @@ func (r *ReconcileProposal) SetStatus(ctx context.Context, instance *current.Pro
 	return
 }
 
+// Tally records the votes of a proposal in its status and evaluates them with the proposal policy.
+// When closed is true the voting is over and pending votes count as not taking part.
+func (r *ReconcileProposal) Tally(ctx context.Context, instance *current.Proposal, closed bool) (voting.Result, error) {
+	federation := &current.Federation{}
+	// the federation may be gone after a DissolveFederation proposal, every member weighs 1 then
+	err := r.client.Get(ctx, types.NamespacedName{Name: instance.Spec.Federation}, federation)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return voting.Result{}, err
+	}
+	rule, err := voting.RuleFor(instance.VotingPolicy(federation))
+	if err != nil {
+		return voting.Result{}, err
+	}
+	votes, err := r.GetVoteStatus(ctx, instance)
+	if err != nil {
+		return voting.Result{}, err
+	}
+	externalVotes, err := r.GetExternalVoteStatus(ctx, instance)
+	if err != nil {
+		return voting.Result{}, err
+	}
+	votes = append(votes, externalVotes...)
+
+	ballots := voting.Ballots(votes, instance.VotingWeights(federation))
+	var res voting.Result
+	if closed {
+		res = rule.Close(ballots)
+	} else {
+		res = rule.Evaluate(ballots)
+	}
+	instance.Status.Votes = votes
+	instance.Status.Tally = &res.Tally
+	return res, nil
+}
+
+func (r *ReconcileProposal) setSucceeded(instance *current.Proposal, res voting.Result) {
+	r.setCondition(instance, &current.ProposalCondition{
+		Type:               current.ProposalSucceeded,
+		Status:             v1.ConditionTrue,
+		LastTransitionTime: v1.Now(),
+		Reason:             "Success",
+		Message:            res.String(),
+	})
+	instance.Status.Phase = current.ProposalFinished
+}
+
 func (r *ReconcileProposal) PatchStatus(ctx context.Context, instance client.Object) error {
 	return r.client.PatchStatus(ctx, instance, nil, k8sclient.PatchOption{
 		Resilient: &k8sclient.ResilientPatch{
@@ -341,6 +374,15 @@ func (r *ReconcileProposal) GetCondition(status *current.ProposalStatus, conditi
 	return -1, nil
 }
 
+// setCondition updates the proposal condition and records an event when it has changed
+func (r *ReconcileProposal) setCondition(instance *current.Proposal, condition *current.ProposalCondition) {
+	if !r.UpdateCondition(&instance.Status, condition) {
+		return
+	}
+	reason, eventtype := opevent.ProposalReason(condition.Type)
+	opevent.Record(r.Config.Recorder(), instance, eventtype, reason, "%s", condition.Message)
+}
+
 // UpdateCondition updates existing proposal condition or creates a new one.
 // Sets LastTransitionTime to now if the status has changed.
 // Returns true if proposal condition has changed or has been added.
@@ -461,6 +503,38 @@ func (r *ReconcileProposal) GetVoteStatus(ctx context.Context, instance v1.Objec
 	return res, nil
 }
 
+// GetExternalVoteStatus returns the votes of external organizations, which are signed in their own clusters
+// and recorded in proposal spec. An external organization without a valid signed vote is pending.
+func (r *ReconcileProposal) GetExternalVoteStatus(ctx context.Context, instance *current.Proposal) ([]current.VoteResult, error) {
+	candidates, err := instance.GetCandidateOrganizations(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]current.VoteResult, 0)
+	for _, candidate := range candidates {
+		org, err := current.GetExternalOrganization(ctx, r.client, candidate)
+		if err != nil {
+			return nil, err
+		}
+		if org == nil {
+			continue
+		}
+		pending := current.VoteResult{Organization: current.NamespacedName{Name: candidate}, Phase: current.VoteCreated}
+		vote := instance.GetExternalVote(candidate)
+		if vote == nil {
+			res = append(res, pending)
+			continue
+		}
+		if err = org.VerifyVote(instance, *vote); err != nil {
+			log.Error(err, "ignore external vote", "proposal", instance.GetName())
+			res = append(res, pending)
+			continue
+		}
+		res = append(res, vote.Result())
+	}
+	return res, nil
+}
+
 func (r *ReconcileProposal) VoteUpdateFunc(e event.UpdateEvent) bool {
 	oldVote := e.ObjectOld.(*current.Vote)
 	newVote := e.ObjectNew.(*current.Vote)
@@ -481,6 +555,7 @@ func (r *ReconcileProposal) VoteUpdateFunc(e event.UpdateEvent) bool {
 		NamespacedName: newVote.GetNamespacedName(),
 		Organization:   newVote.GetOrganization(),
 		Decision:       newVote.Spec.Decision,
+		Abstain:        newVote.Spec.Abstain,
 		Description:    newVote.Spec.Description,
 		Phase:          newVote.Status.Phase,
 		VoteTime:       newVote.Status.VoteTime,
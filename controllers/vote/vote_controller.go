@@ -318,7 +318,7 @@ func (r *ReconcileVote) UpdateFunc(e event.UpdateEvent) bool {
 		return false
 	}
 
-	if oldVote.Spec.Decision == nil && newVote.Spec.Decision != nil {
+	if (oldVote.Spec.Decision == nil && newVote.Spec.Decision != nil) || (!oldVote.Spec.Abstain && newVote.Spec.Abstain) {
 		r.UpdateVoted(newVote.GetName())
 		log.Info(fmt.Sprintf("vote:%s voted\n", newVote.GetName()))
 		return true
@@ -264,5 +264,6 @@ func setDefaultChaincodeBuildDefinitions(cfg *config.Config) {
 		MinioSecretKey:      os.Getenv("MINIO_SECRET_KEY"),
 		PipelineRunPVCFile:  filepath.Join(defaultChaincodeBuildDef, "pipelinerun_pvc.yaml"),
 		PipelineRunFile:     filepath.Join(defaultChaincodeBuildDef, "pipelinerun.yaml"),
+		LanguagePipeline:    os.Getenv("LANGUAGE_PIPELINE"),
 	}
 }
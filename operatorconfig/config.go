@@ -29,6 +29,7 @@ import (
 	peerinit "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/peer"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering"
 	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/record"
 )
 
 type Config struct {
@@ -46,6 +47,16 @@ type Config struct {
 	Offering                 offering.Type
 	Operator                 Operator
 	Logger                   *logr.Logger
+	EventRecorder            record.EventRecorder
+}
+
+// Recorder returns the event recorder shared by the reconcilers, or nil if
+// events are disabled (for example in unit tests)
+func (c *Config) Recorder() record.EventRecorder {
+	if c == nil {
+		return nil
+	}
+	return c.EventRecorder
 }
 
 type ConsoleConfig struct {
@@ -26,6 +26,7 @@ import (
 	cainit "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/ca"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common/enroller"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources/container"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
 	"github.com/vrischmann/envconfig"
 
 	corev1 "k8s.io/api/core/v1"
@@ -89,6 +90,8 @@ type Operator struct {
 	CA            CA                 `json:"ca" yaml:"ca"`
 	Console       Console            `json:"console" yaml:"console"`
 	Restart       Restart            `json:"restart" yaml:"restart"`
+	Backup        Backup             `json:"backup" yaml:"backup"`
+	SecretStore   secretstore.Config `json:"secretStore" yaml:"secretStore"`
 	Versions      *deployer.Versions `json:"versions,omitempty" yaml:"versions,omitempty"`
 	Globals       Globals            `json:"globals,omitempty" yaml:"globals,omitempty" envconfig:"optional"`
 	Debug         Debug              `json:"debug" yaml:"debug"`
@@ -157,6 +160,20 @@ type Globals struct {
 	AllowKubernetesEighteen string                     `json:"allowKubernetesEighteen,omitempty" yaml:"allowKubernetesEighteen,omitempty"`
 }
 
+// Backup defines configurable properties for Backup and Restore custom resources
+type Backup struct {
+	// Image of the jobs transferring volumes, it must provide sh, tar and curl
+	Image    string         `json:"image" yaml:"image"`
+	Timeouts BackupTimeouts `json:"timeouts" yaml:"timeouts"`
+}
+
+type BackupTimeouts struct {
+	// ReplicaChange is how long a node may take to scale down
+	ReplicaChange common.Duration `json:"replicaChange" yaml:"replicaChange"`
+	// JobCompletion is how long a volume transfer may take, presigned urls expire as well
+	JobCompletion common.Duration `json:"jobCompletion" yaml:"jobCompletion"`
+}
+
 type Debug struct {
 	DisableDeploymentChecks string `json:"disableDeploymentChecks,omitempty" yaml:"disableDeploymentChecks,omitempty"`
 }
@@ -207,6 +224,22 @@ func (o *Operator) SetDefaults() {
 			WaitTime: common.MustParseDuration("10m"),
 			Timeout:  common.MustParseDuration("5m"),
 		},
+		Backup: Backup{
+			Image: "curlimages/curl:7.86.0",
+			Timeouts: BackupTimeouts{
+				ReplicaChange: common.MustParseDuration("5m"),
+				JobCompletion: common.MustParseDuration("2h"),
+			},
+		},
+		SecretStore: secretstore.Config{
+			Backend: secretstore.BackendKubernetes,
+			Vault: secretstore.VaultConfig{
+				Mount:            secretstore.DefaultVaultMount,
+				Prefix:           secretstore.DefaultVaultPrefix,
+				FetchImage:       secretstore.DefaultFetchImage,
+				FetchTokenSecret: secretstore.DefaultFetchTokenSecret,
+			},
+		},
 		Versions: getDefaultVersions(),
 	}
 }
@@ -27,6 +27,8 @@ const (
 	LatestTag       = "latest"
 	FabricCAVersion = "1.5.3"
 	FabricVersion   = "2.4.7"
+	// FabricEnvVersion is the tag of node and java chaincode builder images
+	FabricEnvVersion = "2.4"
 )
 
 func getDefaultVersions() *deployer.Versions {
@@ -57,6 +59,12 @@ func getDefaultVersions() *deployer.Versions {
 					CouchDBTag:    "3.2.2",
 					GRPCWebImage:  util.GetRegistyServer() + "grpc-web",
 					GRPCWebTag:    LatestTag,
+					GoEnvImage:    util.GetRegistyServer() + "fabric-ccenv",
+					GoEnvTag:      FabricVersion,
+					NodeEnvImage:  util.GetRegistyServer() + "fabric-nodeenv",
+					NodeEnvTag:    FabricEnvVersion,
+					JavaEnvImage:  util.GetRegistyServer() + "fabric-javaenv",
+					JavaEnvTag:    FabricEnvVersion,
 				},
 			},
 		},
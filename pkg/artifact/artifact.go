@@ -0,0 +1,115 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package artifact signs and verifies the artifacts organizations in different clusters exchange
+// out of band, such as join packages and votes. An artifact is a payload signed by an identity
+// whose certificate is issued by one of the organization's CAs. Certificates are base64 encoded PEM,
+// the same encoding as the certificates in connection profiles.
+package artifact
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+
+	"github.com/pkg/errors"
+)
+
+// Sign returns the base64 encoded ASN.1 ECDSA signature of payload
+func Sign(signer crypto.Signer, payload []byte) (string, error) {
+	digest := sha256.Sum256(payload)
+	sig, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign artifact")
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// ParseCertificate parses a base64 encoded PEM certificate
+func ParseCertificate(certificate string) (*x509.Certificate, error) {
+	certPEM, err := base64.StdEncoding.DecodeString(certificate)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid certificate encoding")
+	}
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, errors.New("invalid certificate pem")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse certificate")
+	}
+	return cert, nil
+}
+
+// Verify checks certificate is issued by one of roots, optionally through intermediates,
+// and signature of payload is made by its key. It returns the parsed certificate.
+func Verify(payload []byte, signature string, certificate string, roots []string, intermediates []string) (*x509.Certificate, error) {
+	if signature == "" || certificate == "" {
+		return nil, errors.New("artifact is not signed")
+	}
+	cert, err := ParseCertificate(certificate)
+	if err != nil {
+		return nil, err
+	}
+	rootPool, err := certPool(roots)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid root certificate")
+	}
+	intermediatePool, err := certPool(intermediates)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid intermediate certificate")
+	}
+	if _, err = cert.Verify(x509.VerifyOptions{
+		Roots:         rootPool,
+		Intermediates: intermediatePool,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return nil, errors.Wrap(err, "certificate is not issued by the organization")
+	}
+
+	pub, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("certificate key is not ecdsa")
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature encoding")
+	}
+	digest := sha256.Sum256(payload)
+	if !ecdsa.VerifyASN1(pub, digest[:], sig) {
+		return nil, errors.New("signature does not match the certificate")
+	}
+	return cert, nil
+}
+
+func certPool(certs []string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	for _, c := range certs {
+		cert, err := ParseCertificate(c)
+		if err != nil {
+			return nil, err
+		}
+		pool.AddCert(cert)
+	}
+	return pool, nil
+}
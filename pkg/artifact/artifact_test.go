@@ -0,0 +1,208 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package artifact_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/artifact"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util/testcert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+// encoded returns the base64 encoded PEM certificate of id, as artifacts carry it
+func encoded(id *testcert.Identity) string {
+	return base64.StdEncoding.EncodeToString(id.PEM)
+}
+
+func TestSignVerify(t *testing.T) {
+	ca := testcert.New(t, "ca", nil)
+	admin := testcert.New(t, "admin", ca)
+	otherCA := testcert.New(t, "other-ca", nil)
+	payload := []byte(`{"name":"org1"}`)
+
+	sig, err := artifact.Sign(admin.Key, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = artifact.Verify(payload, sig, encoded(admin), []string{encoded(ca)}, nil); err != nil {
+		t.Fatalf("expect verified, got %v", err)
+	}
+	if _, err = artifact.Verify([]byte(`{"name":"org2"}`), sig, encoded(admin), []string{encoded(ca)}, nil); err == nil {
+		t.Fatal("expect tampered payload rejected")
+	}
+	if _, err = artifact.Verify(payload, sig, encoded(admin), []string{encoded(otherCA)}, nil); err == nil {
+		t.Fatal("expect certificate of another CA rejected")
+	}
+	if _, err = artifact.Verify(payload, "", encoded(admin), []string{encoded(ca)}, nil); err == nil {
+		t.Fatal("expect unsigned artifact rejected")
+	}
+}
+
+func newExternalOrganization(t *testing.T, ca, admin *testcert.Identity) *current.ExternalOrganization {
+	org := &current.ExternalOrganization{
+		ObjectMeta: metav1.ObjectMeta{Name: "org2"},
+		Spec: current.ExternalOrganizationSpec{
+			MSP: current.ExternalMSP{
+				RootCerts:    []string{encoded(ca)},
+				TLSRootCerts: []string{encoded(ca)},
+				Admins:       []string{encoded(admin)},
+			},
+			Peers: []current.ExternalNode{{Name: "peer0", URL: "grpcs://peer0.org2.example.com:443"}},
+		},
+	}
+	sig, err := artifact.Sign(admin.Key, org.JoinPayload())
+	if err != nil {
+		t.Fatal(err)
+	}
+	org.Spec.JoinPackage = current.SignedArtifact{Signature: sig, Certificate: encoded(admin)}
+	return org
+}
+
+func TestVerifyJoinPackage(t *testing.T) {
+	ca := testcert.New(t, "ca", nil)
+	admin := testcert.New(t, "admin", ca)
+	client := testcert.New(t, "client", ca)
+
+	org := newExternalOrganization(t, ca, admin)
+	if err := org.VerifyJoinPackage(); err != nil {
+		t.Fatalf("expect verified, got %v", err)
+	}
+
+	tampered := org.DeepCopy()
+	tampered.Spec.Peers[0].URL = "grpcs://attacker.example.com:443"
+	if err := tampered.VerifyJoinPackage(); err == nil {
+		t.Fatal("expect tampered definition rejected")
+	}
+
+	// a member of the organization which is not an admin can not sign
+	byClient := org.DeepCopy()
+	sig, err := artifact.Sign(client.Key, byClient.JoinPayload())
+	if err != nil {
+		t.Fatal(err)
+	}
+	byClient.Spec.JoinPackage = current.SignedArtifact{Signature: sig, Certificate: encoded(client)}
+	if err = byClient.VerifyJoinPackage(); err == nil {
+		t.Fatal("expect join package signed by non-admin rejected")
+	}
+}
+
+func TestVerifyUpdate(t *testing.T) {
+	ca := testcert.New(t, "ca", nil)
+	admin := testcert.New(t, "admin", ca)
+	existing := newExternalOrganization(t, ca, admin)
+
+	// new peers signed by the existing admin
+	updated := existing.DeepCopy()
+	updated.Spec.Peers = append(updated.Spec.Peers, current.ExternalNode{Name: "peer1", URL: "grpcs://peer1.org2.example.com:443"})
+	sig, err := artifact.Sign(admin.Key, updated.JoinPayload())
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated.Spec.JoinPackage = current.SignedArtifact{Signature: sig, Certificate: encoded(admin)}
+	if err = updated.VerifyUpdate(existing); err != nil {
+		t.Fatalf("expect verified, got %v", err)
+	}
+
+	// a self-signed package of a new msp can not take over the organization
+	attackerCA := testcert.New(t, "attacker-ca", nil)
+	attacker := testcert.New(t, "attacker", attackerCA)
+	takeover := newExternalOrganization(t, attackerCA, attacker)
+	if err = takeover.VerifyJoinPackage(); err != nil {
+		t.Fatalf("expect verified by its own msp, got %v", err)
+	}
+	if err = takeover.VerifyUpdate(existing); err == nil {
+		t.Fatal("expect msp replaced without existing admins rejected")
+	}
+
+	// an existing admin can rotate the CA as long as it stays an admin
+	rotated := existing.DeepCopy()
+	newCA := testcert.New(t, "new-ca", nil)
+	rotated.Spec.MSP.RootCerts = append(rotated.Spec.MSP.RootCerts, encoded(newCA))
+	sig, err = artifact.Sign(admin.Key, rotated.JoinPayload())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotated.Spec.JoinPackage = current.SignedArtifact{Signature: sig, Certificate: encoded(admin)}
+	if err = rotated.VerifyUpdate(existing); err != nil {
+		t.Fatalf("expect rotation by existing admin verified, got %v", err)
+	}
+}
+
+func TestVerifyVote(t *testing.T) {
+	ca := testcert.New(t, "ca", nil)
+	admin := testcert.New(t, "admin", ca)
+	org := newExternalOrganization(t, ca, admin)
+
+	proposal := &current.Proposal{
+		ObjectMeta: metav1.ObjectMeta{Name: "add-member", UID: "uid-1"},
+		Spec:       current.ProposalSpec{Federation: "fed"},
+	}
+	vote := current.ExternalVote{
+		Organization: "org2",
+		Decision:     pointer.Bool(true),
+		VoteTime:     metav1.NewTime(time.Now().Truncate(time.Second)),
+	}
+	sig, err := artifact.Sign(admin.Key, vote.Payload(proposal))
+	if err != nil {
+		t.Fatal(err)
+	}
+	vote.Signed = current.SignedArtifact{Signature: sig, Certificate: encoded(admin)}
+	if err = org.VerifyVote(proposal, vote); err != nil {
+		t.Fatalf("expect verified, got %v", err)
+	}
+	if res := vote.Result(); res.Phase != current.VoteVoted || !*res.Decision || res.Organization.Name != "org2" {
+		t.Fatalf("unexpected vote result %+v", res)
+	}
+
+	flipped := vote
+	flipped.Decision = pointer.Bool(false)
+	if err = org.VerifyVote(proposal, flipped); err == nil {
+		t.Fatal("expect changed decision rejected")
+	}
+
+	// a vote can not be replayed on another proposal
+	other := proposal.DeepCopy()
+	other.Name = "delete-member"
+	if err = org.VerifyVote(other, vote); err == nil {
+		t.Fatal("expect vote on another proposal rejected")
+	}
+
+	// nor on a proposal recreated under the same name, or with another content
+	recreated := proposal.DeepCopy()
+	recreated.UID = "uid-2"
+	if err = org.VerifyVote(recreated, vote); err == nil {
+		t.Fatal("expect vote on a recreated proposal rejected")
+	}
+	changed := proposal.DeepCopy()
+	changed.Spec.DissolveFederation = &current.DissolveFederation{}
+	if err = org.VerifyVote(changed, vote); err == nil {
+		t.Fatal("expect vote on a changed proposal rejected")
+	}
+
+	// recording the vote does not change what it signed
+	proposal.Spec.ExternalVotes = []current.ExternalVote{vote}
+	if err = org.VerifyVote(proposal, vote); err != nil {
+		t.Fatalf("expect recorded vote verified, got %v", err)
+	}
+}
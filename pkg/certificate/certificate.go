@@ -30,13 +30,13 @@ import (
 	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common/config"
 	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -50,15 +50,26 @@ type Reenroller interface {
 type CertificateManager struct {
 	Client k8sclient.Client
 	Scheme *runtime.Scheme
+	// Store keeps the keystore secrets
+	Store secretstore.Store
 }
 
 func New(client k8sclient.Client, scheme *runtime.Scheme) *CertificateManager {
 	return &CertificateManager{
 		Client: client,
 		Scheme: scheme,
+		Store:  secretstore.NewKubernetes(client, scheme),
 	}
 }
 
+// store returns the kubernetes store for managers created without one
+func (c *CertificateManager) store() secretstore.Store {
+	if c.Store == nil {
+		return secretstore.NewKubernetes(c.Client, c.Scheme)
+	}
+	return c.Store
+}
+
 func (c *CertificateManager) GetExpireDate(pemBytes []byte) (time.Time, error) {
 	cert, err := util.GetCertificateFromPEMBytes(pemBytes)
 	if err != nil {
@@ -336,11 +347,19 @@ func (c *CertificateManager) UpdateKey(name string, key []byte, instance v1.Obje
 		return nil
 	}
 
-	data := map[string][]byte{
-		"key.pem": key,
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: instance.GetNamespace(),
+			Labels:    instance.GetLabels(),
+		},
+		Data: map[string][]byte{
+			"key.pem": key,
+		},
+		Type: corev1.SecretTypeOpaque,
 	}
 
-	err := c.UpdateSecret(instance, name, data)
+	err := c.store().Put(secret, instance)
 	if err != nil {
 		return err
 	}
@@ -405,7 +424,7 @@ func (c *CertificateManager) GetSignCert(name, namespace string) ([]byte, error)
 }
 
 func (c *CertificateManager) GetKey(name, namespace string) ([]byte, error) {
-	secret, err := c.GetSecret(name, namespace)
+	secret, err := c.store().Get(namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -421,17 +440,7 @@ func (c *CertificateManager) GetKey(name, namespace string) ([]byte, error) {
 	return nil, errors.New(fmt.Sprintf("cannot get %s", name))
 }
 
+// GetSecret reads the secret through the store, so the data of secrets kept in vault is included
 func (c *CertificateManager) GetSecret(name, namespace string) (*corev1.Secret, error) {
-	namespacedName := types.NamespacedName{
-		Name:      name,
-		Namespace: namespace,
-	}
-
-	secret := &corev1.Secret{}
-	err := c.Client.Get(context.TODO(), namespacedName, secret)
-	if err != nil {
-		return nil, err
-	}
-
-	return secret, nil
+	return c.store().Get(namespace, name)
 }
@@ -246,7 +246,7 @@ var _ = Describe("Certificate", func() {
 			})
 
 			It("returns error if failed to update keystore secret", func() {
-				mockClient.UpdateReturnsOnCall(1, errors.New("fake error"))
+				mockClient.CreateOrUpdateReturns(errors.New("fake error"))
 				err := certificateManager.ReenrollCert("tls", mockEnroller, instance, false)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("failed to update keystore secret for instance 'peer-1': fake error"))
@@ -257,7 +257,8 @@ var _ = Describe("Certificate", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				By("updating cert and key secret", func() {
-					Expect(mockClient.UpdateCallCount()).To(Equal(2))
+					Expect(mockClient.UpdateCallCount()).To(Equal(1))
+					Expect(mockClient.CreateOrUpdateCallCount()).To(Equal(1))
 				})
 			})
 		})
@@ -267,6 +268,7 @@ var _ = Describe("Certificate", func() {
 				err := certificateManager.ReenrollCert("tls", mockEnroller, instance, true)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(mockClient.UpdateCallCount()).To(Equal(1))
+				Expect(mockClient.CreateOrUpdateCallCount()).To(Equal(0))
 			})
 		})
 	})
@@ -287,7 +289,7 @@ var _ = Describe("Certificate", func() {
 
 	Context("update key", func() {
 		It("returns error if client fails to update secret", func() {
-			mockClient.UpdateReturns(errors.New("fake error"))
+			mockClient.CreateOrUpdateReturns(errors.New("fake error"))
 			err := certificateManager.UpdateKey("secret-name", []byte("cert"), instance)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(Equal("fake error"))
@@ -0,0 +1,274 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inventory
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("certificate_inventory")
+
+// source is a certificate kept in a key of a secret
+type source struct {
+	certType current.CertificateType
+	secret   string
+	key      string
+}
+
+func nodeSources(name string) []source {
+	return []source{
+		{certType: current.CertificateEcert, secret: fmt.Sprintf("ecert-%s-signcert", name), key: "cert.pem"},
+		{certType: current.CertificateTLS, secret: fmt.Sprintf("tls-%s-signcert", name), key: "cert.pem"},
+	}
+}
+
+func caSources(name string) []source {
+	return []source{
+		{certType: current.CertificateCA, secret: fmt.Sprintf("%s-ca-crypto", name), key: "cert.pem"},
+		{certType: current.CertificateTLS, secret: fmt.Sprintf("%s-ca-crypto", name), key: "tls-cert.pem"},
+		{certType: current.CertificateOperations, secret: fmt.Sprintf("%s-ca-crypto", name), key: "operations-cert.pem"},
+		{certType: current.CertificateTLSCA, secret: fmt.Sprintf("%s-tlsca-crypto", name), key: "cert.pem"},
+	}
+}
+
+func organizationSources(organization *current.Organization) []source {
+	secret := organization.GetMSPCrypto().Name
+	return []source{
+		{certType: current.CertificateAdmin, secret: secret, key: "admin-signcert"},
+		{certType: current.CertificateAdminTLS, secret: secret, key: "admin-tls-signcert"},
+	}
+}
+
+// Collect reads the certificates of peers, orderer nodes, CAs and organization admins in namespaces,
+// all namespaces when empty. Secrets are read from store, which keeps the crypto of CAs with their keys.
+// Other admin and client enrollments come from their Identity records, as the operator doesn't hold their certificates.
+// Certificates are sorted by expiry, soonest first
+func Collect(c controllerclient.Client, store secretstore.Store, namespaces []string, now time.Time, expiringWithin time.Duration) ([]current.CertificateInfo, error) {
+	collector := &collector{client: c, store: store, now: now, expiringWithin: expiringWithin}
+
+	listNamespaces := namespaces
+	if len(listNamespaces) == 0 {
+		listNamespaces = []string{metav1.NamespaceAll}
+	}
+	for _, ns := range listNamespaces {
+		if err := collector.collectNamespace(ns); err != nil {
+			return nil, err
+		}
+	}
+
+	organizations := &current.OrganizationList{}
+	if err := c.List(context.TODO(), organizations); err != nil {
+		return nil, errors.Wrap(err, "failed to list organizations")
+	}
+	for i := range organizations.Items {
+		organization := &organizations.Items[i]
+		if len(namespaces) > 0 && !contains(namespaces, organization.GetUserNamespace()) {
+			continue
+		}
+		collector.add("Organization", organization.GetUserNamespace(), organization.GetName(), organizationSources(organization))
+		if err := collector.addIdentities(organization); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(collector.certs, func(i, j int) bool {
+		return collector.certs[i].NotAfter.Before(&collector.certs[j].NotAfter)
+	})
+	return collector.certs, nil
+}
+
+// Read returns the certificate of type certType of a peer, orderer node or CA
+func Read(store secretstore.Store, kind, namespace, name string, certType current.CertificateType) (*x509.Certificate, error) {
+	var sources []source
+	switch kind {
+	case "IBPPeer", "IBPOrderer":
+		sources = nodeSources(name)
+	case "IBPCA":
+		sources = caSources(name)
+	default:
+		return nil, errors.Errorf("kind %s not supported", kind)
+	}
+	for _, src := range sources {
+		if src.certType != certType {
+			continue
+		}
+		secret, err := store.Get(namespace, src.secret)
+		if err != nil {
+			return nil, err
+		}
+		return util.GetCertificateFromPEMBytes(secret.Data[src.key])
+	}
+	return nil, errors.Errorf("%s has no %s certificate", kind, certType)
+}
+
+// Summarize counts certificates by state
+func Summarize(certs []current.CertificateInfo) current.CertificateSummary {
+	summary := current.CertificateSummary{}
+	for _, cert := range certs {
+		summary.Add(cert.State)
+	}
+	return summary
+}
+
+type collector struct {
+	client         controllerclient.Client
+	store          secretstore.Store
+	now            time.Time
+	expiringWithin time.Duration
+
+	certs []current.CertificateInfo
+}
+
+func (c *collector) collectNamespace(namespace string) error {
+	peers := &current.IBPPeerList{}
+	if err := c.client.List(context.TODO(), peers, client.InNamespace(namespace)); err != nil {
+		return errors.Wrap(err, "failed to list peers")
+	}
+	for _, peer := range peers.Items {
+		c.add("IBPPeer", peer.GetNamespace(), peer.GetName(), nodeSources(peer.GetName()))
+	}
+
+	orderers := &current.IBPOrdererList{}
+	if err := c.client.List(context.TODO(), orderers, client.InNamespace(namespace)); err != nil {
+		return errors.Wrap(err, "failed to list orderers")
+	}
+	for _, orderer := range orderers.Items {
+		// the parent of an orderer cluster has no crypto of its own
+		if orderer.Spec.NodeNumber == nil {
+			continue
+		}
+		c.add("IBPOrderer", orderer.GetNamespace(), orderer.GetName(), nodeSources(orderer.GetName()))
+	}
+
+	cas := &current.IBPCAList{}
+	if err := c.client.List(context.TODO(), cas, client.InNamespace(namespace)); err != nil {
+		return errors.Wrap(err, "failed to list CAs")
+	}
+	for _, ca := range cas.Items {
+		c.add("IBPCA", ca.GetNamespace(), ca.GetName(), caSources(ca.GetName()))
+	}
+	return nil
+}
+
+// add reads the certificates of sources, missing secrets and unparsable certificates are skipped
+func (c *collector) add(kind, namespace, name string, sources []source) {
+	secrets := map[string]*corev1.Secret{}
+	for _, src := range sources {
+		secret, ok := secrets[src.secret]
+		if !ok {
+			var err error
+			if secret, err = c.store.Get(namespace, src.secret); err != nil {
+				if !k8serrors.IsNotFound(err) {
+					log.Error(err, "failed to get certificate secret", "namespace", namespace, "secret", src.secret)
+				}
+				secret = nil
+			}
+			secrets[src.secret] = secret
+		}
+		if secret == nil || len(secret.Data[src.key]) == 0 {
+			continue
+		}
+
+		cert, err := util.GetCertificateFromPEMBytes(secret.Data[src.key])
+		if err != nil {
+			log.Error(err, "failed to parse certificate", "namespace", namespace, "secret", src.secret, "key", src.key)
+			continue
+		}
+
+		info := current.CertificateInfo{
+			Kind:      kind,
+			Namespace: namespace,
+			Name:      name,
+			Type:      src.certType,
+			Secret:    src.secret,
+			Subject:   cert.Subject.String(),
+			Issuer:    cert.Issuer.String(),
+			NotAfter:  metav1.NewTime(cert.NotAfter),
+		}
+		if src.certType == current.CertificateCA || src.certType == current.CertificateTLSCA {
+			info.Intermediate = !selfSigned(cert)
+		}
+		info.State = info.StateAt(c.now, c.expiringWithin)
+		c.certs = append(c.certs, info)
+	}
+}
+
+// addIdentities adds the admin and client identities of organization whose expiry is recorded.
+// The organization admin is read from its MSP crypto and revoked identities are skipped
+func (c *collector) addIdentities(organization *current.Organization) error {
+	identities := &current.IdentityList{}
+	err := c.client.List(context.TODO(), identities, client.InNamespace(organization.GetUserNamespace()), &client.ListOptions{
+		LabelSelector: current.IdentitySelector("", current.AdminIdentity, current.ClientIdentity),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list identities")
+	}
+	for _, identity := range identities.Items {
+		if identity.Status.Expiry == nil || identity.IsRevoked() || identity.Spec.EnrollmentID == organization.Spec.Admin {
+			continue
+		}
+		certType := current.CertificateClient
+		if identity.Spec.Type == current.AdminIdentity {
+			certType = current.CertificateAdmin
+		}
+		info := current.CertificateInfo{
+			Kind:      "Identity",
+			Namespace: identity.GetNamespace(),
+			Name:      identity.GetName(),
+			Type:      certType,
+			Subject:   "CN=" + identity.Spec.EnrollmentID,
+			NotAfter:  *identity.Status.Expiry,
+		}
+		info.State = info.StateAt(c.now, c.expiringWithin)
+		c.certs = append(c.certs, info)
+	}
+	return nil
+}
+
+func selfSigned(cert *x509.Certificate) bool {
+	if !bytes.Equal(cert.RawIssuer, cert.RawSubject) {
+		return false
+	}
+	return cert.CheckSignatureFrom(cert) == nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,185 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inventory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	cmocks "github.com/IBM-Blockchain/fabric-operator/controllers/mocks"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util/testcert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var now = time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// newCert issues a certificate expiring at notAfter, a self signed root when parent is nil
+func newCert(t *testing.T, cn string, notAfter time.Time, parent *testcert.Identity) (*testcert.Identity, []byte) {
+	id := testcert.New(t, cn, parent, testcert.CA(), testcert.Validity(now.Add(-time.Hour), notAfter))
+	return id, id.PEM
+}
+
+func newClient(secrets map[types.NamespacedName]map[string][]byte) *cmocks.Client {
+	c := &cmocks.Client{}
+	c.GetStub = func(ctx context.Context, key types.NamespacedName, obj client.Object) error {
+		data, ok := secrets[key]
+		if !ok {
+			return k8serrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, key.Name)
+		}
+		obj.(*corev1.Secret).Data = data
+		return nil
+	}
+	c.ListStub = func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+		switch l := list.(type) {
+		case *current.IBPPeerList:
+			l.Items = []current.IBPPeer{{ObjectMeta: metav1.ObjectMeta{Namespace: "org1", Name: "peer1"}}}
+		case *current.IBPOrdererList:
+			one := 1
+			l.Items = []current.IBPOrderer{
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "org1", Name: "orderer"}},
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "org1", Name: "orderernode1"}, Spec: current.IBPOrdererSpec{NodeNumber: &one}},
+			}
+		case *current.IBPCAList:
+			l.Items = []current.IBPCA{{ObjectMeta: metav1.ObjectMeta{Namespace: "org1", Name: "org1"}}}
+		case *current.OrganizationList:
+			l.Items = []current.Organization{
+				{ObjectMeta: metav1.ObjectMeta{Name: "org1"}, Spec: current.OrganizationSpec{Admin: "org1admin"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "org2"}},
+			}
+		case *current.IdentityList:
+			listOpts := &client.ListOptions{}
+			listOpts.ApplyOptions(opts)
+			if listOpts.Namespace != "org1" {
+				return nil
+			}
+			expiring := metav1.NewTime(now.AddDate(0, 0, 20))
+			valid := metav1.NewTime(now.AddDate(0, 6, 0))
+			l.Items = []current.Identity{
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "org1", Name: "client1"}, Spec: current.IdentitySpec{EnrollmentID: "client1", Type: current.ClientIdentity},
+					Status: current.IdentityStatus{Expiry: &expiring}},
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "org1", Name: "admin2"}, Spec: current.IdentitySpec{EnrollmentID: "admin2", Type: current.AdminIdentity},
+					Status: current.IdentityStatus{Expiry: &valid}},
+				// read from the MSP crypto of the organization
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "org1", Name: "org1admin"}, Spec: current.IdentitySpec{EnrollmentID: "org1admin", Type: current.AdminIdentity},
+					Status: current.IdentityStatus{Expiry: &valid}},
+				// no certificate known yet
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "org1", Name: "client2"}, Spec: current.IdentitySpec{EnrollmentID: "client2", Type: current.ClientIdentity}},
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "org1", Name: "client3"}, Spec: current.IdentitySpec{EnrollmentID: "client3", Type: current.ClientIdentity},
+					Status: current.IdentityStatus{Expiry: &valid, Revocation: current.IdentityRevoked}},
+			}
+		}
+		return nil
+	}
+	return c
+}
+
+func TestCollect(t *testing.T) {
+	root, rootPEM := newCert(t, "root", now.AddDate(10, 0, 0), nil)
+	_, interPEM := newCert(t, "intermediate", now.AddDate(5, 0, 0), root)
+	_, ecertPEM := newCert(t, "peer1", now.AddDate(0, 0, 10), root)
+	_, tlsPEM := newCert(t, "peer1-tls", now.AddDate(1, 0, 0), root)
+	_, ordererPEM := newCert(t, "orderernode1", now.AddDate(0, 0, 40), root)
+	_, adminPEM := newCert(t, "admin", now.Add(-time.Hour), root)
+
+	c := newClient(map[types.NamespacedName]map[string][]byte{
+		{Namespace: "org1", Name: "ecert-peer1-signcert"}:        {"cert.pem": ecertPEM},
+		{Namespace: "org1", Name: "tls-peer1-signcert"}:          {"cert.pem": tlsPEM},
+		{Namespace: "org1", Name: "ecert-orderernode1-signcert"}: {"cert.pem": ordererPEM},
+		{Namespace: "org1", Name: "org1-ca-crypto"}:              {"cert.pem": rootPEM},
+		{Namespace: "org1", Name: "org1-tlsca-crypto"}:           {"cert.pem": interPEM},
+		{Namespace: "org1", Name: "org1-msp-crypto"}:             {"admin-signcert": adminPEM, "admin-tls-signcert": []byte("invalid")},
+	})
+
+	certs, err := Collect(c, secretstore.NewKubernetes(c, nil), []string{"org1"}, now, 30*24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []struct {
+		kind     string
+		name     string
+		certType current.CertificateType
+		state    current.CertificateState
+	}{
+		{"Organization", "org1", current.CertificateAdmin, current.CertificateExpired},
+		{"IBPPeer", "peer1", current.CertificateEcert, current.CertificateExpiring},
+		{"Identity", "client1", current.CertificateClient, current.CertificateExpiring},
+		{"IBPOrderer", "orderernode1", current.CertificateEcert, current.CertificateValid},
+		{"Identity", "admin2", current.CertificateAdmin, current.CertificateValid},
+		{"IBPPeer", "peer1", current.CertificateTLS, current.CertificateValid},
+		{"IBPCA", "org1", current.CertificateTLSCA, current.CertificateValid},
+		{"IBPCA", "org1", current.CertificateCA, current.CertificateValid},
+	}
+	if len(certs) != len(expect) {
+		t.Fatalf("expect %d certificates, get %+v", len(expect), certs)
+	}
+	for i, e := range expect {
+		cert := certs[i]
+		if cert.Kind != e.kind || cert.Name != e.name || cert.Type != e.certType || cert.State != e.state {
+			t.Errorf("certificate %d: expect %+v, get %+v", i, e, cert)
+		}
+	}
+	if certs[6].Intermediate == false || certs[7].Intermediate == true {
+		t.Errorf("expect only the tls CA to be intermediate, get %v %v", certs[6].Intermediate, certs[7].Intermediate)
+	}
+
+	summary := Summarize(certs)
+	if summary != (current.CertificateSummary{Total: 8, Valid: 5, Expiring: 2, Expired: 1}) {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+
+	// organizations outside the namespaces are skipped
+	certs, err = Collect(c, secretstore.NewKubernetes(c, nil), []string{"org2"}, now, 30*24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cert := range certs {
+		if cert.Kind == "Organization" && cert.Name == "org1" {
+			t.Errorf("organization org1 is not in namespace org2")
+		}
+	}
+}
+
+func TestRead(t *testing.T) {
+	_, tlsPEM := newCert(t, "ca-tls", now.AddDate(1, 0, 0), nil)
+	c := newClient(map[types.NamespacedName]map[string][]byte{
+		{Namespace: "org1", Name: "org1-ca-crypto"}: {"tls-cert.pem": tlsPEM},
+	})
+
+	cert, err := Read(secretstore.NewKubernetes(c, nil), "IBPCA", "org1", "org1", current.CertificateTLS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cert.Subject.CommonName != "ca-tls" {
+		t.Errorf("expect the CA tls certificate, get %s", cert.Subject.CommonName)
+	}
+	if _, err := Read(secretstore.NewKubernetes(c, nil), "IBPPeer", "org1", "peer1", current.CertificateEcert); !k8serrors.IsNotFound(err) {
+		t.Errorf("expect not found, get %v", err)
+	}
+	if _, err := Read(secretstore.NewKubernetes(c, nil), "Organization", "", "org1", current.CertificateAdmin); err == nil {
+		t.Error("expect organizations not to be supported")
+	}
+}
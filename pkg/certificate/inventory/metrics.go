@@ -0,0 +1,97 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inventory
+
+import (
+	"sync"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	expirationDesc = prometheus.NewDesc(
+		"fabric_operator_certificate_expiration_timestamp_seconds",
+		"Expiry of a certificate as a unix timestamp",
+		[]string{"inventory", "kind", "namespace", "name", "type", "secret"}, nil,
+	)
+	certificatesDesc = prometheus.NewDesc(
+		"fabric_operator_certificates",
+		"Number of certificates by expiry state",
+		[]string{"inventory", "state"}, nil,
+	)
+)
+
+// Metrics exports the certificates of inventories to prometheus
+type Metrics struct {
+	mu          sync.RWMutex
+	inventories map[string][]current.CertificateInfo
+}
+
+// DefaultMetrics is registered on the controller-runtime metrics registry
+var DefaultMetrics = NewMetrics()
+
+func init() {
+	metrics.Registry.MustRegister(DefaultMetrics)
+}
+
+func NewMetrics() *Metrics {
+	return &Metrics{inventories: make(map[string][]current.CertificateInfo)}
+}
+
+// Set replaces the certificates of an inventory
+func (m *Metrics) Set(inventory string, certs []current.CertificateInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inventories[inventory] = certs
+}
+
+// Delete drops the certificates of an inventory
+func (m *Metrics) Delete(inventory string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.inventories, inventory)
+}
+
+// Describe implements prometheus.Collector
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- expirationDesc
+	ch <- certificatesDesc
+}
+
+// Collect implements prometheus.Collector
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for inventory, certs := range m.inventories {
+		for _, cert := range certs {
+			ch <- prometheus.MustNewConstMetric(expirationDesc, prometheus.GaugeValue, float64(cert.NotAfter.Unix()),
+				inventory, cert.Kind, cert.Namespace, cert.Name, string(cert.Type), cert.Secret)
+		}
+		summary := Summarize(certs)
+		for state, count := range map[current.CertificateState]int{
+			current.CertificateValid:    summary.Valid,
+			current.CertificateExpiring: summary.Expiring,
+			current.CertificateExpired:  summary.Expired,
+		} {
+			ch <- prometheus.MustNewConstMetric(certificatesDesc, prometheus.GaugeValue, float64(count), inventory, string(state))
+		}
+	}
+}
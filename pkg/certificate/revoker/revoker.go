@@ -0,0 +1,78 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package revoker
+
+import (
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/certificate/reenroller"
+	"github.com/bestchains/fabric-ca/api"
+	"github.com/bestchains/fabric-ca/lib"
+	"github.com/pkg/errors"
+)
+
+// ReasonAffiliationChanged is the revocation reason of users removed from an organization
+const ReasonAffiliationChanged = "affiliationchanged"
+
+// Revoker revokes identities and generates CRLs in a Fabric CA
+// as a registrar with `hf.Revoker` and `hf.GenCRL` attributes
+type Revoker struct {
+	CAName   string
+	Identity *lib.Identity
+}
+
+// New connects to the CA in cfg as the registrar whose enrollment certificate and key are certPem and keyPem
+func New(cfg *current.Enrollment, homeDir string, timeout string, certPem []byte, keyPem []byte) (*Revoker, error) {
+	r, err := reenroller.New(cfg, homeDir, nil, timeout, false)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.InitClient(); err != nil {
+		return nil, err
+	}
+	if err = r.LoadIdentity(certPem, keyPem, false); err != nil {
+		return nil, err
+	}
+	identity, ok := r.Identity.(*lib.Identity)
+	if !ok {
+		return nil, errors.New("unexpected registrar identity")
+	}
+	return &Revoker{CAName: cfg.CAName, Identity: identity}, nil
+}
+
+// Revoke all certificates of enrollmentID and the identity itself
+func (r *Revoker) Revoke(enrollmentID string, reason string) error {
+	_, err := r.Identity.Revoke(&api.RevocationRequest{
+		Name:   enrollmentID,
+		Reason: reason,
+		CAName: r.CAName,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to revoke %s", enrollmentID)
+	}
+	return nil
+}
+
+// GenCRL generates the CRL of all certificates revoked in CA, PEM encoded
+func (r *Revoker) GenCRL() ([]byte, error) {
+	resp, err := r.Identity.GenCRL(&api.GenCRLRequest{CAName: r.CAName})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate CRL")
+	}
+	return resp.CRL, nil
+}
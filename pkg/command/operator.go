@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	"runtime"
 	"time"
 
@@ -44,8 +45,12 @@ import (
 	ibpv1beta1 "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	controller "github.com/IBM-Blockchain/fabric-operator/controllers"
 	oconfig "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/explorer"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/global"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/migrator"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/provenance"
 	openshiftv1 "github.com/openshift/api/config/v1"
 
 	"k8s.io/apimachinery/pkg/types"
@@ -151,6 +156,8 @@ func OperatorWithSignal(operatorCfg *oconfig.Config, signalHandler context.Conte
 	var probeAddr string
 	var enableLeaderElection bool
 	var webhookDisabled string
+	var explorerAddr string
+	var explorerCertDir string
 
 	if flag.Lookup("metrics-addr") == nil {
 		flag.StringVar(&metricsAddr, "metrics-addr", ":8383", "The address the metric endpoint binds to.")
@@ -163,6 +170,13 @@ func OperatorWithSignal(operatorCfg *oconfig.Config, signalHandler context.Conte
 			"Enable leader election for controller manager. "+
 				"Enabling this will ensure there is only one active controller manager.")
 	}
+	if flag.Lookup("explorer-bind-address") == nil {
+		flag.StringVar(&explorerAddr, "explorer-bind-address", ":8090", "The address the ledger explorer api binds to. Set to 0 to disable it.")
+	}
+	if flag.Lookup("explorer-cert-dir") == nil {
+		flag.StringVar(&explorerCertDir, "explorer-cert-dir", filepath.Join(os.TempDir(), "k8s-webhook-server", "serving-certs"),
+			"The directory of tls.crt and tls.key the ledger explorer api is served with. Defaults to the webhook serving cert.")
+	}
 	flag.Parse()
 
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
@@ -179,6 +193,7 @@ func OperatorWithSignal(operatorCfg *oconfig.Config, signalHandler context.Conte
 		setupLog.Error(err, "unable to start manager")
 		return err
 	}
+	operatorCfg.EventRecorder = mgr.GetEventRecorderFor("fabric-operator")
 
 	log.Info("Registering Components.")
 
@@ -203,6 +218,15 @@ func OperatorWithSignal(operatorCfg *oconfig.Config, signalHandler context.Conte
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(ibpv1beta1.AddToScheme(scheme))
 
+	// Setup read-only ledger explorer
+	if explorerAddr != "" && explorerAddr != "0" {
+		explorerClient := controllerclient.New(mgr.GetClient(), &global.ConfigSetter{Config: operatorCfg.Operator.Globals})
+		if err := mgr.Add(explorer.New(explorerAddr, explorerCertDir, explorerClient)); err != nil {
+			setupLog.Error(err, "unable to set up explorer")
+			return err
+		}
+	}
+
 	go func() {
 		runtime.Gosched()
 		mgrSyncContext, mgrSyncContextCancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -227,6 +251,10 @@ func OperatorWithSignal(operatorCfg *oconfig.Config, signalHandler context.Conte
 		// Setup all Webhook
 		webhookDisabled = os.Getenv("WEBHOOK_DISABLED")
 		if webhookDisabled != "true" {
+			// an OCI image layout mirroring the registry, digests of chaincode images are resolved from it offline
+			if dir := os.Getenv("PROVENANCE_REGISTRY_DIR"); dir != "" {
+				ibpv1beta1.ProvenanceRegistry = provenance.LayoutRegistry{Dir: dir}
+			}
 			go func() {
 				if err := ibpv1beta1.AddWebhooks(mgr, log); err != nil {
 					log.Error(err, "setup webhook err, exit")
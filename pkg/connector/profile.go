@@ -361,6 +361,55 @@ func (profile *Profile) RemoveOrderer(orderer current.NamespacedName) {
 	delete(profile.Orderers, orderer.String())
 }
 
+// SetExternalOrganization adds an organization hosted in another cluster along with its peers and orderers.
+// It has no users since its admins sign in their own cluster. Nodes are named as `<organization>/<node>`.
+func (profile *Profile) SetExternalOrganization(org *current.ExternalOrganization) error {
+	if profile.Peers == nil {
+		profile.Peers = make(map[string]NodeEndpoint)
+	}
+	if profile.Orderers == nil {
+		profile.Orderers = make(map[string]NodeEndpoint)
+	}
+	peers := make([]string, 0, len(org.Spec.Peers))
+	for _, p := range org.Spec.Peers {
+		endpoint, err := GetExternalNodeEndpoint(org, p)
+		if err != nil {
+			return err
+		}
+		name := ExternalNodeName(org, p)
+		profile.Peers[name] = endpoint
+		peers = append(peers, name)
+	}
+	for _, o := range org.Spec.Orderers {
+		endpoint, err := GetExternalNodeEndpoint(org, o)
+		if err != nil {
+			return err
+		}
+		profile.Orderers[ExternalNodeName(org, o)] = endpoint
+	}
+	profile.SetOrganization(org.GetName(), peers)
+	return nil
+}
+
+// ExternalNodeName returns the name of an external organization's node in connection profiles
+func ExternalNodeName(org *current.ExternalOrganization, node current.ExternalNode) string {
+	return current.NamespacedName{Namespace: org.GetName(), Name: node.Name}.String()
+}
+
+// GetExternalNodeEndpoint with the endpoint of an external organization's node
+func GetExternalNodeEndpoint(org *current.ExternalOrganization, node current.ExternalNode) (NodeEndpoint, error) {
+	tlsPem, err := base64.StdEncoding.DecodeString(org.GetTLSCACert(node))
+	if err != nil {
+		return NodeEndpoint{}, errors.Wrap(err, "not a valid pem format cert")
+	}
+	return NodeEndpoint{
+		URL: node.URL,
+		TLSCACerts: TLSCACerts{
+			Pem: string(tlsPem),
+		},
+	}, nil
+}
+
 // GetNodeEndpoint with node(peer/orderer)'s connection profile
 func GetNodeEndpoint(client controllerclient.Client, node current.NamespacedName) (NodeEndpoint, error) {
 	cm := &corev1.ConfigMap{}
@@ -0,0 +1,158 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package event defines the reasons of the kubernetes events emitted by the
+// reconcilers. Reasons are stable so that users and tests can filter on them.
+package event
+
+import (
+	"fmt"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// Proposal
+	ProposalInitialized = "ProposalInitialized"
+	ProposalDeployed    = "ProposalDeployed"
+	ProposalPending     = "ProposalPending"
+	ProposalVoting      = "ProposalVoting"
+	ProposalSucceeded   = "ProposalSucceeded"
+	ProposalFailed      = "ProposalFailed"
+	ProposalExpired     = "ProposalExpired"
+	ProposalError       = "ProposalError"
+
+	// Channel
+	PeerJoined     = "PeerJoined"
+	PeerJoinFailed = "PeerJoinFailed"
+	PeerAnchored   = "PeerAnchored"
+
+	// Channel archive
+	PeerSnapshotted        = "PeerSnapshotted"
+	PeerUnjoined           = "PeerUnjoined"
+	OrdererChannelRemoved  = "OrdererChannelRemoved"
+	OrdererChannelRestored = "OrdererChannelRestored"
+	ChannelArchived        = "ChannelArchived"
+	ChannelUnarchived      = "ChannelUnarchived"
+	ChannelArchiveFailed   = "ChannelArchiveFailed"
+
+	// Chaincode
+	ChaincodePackaged    = "ChaincodePackaged"
+	ChaincodeInstalled   = "ChaincodeInstalled"
+	ChaincodeApproved    = "ChaincodeApproved"
+	ChaincodeCanary      = "ChaincodeCanaryHealthy"
+	ChaincodeCommitted   = "ChaincodeCommitted"
+	ChaincodeRunning     = "ChaincodeRunning"
+	ChaincodeStageFailed = "ChaincodeStageFailed"
+
+	// Restart & reenroll
+	RestartQueued      = "RestartQueued"
+	Reenrolled         = "Reenrolled"
+	ReenrollFailed     = "ReenrollFailed"
+	TLSCertRenewed     = "TLSCertRenewed"
+	TLSCertRenewFailed = "TLSCertRenewFailed"
+
+	// Organization bundle
+	BundleImported     = "BundleImported"
+	BundleImportFailed = "BundleImportFailed"
+
+	// Identity revocation
+	IdentityRevoked  = "IdentityRevoked"
+	RevocationFailed = "RevocationFailed"
+	CRLApproved      = "CRLApproved"
+	CRLUpdated       = "CRLUpdated"
+)
+
+// Normal records an event of type Normal. A nil recorder is a no-op.
+func Normal(recorder record.EventRecorder, object runtime.Object, reason, messageFmt string, args ...interface{}) {
+	Record(recorder, object, corev1.EventTypeNormal, reason, messageFmt, args...)
+}
+
+// Warning records an event of type Warning. A nil recorder is a no-op.
+func Warning(recorder record.EventRecorder, object runtime.Object, reason, messageFmt string, args ...interface{}) {
+	Record(recorder, object, corev1.EventTypeWarning, reason, messageFmt, args...)
+}
+
+// Record records an event of the given type. A nil recorder is a no-op.
+func Record(recorder record.EventRecorder, object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	if recorder == nil || object == nil {
+		return
+	}
+	recorder.Eventf(object, eventtype, reason, messageFmt, args...)
+}
+
+// ProposalReason maps a proposal condition to its event reason
+func ProposalReason(condType current.ProposalConditionType) (reason string, eventtype string) {
+	switch condType {
+	case current.ProposalInitialized:
+		return ProposalInitialized, corev1.EventTypeNormal
+	case current.ProposalDeployed:
+		return ProposalDeployed, corev1.EventTypeNormal
+	case current.ProposalSucceeded:
+		return ProposalSucceeded, corev1.EventTypeNormal
+	case current.ProposalFailed:
+		return ProposalFailed, corev1.EventTypeWarning
+	case current.ProposalExpired:
+		return ProposalExpired, corev1.EventTypeWarning
+	case current.ProposalError:
+		return ProposalError, corev1.EventTypeWarning
+	default:
+		return fmt.Sprintf("Proposal%s", condType), corev1.EventTypeNormal
+	}
+}
+
+// ChaincodeReason maps the chaincode stage just completed to its event reason
+func ChaincodeReason(stage current.ChaincodeConditionType) string {
+	switch stage {
+	case current.ChaincodeCondPackaged:
+		return ChaincodePackaged
+	case current.ChaincodeCondInstalled:
+		return ChaincodeInstalled
+	case current.ChaincodeCondApproved:
+		return ChaincodeApproved
+	case current.ChaincodeCondCanary:
+		return ChaincodeCanary
+	case current.ChaincodeCondCommitted:
+		return ChaincodeCommitted
+	case current.ChaincodeCondRunning:
+		return ChaincodeRunning
+	default:
+		return ChaincodeStageFailed
+	}
+}
+
+// Chaincode records the outcome of a chaincode stage from its condition
+func Chaincode(recorder record.EventRecorder, object runtime.Object, stage current.ChaincodeConditionType, cond current.ChaincodeCondition) {
+	if cond.Type == current.ChaincodeCondError {
+		Warning(recorder, object, ChaincodeStageFailed, "stage %s failed: %s: %s", stage, cond.Message, cond.Reason)
+		return
+	}
+	Normal(recorder, object, ChaincodeReason(stage), "stage %s done, next stage %s", stage, cond.NextStage)
+}
+
+// Reenroll records the outcome of a certificate reenrollment
+func Reenroll(recorder record.EventRecorder, object runtime.Object, certType string, newKey bool, err error) {
+	if err != nil {
+		Warning(recorder, object, ReenrollFailed, "reenroll %s certificate (new key: %t) failed: %s", certType, newKey, err)
+		return
+	}
+	Normal(recorder, object, Reenrolled, "reenrolled %s certificate (new key: %t)", certType, newKey)
+}
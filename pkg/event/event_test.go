@@ -0,0 +1,82 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package event
+
+import (
+	"errors"
+	"testing"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestNilRecorder(t *testing.T) {
+	// must not panic
+	Normal(nil, &current.Proposal{}, ProposalVoting, "voting")
+	Warning(nil, &current.Proposal{}, ProposalFailed, "failed")
+}
+
+func TestProposalReason(t *testing.T) {
+	for condType, expect := range map[current.ProposalConditionType][2]string{
+		current.ProposalSucceeded: {ProposalSucceeded, "Normal"},
+		current.ProposalFailed:    {ProposalFailed, "Warning"},
+		current.ProposalExpired:   {ProposalExpired, "Warning"},
+		current.ProposalError:     {ProposalError, "Warning"},
+		current.ProposalDeployed:  {ProposalDeployed, "Normal"},
+	} {
+		reason, eventtype := ProposalReason(condType)
+		if reason != expect[0] || eventtype != expect[1] {
+			t.Errorf("%s: expect %v get %s %s", condType, expect, reason, eventtype)
+		}
+	}
+}
+
+func TestChaincode(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	instance := &current.Chaincode{}
+
+	Chaincode(recorder, instance, current.ChaincodeCondInstalled, current.ChaincodeCondition{Type: current.ChaincodeCondInstalled, NextStage: current.ChaincodeCondApproved})
+	Chaincode(recorder, instance, current.ChaincodeCondApproved, current.ChaincodeCondition{Type: current.ChaincodeCondError, Message: "approve failed", Reason: "timeout"})
+
+	for _, expect := range []string{
+		"Normal ChaincodeInstalled stage Installed done, next stage Approved",
+		"Warning ChaincodeStageFailed stage Approved failed: approve failed: timeout",
+	} {
+		if e := <-recorder.Events; e != expect {
+			t.Errorf("expect %q get %q", expect, e)
+		}
+	}
+}
+
+func TestReenroll(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	instance := &current.IBPPeer{}
+
+	Reenroll(recorder, instance, "tls", true, nil)
+	Reenroll(recorder, instance, "ecert", false, errors.New("ca unreachable"))
+
+	for _, expect := range []string{
+		"Normal Reenrolled reenrolled tls certificate (new key: true)",
+		"Warning ReenrollFailed reenroll ecert certificate (new key: false) failed: ca unreachable",
+	} {
+		if e := <-recorder.Events; e != expect {
+			t.Errorf("expect %q get %q", expect, e)
+		}
+	}
+}
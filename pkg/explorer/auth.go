@@ -0,0 +1,106 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package explorer
+
+import (
+	"context"
+
+	iam "github.com/IBM-Blockchain/fabric-operator/api/iam/v1alpha1"
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	bcrbac "github.com/IBM-Blockchain/fabric-operator/pkg/rbac"
+	bcuser "github.com/IBM-Blockchain/fabric-operator/pkg/user"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
+	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var (
+	ErrUnauthenticated = errors.New("unauthenticated")
+	ErrForbidden       = errors.New("forbidden")
+)
+
+// Authenticator resolves the kubernetes user behind a bearer token
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (authenticationv1.UserInfo, error)
+}
+
+// TokenReviewAuthenticator authenticates tokens by kubernetes TokenReview
+type TokenReviewAuthenticator struct {
+	Client controllerclient.Client
+}
+
+func (a *TokenReviewAuthenticator) Authenticate(ctx context.Context, token string) (authenticationv1.UserInfo, error) {
+	if token == "" {
+		return authenticationv1.UserInfo{}, ErrUnauthenticated
+	}
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}
+	if err := a.Client.Create(ctx, review); err != nil {
+		return authenticationv1.UserInfo{}, errors.Wrap(err, "failed to review token")
+	}
+	if !review.Status.Authenticated {
+		return authenticationv1.UserInfo{}, ErrUnauthenticated
+	}
+	return review.Status.User, nil
+}
+
+// Authorizer decides which channel member's ledger view a user can read.
+// A user can read a channel when the user is the admin of a member organization,
+// or has an admin/client role in a member organization(label on iam User set by pkg/user)
+type Authorizer struct {
+	Client controllerclient.Client
+}
+
+// Authorize returns the member organization whose admin identity will be used to query the ledger
+func (a *Authorizer) Authorize(ctx context.Context, user authenticationv1.UserInfo, channel *current.Channel) (*current.Organization, error) {
+	var iamUser *iam.User
+	if !isSuperUser(user) {
+		// users without iam User can still be organization admins
+		iamUser, _ = bcuser.GetUser(a.Client, user.Username)
+	}
+	for _, member := range channel.Spec.Members {
+		org := &current.Organization{}
+		if err := a.Client.Get(ctx, types.NamespacedName{Name: member.Name}, org); err != nil {
+			return nil, errors.Wrap(err, "failed to get organization")
+		}
+		if CanRead(user, org, iamUser) {
+			return org, nil
+		}
+	}
+	return nil, ErrForbidden
+}
+
+// CanRead checks whether user can read ledgers through org
+func CanRead(user authenticationv1.UserInfo, org *current.Organization, iamUser *iam.User) bool {
+	if isSuperUser(user) || org.IsAdmin(user.Username) {
+		return true
+	}
+	if iamUser == nil {
+		return false
+	}
+	return util.ContainsValue(iamUser.GetLabels()[bcuser.OrganizationLabel.String(org.GetName())], bcrbac.Roles())
+}
+
+// isSuperUser keeps same with webhooks' super user check
+func isSuperUser(user authenticationv1.UserInfo) bool {
+	return util.ContainsValue("system:masters", user.Groups) || util.ContainsValue("system:serviceaccounts:kube-system", user.Groups)
+}
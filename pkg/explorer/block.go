@@ -0,0 +1,247 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package explorer
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric-protos-go/msp"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/pkg/errors"
+)
+
+// Block is the decoded form of a ledger block
+type Block struct {
+	Number       uint64        `json:"number"`
+	DataHash     string        `json:"dataHash,omitempty"`
+	PreviousHash string        `json:"previousHash,omitempty"`
+	Transactions []Transaction `json:"transactions"`
+}
+
+// Transaction is the decoded form of an envelope in a block
+type Transaction struct {
+	TxID        string `json:"txID,omitempty"`
+	BlockNumber uint64 `json:"blockNumber"`
+	// Type is the header type, e.g. ENDORSER_TRANSACTION or CONFIG
+	Type      string `json:"type"`
+	Timestamp string `json:"timestamp,omitempty"`
+	// CreatorMSP is the MSP ID of the transaction submitter
+	CreatorMSP string `json:"creatorMSP,omitempty"`
+	// ValidationCode is taken from the block's transactions filter
+	ValidationCode string           `json:"validationCode,omitempty"`
+	Chaincode      string           `json:"chaincode,omitempty"`
+	RWSets         []NsRWSet        `json:"rwsets,omitempty"`
+	Events         []ChaincodeEvent `json:"events,omitempty"`
+}
+
+// NsRWSet is the read/write set of a transaction in one namespace(chaincode)
+type NsRWSet struct {
+	Namespace string    `json:"namespace"`
+	Reads     []KVRead  `json:"reads,omitempty"`
+	Writes    []KVWrite `json:"writes,omitempty"`
+}
+
+type KVRead struct {
+	Key string `json:"key"`
+	// Version is the committed version(block and tx number) of the key, nil if the key did not exist
+	Version *KVVersion `json:"version,omitempty"`
+}
+
+type KVVersion struct {
+	BlockNum uint64 `json:"blockNum"`
+	TxNum    uint64 `json:"txNum"`
+}
+
+type KVWrite struct {
+	Key      string `json:"key"`
+	IsDelete bool   `json:"isDelete,omitempty"`
+	Value    []byte `json:"value,omitempty"`
+}
+
+// ChaincodeEvent is an event set by a chaincode in a transaction
+type ChaincodeEvent struct {
+	TxID        string `json:"txID"`
+	BlockNumber uint64 `json:"blockNumber"`
+	Chaincode   string `json:"chaincode"`
+	EventName   string `json:"eventName"`
+	Payload     []byte `json:"payload,omitempty"`
+	// Valid is true when the transaction which emits this event is valid
+	Valid bool `json:"valid"`
+}
+
+// DecodeBlock decodes all envelopes in block along with their validation codes
+func DecodeBlock(block *common.Block) (*Block, error) {
+	if block == nil || block.Header == nil {
+		return nil, errors.New("invalid block: empty header")
+	}
+	b := &Block{
+		Number:       block.Header.Number,
+		DataHash:     hex.EncodeToString(block.Header.DataHash),
+		PreviousHash: hex.EncodeToString(block.Header.PreviousHash),
+		Transactions: make([]Transaction, 0),
+	}
+	if block.Data == nil {
+		return b, nil
+	}
+
+	var filter []byte
+	if block.Metadata != nil && len(block.Metadata.Metadata) > int(common.BlockMetadataIndex_TRANSACTIONS_FILTER) {
+		filter = block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER]
+	}
+	for index, data := range block.Data.Data {
+		tx, err := DecodeTransaction(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode transaction %d in block %d", index, b.Number)
+		}
+		tx.BlockNumber = b.Number
+		if index < len(filter) {
+			code := pb.TxValidationCode(filter[index])
+			tx.ValidationCode = code.String()
+			for i := range tx.Events {
+				tx.Events[i].Valid = code == pb.TxValidationCode_VALID
+			}
+		}
+		for i := range tx.Events {
+			tx.Events[i].BlockNumber = b.Number
+		}
+		b.Transactions = append(b.Transactions, *tx)
+	}
+	return b, nil
+}
+
+// DecodeTransaction decodes a marshalled envelope. Read/write sets and events are only available in endorser transactions.
+func DecodeTransaction(data []byte) (*Transaction, error) {
+	envelope := &common.Envelope{}
+	if err := proto.Unmarshal(data, envelope); err != nil {
+		return nil, errors.Wrap(err, "unmarshal envelope")
+	}
+	payload := &common.Payload{}
+	if err := proto.Unmarshal(envelope.Payload, payload); err != nil {
+		return nil, errors.Wrap(err, "unmarshal payload")
+	}
+	if payload.Header == nil {
+		return nil, errors.New("payload header is empty")
+	}
+	chdr := &common.ChannelHeader{}
+	if err := proto.Unmarshal(payload.Header.ChannelHeader, chdr); err != nil {
+		return nil, errors.Wrap(err, "unmarshal channel header")
+	}
+	shdr := &common.SignatureHeader{}
+	if err := proto.Unmarshal(payload.Header.SignatureHeader, shdr); err != nil {
+		return nil, errors.Wrap(err, "unmarshal signature header")
+	}
+	creator := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(shdr.Creator, creator); err != nil {
+		return nil, errors.Wrap(err, "unmarshal creator")
+	}
+
+	headerType := common.HeaderType(chdr.Type)
+	tx := &Transaction{
+		TxID:       chdr.TxId,
+		Type:       headerType.String(),
+		CreatorMSP: creator.Mspid,
+	}
+	if chdr.Timestamp != nil {
+		tx.Timestamp = chdr.Timestamp.AsTime().UTC().Format(time.RFC3339Nano)
+	}
+	if headerType != common.HeaderType_ENDORSER_TRANSACTION {
+		return tx, nil
+	}
+
+	transaction := &pb.Transaction{}
+	if err := proto.Unmarshal(payload.Data, transaction); err != nil {
+		return nil, errors.Wrap(err, "unmarshal transaction")
+	}
+	for _, action := range transaction.Actions {
+		actionPayload := &pb.ChaincodeActionPayload{}
+		if err := proto.Unmarshal(action.Payload, actionPayload); err != nil {
+			return nil, errors.Wrap(err, "unmarshal chaincode action payload")
+		}
+		if actionPayload.Action == nil {
+			continue
+		}
+		responsePayload := &pb.ProposalResponsePayload{}
+		if err := proto.Unmarshal(actionPayload.Action.ProposalResponsePayload, responsePayload); err != nil {
+			return nil, errors.Wrap(err, "unmarshal proposal response payload")
+		}
+		ccAction := &pb.ChaincodeAction{}
+		if err := proto.Unmarshal(responsePayload.Extension, ccAction); err != nil {
+			return nil, errors.Wrap(err, "unmarshal chaincode action")
+		}
+		if ccAction.ChaincodeId != nil {
+			tx.Chaincode = ccAction.ChaincodeId.Name
+		}
+		rwsets, err := decodeRWSets(ccAction.Results)
+		if err != nil {
+			return nil, err
+		}
+		tx.RWSets = append(tx.RWSets, rwsets...)
+		if len(ccAction.Events) == 0 {
+			continue
+		}
+		event := &pb.ChaincodeEvent{}
+		if err := proto.Unmarshal(ccAction.Events, event); err != nil {
+			return nil, errors.Wrap(err, "unmarshal chaincode event")
+		}
+		if event.EventName != "" {
+			tx.Events = append(tx.Events, ChaincodeEvent{
+				TxID:      tx.TxID,
+				Chaincode: event.ChaincodeId,
+				EventName: event.EventName,
+				Payload:   event.Payload,
+			})
+		}
+	}
+	return tx, nil
+}
+
+func decodeRWSets(results []byte) ([]NsRWSet, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+	txRWSet := &rwset.TxReadWriteSet{}
+	if err := proto.Unmarshal(results, txRWSet); err != nil {
+		return nil, errors.Wrap(err, "unmarshal read/write set")
+	}
+	nsRWSets := make([]NsRWSet, 0, len(txRWSet.NsRwset))
+	for _, ns := range txRWSet.NsRwset {
+		kv := &kvrwset.KVRWSet{}
+		if err := proto.Unmarshal(ns.Rwset, kv); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal kv read/write set of %s", ns.Namespace)
+		}
+		nsRWSet := NsRWSet{Namespace: ns.Namespace}
+		for _, r := range kv.Reads {
+			read := KVRead{Key: r.Key}
+			if r.Version != nil {
+				read.Version = &KVVersion{BlockNum: r.Version.BlockNum, TxNum: r.Version.TxNum}
+			}
+			nsRWSet.Reads = append(nsRWSet.Reads, read)
+		}
+		for _, w := range kv.Writes {
+			nsRWSet.Writes = append(nsRWSet.Writes, KVWrite{Key: w.Key, IsDelete: w.IsDelete, Value: w.Value})
+		}
+		nsRWSets = append(nsRWSets, nsRWSet)
+	}
+	return nsRWSets, nil
+}
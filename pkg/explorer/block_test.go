@@ -0,0 +1,130 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package explorer
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric-protos-go/msp"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+)
+
+func mustMarshal(t *testing.T, m proto.Message) []byte {
+	raw, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func envelope(t *testing.T, txID string, headerType common.HeaderType, mspID string, data []byte) []byte {
+	payload := &common.Payload{
+		Header: &common.Header{
+			ChannelHeader:   mustMarshal(t, &common.ChannelHeader{Type: int32(headerType), TxId: txID, ChannelId: "channel1"}),
+			SignatureHeader: mustMarshal(t, &common.SignatureHeader{Creator: mustMarshal(t, &msp.SerializedIdentity{Mspid: mspID})}),
+		},
+		Data: data,
+	}
+	return mustMarshal(t, &common.Envelope{Payload: mustMarshal(t, payload)})
+}
+
+func endorserTx(t *testing.T) []byte {
+	kv := &kvrwset.KVRWSet{
+		Reads:  []*kvrwset.KVRead{{Key: "a", Version: &kvrwset.Version{BlockNum: 3, TxNum: 1}}, {Key: "b"}},
+		Writes: []*kvrwset.KVWrite{{Key: "a", Value: []byte("10")}, {Key: "c", IsDelete: true}},
+	}
+	results := &rwset.TxReadWriteSet{
+		DataModel: rwset.TxReadWriteSet_KV,
+		NsRwset:   []*rwset.NsReadWriteSet{{Namespace: "basic", Rwset: mustMarshal(t, kv)}},
+	}
+	action := &pb.ChaincodeAction{
+		Results:     mustMarshal(t, results),
+		Events:      mustMarshal(t, &pb.ChaincodeEvent{ChaincodeId: "basic", TxId: "tx1", EventName: "Transfer", Payload: []byte("p")}),
+		ChaincodeId: &pb.ChaincodeID{Name: "basic", Version: "1"},
+	}
+	actionPayload := &pb.ChaincodeActionPayload{
+		Action: &pb.ChaincodeEndorsedAction{
+			ProposalResponsePayload: mustMarshal(t, &pb.ProposalResponsePayload{Extension: mustMarshal(t, action)}),
+		},
+	}
+	tx := &pb.Transaction{Actions: []*pb.TransactionAction{{Payload: mustMarshal(t, actionPayload)}}}
+	return mustMarshal(t, tx)
+}
+
+func TestDecodeBlock(t *testing.T) {
+	metadata := make([][]byte, len(common.BlockMetadataIndex_name))
+	metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = []byte{byte(pb.TxValidationCode_VALID), byte(pb.TxValidationCode_MVCC_READ_CONFLICT)}
+	block := &common.Block{
+		Header: &common.BlockHeader{Number: 5, DataHash: []byte{0xab}},
+		Data: &common.BlockData{Data: [][]byte{
+			envelope(t, "tx1", common.HeaderType_ENDORSER_TRANSACTION, "org1", endorserTx(t)),
+			envelope(t, "tx2", common.HeaderType_ENDORSER_TRANSACTION, "org2", endorserTx(t)),
+		}},
+		Metadata: &common.BlockMetadata{Metadata: metadata},
+	}
+
+	b, err := DecodeBlock(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Number != 5 || b.DataHash != "ab" || len(b.Transactions) != 2 {
+		t.Fatalf("unexpected block %+v", b)
+	}
+
+	tx := b.Transactions[0]
+	if tx.TxID != "tx1" || tx.Type != "ENDORSER_TRANSACTION" || tx.CreatorMSP != "org1" || tx.ValidationCode != "VALID" || tx.Chaincode != "basic" || tx.BlockNumber != 5 {
+		t.Errorf("unexpected transaction %+v", tx)
+	}
+	if len(tx.RWSets) != 1 || tx.RWSets[0].Namespace != "basic" {
+		t.Fatalf("unexpected rwsets %+v", tx.RWSets)
+	}
+	rw := tx.RWSets[0]
+	if len(rw.Reads) != 2 || rw.Reads[0].Version == nil || rw.Reads[0].Version.BlockNum != 3 || rw.Reads[1].Version != nil {
+		t.Errorf("unexpected reads %+v", rw.Reads)
+	}
+	if len(rw.Writes) != 2 || string(rw.Writes[0].Value) != "10" || !rw.Writes[1].IsDelete {
+		t.Errorf("unexpected writes %+v", rw.Writes)
+	}
+	if len(tx.Events) != 1 || tx.Events[0].EventName != "Transfer" || !tx.Events[0].Valid || tx.Events[0].BlockNumber != 5 {
+		t.Errorf("unexpected events %+v", tx.Events)
+	}
+
+	invalid := b.Transactions[1]
+	if invalid.ValidationCode != "MVCC_READ_CONFLICT" || invalid.Events[0].Valid {
+		t.Errorf("unexpected invalid transaction %+v", invalid)
+	}
+}
+
+func TestDecodeConfigTransaction(t *testing.T) {
+	tx, err := DecodeTransaction(envelope(t, "", common.HeaderType_CONFIG, "orderer", []byte("ignored")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Type != "CONFIG" || tx.CreatorMSP != "orderer" || len(tx.RWSets) != 0 {
+		t.Errorf("unexpected transaction %+v", tx)
+	}
+
+	if _, err = DecodeBlock(&common.Block{}); err == nil {
+		t.Error("expect error on block without header")
+	}
+}
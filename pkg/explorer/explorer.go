@@ -0,0 +1,386 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package explorer
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("explorer")
+
+const (
+	// APIPrefix is the path prefix of channel ledger apis
+	APIPrefix = "/api/v1/channels/"
+
+	DefaultBlockLimit = 10
+	MaxBlockLimit     = 50
+)
+
+// ChannelAuthorizer decides which member organization a user reads the channel ledger as
+type ChannelAuthorizer interface {
+	Authorize(ctx context.Context, user authenticationv1.UserInfo, channel *current.Channel) (*current.Organization, error)
+}
+
+// Server serves read-only apis over channel ledgers:
+//   - GET /api/v1/channels/{channel}/height
+//   - GET /api/v1/channels/{channel}/blocks?start={number}&limit={count}
+//   - GET /api/v1/channels/{channel}/blocks/{number}
+//   - GET /api/v1/channels/{channel}/transactions?start={number}&limit={count}
+//   - GET /api/v1/channels/{channel}/transactions/{txID}
+//   - GET /api/v1/channels/{channel}/events?chaincode={name}&start={number}&limit={count}
+//
+// Lists walk backwards from block `start`(default the latest block) over at most `limit` blocks.
+// The apis are served over TLS with the `tls.crt` and `tls.key` in CertDir, which is reloaded on rotation.
+type Server struct {
+	Addr          string
+	CertDir       string
+	Client        controllerclient.Client
+	Authenticator Authenticator
+	Authorizer    ChannelAuthorizer
+	Ledger        LedgerFunc
+}
+
+// New creates an explorer server which authenticates requests by TokenReview
+func New(addr, certDir string, c controllerclient.Client) *Server {
+	return &Server{
+		Addr:          addr,
+		CertDir:       certDir,
+		Client:        c,
+		Authenticator: &TokenReviewAuthenticator{Client: c},
+		Authorizer:    &Authorizer{Client: c},
+		Ledger:        NewChannelLedgerFunc(c),
+	}
+}
+
+// Start implements manager.Runnable
+func (s *Server) Start(ctx context.Context) error {
+	watcher, err := certwatcher.New(filepath.Join(s.CertDir, "tls.crt"), filepath.Join(s.CertDir, "tls.key"))
+	if err != nil {
+		return errors.Wrapf(err, "failed to load explorer serving cert from %s", s.CertDir)
+	}
+	go func() {
+		if err := watcher.Start(ctx); err != nil {
+			log.Error(err, "failed to watch explorer serving cert")
+		}
+	}()
+
+	listener, err := tls.Listen("tcp", s.Addr, &tls.Config{
+		GetCertificate: watcher.GetCertificate,
+		MinVersion:     tls.VersionTLS12,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to listen on %s", s.Addr)
+	}
+	return s.serve(ctx, listener)
+}
+
+func (s *Server) serve(ctx context.Context, listener net.Listener) error {
+	mux := http.NewServeMux()
+	mux.Handle(APIPrefix, s)
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Error(err, "failed to shutdown explorer server")
+		}
+	}()
+
+	log.Info("Starting explorer server", "addr", listener.Addr().String())
+	if err := srv.Serve(listener); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Explorer only reads ledgers.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("only GET is supported"))
+		return
+	}
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, APIPrefix), "/"), "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" {
+		writeError(w, http.StatusNotFound, errors.Errorf("unknown path %s", r.URL.Path))
+		return
+	}
+
+	user, err := s.Authenticator.Authenticate(r.Context(), bearerToken(r))
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, err)
+		return
+	}
+	channel := &current.Channel{}
+	if err = s.Client.Get(r.Context(), types.NamespacedName{Name: parts[0]}, channel); err != nil {
+		if k8serrors.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, errors.Errorf("channel %s not found", parts[0]))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	org, err := s.Authorizer.Authorize(r.Context(), user, channel)
+	if err != nil {
+		if errors.Is(err, ErrForbidden) {
+			writeError(w, http.StatusForbidden, errors.Errorf("user %s can not read channel %s", user.Username, channel.GetName()))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	l, err := s.Ledger(channel, org)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, errors.Wrap(err, "failed to connect channel ledger"))
+		return
+	}
+	defer l.Close()
+
+	var id string
+	if len(parts) == 3 {
+		id = parts[2]
+	}
+	switch {
+	case parts[1] == "height" && id == "":
+		s.height(w, l)
+	case parts[1] == "blocks" && id == "":
+		s.blocks(w, r, l)
+	case parts[1] == "blocks":
+		s.block(w, l, id)
+	case parts[1] == "transactions" && id == "":
+		s.transactions(w, r, l)
+	case parts[1] == "transactions":
+		s.transaction(w, l, id)
+	case parts[1] == "events" && id == "":
+		s.events(w, r, l)
+	default:
+		writeError(w, http.StatusNotFound, errors.Errorf("unknown path %s", r.URL.Path))
+	}
+}
+
+func (s *Server) height(w http.ResponseWriter, l Ledger) {
+	height, hash, err := l.Height()
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	writeJSON(w, map[string]interface{}{
+		"height":           height,
+		"currentBlockHash": hex.EncodeToString(hash),
+	})
+}
+
+func (s *Server) block(w http.ResponseWriter, l Ledger, id string) {
+	number, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.Errorf("invalid block number %s", id))
+		return
+	}
+	block, err := s.queryBlock(l, number)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	writeJSON(w, block)
+}
+
+func (s *Server) blocks(w http.ResponseWriter, r *http.Request, l Ledger) {
+	blocks, err := s.walkBlocks(r, l)
+	if err != nil {
+		writeError(w, statusOf(err), err)
+		return
+	}
+	writeJSON(w, map[string]interface{}{"blocks": blocks})
+}
+
+func (s *Server) transaction(w http.ResponseWriter, l Ledger, txID string) {
+	raw, err := l.QueryBlockByTxID(txID)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	block, err := DecodeBlock(raw)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	for _, tx := range block.Transactions {
+		if tx.TxID == txID {
+			writeJSON(w, tx)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, errors.Errorf("transaction %s not found", txID))
+}
+
+func (s *Server) transactions(w http.ResponseWriter, r *http.Request, l Ledger) {
+	blocks, err := s.walkBlocks(r, l)
+	if err != nil {
+		writeError(w, statusOf(err), err)
+		return
+	}
+	txs := make([]Transaction, 0)
+	for _, b := range blocks {
+		txs = append(txs, b.Transactions...)
+	}
+	writeJSON(w, map[string]interface{}{"transactions": txs})
+}
+
+func (s *Server) events(w http.ResponseWriter, r *http.Request, l Ledger) {
+	blocks, err := s.walkBlocks(r, l)
+	if err != nil {
+		writeError(w, statusOf(err), err)
+		return
+	}
+	writeJSON(w, map[string]interface{}{"events": FilterEvents(blocks, r.URL.Query().Get("chaincode"))})
+}
+
+// FilterEvents collects chaincode events in blocks. All events are returned when chaincode is empty.
+func FilterEvents(blocks []*Block, chaincode string) []ChaincodeEvent {
+	events := make([]ChaincodeEvent, 0)
+	for _, b := range blocks {
+		for _, tx := range b.Transactions {
+			for _, e := range tx.Events {
+				if chaincode == "" || e.Chaincode == chaincode {
+					events = append(events, e)
+				}
+			}
+		}
+	}
+	return events
+}
+
+type badRequestError struct{ error }
+
+func statusOf(err error) int {
+	if _, ok := err.(badRequestError); ok {
+		return http.StatusBadRequest
+	}
+	return http.StatusBadGateway
+}
+
+// walkBlocks decodes blocks in window specified by query `start` and `limit`, newest first
+func (s *Server) walkBlocks(r *http.Request, l Ledger) ([]*Block, error) {
+	height, _, err := l.Height()
+	if err != nil {
+		return nil, err
+	}
+	start, limit, err := BlockWindow(height, r.URL.Query().Get("start"), r.URL.Query().Get("limit"))
+	if err != nil {
+		return nil, badRequestError{err}
+	}
+	blocks := make([]*Block, 0, limit)
+	for i := uint64(0); i < limit; i++ {
+		block, err := s.queryBlock(l, start-i)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+func (s *Server) queryBlock(l Ledger, number uint64) (*Block, error) {
+	raw, err := l.QueryBlock(number)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query block %d", number)
+	}
+	return DecodeBlock(raw)
+}
+
+// BlockWindow parses the walk window over a ledger with height. It returns the first(newest) block and how many blocks to walk.
+func BlockWindow(height uint64, startParam, limitParam string) (uint64, uint64, error) {
+	if height == 0 {
+		return 0, 0, nil
+	}
+	start := height - 1
+	if startParam != "" {
+		n, err := strconv.ParseUint(startParam, 10, 64)
+		if err != nil {
+			return 0, 0, errors.Errorf("invalid start %s", startParam)
+		}
+		if n >= height {
+			return 0, 0, errors.Errorf("start %d exceeds the latest block %d", n, height-1)
+		}
+		start = n
+	}
+	limit := uint64(DefaultBlockLimit)
+	if limitParam != "" {
+		n, err := strconv.ParseUint(limitParam, 10, 64)
+		if err != nil || n == 0 {
+			return 0, 0, errors.Errorf("invalid limit %s", limitParam)
+		}
+		limit = n
+	}
+	if limit > MaxBlockLimit {
+		limit = MaxBlockLimit
+	}
+	if limit > start+1 {
+		limit = start + 1
+	}
+	return start, limit, nil
+}
+
+func bearerToken(r *http.Request) string {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Error(err, "failed to write response")
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"message": err.Error()})
+}
@@ -0,0 +1,171 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package explorer
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	iam "github.com/IBM-Blockchain/fabric-operator/api/iam/v1alpha1"
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util/testcert"
+	authenticationv1 "k8s.io/api/authentication/v1"
+)
+
+func TestBlockWindow(t *testing.T) {
+	tests := []struct {
+		name          string
+		height        uint64
+		start, limit  string
+		expectStart   uint64
+		expectLimit   uint64
+		expectedError bool
+	}{
+		{name: "empty ledger", height: 0},
+		{name: "defaults", height: 100, expectStart: 99, expectLimit: DefaultBlockLimit},
+		{name: "short ledger", height: 3, expectStart: 2, expectLimit: 3},
+		{name: "start and limit", height: 100, start: "20", limit: "5", expectStart: 20, expectLimit: 5},
+		{name: "limit capped", height: 1000, limit: "1000", expectStart: 999, expectLimit: MaxBlockLimit},
+		{name: "limit beyond genesis", height: 100, start: "2", limit: "10", expectStart: 2, expectLimit: 3},
+		{name: "start too large", height: 100, start: "100", expectedError: true},
+		{name: "invalid limit", height: 100, limit: "0", expectedError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, limit, err := BlockWindow(tt.height, tt.start, tt.limit)
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if err == nil && (start != tt.expectStart || limit != tt.expectLimit) {
+				t.Errorf("got (%d,%d), expect (%d,%d)", start, limit, tt.expectStart, tt.expectLimit)
+			}
+		})
+	}
+}
+
+func TestFilterEvents(t *testing.T) {
+	blocks := []*Block{
+		{Number: 2, Transactions: []Transaction{{Events: []ChaincodeEvent{{Chaincode: "a", EventName: "e1"}}}}},
+		{Number: 1, Transactions: []Transaction{{}, {Events: []ChaincodeEvent{{Chaincode: "b", EventName: "e2"}}}}},
+	}
+	if got := FilterEvents(blocks, ""); len(got) != 2 {
+		t.Errorf("expect 2 events, got %d", len(got))
+	}
+	if got := FilterEvents(blocks, "b"); len(got) != 1 || got[0].EventName != "e2" {
+		t.Errorf("unexpected events %+v", got)
+	}
+}
+
+func TestCanRead(t *testing.T) {
+	org := &current.Organization{}
+	org.Name = "org1"
+	org.Spec.Admin = "alice"
+
+	client := &iam.User{}
+	client.Labels = map[string]string{"bestchains.organizaiton.org1": "client"}
+	other := &iam.User{}
+	other.Labels = map[string]string{"bestchains.organizaiton.org2": "admin"}
+
+	tests := []struct {
+		name    string
+		user    authenticationv1.UserInfo
+		iamUser *iam.User
+		expect  bool
+	}{
+		{name: "organization admin", user: authenticationv1.UserInfo{Username: "alice"}, expect: true},
+		{name: "super user", user: authenticationv1.UserInfo{Username: "root", Groups: []string{"system:masters"}}, expect: true},
+		{name: "organization client", user: authenticationv1.UserInfo{Username: "bob"}, iamUser: client, expect: true},
+		{name: "other organization", user: authenticationv1.UserInfo{Username: "carol"}, iamUser: other},
+		{name: "unknown user", user: authenticationv1.UserInfo{Username: "dave"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanRead(tt.user, org, tt.iamUser); got != tt.expect {
+				t.Errorf("expect %v, got %v", tt.expect, got)
+			}
+		})
+	}
+}
+
+type rejectAll struct{}
+
+func (rejectAll) Authenticate(ctx context.Context, token string) (authenticationv1.UserInfo, error) {
+	return authenticationv1.UserInfo{}, ErrUnauthenticated
+}
+
+func TestServeTLS(t *testing.T) {
+	dir := t.TempDir()
+	serving := testcert.New(t, "explorer", nil, testcert.Hosts("127.0.0.1"))
+	err := os.WriteFile(filepath.Join(dir, "tls.crt"), serving.PEM, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(dir, "tls.key"), serving.KeyPEM(t), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &Server{Addr: addr, CertDir: dir, Authenticator: rejectAll{}}
+	go func() {
+		if err := s.Start(ctx); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(serving.Cert)
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}}
+	var resp *http.Response
+	for i := 0; i < 50; i++ {
+		if resp, err = client.Get("https://" + addr + APIPrefix + "mychannel/height"); err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expect 401 over tls, get %d", resp.StatusCode)
+	}
+
+	resp, err = http.Get("http://" + addr + APIPrefix + "mychannel/height")
+	if err == nil {
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("expect plain http to be refused, get %d", resp.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package explorer
+
+import (
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/connector"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/ledger"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
+	"github.com/pkg/errors"
+)
+
+// Ledger queries blocks of a channel
+type Ledger interface {
+	// Height returns the channel height and the hash of current block
+	Height() (uint64, []byte, error)
+	QueryBlock(number uint64) (*common.Block, error)
+	QueryBlockByTxID(txID string) (*common.Block, error)
+	Close()
+}
+
+// LedgerFunc opens a channel ledger with org's admin identity
+type LedgerFunc func(channel *current.Channel, org *current.Organization) (Ledger, error)
+
+// NewChannelLedgerFunc opens ledgers with channel's connection profile(generated by GenerateChannelConnProfile)
+func NewChannelLedgerFunc(c controllerclient.Client) LedgerFunc {
+	return func(channel *current.Channel, org *current.Organization) (Ledger, error) {
+		profile, err := connector.ChannelProfile(c, channel.GetName())
+		if err != nil {
+			return nil, err
+		}
+		profile.Client.Organization = org.GetName()
+		con, err := connector.NewConnector(func() ([]byte, error) {
+			return profile.Marshal(connector.YAML)
+		})
+		if err != nil {
+			return nil, err
+		}
+		client, err := ledger.New(con.SDK().ChannelContext(channel.GetChannelID(), fabsdk.WithUser(org.Spec.Admin), fabsdk.WithOrg(org.GetName())))
+		if err != nil {
+			con.Close()
+			return nil, errors.Wrap(err, "failed to create ledger client")
+		}
+		return &channelLedger{
+			con:    con,
+			client: client,
+			// prefer peers of org itself
+			targets: profile.GetOrganization(org.GetName()).Peers,
+		}, nil
+	}
+}
+
+type channelLedger struct {
+	con     *connector.Connector
+	client  *ledger.Client
+	targets []string
+}
+
+func (l *channelLedger) options() []ledger.RequestOption {
+	if len(l.targets) == 0 {
+		return nil
+	}
+	return []ledger.RequestOption{ledger.WithTargetEndpoints(l.targets...)}
+}
+
+func (l *channelLedger) Height() (uint64, []byte, error) {
+	info, err := l.client.QueryInfo(l.options()...)
+	if err != nil {
+		return 0, nil, err
+	}
+	if info.BCI == nil {
+		return 0, nil, errors.New("empty blockchain info")
+	}
+	return info.BCI.Height, info.BCI.CurrentBlockHash, nil
+}
+
+func (l *channelLedger) QueryBlock(number uint64) (*common.Block, error) {
+	return l.client.QueryBlock(number, l.options()...)
+}
+
+func (l *channelLedger) QueryBlockByTxID(txID string) (*common.Block, error) {
+	return l.client.QueryBlockByTxID(fab.TransactionID(txID), l.options()...)
+}
+
+func (l *channelLedger) Close() {
+	l.con.Close()
+}
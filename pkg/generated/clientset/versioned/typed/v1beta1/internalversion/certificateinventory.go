@@ -0,0 +1,186 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package internalversion
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	scheme "github.com/IBM-Blockchain/fabric-operator/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// CertificateInventoriesGetter has a method to return a CertificateInventoryInterface.
+// A group's client should implement this interface.
+type CertificateInventoriesGetter interface {
+	CertificateInventories() CertificateInventoryInterface
+}
+
+// CertificateInventoryInterface has methods to work with CertificateInventory resources.
+type CertificateInventoryInterface interface {
+	Create(ctx context.Context, certificateInventory *v1beta1.CertificateInventory, opts v1.CreateOptions) (*v1beta1.CertificateInventory, error)
+	Update(ctx context.Context, certificateInventory *v1beta1.CertificateInventory, opts v1.UpdateOptions) (*v1beta1.CertificateInventory, error)
+	UpdateStatus(ctx context.Context, certificateInventory *v1beta1.CertificateInventory, opts v1.UpdateOptions) (*v1beta1.CertificateInventory, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.CertificateInventory, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.CertificateInventoryList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.CertificateInventory, err error)
+	CertificateInventoryExpansion
+}
+
+// certificateInventories implements CertificateInventoryInterface
+type certificateInventories struct {
+	client rest.Interface
+}
+
+// newCertificateInventories returns a CertificateInventories
+func newCertificateInventories(c *IbpClient) *certificateInventories {
+	return &certificateInventories{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the certificateInventory, and returns the corresponding certificateInventory object, and an error if there is any.
+func (c *certificateInventories) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.CertificateInventory, err error) {
+	result = &v1beta1.CertificateInventory{}
+	err = c.client.Get().
+		Resource("certificateinventories").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of CertificateInventories that match those selectors.
+func (c *certificateInventories) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.CertificateInventoryList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.CertificateInventoryList{}
+	err = c.client.Get().
+		Resource("certificateinventories").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested certificateInventories.
+func (c *certificateInventories) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("certificateinventories").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a certificateInventory and creates it.  Returns the server's representation of the certificateInventory, and an error, if there is any.
+func (c *certificateInventories) Create(ctx context.Context, certificateInventory *v1beta1.CertificateInventory, opts v1.CreateOptions) (result *v1beta1.CertificateInventory, err error) {
+	result = &v1beta1.CertificateInventory{}
+	err = c.client.Post().
+		Resource("certificateinventories").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(certificateInventory).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a certificateInventory and updates it. Returns the server's representation of the certificateInventory, and an error, if there is any.
+func (c *certificateInventories) Update(ctx context.Context, certificateInventory *v1beta1.CertificateInventory, opts v1.UpdateOptions) (result *v1beta1.CertificateInventory, err error) {
+	result = &v1beta1.CertificateInventory{}
+	err = c.client.Put().
+		Resource("certificateinventories").
+		Name(certificateInventory.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(certificateInventory).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *certificateInventories) UpdateStatus(ctx context.Context, certificateInventory *v1beta1.CertificateInventory, opts v1.UpdateOptions) (result *v1beta1.CertificateInventory, err error) {
+	result = &v1beta1.CertificateInventory{}
+	err = c.client.Put().
+		Resource("certificateinventories").
+		Name(certificateInventory.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(certificateInventory).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the certificateInventory and deletes it. Returns an error if one occurs.
+func (c *certificateInventories) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("certificateinventories").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *certificateInventories) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("certificateinventories").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched certificateInventory.
+func (c *certificateInventories) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.CertificateInventory, err error) {
+	result = &v1beta1.CertificateInventory{}
+	err = c.client.Patch(pt).
+		Resource("certificateinventories").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,186 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package internalversion
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	scheme "github.com/IBM-Blockchain/fabric-operator/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ChaincodeInvocationsGetter has a method to return a ChaincodeInvocationInterface.
+// A group's client should implement this interface.
+type ChaincodeInvocationsGetter interface {
+	ChaincodeInvocations() ChaincodeInvocationInterface
+}
+
+// ChaincodeInvocationInterface has methods to work with ChaincodeInvocation resources.
+type ChaincodeInvocationInterface interface {
+	Create(ctx context.Context, chaincodeInvocation *v1beta1.ChaincodeInvocation, opts v1.CreateOptions) (*v1beta1.ChaincodeInvocation, error)
+	Update(ctx context.Context, chaincodeInvocation *v1beta1.ChaincodeInvocation, opts v1.UpdateOptions) (*v1beta1.ChaincodeInvocation, error)
+	UpdateStatus(ctx context.Context, chaincodeInvocation *v1beta1.ChaincodeInvocation, opts v1.UpdateOptions) (*v1beta1.ChaincodeInvocation, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.ChaincodeInvocation, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.ChaincodeInvocationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ChaincodeInvocation, err error)
+	ChaincodeInvocationExpansion
+}
+
+// chaincodeInvocations implements ChaincodeInvocationInterface
+type chaincodeInvocations struct {
+	client rest.Interface
+}
+
+// newChaincodeInvocations returns a ChaincodeInvocations
+func newChaincodeInvocations(c *IbpClient) *chaincodeInvocations {
+	return &chaincodeInvocations{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the chaincodeInvocation, and returns the corresponding chaincodeInvocation object, and an error if there is any.
+func (c *chaincodeInvocations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ChaincodeInvocation, err error) {
+	result = &v1beta1.ChaincodeInvocation{}
+	err = c.client.Get().
+		Resource("chaincodeinvocations").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ChaincodeInvocations that match those selectors.
+func (c *chaincodeInvocations) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ChaincodeInvocationList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.ChaincodeInvocationList{}
+	err = c.client.Get().
+		Resource("chaincodeinvocations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested chaincodeInvocations.
+func (c *chaincodeInvocations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("chaincodeinvocations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a chaincodeInvocation and creates it.  Returns the server's representation of the chaincodeInvocation, and an error, if there is any.
+func (c *chaincodeInvocations) Create(ctx context.Context, chaincodeInvocation *v1beta1.ChaincodeInvocation, opts v1.CreateOptions) (result *v1beta1.ChaincodeInvocation, err error) {
+	result = &v1beta1.ChaincodeInvocation{}
+	err = c.client.Post().
+		Resource("chaincodeinvocations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(chaincodeInvocation).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a chaincodeInvocation and updates it. Returns the server's representation of the chaincodeInvocation, and an error, if there is any.
+func (c *chaincodeInvocations) Update(ctx context.Context, chaincodeInvocation *v1beta1.ChaincodeInvocation, opts v1.UpdateOptions) (result *v1beta1.ChaincodeInvocation, err error) {
+	result = &v1beta1.ChaincodeInvocation{}
+	err = c.client.Put().
+		Resource("chaincodeinvocations").
+		Name(chaincodeInvocation.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(chaincodeInvocation).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *chaincodeInvocations) UpdateStatus(ctx context.Context, chaincodeInvocation *v1beta1.ChaincodeInvocation, opts v1.UpdateOptions) (result *v1beta1.ChaincodeInvocation, err error) {
+	result = &v1beta1.ChaincodeInvocation{}
+	err = c.client.Put().
+		Resource("chaincodeinvocations").
+		Name(chaincodeInvocation.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(chaincodeInvocation).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the chaincodeInvocation and deletes it. Returns an error if one occurs.
+func (c *chaincodeInvocations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("chaincodeinvocations").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *chaincodeInvocations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("chaincodeinvocations").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched chaincodeInvocation.
+func (c *chaincodeInvocations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ChaincodeInvocation, err error) {
+	result = &v1beta1.ChaincodeInvocation{}
+	err = c.client.Patch(pt).
+		Resource("chaincodeinvocations").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package internalversion
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	scheme "github.com/IBM-Blockchain/fabric-operator/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ExternalOrganizationsGetter has a method to return a ExternalOrganizationInterface.
+// A group's client should implement this interface.
+type ExternalOrganizationsGetter interface {
+	ExternalOrganizations() ExternalOrganizationInterface
+}
+
+// ExternalOrganizationInterface has methods to work with ExternalOrganization resources.
+type ExternalOrganizationInterface interface {
+	Create(ctx context.Context, externalOrganization *v1beta1.ExternalOrganization, opts v1.CreateOptions) (*v1beta1.ExternalOrganization, error)
+	Update(ctx context.Context, externalOrganization *v1beta1.ExternalOrganization, opts v1.UpdateOptions) (*v1beta1.ExternalOrganization, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.ExternalOrganization, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.ExternalOrganizationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ExternalOrganization, err error)
+	ExternalOrganizationExpansion
+}
+
+// externalOrganizations implements ExternalOrganizationInterface
+type externalOrganizations struct {
+	client rest.Interface
+}
+
+// newExternalOrganizations returns a ExternalOrganizations
+func newExternalOrganizations(c *IbpClient) *externalOrganizations {
+	return &externalOrganizations{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the externalOrganization, and returns the corresponding externalOrganization object, and an error if there is any.
+func (c *externalOrganizations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ExternalOrganization, err error) {
+	result = &v1beta1.ExternalOrganization{}
+	err = c.client.Get().
+		Resource("externalorganizations").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ExternalOrganizations that match those selectors.
+func (c *externalOrganizations) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ExternalOrganizationList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.ExternalOrganizationList{}
+	err = c.client.Get().
+		Resource("externalorganizations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested externalOrganizations.
+func (c *externalOrganizations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("externalorganizations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a externalOrganization and creates it.  Returns the server's representation of the externalOrganization, and an error, if there is any.
+func (c *externalOrganizations) Create(ctx context.Context, externalOrganization *v1beta1.ExternalOrganization, opts v1.CreateOptions) (result *v1beta1.ExternalOrganization, err error) {
+	result = &v1beta1.ExternalOrganization{}
+	err = c.client.Post().
+		Resource("externalorganizations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(externalOrganization).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a externalOrganization and updates it. Returns the server's representation of the externalOrganization, and an error, if there is any.
+func (c *externalOrganizations) Update(ctx context.Context, externalOrganization *v1beta1.ExternalOrganization, opts v1.UpdateOptions) (result *v1beta1.ExternalOrganization, err error) {
+	result = &v1beta1.ExternalOrganization{}
+	err = c.client.Put().
+		Resource("externalorganizations").
+		Name(externalOrganization.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(externalOrganization).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the externalOrganization and deletes it. Returns an error if one occurs.
+func (c *externalOrganizations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("externalorganizations").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *externalOrganizations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("externalorganizations").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched externalOrganization.
+func (c *externalOrganizations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ExternalOrganization, err error) {
+	result = &v1beta1.ExternalOrganization{}
+	err = c.client.Patch(pt).
+		Resource("externalorganizations").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeCertificateInventories implements CertificateInventoryInterface
+type FakeCertificateInventories struct {
+	Fake *FakeIbp
+}
+
+var certificateinventoriesResource = schema.GroupVersionResource{Group: "ibp.com", Version: "", Resource: "certificateinventories"}
+
+var certificateinventoriesKind = schema.GroupVersionKind{Group: "ibp.com", Version: "", Kind: "CertificateInventory"}
+
+// Get takes name of the certificateInventory, and returns the corresponding certificateInventory object, and an error if there is any.
+func (c *FakeCertificateInventories) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.CertificateInventory, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(certificateinventoriesResource, name), &v1beta1.CertificateInventory{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CertificateInventory), err
+}
+
+// List takes label and field selectors, and returns the list of CertificateInventories that match those selectors.
+func (c *FakeCertificateInventories) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.CertificateInventoryList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(certificateinventoriesResource, certificateinventoriesKind, opts), &v1beta1.CertificateInventoryList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.CertificateInventoryList{ListMeta: obj.(*v1beta1.CertificateInventoryList).ListMeta}
+	for _, item := range obj.(*v1beta1.CertificateInventoryList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested certificateInventories.
+func (c *FakeCertificateInventories) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(certificateinventoriesResource, opts))
+}
+
+// Create takes the representation of a certificateInventory and creates it.  Returns the server's representation of the certificateInventory, and an error, if there is any.
+func (c *FakeCertificateInventories) Create(ctx context.Context, certificateInventory *v1beta1.CertificateInventory, opts v1.CreateOptions) (result *v1beta1.CertificateInventory, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(certificateinventoriesResource, certificateInventory), &v1beta1.CertificateInventory{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CertificateInventory), err
+}
+
+// Update takes the representation of a certificateInventory and updates it. Returns the server's representation of the certificateInventory, and an error, if there is any.
+func (c *FakeCertificateInventories) Update(ctx context.Context, certificateInventory *v1beta1.CertificateInventory, opts v1.UpdateOptions) (result *v1beta1.CertificateInventory, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(certificateinventoriesResource, certificateInventory), &v1beta1.CertificateInventory{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CertificateInventory), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeCertificateInventories) UpdateStatus(ctx context.Context, certificateInventory *v1beta1.CertificateInventory, opts v1.UpdateOptions) (*v1beta1.CertificateInventory, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(certificateinventoriesResource, "status", certificateInventory), &v1beta1.CertificateInventory{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CertificateInventory), err
+}
+
+// Delete takes name of the certificateInventory and deletes it. Returns an error if one occurs.
+func (c *FakeCertificateInventories) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(certificateinventoriesResource, name), &v1beta1.CertificateInventory{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeCertificateInventories) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(certificateinventoriesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.CertificateInventoryList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched certificateInventory.
+func (c *FakeCertificateInventories) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.CertificateInventory, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(certificateinventoriesResource, name, pt, data, subresources...), &v1beta1.CertificateInventory{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CertificateInventory), err
+}
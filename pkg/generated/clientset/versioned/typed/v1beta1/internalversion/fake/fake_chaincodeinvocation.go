@@ -0,0 +1,135 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeChaincodeInvocations implements ChaincodeInvocationInterface
+type FakeChaincodeInvocations struct {
+	Fake *FakeIbp
+}
+
+var chaincodeinvocationsResource = schema.GroupVersionResource{Group: "ibp.com", Version: "", Resource: "chaincodeinvocations"}
+
+var chaincodeinvocationsKind = schema.GroupVersionKind{Group: "ibp.com", Version: "", Kind: "ChaincodeInvocation"}
+
+// Get takes name of the chaincodeInvocation, and returns the corresponding chaincodeInvocation object, and an error if there is any.
+func (c *FakeChaincodeInvocations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ChaincodeInvocation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(chaincodeinvocationsResource, name), &v1beta1.ChaincodeInvocation{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ChaincodeInvocation), err
+}
+
+// List takes label and field selectors, and returns the list of ChaincodeInvocations that match those selectors.
+func (c *FakeChaincodeInvocations) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ChaincodeInvocationList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(chaincodeinvocationsResource, chaincodeinvocationsKind, opts), &v1beta1.ChaincodeInvocationList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.ChaincodeInvocationList{ListMeta: obj.(*v1beta1.ChaincodeInvocationList).ListMeta}
+	for _, item := range obj.(*v1beta1.ChaincodeInvocationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested chaincodeInvocations.
+func (c *FakeChaincodeInvocations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(chaincodeinvocationsResource, opts))
+}
+
+// Create takes the representation of a chaincodeInvocation and creates it.  Returns the server's representation of the chaincodeInvocation, and an error, if there is any.
+func (c *FakeChaincodeInvocations) Create(ctx context.Context, chaincodeInvocation *v1beta1.ChaincodeInvocation, opts v1.CreateOptions) (result *v1beta1.ChaincodeInvocation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(chaincodeinvocationsResource, chaincodeInvocation), &v1beta1.ChaincodeInvocation{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ChaincodeInvocation), err
+}
+
+// Update takes the representation of a chaincodeInvocation and updates it. Returns the server's representation of the chaincodeInvocation, and an error, if there is any.
+func (c *FakeChaincodeInvocations) Update(ctx context.Context, chaincodeInvocation *v1beta1.ChaincodeInvocation, opts v1.UpdateOptions) (result *v1beta1.ChaincodeInvocation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(chaincodeinvocationsResource, chaincodeInvocation), &v1beta1.ChaincodeInvocation{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ChaincodeInvocation), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeChaincodeInvocations) UpdateStatus(ctx context.Context, chaincodeInvocation *v1beta1.ChaincodeInvocation, opts v1.UpdateOptions) (*v1beta1.ChaincodeInvocation, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(chaincodeinvocationsResource, "status", chaincodeInvocation), &v1beta1.ChaincodeInvocation{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ChaincodeInvocation), err
+}
+
+// Delete takes name of the chaincodeInvocation and deletes it. Returns an error if one occurs.
+func (c *FakeChaincodeInvocations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(chaincodeinvocationsResource, name), &v1beta1.ChaincodeInvocation{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeChaincodeInvocations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(chaincodeinvocationsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.ChaincodeInvocationList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched chaincodeInvocation.
+func (c *FakeChaincodeInvocations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ChaincodeInvocation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(chaincodeinvocationsResource, name, pt, data, subresources...), &v1beta1.ChaincodeInvocation{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ChaincodeInvocation), err
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeExternalOrganizations implements ExternalOrganizationInterface
+type FakeExternalOrganizations struct {
+	Fake *FakeIbp
+}
+
+var externalorganizationsResource = schema.GroupVersionResource{Group: "ibp.com", Version: "", Resource: "externalorganizations"}
+
+var externalorganizationsKind = schema.GroupVersionKind{Group: "ibp.com", Version: "", Kind: "ExternalOrganization"}
+
+// Get takes name of the externalOrganization, and returns the corresponding externalOrganization object, and an error if there is any.
+func (c *FakeExternalOrganizations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ExternalOrganization, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(externalorganizationsResource, name), &v1beta1.ExternalOrganization{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ExternalOrganization), err
+}
+
+// List takes label and field selectors, and returns the list of ExternalOrganizations that match those selectors.
+func (c *FakeExternalOrganizations) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ExternalOrganizationList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(externalorganizationsResource, externalorganizationsKind, opts), &v1beta1.ExternalOrganizationList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.ExternalOrganizationList{ListMeta: obj.(*v1beta1.ExternalOrganizationList).ListMeta}
+	for _, item := range obj.(*v1beta1.ExternalOrganizationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested externalOrganizations.
+func (c *FakeExternalOrganizations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(externalorganizationsResource, opts))
+}
+
+// Create takes the representation of a externalOrganization and creates it.  Returns the server's representation of the externalOrganization, and an error, if there is any.
+func (c *FakeExternalOrganizations) Create(ctx context.Context, externalOrganization *v1beta1.ExternalOrganization, opts v1.CreateOptions) (result *v1beta1.ExternalOrganization, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(externalorganizationsResource, externalOrganization), &v1beta1.ExternalOrganization{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ExternalOrganization), err
+}
+
+// Update takes the representation of a externalOrganization and updates it. Returns the server's representation of the externalOrganization, and an error, if there is any.
+func (c *FakeExternalOrganizations) Update(ctx context.Context, externalOrganization *v1beta1.ExternalOrganization, opts v1.UpdateOptions) (result *v1beta1.ExternalOrganization, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(externalorganizationsResource, externalOrganization), &v1beta1.ExternalOrganization{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ExternalOrganization), err
+}
+
+// Delete takes name of the externalOrganization and deletes it. Returns an error if one occurs.
+func (c *FakeExternalOrganizations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(externalorganizationsResource, name), &v1beta1.ExternalOrganization{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeExternalOrganizations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(externalorganizationsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.ExternalOrganizationList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched externalOrganization.
+func (c *FakeExternalOrganizations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ExternalOrganization, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(externalorganizationsResource, name, pt, data, subresources...), &v1beta1.ExternalOrganization{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ExternalOrganization), err
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRenewalPolicies implements RenewalPolicyInterface
+type FakeRenewalPolicies struct {
+	Fake *FakeIbp
+}
+
+var renewalpoliciesResource = schema.GroupVersionResource{Group: "ibp.com", Version: "", Resource: "renewalpolicies"}
+
+var renewalpoliciesKind = schema.GroupVersionKind{Group: "ibp.com", Version: "", Kind: "RenewalPolicy"}
+
+// Get takes name of the renewalPolicy, and returns the corresponding renewalPolicy object, and an error if there is any.
+func (c *FakeRenewalPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.RenewalPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(renewalpoliciesResource, name), &v1beta1.RenewalPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.RenewalPolicy), err
+}
+
+// List takes label and field selectors, and returns the list of RenewalPolicies that match those selectors.
+func (c *FakeRenewalPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.RenewalPolicyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(renewalpoliciesResource, renewalpoliciesKind, opts), &v1beta1.RenewalPolicyList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.RenewalPolicyList{ListMeta: obj.(*v1beta1.RenewalPolicyList).ListMeta}
+	for _, item := range obj.(*v1beta1.RenewalPolicyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested renewalPolicies.
+func (c *FakeRenewalPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(renewalpoliciesResource, opts))
+}
+
+// Create takes the representation of a renewalPolicy and creates it.  Returns the server's representation of the renewalPolicy, and an error, if there is any.
+func (c *FakeRenewalPolicies) Create(ctx context.Context, renewalPolicy *v1beta1.RenewalPolicy, opts v1.CreateOptions) (result *v1beta1.RenewalPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(renewalpoliciesResource, renewalPolicy), &v1beta1.RenewalPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.RenewalPolicy), err
+}
+
+// Update takes the representation of a renewalPolicy and updates it. Returns the server's representation of the renewalPolicy, and an error, if there is any.
+func (c *FakeRenewalPolicies) Update(ctx context.Context, renewalPolicy *v1beta1.RenewalPolicy, opts v1.UpdateOptions) (result *v1beta1.RenewalPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(renewalpoliciesResource, renewalPolicy), &v1beta1.RenewalPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.RenewalPolicy), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeRenewalPolicies) UpdateStatus(ctx context.Context, renewalPolicy *v1beta1.RenewalPolicy, opts v1.UpdateOptions) (*v1beta1.RenewalPolicy, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(renewalpoliciesResource, "status", renewalPolicy), &v1beta1.RenewalPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.RenewalPolicy), err
+}
+
+// Delete takes name of the renewalPolicy and deletes it. Returns an error if one occurs.
+func (c *FakeRenewalPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(renewalpoliciesResource, name), &v1beta1.RenewalPolicy{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRenewalPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(renewalpoliciesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.RenewalPolicyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched renewalPolicy.
+func (c *FakeRenewalPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.RenewalPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(renewalpoliciesResource, name, pt, data, subresources...), &v1beta1.RenewalPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.RenewalPolicy), err
+}
@@ -30,6 +30,14 @@ type FakeIbp struct {
 	*testing.Fake
 }
 
+func (c *FakeIbp) Backups(namespace string) internalversion.BackupInterface {
+	return &FakeBackups{c, namespace}
+}
+
+func (c *FakeIbp) CertificateInventories() internalversion.CertificateInventoryInterface {
+	return &FakeCertificateInventories{c}
+}
+
 func (c *FakeIbp) Chaincodes() internalversion.ChaincodeInterface {
 	return &FakeChaincodes{c}
 }
@@ -38,6 +46,10 @@ func (c *FakeIbp) ChaincodeBuilds() internalversion.ChaincodeBuildInterface {
 	return &FakeChaincodeBuilds{c}
 }
 
+func (c *FakeIbp) ChaincodeInvocations() internalversion.ChaincodeInvocationInterface {
+	return &FakeChaincodeInvocations{c}
+}
+
 func (c *FakeIbp) Channels() internalversion.ChannelInterface {
 	return &FakeChannels{c}
 }
@@ -46,6 +58,10 @@ func (c *FakeIbp) EndorsePolicies() internalversion.EndorsePolicyInterface {
 	return &FakeEndorsePolicies{c}
 }
 
+func (c *FakeIbp) ExternalOrganizations() internalversion.ExternalOrganizationInterface {
+	return &FakeExternalOrganizations{c}
+}
+
 func (c *FakeIbp) Federations() internalversion.FederationInterface {
 	return &FakeFederations{c}
 }
@@ -66,6 +82,10 @@ func (c *FakeIbp) IBPPeers(namespace string) internalversion.IBPPeerInterface {
 	return &FakeIBPPeers{c, namespace}
 }
 
+func (c *FakeIbp) Identities(namespace string) internalversion.IdentityInterface {
+	return &FakeIdentities{c, namespace}
+}
+
 func (c *FakeIbp) Networks() internalversion.NetworkInterface {
 	return &FakeNetworks{c}
 }
@@ -78,6 +98,14 @@ func (c *FakeIbp) Proposals() internalversion.ProposalInterface {
 	return &FakeProposals{c}
 }
 
+func (c *FakeIbp) RenewalPolicies() internalversion.RenewalPolicyInterface {
+	return &FakeRenewalPolicies{c}
+}
+
+func (c *FakeIbp) Restores(namespace string) internalversion.RestoreInterface {
+	return &FakeRestores{c, namespace}
+}
+
 func (c *FakeIbp) Votes(namespace string) internalversion.VoteInterface {
 	return &FakeVotes{c, namespace}
 }
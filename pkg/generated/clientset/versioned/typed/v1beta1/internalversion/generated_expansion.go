@@ -20,14 +20,22 @@
 
 package internalversion
 
+type BackupExpansion interface{}
+
+type CertificateInventoryExpansion interface{}
+
 type ChaincodeExpansion interface{}
 
 type ChaincodeBuildExpansion interface{}
 
+type ChaincodeInvocationExpansion interface{}
+
 type ChannelExpansion interface{}
 
 type EndorsePolicyExpansion interface{}
 
+type ExternalOrganizationExpansion interface{}
+
 type FederationExpansion interface{}
 
 type IBPCAExpansion interface{}
@@ -38,10 +46,16 @@ type IBPOrdererExpansion interface{}
 
 type IBPPeerExpansion interface{}
 
+type IdentityExpansion interface{}
+
 type NetworkExpansion interface{}
 
 type OrganizationExpansion interface{}
 
 type ProposalExpansion interface{}
 
+type RenewalPolicyExpansion interface{}
+
+type RestoreExpansion interface{}
+
 type VoteExpansion interface{}
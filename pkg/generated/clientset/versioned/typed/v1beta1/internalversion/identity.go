@@ -0,0 +1,197 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package internalversion
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	scheme "github.com/IBM-Blockchain/fabric-operator/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// IdentitiesGetter has a method to return a IdentityInterface.
+// A group's client should implement this interface.
+type IdentitiesGetter interface {
+	Identities(namespace string) IdentityInterface
+}
+
+// IdentityInterface has methods to work with Identity resources.
+type IdentityInterface interface {
+	Create(ctx context.Context, identity *v1beta1.Identity, opts v1.CreateOptions) (*v1beta1.Identity, error)
+	Update(ctx context.Context, identity *v1beta1.Identity, opts v1.UpdateOptions) (*v1beta1.Identity, error)
+	UpdateStatus(ctx context.Context, identity *v1beta1.Identity, opts v1.UpdateOptions) (*v1beta1.Identity, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.Identity, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.IdentityList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Identity, err error)
+	IdentityExpansion
+}
+
+// identities implements IdentityInterface
+type identities struct {
+	client rest.Interface
+	ns     string
+}
+
+// newIdentities returns a Identities
+func newIdentities(c *IbpClient, namespace string) *identities {
+	return &identities{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the identity, and returns the corresponding identity object, and an error if there is any.
+func (c *identities) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.Identity, err error) {
+	result = &v1beta1.Identity{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("identities").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Identities that match those selectors.
+func (c *identities) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.IdentityList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.IdentityList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("identities").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested identities.
+func (c *identities) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("identities").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a identity and creates it.  Returns the server's representation of the identity, and an error, if there is any.
+func (c *identities) Create(ctx context.Context, identity *v1beta1.Identity, opts v1.CreateOptions) (result *v1beta1.Identity, err error) {
+	result = &v1beta1.Identity{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("identities").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(identity).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a identity and updates it. Returns the server's representation of the identity, and an error, if there is any.
+func (c *identities) Update(ctx context.Context, identity *v1beta1.Identity, opts v1.UpdateOptions) (result *v1beta1.Identity, err error) {
+	result = &v1beta1.Identity{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("identities").
+		Name(identity.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(identity).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *identities) UpdateStatus(ctx context.Context, identity *v1beta1.Identity, opts v1.UpdateOptions) (result *v1beta1.Identity, err error) {
+	result = &v1beta1.Identity{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("identities").
+		Name(identity.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(identity).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the identity and deletes it. Returns an error if one occurs.
+func (c *identities) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("identities").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *identities) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("identities").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched identity.
+func (c *identities) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Identity, err error) {
+	result = &v1beta1.Identity{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("identities").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
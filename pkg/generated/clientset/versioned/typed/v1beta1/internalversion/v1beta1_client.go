@@ -27,18 +27,25 @@ import (
 
 type IbpInterface interface {
 	RESTClient() rest.Interface
+	BackupsGetter
+	CertificateInventoriesGetter
 	ChaincodesGetter
 	ChaincodeBuildsGetter
+	ChaincodeInvocationsGetter
 	ChannelsGetter
 	EndorsePoliciesGetter
+	ExternalOrganizationsGetter
 	FederationsGetter
 	IBPCAsGetter
 	IBPConsolesGetter
 	IBPOrderersGetter
 	IBPPeersGetter
+	IdentitiesGetter
 	NetworksGetter
 	OrganizationsGetter
 	ProposalsGetter
+	RenewalPoliciesGetter
+	RestoresGetter
 	VotesGetter
 }
 
@@ -47,6 +54,14 @@ type IbpClient struct {
 	restClient rest.Interface
 }
 
+func (c *IbpClient) Backups(namespace string) BackupInterface {
+	return newBackups(c, namespace)
+}
+
+func (c *IbpClient) CertificateInventories() CertificateInventoryInterface {
+	return newCertificateInventories(c)
+}
+
 func (c *IbpClient) Chaincodes() ChaincodeInterface {
 	return newChaincodes(c)
 }
@@ -55,6 +70,10 @@ func (c *IbpClient) ChaincodeBuilds() ChaincodeBuildInterface {
 	return newChaincodeBuilds(c)
 }
 
+func (c *IbpClient) ChaincodeInvocations() ChaincodeInvocationInterface {
+	return newChaincodeInvocations(c)
+}
+
 func (c *IbpClient) Channels() ChannelInterface {
 	return newChannels(c)
 }
@@ -63,6 +82,10 @@ func (c *IbpClient) EndorsePolicies() EndorsePolicyInterface {
 	return newEndorsePolicies(c)
 }
 
+func (c *IbpClient) ExternalOrganizations() ExternalOrganizationInterface {
+	return newExternalOrganizations(c)
+}
+
 func (c *IbpClient) Federations() FederationInterface {
 	return newFederations(c)
 }
@@ -83,6 +106,10 @@ func (c *IbpClient) IBPPeers(namespace string) IBPPeerInterface {
 	return newIBPPeers(c, namespace)
 }
 
+func (c *IbpClient) Identities(namespace string) IdentityInterface {
+	return newIdentities(c, namespace)
+}
+
 func (c *IbpClient) Networks() NetworkInterface {
 	return newNetworks(c)
 }
@@ -95,6 +122,14 @@ func (c *IbpClient) Proposals() ProposalInterface {
 	return newProposals(c)
 }
 
+func (c *IbpClient) RenewalPolicies() RenewalPolicyInterface {
+	return newRenewalPolicies(c)
+}
+
+func (c *IbpClient) Restores(namespace string) RestoreInterface {
+	return newRestores(c, namespace)
+}
+
 func (c *IbpClient) Votes(namespace string) VoteInterface {
 	return newVotes(c, namespace)
 }
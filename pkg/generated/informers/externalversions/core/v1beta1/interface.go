@@ -26,14 +26,22 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// Backups returns a BackupInformer.
+	Backups() BackupInformer
+	// CertificateInventories returns a CertificateInventoryInformer.
+	CertificateInventories() CertificateInventoryInformer
 	// Chaincodes returns a ChaincodeInformer.
 	Chaincodes() ChaincodeInformer
 	// ChaincodeBuilds returns a ChaincodeBuildInformer.
 	ChaincodeBuilds() ChaincodeBuildInformer
+	// ChaincodeInvocations returns a ChaincodeInvocationInformer.
+	ChaincodeInvocations() ChaincodeInvocationInformer
 	// Channels returns a ChannelInformer.
 	Channels() ChannelInformer
 	// EndorsePolicies returns a EndorsePolicyInformer.
 	EndorsePolicies() EndorsePolicyInformer
+	// ExternalOrganizations returns a ExternalOrganizationInformer.
+	ExternalOrganizations() ExternalOrganizationInformer
 	// Federations returns a FederationInformer.
 	Federations() FederationInformer
 	// IBPCAs returns a IBPCAInformer.
@@ -44,12 +52,18 @@ type Interface interface {
 	IBPOrderers() IBPOrdererInformer
 	// IBPPeers returns a IBPPeerInformer.
 	IBPPeers() IBPPeerInformer
+	// Identities returns a IdentityInformer.
+	Identities() IdentityInformer
 	// Networks returns a NetworkInformer.
 	Networks() NetworkInformer
 	// Organizations returns a OrganizationInformer.
 	Organizations() OrganizationInformer
 	// Proposals returns a ProposalInformer.
 	Proposals() ProposalInformer
+	// RenewalPolicies returns a RenewalPolicyInformer.
+	RenewalPolicies() RenewalPolicyInformer
+	// Restores returns a RestoreInformer.
+	Restores() RestoreInformer
 	// Votes returns a VoteInformer.
 	Votes() VoteInformer
 }
@@ -65,6 +79,16 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// Backups returns a BackupInformer.
+func (v *version) Backups() BackupInformer {
+	return &backupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// CertificateInventories returns a CertificateInventoryInformer.
+func (v *version) CertificateInventories() CertificateInventoryInformer {
+	return &certificateInventoryInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Chaincodes returns a ChaincodeInformer.
 func (v *version) Chaincodes() ChaincodeInformer {
 	return &chaincodeInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -75,6 +99,11 @@ func (v *version) ChaincodeBuilds() ChaincodeBuildInformer {
 	return &chaincodeBuildInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ChaincodeInvocations returns a ChaincodeInvocationInformer.
+func (v *version) ChaincodeInvocations() ChaincodeInvocationInformer {
+	return &chaincodeInvocationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Channels returns a ChannelInformer.
 func (v *version) Channels() ChannelInformer {
 	return &channelInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -85,6 +114,11 @@ func (v *version) EndorsePolicies() EndorsePolicyInformer {
 	return &endorsePolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ExternalOrganizations returns a ExternalOrganizationInformer.
+func (v *version) ExternalOrganizations() ExternalOrganizationInformer {
+	return &externalOrganizationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Federations returns a FederationInformer.
 func (v *version) Federations() FederationInformer {
 	return &federationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -110,6 +144,11 @@ func (v *version) IBPPeers() IBPPeerInformer {
 	return &iBPPeerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// Identities returns a IdentityInformer.
+func (v *version) Identities() IdentityInformer {
+	return &identityInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Networks returns a NetworkInformer.
 func (v *version) Networks() NetworkInformer {
 	return &networkInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -125,6 +164,16 @@ func (v *version) Proposals() ProposalInformer {
 	return &proposalInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// RenewalPolicies returns a RenewalPolicyInformer.
+func (v *version) RenewalPolicies() RenewalPolicyInformer {
+	return &renewalPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Restores returns a RestoreInformer.
+func (v *version) Restores() RestoreInformer {
+	return &restoreInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Votes returns a VoteInformer.
 func (v *version) Votes() VoteInformer {
 	return &voteInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
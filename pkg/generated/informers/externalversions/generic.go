@@ -55,14 +55,22 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=ibp.com, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("backups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().Backups().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("certificateinventories"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().CertificateInventories().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("chaincodes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().Chaincodes().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("chaincodebuilds"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().ChaincodeBuilds().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("chaincodeinvocations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().ChaincodeInvocations().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("channels"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().Channels().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("endorsepolicies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().EndorsePolicies().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("externalorganizations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().ExternalOrganizations().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("federations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().Federations().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("ibpcas"):
@@ -73,12 +81,18 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().IBPOrderers().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("ibppeers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().IBPPeers().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("identities"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().Identities().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("networks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().Networks().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("organizations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().Organizations().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("proposals"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().Proposals().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("renewalpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().RenewalPolicies().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("restores"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().Restores().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("votes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ibp().V1beta1().Votes().Informer()}, nil
 
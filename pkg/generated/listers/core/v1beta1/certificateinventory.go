@@ -0,0 +1,70 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// CertificateInventoryLister helps list CertificateInventories.
+// All objects returned here must be treated as read-only.
+type CertificateInventoryLister interface {
+	// List lists all CertificateInventories in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.CertificateInventory, err error)
+	// Get retrieves the CertificateInventory from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.CertificateInventory, error)
+	CertificateInventoryListerExpansion
+}
+
+// certificateInventoryLister implements the CertificateInventoryLister interface.
+type certificateInventoryLister struct {
+	indexer cache.Indexer
+}
+
+// NewCertificateInventoryLister returns a new CertificateInventoryLister.
+func NewCertificateInventoryLister(indexer cache.Indexer) CertificateInventoryLister {
+	return &certificateInventoryLister{indexer: indexer}
+}
+
+// List lists all CertificateInventories in the indexer.
+func (s *certificateInventoryLister) List(selector labels.Selector) (ret []*v1beta1.CertificateInventory, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.CertificateInventory))
+	})
+	return ret, err
+}
+
+// Get retrieves the CertificateInventory from the index for a given name.
+func (s *certificateInventoryLister) Get(name string) (*v1beta1.CertificateInventory, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("certificateinventory"), name)
+	}
+	return obj.(*v1beta1.CertificateInventory), nil
+}
@@ -20,6 +20,18 @@
 
 package v1beta1
 
+// BackupListerExpansion allows custom methods to be added to
+// BackupLister.
+type BackupListerExpansion interface{}
+
+// BackupNamespaceListerExpansion allows custom methods to be added to
+// BackupNamespaceLister.
+type BackupNamespaceListerExpansion interface{}
+
+// CertificateInventoryListerExpansion allows custom methods to be added to
+// CertificateInventoryLister.
+type CertificateInventoryListerExpansion interface{}
+
 // ChaincodeListerExpansion allows custom methods to be added to
 // ChaincodeLister.
 type ChaincodeListerExpansion interface{}
@@ -28,6 +40,10 @@ type ChaincodeListerExpansion interface{}
 // ChaincodeBuildLister.
 type ChaincodeBuildListerExpansion interface{}
 
+// ChaincodeInvocationListerExpansion allows custom methods to be added to
+// ChaincodeInvocationLister.
+type ChaincodeInvocationListerExpansion interface{}
+
 // ChannelListerExpansion allows custom methods to be added to
 // ChannelLister.
 type ChannelListerExpansion interface{}
@@ -36,6 +52,10 @@ type ChannelListerExpansion interface{}
 // EndorsePolicyLister.
 type EndorsePolicyListerExpansion interface{}
 
+// ExternalOrganizationListerExpansion allows custom methods to be added to
+// ExternalOrganizationLister.
+type ExternalOrganizationListerExpansion interface{}
+
 // FederationListerExpansion allows custom methods to be added to
 // FederationLister.
 type FederationListerExpansion interface{}
@@ -72,6 +92,14 @@ type IBPPeerListerExpansion interface{}
 // IBPPeerNamespaceLister.
 type IBPPeerNamespaceListerExpansion interface{}
 
+// IdentityListerExpansion allows custom methods to be added to
+// IdentityLister.
+type IdentityListerExpansion interface{}
+
+// IdentityNamespaceListerExpansion allows custom methods to be added to
+// IdentityNamespaceLister.
+type IdentityNamespaceListerExpansion interface{}
+
 // NetworkListerExpansion allows custom methods to be added to
 // NetworkLister.
 type NetworkListerExpansion interface{}
@@ -84,6 +112,18 @@ type OrganizationListerExpansion interface{}
 // ProposalLister.
 type ProposalListerExpansion interface{}
 
+// RenewalPolicyListerExpansion allows custom methods to be added to
+// RenewalPolicyLister.
+type RenewalPolicyListerExpansion interface{}
+
+// RestoreListerExpansion allows custom methods to be added to
+// RestoreLister.
+type RestoreListerExpansion interface{}
+
+// RestoreNamespaceListerExpansion allows custom methods to be added to
+// RestoreNamespaceLister.
+type RestoreNamespaceListerExpansion interface{}
+
 // VoteListerExpansion allows custom methods to be added to
 // VoteLister.
 type VoteListerExpansion interface{}
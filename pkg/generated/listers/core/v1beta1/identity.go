@@ -0,0 +1,101 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// IdentityLister helps list Identities.
+// All objects returned here must be treated as read-only.
+type IdentityLister interface {
+	// List lists all Identities in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.Identity, err error)
+	// Identities returns an object that can list and get Identities.
+	Identities(namespace string) IdentityNamespaceLister
+	IdentityListerExpansion
+}
+
+// identityLister implements the IdentityLister interface.
+type identityLister struct {
+	indexer cache.Indexer
+}
+
+// NewIdentityLister returns a new IdentityLister.
+func NewIdentityLister(indexer cache.Indexer) IdentityLister {
+	return &identityLister{indexer: indexer}
+}
+
+// List lists all Identities in the indexer.
+func (s *identityLister) List(selector labels.Selector) (ret []*v1beta1.Identity, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.Identity))
+	})
+	return ret, err
+}
+
+// Identities returns an object that can list and get Identities.
+func (s *identityLister) Identities(namespace string) IdentityNamespaceLister {
+	return identityNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// IdentityNamespaceLister helps list and get Identities.
+// All objects returned here must be treated as read-only.
+type IdentityNamespaceLister interface {
+	// List lists all Identities in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.Identity, err error)
+	// Get retrieves the Identity from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.Identity, error)
+	IdentityNamespaceListerExpansion
+}
+
+// identityNamespaceLister implements the IdentityNamespaceLister
+// interface.
+type identityNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all Identities in the indexer for a given namespace.
+func (s identityNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.Identity, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.Identity))
+	})
+	return ret, err
+}
+
+// Get retrieves the Identity from the indexer for a given namespace and name.
+func (s identityNamespaceLister) Get(name string) (*v1beta1.Identity, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("identity"), name)
+	}
+	return obj.(*v1beta1.Identity), nil
+}
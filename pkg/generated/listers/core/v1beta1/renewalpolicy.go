@@ -0,0 +1,70 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RenewalPolicyLister helps list RenewalPolicies.
+// All objects returned here must be treated as read-only.
+type RenewalPolicyLister interface {
+	// List lists all RenewalPolicies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.RenewalPolicy, err error)
+	// Get retrieves the RenewalPolicy from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.RenewalPolicy, error)
+	RenewalPolicyListerExpansion
+}
+
+// renewalPolicyLister implements the RenewalPolicyLister interface.
+type renewalPolicyLister struct {
+	indexer cache.Indexer
+}
+
+// NewRenewalPolicyLister returns a new RenewalPolicyLister.
+func NewRenewalPolicyLister(indexer cache.Indexer) RenewalPolicyLister {
+	return &renewalPolicyLister{indexer: indexer}
+}
+
+// List lists all RenewalPolicies in the indexer.
+func (s *renewalPolicyLister) List(selector labels.Selector) (ret []*v1beta1.RenewalPolicy, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.RenewalPolicy))
+	})
+	return ret, err
+}
+
+// Get retrieves the RenewalPolicy from the index for a given name.
+func (s *renewalPolicyLister) Get(name string) (*v1beta1.RenewalPolicy, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("renewalpolicy"), name)
+	}
+	return obj.(*v1beta1.RenewalPolicy), nil
+}
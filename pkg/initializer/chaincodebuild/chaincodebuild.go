@@ -30,4 +30,7 @@ type Config struct {
 	// PipelineRun tempaltes
 	PipelineRunPVCFile string
 	PipelineRunFile    string
+
+	// LanguagePipeline is the tekton pipeline building chaincode without a Dockerfile
+	LanguagePipeline string
 }
@@ -21,6 +21,7 @@ package channel
 import (
 	"context"
 	"encoding/base64"
+	"fmt"
 	"path/filepath"
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
@@ -44,6 +45,14 @@ func (i *Initializer) ConfigureApplication(instance *current.Channel, profile *c
 func (i *Initializer) GetApplicationOrganization(instance *current.Channel, member string) (*configtx.Organization, error) {
 	var err error
 
+	external, err := current.GetExternalOrganization(context.TODO(), i.Client, member)
+	if err != nil {
+		return nil, err
+	}
+	if external != nil {
+		return i.GetExternalApplicationOrganization(instance, external)
+	}
+
 	organization := &current.Organization{}
 	err = i.Client.Get(context.TODO(), types.NamespacedName{Name: member}, organization)
 	if err != nil {
@@ -97,3 +106,38 @@ func (i *Initializer) GetApplicationOrganization(instance *current.Channel, memb
 
 	return org, nil
 }
+
+// GetExternalApplicationOrganization writes the MSP of an external organization under /msp/dir
+func (i *Initializer) GetExternalApplicationOrganization(instance *current.Channel, external *current.ExternalOrganization) (*configtx.Organization, error) {
+	mspDir := i.GetOrgMSPDir(instance, external.GetName())
+	msp := external.Spec.MSP
+	for dir, certs := range map[string][]string{
+		"cacerts":              msp.RootCerts,
+		"intermediatecerts":    msp.IntermediateCerts,
+		"tlscacerts":           msp.TLSRootCerts,
+		"tlsintermediatecerts": msp.TLSIntermediateCerts,
+		"admincerts":           msp.Admins,
+	} {
+		for index, cert := range certs {
+			certPem, err := base64.StdEncoding.DecodeString(cert)
+			if err != nil {
+				return nil, err
+			}
+			err = util.WriteFile(filepath.Join(mspDir, dir, fmt.Sprintf("cert-%d.pem", index)), certPem, 0777)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if msp.NodeOUs != "" {
+		err := util.WriteFile(filepath.Join(mspDir, "config.yaml"), []byte(msp.NodeOUs), 0777)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	org := configtx.DefaultOrganization(external.GetName())
+	org.MSPDir = mspDir
+
+	return org, nil
+}
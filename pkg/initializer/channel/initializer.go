@@ -107,6 +107,9 @@ func (i *Initializer) CreateChannel(instance *current.Channel) error {
 		return err
 	}
 	for _, target := range clusterNodes.Items {
+		if IsLeavingNode(parentOrderer, &target) {
+			continue
+		}
 		// make sure orderer not joined yet
 		resp, err := osn.Query(target.GetName(), instance.GetChannelID())
 		if err != nil {
@@ -24,6 +24,7 @@ import (
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/orderer/configtx"
+	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
 	"github.com/gogo/protobuf/proto"
 	"github.com/hyperledger/fabric-protos-go/msp"
 	"github.com/hyperledger/fabric-protos-go/orderer/etcdraft"
@@ -63,41 +64,27 @@ func (i *Initializer) ConfigureOrderer(instance *current.Channel, profile *confi
 
 func (i *Initializer) AddHostPortToProfile(profile *configtx.Profile, parent *current.IBPOrderer, clusterNodes *current.IBPOrdererList) error {
 	log.Info("Adding hosts to genesis block")
-	ns := parent.GetNamespace()
 	parentName := parent.GetName()
 
-	for _, node := range clusterNodes.Items {
+	for index := range clusterNodes.Items {
+		node := &clusterNodes.Items[index]
 		if node.Status.Type != current.Deployed {
 			return errors.Errorf("consensus node {name:%s,namespace:%s} not deployed yet", node.GetName(), node.GetNamespace())
 		}
-
-		n := types.NamespacedName{
-			Name:      fmt.Sprintf("tls-%s-signcert", node.Name),
-			Namespace: ns,
+		if IsLeavingNode(parent, node) {
+			log.Info(fmt.Sprintf("Skipping consentor '%s' which is leaving the cluster", node.GetName()))
+			continue
 		}
 
-		// To avoid the race condition of the TLS signcert secret not existing, need to poll for it's
-		// existence before proceeding
-		tlsSecret := &corev1.Secret{}
-		err := i.Client.Get(context.TODO(), n, tlsSecret)
+		consentor, err := GetConsenter(i.Client, parentName, node)
 		if err != nil {
-			return errors.Wrapf(err, "failed to find secret '%s'", n.Name)
+			return err
 		}
 
-		nodeName := fmt.Sprintf("node%d", *node.Spec.NodeNumber)
-		domain := node.Spec.Domain
-		fqdn := ns + "-" + parentName + nodeName + "-orderer" + "." + domain
-
-		log.Info(fmt.Sprintf("Adding consentor domain '%s' to genesis block", fqdn))
+		log.Info(fmt.Sprintf("Adding consentor domain '%s' to genesis block", consentor.Host))
 
-		profile.AddOrdererAddress(fmt.Sprintf("%s:%d", fqdn, 443))
-		consentors := &etcdraft.Consenter{
-			Host:          fqdn,
-			Port:          443,
-			ClientTlsCert: tlsSecret.Data["cert.pem"],
-			ServerTlsCert: tlsSecret.Data["cert.pem"],
-		}
-		err = profile.AddRaftConsentingNode(consentors)
+		profile.AddOrdererAddress(ConsenterAddress(consentor))
+		err = profile.AddRaftConsentingNode(consentor)
 		if err != nil {
 			return err
 		}
@@ -105,6 +92,48 @@ func (i *Initializer) AddHostPortToProfile(profile *configtx.Profile, parent *cu
 	return nil
 }
 
+// ConsenterPort is the port of cluster nodes exposed by ingress
+const ConsenterPort = 443
+
+// IsLeavingNode returns true if node is beyond its parent's cluster size, which means it is being removed from the cluster
+func IsLeavingNode(parent *current.IBPOrderer, node *current.IBPOrderer) bool {
+	return node.Spec.NodeNumber != nil && *node.Spec.NodeNumber > parent.Spec.ClusterSize
+}
+
+// GetConsenter returns the raft consenter of a cluster node, which uses the node's tls signcert as both client and server cert
+func GetConsenter(client k8sclient.Client, parentName string, node *current.IBPOrderer) (*etcdraft.Consenter, error) {
+	n := types.NamespacedName{
+		Name:      fmt.Sprintf("tls-%s-signcert", node.Name),
+		Namespace: node.GetNamespace(),
+	}
+
+	// To avoid the race condition of the TLS signcert secret not existing, need to poll for it's
+	// existence before proceeding
+	tlsSecret := &corev1.Secret{}
+	err := client.Get(context.TODO(), n, tlsSecret)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find secret '%s'", n.Name)
+	}
+
+	return &etcdraft.Consenter{
+		Host:          ConsenterHost(parentName, node),
+		Port:          ConsenterPort,
+		ClientTlsCert: tlsSecret.Data["cert.pem"],
+		ServerTlsCert: tlsSecret.Data["cert.pem"],
+	}, nil
+}
+
+// ConsenterHost returns the fqdn of a cluster node which is exposed by ingress
+func ConsenterHost(parentName string, node *current.IBPOrderer) string {
+	nodeName := fmt.Sprintf("node%d", *node.Spec.NodeNumber)
+	return node.GetNamespace() + "-" + parentName + nodeName + "-orderer" + "." + node.Spec.Domain
+}
+
+// ConsenterAddress returns the `host:port` orderer endpoint of consenter
+func ConsenterAddress(consenter *etcdraft.Consenter) string {
+	return fmt.Sprintf("%s:%d", consenter.Host, consenter.Port)
+}
+
 func (i *Initializer) GetOrdererMSPConfig(instance *current.IBPOrderer, ID string) (*msp.MSPConfig, error) {
 	isIntermediate := false
 	admincert := [][]byte{}
@@ -33,6 +33,7 @@ import (
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/metrics"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -45,10 +46,58 @@ const (
 	ListChannelsAPI = "%s/participation/v1/channels"
 	// QueryChannelAPI query a specific channel
 	QueryChannelAPI = "%s/participation/v1/channels/%s"
+	// RemoveChannelAPI remove a specific channel from orderer
+	RemoveChannelAPI = "%s/participation/v1/channels/%s"
 )
 
+// Channel status and consensus relation reported by channel participation api
+const (
+	ChannelStatusActive     = "active"
+	ChannelStatusOnboarding = "onboarding"
+
+	ConsensusRelationConsenter = "consenter"
+)
+
+// ChannelList is the response of List
+// github.com/hyperledger/fabric/orderer/common/types/channelinfo.go
+type ChannelList struct {
+	SystemChannel *ChannelInfoShort  `json:"systemChannel"`
+	Channels      []ChannelInfoShort `json:"channels"`
+}
+
+// ChannelInfoShort is the short info of a channel in ChannelList
+type ChannelInfoShort struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// ChannelInfo is the response of Query
+type ChannelInfo struct {
+	Name              string `json:"name"`
+	URL               string `json:"url"`
+	ConsensusRelation string `json:"consensusRelation"`
+	Status            string `json:"status"`
+	Height            uint64 `json:"height"`
+}
+
+// Has returns true if channelID is in list
+func (list *ChannelList) Has(channelID string) bool {
+	for _, ch := range list.Channels {
+		if ch.Name == channelID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsActiveConsenter returns true if the orderer is an active consenter of the channel
+func (info *ChannelInfo) IsActiveConsenter() bool {
+	return info.Status == ChannelStatusActive && info.ConsensusRelation == ConsensusRelationConsenter
+}
+
 var (
-	ErrTargetNotFound = errors.New("target not found")
+	ErrTargetNotFound  = errors.New("target not found")
+	ErrChannelNotFound = errors.New("channel not found in target")
 )
 
 // OSNAdmin wraps a client to call orderering service's admin api
@@ -169,7 +218,8 @@ func (osn *OSNAdmin) DeleteTarget(target string) {
 /* Channel management */
 
 // Join orderers into channel
-func (osn *OSNAdmin) Join(target string, blockBytes []byte) error {
+func (osn *OSNAdmin) Join(target string, blockBytes []byte) (err error) {
+	defer func(start time.Time) { metrics.ObserveSDKCall(metrics.OperationOSNJoin, start, err) }(time.Now())
 	instance, err := osn.GetTarget(target)
 	if err != nil {
 		return err
@@ -248,6 +298,67 @@ func (osn *OSNAdmin) Query(target string, channelID string) (*http.Response, err
 	return resp, err
 }
 
+// ListChannels decodes the channels which target has joined
+func (osn *OSNAdmin) ListChannels(target string) (*ChannelList, error) {
+	res, err := osn.List(target)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("List failed with status %d", res.StatusCode)
+	}
+	list := &ChannelList{}
+	if err = json.NewDecoder(res.Body).Decode(list); err != nil {
+		return nil, errors.Wrap(err, "decode channel list")
+	}
+	return list, nil
+}
+
+// QueryChannel decodes the channel info from target. ErrChannelNotFound returned if target not joined
+func (osn *OSNAdmin) QueryChannel(target string, channelID string) (*ChannelInfo, error) {
+	res, err := osn.Query(target, channelID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrChannelNotFound
+	default:
+		return nil, errors.Errorf("Query failed with status %d", res.StatusCode)
+	}
+	info := &ChannelInfo{}
+	if err = json.NewDecoder(res.Body).Decode(info); err != nil {
+		return nil, errors.Wrap(err, "decode channel info")
+	}
+	return info, nil
+}
+
+// Remove target from channel
+func (osn *OSNAdmin) Remove(target string, channelID string) error {
+	instance, err := osn.GetTarget(target)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf(RemoveChannelAPI, instance.URL, channelID)
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return err
+	}
+	res, err := instance.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	// 204 on success, 404 if target not in channel
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
+		return errors.Errorf("Remove failed with status %d", res.StatusCode)
+	}
+	return nil
+}
+
 // WaitForChannel wait until channel is ready
 func (osn *OSNAdmin) WaitForChannel(target string, channelID string, duration time.Duration) error {
 	instance, err := osn.GetTarget(target)
@@ -26,6 +26,7 @@ import (
 	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common/config"
 	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -41,6 +42,8 @@ type SecretManager struct {
 	Client    k8sclient.Client
 	Scheme    *runtime.Scheme
 	GetLabels func(instance v1.Object) map[string]string
+	// Store keeps the keystore secrets, certificates are always kept in kubernetes secrets
+	Store secretstore.Store
 }
 
 func New(client k8sclient.Client, scheme *runtime.Scheme, labels func(instance v1.Object) map[string]string) *SecretManager {
@@ -48,6 +51,7 @@ func New(client k8sclient.Client, scheme *runtime.Scheme, labels func(instance v
 		Client:    client,
 		Scheme:    scheme,
 		GetLabels: labels,
+		Store:     secretstore.NewKubernetes(client, scheme),
 	}
 }
 
@@ -175,10 +179,12 @@ func (s *SecretManager) CreateKey(name string, instance v1.Object, key []byte) e
 		return nil
 	}
 
+	log.Info(fmt.Sprintf("Create/Update key secret '%s'", name))
+
 	data := map[string][]byte{
 		"key.pem": key,
 	}
-	err := s.CreateOrUpdateSecret(instance, name, data)
+	err := s.Store.Put(s.BuildSecret(instance, name, data, s.GetLabels(instance)), instance)
 	if err != nil {
 		return err
 	}
@@ -302,12 +308,9 @@ func (s *SecretManager) DeleteSecrets(prefix string, instance v1.Object, name st
 		}
 	}
 
-	secret.Name = fmt.Sprintf("%s-%s-%s", prefix, name, "keystore")
-	err = s.Client.Delete(context.TODO(), secret)
+	err = s.Store.Delete(instance.GetNamespace(), fmt.Sprintf("%s-%s-%s", prefix, name, "keystore"))
 	if err != nil {
-		if !k8serrors.IsNotFound(err) {
-			return errors.Wrapf(err, "failed to delete secret '%s'", secret.Name)
-		}
+		return err
 	}
 
 	return nil
@@ -348,8 +351,8 @@ func (s *SecretManager) GetCryptoFromSecrets(prefix common.SecretType, instance
 		resp.SignCert = signcert.Data["cert.pem"]
 	}
 
-	keystore, err := s.GetSecret(fmt.Sprintf("%s-%s-%s", prefix, instance.GetName(), "keystore"), instance)
-	if err != nil {
+	keystore, err := s.Store.Get(instance.GetNamespace(), fmt.Sprintf("%s-%s-%s", prefix, instance.GetName(), "keystore"))
+	if err != nil && !k8serrors.IsNotFound(err) {
 		return nil, err
 	}
 	if keystore != nil {
@@ -25,6 +25,7 @@ import (
 	"strings"
 
 	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,6 +35,8 @@ import (
 type Validator struct {
 	Client     k8sclient.Client
 	HSMEnabled bool
+	// Store keeps the keystore secrets, kubernetes secrets are read if not set
+	Store secretstore.Store
 }
 
 func (v *Validator) CheckAdminCerts(instance v1.Object, prefix string) error {
@@ -162,13 +165,11 @@ func (v *Validator) CheckCert(instance v1.Object, prefix string) error {
 }
 
 func (v *Validator) CheckKey(instance v1.Object, prefix string) error {
-	namespacedName := types.NamespacedName{
-		Namespace: instance.GetNamespace(),
+	store := v.Store
+	if store == nil {
+		store = secretstore.NewKubernetes(v.Client, nil)
 	}
-
-	key := &corev1.Secret{}
-	namespacedName.Name = prefix + "-keystore"
-	err := v.Client.Get(context.TODO(), namespacedName, key)
+	key, err := store.Get(instance.GetNamespace(), prefix+"-keystore")
 	if err != nil {
 		return err
 	}
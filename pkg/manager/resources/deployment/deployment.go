@@ -54,6 +54,17 @@ func (d *Deployment) RemoveContainer(name string) {
 	}
 }
 
+func (d *Deployment) RemoveInitContainer(name string) {
+	for i, c := range d.Deployment.Spec.Template.Spec.InitContainers {
+		if c.Name == name {
+			d.Deployment.Spec.Template.Spec.InitContainers = append(
+				d.Deployment.Spec.Template.Spec.InitContainers[:i],
+				d.Deployment.Spec.Template.Spec.InitContainers[i+1:]...)
+			return
+		}
+	}
+}
+
 func (d *Deployment) UpdateContainer(update container.Container) {
 	for i, c := range d.Deployment.Spec.Template.Spec.Containers {
 		if c.Name == update.Name {
@@ -0,0 +1,126 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package metrics defines the prometheus metrics of the operator, all registered on the
+// controller-runtime metrics registry and served by the manager's metrics endpoint
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const namespace = "fabric_operator"
+
+// Kinds of the base offerings whose reconcile steps are observed
+const (
+	KindChannel      = "Channel"
+	KindChaincode    = "Chaincode"
+	KindProposal     = "Proposal"
+	KindNetwork      = "Network"
+	KindFederation   = "Federation"
+	KindOrganization = "Organization"
+)
+
+// Reconcile steps of the base offerings
+const (
+	StepPreReconcileChecks = "PreReconcileChecks"
+	StepInitialize         = "Initialize"
+	StepReconcileManagers  = "ReconcileManagers"
+	StepCheckStates        = "CheckStates"
+
+	// chaincode lifecycle steps
+	StepPackage = "Package"
+	StepInstall = "Install"
+	StepApprove = "Approve"
+	StepCanary  = "Canary"
+	StepCommit  = "Commit"
+	StepRunning = "Running"
+)
+
+// Fabric operations observed by SDKCallDuration
+const (
+	OperationInstall = "install"
+	OperationApprove = "approve"
+	OperationCommit  = "commit"
+	OperationJoin    = "join"
+	OperationOSNJoin = "osnadmin_join"
+	ResultSuccess    = "success"
+	ResultError      = "error"
+)
+
+var (
+	// ReconcileStepDuration observes how long each reconcile step of a kind takes
+	ReconcileStepDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "reconcile_step_duration_seconds",
+		Help:      "Duration of the reconcile steps of the base offerings",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 120, 300},
+	}, []string{"kind", "step"})
+
+	// ReconcileStepErrors counts the failed reconcile steps of a kind
+	ReconcileStepErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reconcile_step_errors_total",
+		Help:      "Number of failed reconcile steps of the base offerings",
+	}, []string{"kind", "step"})
+
+	// SDKCallDuration observes the fabric sdk and osnadmin calls
+	SDKCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "fabric_call_duration_seconds",
+		Help:      "Duration of the chaincode lifecycle, channel join and osnadmin calls",
+		Buckets:   []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, []string{"operation", "result"})
+
+	// StaggerRestartQueueDepth is the number of components waiting for a restart
+	StaggerRestartQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "stagger_restart_queue_depth",
+		Help:      "Number of components queued for a stagger restart",
+	}, []string{"component", "namespace"})
+)
+
+func init() {
+	crmetrics.Registry.MustRegister(
+		ReconcileStepDuration,
+		ReconcileStepErrors,
+		SDKCallDuration,
+		StaggerRestartQueueDepth,
+	)
+}
+
+// ObserveStep records a reconcile step of kind started at start, meant to be deferred
+// with the step's named error like `defer metrics.ObserveStep(kind, step, time.Now(), &err)`
+func ObserveStep(kind, step string, start time.Time, err *error) {
+	ReconcileStepDuration.WithLabelValues(kind, step).Observe(time.Since(start).Seconds())
+	if err != nil && *err != nil {
+		ReconcileStepErrors.WithLabelValues(kind, step).Inc()
+	}
+}
+
+// ObserveSDKCall records a fabric call of operation started at start
+func ObserveSDKCall(operation string, start time.Time, err error) {
+	result := ResultSuccess
+	if err != nil {
+		result = ResultError
+	}
+	SDKCallDuration.WithLabelValues(operation, result).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	cmocks "github.com/IBM-Blockchain/fabric-operator/controllers/mocks"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestObserveStep(t *testing.T) {
+	observe := func(fail bool) (err error) {
+		defer ObserveStep(KindChannel, StepInitialize, time.Now(), &err)
+		if fail {
+			return errors.New("failed")
+		}
+		return nil
+	}
+	_ = observe(false)
+	_ = observe(true)
+
+	if n := testutil.CollectAndCount(ReconcileStepDuration, "fabric_operator_reconcile_step_duration_seconds"); n != 1 {
+		t.Fatalf("expect one histogram, get %d", n)
+	}
+	if v := testutil.ToFloat64(ReconcileStepErrors.WithLabelValues(KindChannel, StepInitialize)); v != 1 {
+		t.Fatalf("expect one error, get %v", v)
+	}
+}
+
+func TestObserveSDKCall(t *testing.T) {
+	ObserveSDKCall(OperationJoin, time.Now(), nil)
+	ObserveSDKCall(OperationJoin, time.Now(), errors.New("failed"))
+
+	if n := testutil.CollectAndCount(SDKCallDuration); n != 2 {
+		t.Fatalf("expect a histogram per result, get %d", n)
+	}
+}
+
+func TestProposalCollector(t *testing.T) {
+	c := &cmocks.Client{}
+	c.ListStub = func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+		proposals := list.(*current.ProposalList)
+		proposals.Items = []current.Proposal{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "add-org3"},
+				Spec:       current.ProposalSpec{Federation: "fed"},
+				Status: current.ProposalStatus{
+					Phase: current.ProposalVoting,
+					Tally: &current.VoteTally{Electorate: 4, Approved: 2, Rejected: 1, Pending: 1},
+				},
+			},
+			{Status: current.ProposalStatus{Phase: current.ProposalFinished}},
+			{Status: current.ProposalStatus{Phase: current.ProposalFinished}},
+		}
+		return nil
+	}
+
+	expected := `
+# HELP fabric_operator_proposal_vote_turnout_ratio Voting weight cast of a proposal being voted, relative to its electorate
+# TYPE fabric_operator_proposal_vote_turnout_ratio gauge
+fabric_operator_proposal_vote_turnout_ratio{federation="fed",proposal="add-org3"} 0.75
+# HELP fabric_operator_proposals Number of proposals by phase
+# TYPE fabric_operator_proposals gauge
+fabric_operator_proposals{phase="Finished"} 2
+fabric_operator_proposals{phase="Pending"} 0
+fabric_operator_proposals{phase="Voting"} 1
+`
+	if err := testutil.CollectAndCompare(&ProposalCollector{Client: c}, strings.NewReader(expected)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTurnout(t *testing.T) {
+	if _, ok := Turnout(nil); ok {
+		t.Fatal("expect no turnout without a tally")
+	}
+	if _, ok := Turnout(&current.VoteTally{}); ok {
+		t.Fatal("expect no turnout without an electorate")
+	}
+	if turnout, ok := Turnout(&current.VoteTally{Electorate: 2, Pending: 2}); !ok || turnout != 0 {
+		t.Fatalf("expect no vote cast, get %v", turnout)
+	}
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics
+
+import (
+	"context"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/prometheus/client_golang/prometheus"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var log = logf.Log.WithName("metrics")
+
+var (
+	proposalsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "proposals"),
+		"Number of proposals by phase",
+		[]string{"phase"}, nil,
+	)
+	turnoutDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "proposal_vote_turnout_ratio"),
+		"Voting weight cast of a proposal being voted, relative to its electorate",
+		[]string{"proposal", "federation"}, nil,
+	)
+)
+
+// ProposalCollector reads proposals on every scrape, so phases of deleted proposals never go stale
+type ProposalCollector struct {
+	Client controllerclient.Client
+}
+
+// RegisterProposalCollector registers a ProposalCollector reading proposals with c
+func RegisterProposalCollector(c controllerclient.Client) error {
+	err := crmetrics.Registry.Register(&ProposalCollector{Client: c})
+	if _, ok := err.(prometheus.AlreadyRegisteredError); ok {
+		return nil
+	}
+	return err
+}
+
+// Describe implements prometheus.Collector
+func (p *ProposalCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- proposalsDesc
+	ch <- turnoutDesc
+}
+
+// Collect implements prometheus.Collector
+func (p *ProposalCollector) Collect(ch chan<- prometheus.Metric) {
+	proposals := &current.ProposalList{}
+	if err := p.Client.List(context.TODO(), proposals); err != nil {
+		log.Error(err, "failed to list proposals")
+		return
+	}
+
+	phases := map[current.ProposalPhase]int{
+		current.ProposalPending:  0,
+		current.ProposalVoting:   0,
+		current.ProposalFinished: 0,
+	}
+	for _, proposal := range proposals.Items {
+		phases[proposal.Status.Phase]++
+		if proposal.Status.Phase == current.ProposalVoting {
+			if turnout, ok := Turnout(proposal.Status.Tally); ok {
+				ch <- prometheus.MustNewConstMetric(turnoutDesc, prometheus.GaugeValue, turnout, proposal.GetName(), proposal.Spec.Federation)
+			}
+		}
+	}
+	for phase, count := range phases {
+		if phase == "" {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(proposalsDesc, prometheus.GaugeValue, float64(count), string(phase))
+	}
+}
+
+// Turnout returns the share of the electorate's weight which has voted
+func Turnout(tally *current.VoteTally) (float64, bool) {
+	if tally == nil || tally.Electorate <= 0 {
+		return 0, false
+	}
+	return float64(tally.Electorate-tally.Pending) / float64(tally.Electorate), true
+}
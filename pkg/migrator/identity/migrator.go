@@ -0,0 +1,73 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package identity
+
+import (
+	"context"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/user"
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("identity_migrator")
+
+// Migrate records the identities stored in users' blockchain annotations as Identities.
+// Identities of organizations which no longer exist are skipped
+func Migrate(c controllerclient.Client) error {
+	users, err := user.ListUsers(c, labels.Everything())
+	if err != nil {
+		return errors.Wrap(err, "failed to list users")
+	}
+
+	for i := range users.Items {
+		u := &users.Items[i]
+		raw, ok := u.Annotations[user.BlockchainAnnotationKey]
+		if !ok {
+			continue
+		}
+		annotationList := user.NewBlockchainAnnotationList()
+		if err = annotationList.Unmarshal([]byte(raw)); err != nil {
+			log.Error(err, "skip user with invalid blockchain annotation", "user", u.GetName())
+			continue
+		}
+		for organization, annotation := range annotationList.List {
+			err = c.Get(context.TODO(), types.NamespacedName{Name: organization}, &current.Organization{})
+			if err != nil {
+				if k8serrors.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+			for _, id := range annotation.IDs {
+				_, err = user.ReconcileIdentity(c, organization, user.GetUsername(u), id, user.Add)
+				if err != nil {
+					return errors.Wrapf(err, "failed to migrate identity %s of user %s", id.Name, u.GetName())
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package identity
+
+import (
+	"context"
+	"testing"
+
+	iam "github.com/IBM-Blockchain/fabric-operator/api/iam/v1alpha1"
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	cmocks "github.com/IBM-Blockchain/fabric-operator/controllers/mocks"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/user"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newUser(t *testing.T, name string, annotations map[string][]user.ID) iam.User {
+	list := user.NewBlockchainAnnotationList()
+	for organization, ids := range annotations {
+		if err := list.SetAnnotation(organization, *user.NewBlockchainAnnotation(organization, ids...)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	raw, err := list.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := iam.User{}
+	u.Name = name
+	u.Spec.Name = name
+	u.Annotations = map[string]string{user.BlockchainAnnotationKey: string(raw)}
+	return u
+}
+
+func TestMigrate(t *testing.T) {
+	users := []iam.User{
+		newUser(t, "org1admin", map[string][]user.ID{
+			"org1": {user.BuildAdminID("org1admin"), user.BuildPeerID("org1peer1")},
+			// org2 has been deleted
+			"org2": {user.BuildClientID("org1admin")},
+		}),
+		newUser(t, "org1client", map[string][]user.ID{
+			"org1": {user.BuildClientID("org1client")},
+		}),
+		{},
+	}
+	created := make(map[string]*current.Identity)
+
+	c := &cmocks.Client{}
+	c.ListStub = func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+		list.(*iam.UserList).Items = users
+		return nil
+	}
+	c.GetStub = func(ctx context.Context, key types.NamespacedName, obj client.Object) error {
+		switch o := obj.(type) {
+		case *current.Organization:
+			if key.Name != "org1" {
+				return k8serrors.NewNotFound(schema.GroupResource{}, key.Name)
+			}
+			o.Name = key.Name
+		case *current.Identity:
+			stored, ok := created[key.Namespace+"/"+key.Name]
+			if !ok {
+				return k8serrors.NewNotFound(schema.GroupResource{}, key.Name)
+			}
+			stored.DeepCopyInto(o)
+		}
+		return nil
+	}
+	c.CreateStub = func(ctx context.Context, obj client.Object, opts ...controllerclient.CreateOption) error {
+		created[obj.GetNamespace()+"/"+obj.GetName()] = obj.(*current.Identity).DeepCopy()
+		return nil
+	}
+
+	if err := Migrate(c); err != nil {
+		t.Fatal(err)
+	}
+	if len(created) != 3 {
+		t.Fatalf("expect 3 identities, get %d", len(created))
+	}
+	for key, expect := range map[string]current.IdentityType{
+		"org1/org1admin":  current.AdminIdentity,
+		"org1/org1peer1":  current.PeerIdentity,
+		"org1/org1client": current.ClientIdentity,
+	} {
+		identity, ok := created[key]
+		if !ok {
+			t.Fatalf("expect identity %s", key)
+		}
+		if identity.Spec.Type != expect {
+			t.Fatalf("expect identity %s in type %s, get %s", key, expect, identity.Spec.Type)
+		}
+	}
+	if created["org1/org1peer1"].Spec.User != "org1admin" {
+		t.Fatalf("expect peer identity owned by org1admin, get %s", created["org1/org1peer1"].Spec.User)
+	}
+
+	// migrating again changes nothing
+	if err := Migrate(c); err != nil {
+		t.Fatal(err)
+	}
+	if c.CreateCallCount() != 3 || c.UpdateCallCount() != 0 {
+		t.Fatalf("expect no changes, get %d creates %d updates", c.CreateCallCount(), c.UpdateCallCount())
+	}
+}
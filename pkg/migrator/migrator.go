@@ -22,6 +22,7 @@ import (
 	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/global"
 	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/migrator/identity"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -48,8 +49,12 @@ func New(mgr manager.Manager, cfg *config.Config, namespace string) *Migrator {
 }
 
 func (m *Migrator) Migrate() error {
-
-	// No-op
+	if m.Config.Operator.IAM.Enabled {
+		log.Info("Migrating blockchain annotations of users to identities")
+		if err := identity.Migrate(m.Client); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -0,0 +1,373 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util/cron"
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var log = logf.Log.WithName("base_backup")
+
+// PollInterval is how often a running backup or restore checks the node and its job
+const PollInterval = 10 * time.Second
+
+type Backup interface {
+	Reconcile(*current.Backup) (common.Result, error)
+}
+
+type baseBackup struct {
+	client controllerclient.Client
+
+	Scheme *runtime.Scheme
+	Config *config.Config
+}
+
+var _ Backup = &baseBackup{}
+
+func New(client controllerclient.Client, scheme *runtime.Scheme, conf *config.Config) Backup {
+	return &baseBackup{client: client, Scheme: scheme, Config: conf}
+}
+
+// Reconcile starts a run when the schedule is due and moves a running one forward,
+// each step is short so the node's state survives operator restarts in status
+func (b *baseBackup) Reconcile(instance *current.Backup) (common.Result, error) {
+	now := time.Now()
+	if instance.Status.Run == nil {
+		due, next := NextRun(instance, now)
+		if !due {
+			if next.IsZero() {
+				return common.Result{}, nil
+			}
+			return common.Result{Result: reconcile.Result{RequeueAfter: next.Sub(now)}}, nil
+		}
+		b.start(instance, now)
+	} else {
+		b.proceed(instance, now)
+	}
+
+	if err := b.patchStatus(instance); err != nil {
+		return common.Result{}, err
+	}
+	if instance.Status.Run != nil {
+		return common.Result{Result: reconcile.Result{RequeueAfter: PollInterval}}, nil
+	}
+	if _, next := NextRun(instance, now); !next.IsZero() {
+		return common.Result{Result: reconcile.Result{RequeueAfter: next.Sub(now)}}, nil
+	}
+	return common.Result{}, nil
+}
+
+// NextRun reports whether a run is due at now, otherwise when the next one is. The zero time means never
+func NextRun(instance *current.Backup, now time.Time) (bool, time.Time) {
+	if instance.Spec.Suspend {
+		return false, time.Time{}
+	}
+	last := instance.Status.LastScheduleTime
+	if instance.Spec.Schedule == "" {
+		return last == nil, time.Time{}
+	}
+	schedule, err := cron.Parse(instance.Spec.Schedule)
+	if err != nil {
+		return false, time.Time{}
+	}
+	// the first run waits for the schedule as well
+	from := instance.GetCreationTimestamp().Time
+	if last != nil {
+		from = last.Time
+	}
+	next := schedule.Next(from)
+	if next.IsZero() {
+		return false, next
+	}
+	if !next.After(now) {
+		return true, next
+	}
+	return false, next
+}
+
+// start uploads the manifest and quiesces the node
+func (b *baseBackup) start(instance *current.Backup, now time.Time) {
+	startTime := metav1.NewTime(now)
+	instance.Status.LastScheduleTime = &startTime
+	run, err := b.startRun(instance, startTime)
+	if err != nil {
+		log.Error(err, "failed to start backup", "backup", instance.GetName())
+		setStatus(instance, current.Error, err.Error())
+		return
+	}
+	instance.Status.Run = run
+	setStatus(instance, current.Deploying, fmt.Sprintf("archiving %s", run.Archive))
+}
+
+func (b *baseBackup) startRun(instance *current.Backup, startTime metav1.Time) (*current.BackupRun, error) {
+	target := instance.Spec.Target
+	node := target.NewObject()
+	if node == nil {
+		return nil, errors.Errorf("unsupported target kind %s", target.Kind)
+	}
+	if err := b.client.Get(context.TODO(), types.NamespacedName{Namespace: instance.GetNamespace(), Name: target.Name}, node); err != nil {
+		return nil, errors.Wrap(err, "get target")
+	}
+	dep, err := NodeDeployment(b.client, instance.GetNamespace(), target.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "get target deployment")
+	}
+	volumes := VolumeNames(PVCVolumes(dep))
+
+	secrets := secretstore.New(b.client, b.Scheme, b.Config.Operator.SecretStore)
+	manifest, err := BuildManifest(b.client, secrets, target.Kind, node, volumes)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ManifestKey(b.client, instance.GetNamespace(), instance.Spec.Storage)
+	if err != nil {
+		return nil, err
+	}
+	data, err := manifest.Seal(key)
+	if err != nil {
+		return nil, err
+	}
+	store, err := NewStorageClient(b.client, b.Config, instance.GetNamespace(), instance.Spec.Storage)
+	if err != nil {
+		return nil, err
+	}
+	if err = store.EnsureBucket(context.TODO(), instance.Spec.Storage.Bucket); err != nil {
+		return nil, errors.Wrap(err, "ensure bucket")
+	}
+	archive := current.NewArchiveName(target, startTime.Time)
+	object := instance.Spec.Storage.ArchiveObject(instance.GetNamespace(), target, archive, current.BackupManifestObject)
+	if err = store.PutObject(context.TODO(), instance.Spec.Storage.Bucket, object, data); err != nil {
+		return nil, errors.Wrap(err, "upload manifest")
+	}
+
+	run := &current.BackupRun{
+		Archive:   archive,
+		Phase:     current.BackupQuiescing,
+		Replicas:  Replicas(dep),
+		Volumes:   volumes,
+		StartTime: startTime,
+	}
+	log.Info(fmt.Sprintf("quiesce %s %s from %d replicas to archive %s", target.Kind, target.Name, run.Replicas, archive), "backup", instance.GetName())
+	if err = Scale(b.client, dep, 0); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// proceed moves the run to its next phase when the current one is done. Failures still resume the node
+func (b *baseBackup) proceed(instance *current.Backup, now time.Time) {
+	run := instance.Status.Run
+	var err error
+	switch run.Phase {
+	case current.BackupQuiescing:
+		err = b.quiesce(instance, now)
+	case current.BackupArchiving:
+		err = b.archive(instance)
+	case current.BackupResuming:
+		err = b.resume(instance)
+	}
+	if err != nil {
+		log.Error(err, fmt.Sprintf("backup phase %s failed", run.Phase), "backup", instance.GetName())
+		if run.Phase == current.BackupResuming {
+			// keep resuming until the node is back
+			setStatus(instance, current.Error, err.Error())
+			return
+		}
+		run.Error = err.Error()
+		run.Phase = current.BackupResuming
+	}
+}
+
+func (b *baseBackup) quiesce(instance *current.Backup, now time.Time) error {
+	run := instance.Status.Run
+	dep, err := NodeDeployment(b.client, instance.GetNamespace(), instance.Spec.Target.Name)
+	if err != nil {
+		return errors.Wrap(err, "get target deployment")
+	}
+	if err = Scale(b.client, dep, 0); err != nil {
+		return err
+	}
+	quiesced, err := Quiesced(b.client, dep)
+	if err != nil {
+		return err
+	}
+	if !quiesced {
+		if now.Sub(run.StartTime.Time) > b.Config.Operator.Backup.Timeouts.ReplicaChange.Get() {
+			return errors.New("timed out waiting for the node to scale down")
+		}
+		return nil
+	}
+
+	store, err := NewStorageClient(b.client, b.Config, instance.GetNamespace(), instance.Spec.Storage)
+	if err != nil {
+		return err
+	}
+	deadline := b.Config.Operator.Backup.Timeouts.JobCompletion.Get()
+	transfers := make([]Transfer, 0, len(run.Volumes))
+	for _, volume := range run.Volumes {
+		key := instance.Spec.Storage.ArchiveObject(instance.GetNamespace(), instance.Spec.Target, run.Archive, current.BackupVolumeObject(volume))
+		transfers = append(transfers, Transfer{Volume: volume, URL: store.PresignedURL(http.MethodPut, instance.Spec.Storage.Bucket, key, deadline)})
+	}
+	job := TransferJob(JobName(instance.GetName()), instance.GetName(), dep, b.Config.Operator.Backup.Image, false, transfers, deadline)
+	if err = DeleteJob(b.client, job.GetNamespace(), job.GetName()); err != nil {
+		return err
+	}
+	if err = b.client.Create(context.TODO(), job, controllerclient.CreateOption{Owner: instance, Scheme: b.Scheme}); err != nil {
+		return errors.Wrap(err, "create archive job")
+	}
+	run.Phase = current.BackupArchiving
+	return nil
+}
+
+func (b *baseBackup) archive(instance *current.Backup) error {
+	job := &batchv1.Job{}
+	if err := b.client.Get(context.TODO(), types.NamespacedName{Namespace: instance.GetNamespace(), Name: JobName(instance.GetName())}, job); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return errors.New("archive job is gone")
+		}
+		return err
+	}
+	finished, succeeded := JobFinished(job)
+	if !finished {
+		return nil
+	}
+	if !succeeded {
+		return errors.Errorf("archive job %s failed", job.GetName())
+	}
+	instance.Status.Run.Phase = current.BackupResuming
+	return nil
+}
+
+// resume scales the node back, then records the archive and applies retention
+func (b *baseBackup) resume(instance *current.Backup) error {
+	run := instance.Status.Run
+	dep, err := NodeDeployment(b.client, instance.GetNamespace(), instance.Spec.Target.Name)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	if dep != nil {
+		if err = Scale(b.client, dep, run.Replicas); err != nil {
+			return err
+		}
+	}
+	if err = DeleteJob(b.client, instance.GetNamespace(), JobName(instance.GetName())); err != nil {
+		return err
+	}
+
+	instance.Status.Run = nil
+	if run.Error != "" {
+		setStatus(instance, current.Error, run.Error)
+		// drop what the failed run has uploaded
+		if store, err := NewStorageClient(b.client, b.Config, instance.GetNamespace(), instance.Spec.Storage); err == nil {
+			if err = RemoveArchive(store, instance, current.BackupArchive{Name: run.Archive, Volumes: run.Volumes}); err != nil {
+				log.Error(err, fmt.Sprintf("failed to remove incomplete archive %s", run.Archive), "backup", instance.GetName())
+			}
+		}
+		return nil
+	}
+	completed := metav1.Now()
+	instance.Status.LastSuccessfulTime = &completed
+	instance.Status.Archives = append(instance.Status.Archives, current.BackupArchive{Name: run.Archive, Time: completed, Volumes: run.Volumes})
+	setStatus(instance, current.Created, fmt.Sprintf("archive %s completed", run.Archive))
+	b.prune(instance)
+	return nil
+}
+
+// prune deletes the archives beyond retention, the ones failing to be deleted are kept for the next time
+func (b *baseBackup) prune(instance *current.Backup) {
+	expired := instance.ExpiredArchives()
+	if len(expired) == 0 {
+		return
+	}
+	store, err := NewStorageClient(b.client, b.Config, instance.GetNamespace(), instance.Spec.Storage)
+	if err != nil {
+		log.Error(err, "failed to prune archives", "backup", instance.GetName())
+		return
+	}
+	kept := make([]current.BackupArchive, 0, len(instance.Status.Archives))
+	for _, archive := range expired {
+		if err = RemoveArchive(store, instance, archive); err != nil {
+			log.Error(err, fmt.Sprintf("failed to remove archive %s", archive.Name), "backup", instance.GetName())
+			kept = append(kept, archive)
+		}
+	}
+	instance.Status.Archives = append(kept, instance.Status.Archives[len(expired):]...)
+}
+
+// ObjectRemover deletes objects from the object store
+type ObjectRemover interface {
+	RemoveObject(ctx context.Context, bucket, key string) error
+}
+
+// RemoveArchive deletes the manifest and volumes of archive
+func RemoveArchive(store ObjectRemover, instance *current.Backup, archive current.BackupArchive) error {
+	storage := instance.Spec.Storage
+	objects := []string{current.BackupManifestObject}
+	for _, volume := range archive.Volumes {
+		objects = append(objects, current.BackupVolumeObject(volume))
+	}
+	for _, object := range objects {
+		key := storage.ArchiveObject(instance.GetNamespace(), instance.Spec.Target, archive.Name, object)
+		if err := store.RemoveObject(context.TODO(), storage.Bucket, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JobName returns the name of the transfer job of a backup or restore
+func JobName(name string) string {
+	return name + "-transfer"
+}
+
+func setStatus(instance *current.Backup, statusType current.IBPCRStatusType, message string) {
+	instance.Status.Type = statusType
+	instance.Status.Status = current.True
+	instance.Status.Message = message
+	instance.Status.LastHeartbeatTime = metav1.Now()
+}
+
+func (b *baseBackup) patchStatus(instance *current.Backup) error {
+	return b.client.PatchStatus(context.TODO(), instance, nil, controllerclient.PatchOption{
+		Resilient: &controllerclient.ResilientPatch{
+			Retry:    2,
+			Into:     &current.Backup{},
+			Strategy: client.MergeFrom,
+		},
+	})
+}
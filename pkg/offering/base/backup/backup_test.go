@@ -0,0 +1,224 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	cmocks "github.com/IBM-Blockchain/fabric-operator/controllers/mocks"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestNextRun(t *testing.T) {
+	created := time.Date(2022, time.December, 30, 10, 0, 0, 0, time.UTC)
+	instance := &current.Backup{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)}}
+
+	if due, next := NextRun(instance, created); !due || !next.IsZero() {
+		t.Fatalf("a backup without schedule runs at once, get %v %v", due, next)
+	}
+	last := metav1.NewTime(created)
+	instance.Status.LastScheduleTime = &last
+	if due, next := NextRun(instance, created.Add(time.Hour)); due || !next.IsZero() {
+		t.Fatalf("a backup without schedule runs only once, get %v %v", due, next)
+	}
+
+	instance.Spec.Schedule = "0 2 * * *"
+	instance.Status.LastScheduleTime = nil
+	nextDay := time.Date(2022, time.December, 31, 2, 0, 0, 0, time.UTC)
+	if due, next := NextRun(instance, created); due || !next.Equal(nextDay) {
+		t.Fatalf("expect first run at %v, get %v %v", nextDay, due, next)
+	}
+	// missed runs are caught up with a single run
+	if due, _ := NextRun(instance, nextDay.Add(48*time.Hour)); !due {
+		t.Fatal("expect due after the scheduled time")
+	}
+	last = metav1.NewTime(nextDay)
+	instance.Status.LastScheduleTime = &last
+	if due, next := NextRun(instance, nextDay.Add(time.Hour)); due || !next.Equal(nextDay.Add(24*time.Hour)) {
+		t.Fatalf("expect next run a day later, get %v %v", due, next)
+	}
+
+	instance.Spec.Suspend = true
+	if due, next := NextRun(instance, nextDay.Add(48*time.Hour)); due || !next.IsZero() {
+		t.Fatalf("a suspended backup never runs, get %v %v", due, next)
+	}
+}
+
+// fakeStore records removed objects and fails on the given keys
+type fakeStore struct {
+	removed []string
+	fail    map[string]bool
+}
+
+func (s *fakeStore) RemoveObject(ctx context.Context, bucket, key string) error {
+	if s.fail[key] {
+		return errors.New("unavailable")
+	}
+	s.removed = append(s.removed, bucket+"/"+key)
+	return nil
+}
+
+func TestRetention(t *testing.T) {
+	instance := &current.Backup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "org1", Name: "daily"},
+		Spec: current.BackupSpec{
+			Target:    current.BackupTarget{Kind: "IBPPeer", Name: "org1peer1"},
+			Storage:   current.BackupStorage{Bucket: "backups"},
+			Retention: 2,
+		},
+	}
+	for _, name := range []string{"a1", "a2", "a3"} {
+		instance.Status.Archives = append(instance.Status.Archives, current.BackupArchive{Name: name, Volumes: []string{"fabric-peer-0"}})
+	}
+	expired := instance.ExpiredArchives()
+	if len(expired) != 1 || expired[0].Name != "a1" || instance.LatestArchive().Name != "a3" {
+		t.Fatalf("unexpected expired archives %v", expired)
+	}
+
+	store := &fakeStore{}
+	if err := RemoveArchive(store, instance, expired[0]); err != nil {
+		t.Fatal(err)
+	}
+	expect := []string{"backups/org1/org1peer1/a1/manifest.json.enc", "backups/org1/org1peer1/a1/fabric-peer-0.tar.gz"}
+	if strings.Join(store.removed, ",") != strings.Join(expect, ",") {
+		t.Fatalf("unexpected removed objects %v", store.removed)
+	}
+	store = &fakeStore{fail: map[string]bool{"org1/org1peer1/a1/manifest.json.enc": true}}
+	if err := RemoveArchive(store, instance, expired[0]); err == nil {
+		t.Fatal("expect error when the object store fails")
+	}
+}
+
+func TestTransferJob(t *testing.T) {
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "org1", Name: "org1peer1"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "peer"}},
+					Volumes: []corev1.Volume{
+						{Name: "fabric-peer-0", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "org1peer1-pvc"}}},
+						{Name: "ecert-signcert", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "ecert-org1peer1-signcert"}}},
+					},
+				},
+			},
+		},
+	}
+	if volumes := VolumeNames(PVCVolumes(dep)); len(volumes) != 1 || volumes[0] != "fabric-peer-0" {
+		t.Fatalf("expect only volumes of claims, get %v", volumes)
+	}
+
+	transfers := []Transfer{{Volume: "fabric-peer-0", URL: "http://minio/put"}, {Volume: "missing", URL: "http://minio/missing"}}
+	job := TransferJob("daily-transfer", "daily", dep, "curl", false, transfers, time.Hour)
+	pod := job.Spec.Template.Spec
+	if len(pod.Volumes) != 2 || pod.Volumes[1].PersistentVolumeClaim.ClaimName != "org1peer1-pvc" {
+		t.Fatalf("unexpected volumes %v", pod.Volumes)
+	}
+	cont := pod.Containers[0]
+	if !cont.VolumeMounts[1].ReadOnly || len(cont.Env) != 1 || cont.Env[0].Value != "http://minio/put" {
+		t.Fatalf("unexpected container %+v", cont)
+	}
+	if script := cont.Command[2]; !strings.Contains(script, "tar czf /scratch/archive.tar.gz -C '/data/fabric-peer-0' .") || !strings.Contains(script, `curl -sSf -T /scratch/archive.tar.gz "$URL_0"`) {
+		t.Fatalf("unexpected archive script %s", script)
+	}
+	if *job.Spec.ActiveDeadlineSeconds != 3600 {
+		t.Fatalf("unexpected deadline %d", *job.Spec.ActiveDeadlineSeconds)
+	}
+
+	job = TransferJob("restore-transfer", "restore", dep, "curl", true, transfers[:1], time.Hour)
+	cont = job.Spec.Template.Spec.Containers[0]
+	if cont.VolumeMounts[1].ReadOnly {
+		t.Fatalf("unexpected restore container %+v", cont)
+	}
+	script := cont.Command[2]
+	extract := strings.Index(script, "tar xzf /scratch/archive.tar.gz -C '/data/fabric-peer-0/.restore'")
+	remove := strings.Index(script, "find '/data/fabric-peer-0' -mindepth 1 -maxdepth 1 ! -name .restore -exec rm -rf {} +")
+	if extract < 0 || remove < extract {
+		t.Fatalf("expect the volume cleared only after the archive is extracted, get %s", script)
+	}
+}
+
+func TestManifest(t *testing.T) {
+	peer := &current.IBPPeer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "org1", Name: "org1peer1", UID: types.UID("peer-uid"), Labels: map[string]string{"app": "org1peer1"}},
+		Spec:       current.IBPPeerSpec{MSPID: "org1", Domain: "example.com"},
+	}
+	owned := []metav1.OwnerReference{{UID: peer.GetUID()}}
+	c := &cmocks.Client{}
+	c.ListStub = func(ctx context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+		list := obj.(*corev1.SecretList)
+		list.Items = []corev1.Secret{
+			{ObjectMeta: metav1.ObjectMeta{Name: "ecert-org1peer1-signcert", OwnerReferences: owned}, Data: map[string][]byte{"cert.pem": []byte("cert")}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "org1-admin"}},
+		}
+		return nil
+	}
+
+	manifest, err := BuildManifest(c, secretstore.NewKubernetes(c, nil), "IBPPeer", peer, []string{"fabric-peer-0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(manifest.Secrets) != 1 || manifest.Secrets[0].Name != "ecert-org1peer1-signcert" || string(manifest.Secrets[0].Data["cert.pem"]) != "cert" {
+		t.Fatalf("expect only secrets owned by the node, get %v", manifest.Secrets)
+	}
+
+	node, err := manifest.NewNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored := node.(*current.IBPPeer)
+	if restored.GetName() != "org1peer1" || restored.GetNamespace() != "org1" || restored.Spec.MSPID != "org1" || restored.Spec.Domain != "example.com" || restored.GetLabels()["app"] != "org1peer1" {
+		t.Fatalf("unexpected restored node %+v", restored)
+	}
+
+	existing := &current.IBPPeer{Spec: current.IBPPeerSpec{MSPID: "org1", Replicas: new(int32)}}
+	if err = manifest.ApplySpec(existing); err != nil || existing.Spec.Replicas != nil || existing.Spec.Domain != "example.com" {
+		t.Fatalf("expect the spec replaced, get %+v %v", existing.Spec, err)
+	}
+	if err = manifest.ApplySpec(&current.Channel{}); err == nil {
+		t.Fatal("expect error for unsupported kind")
+	}
+
+	key := bytes.Repeat([]byte{1}, 32)
+	sealed, err := manifest.Seal(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(sealed, []byte("ecert-org1peer1-signcert")) {
+		t.Fatal("expect the sealed manifest encrypted")
+	}
+	opened, err := OpenManifest(sealed, key)
+	if err != nil || opened.Name != "org1peer1" || string(opened.Secrets[0].Data["cert.pem"]) != "cert" {
+		t.Fatalf("expect the manifest opened, get %+v %v", opened, err)
+	}
+	if _, err = OpenManifest(sealed, bytes.Repeat([]byte{2}, 32)); err == nil {
+		t.Fatal("expect error for the wrong key")
+	}
+}
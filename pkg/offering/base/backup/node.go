@@ -0,0 +1,250 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	dataPath    = "/data"
+	scratchPath = "/scratch"
+	// restoreDir is created in the volume being restored, so the extracted data is moved in without a copy
+	restoreDir = ".restore"
+)
+
+// Transfer moves one volume through a presigned url
+type Transfer struct {
+	Volume string
+	URL    string
+}
+
+// NodeDeployment returns the deployment running a node, which is named after the node
+func NodeDeployment(c controllerclient.Client, namespace, name string) (*appsv1.Deployment, error) {
+	dep := &appsv1.Deployment{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, dep); err != nil {
+		return nil, err
+	}
+	return dep, nil
+}
+
+// PVCVolumes returns the volumes of dep backed by persistent volume claims
+func PVCVolumes(dep *appsv1.Deployment) []corev1.Volume {
+	var volumes []corev1.Volume
+	for _, v := range dep.Spec.Template.Spec.Volumes {
+		if v.PersistentVolumeClaim != nil {
+			volumes = append(volumes, v)
+		}
+	}
+	return volumes
+}
+
+// VolumeNames returns the names of volumes
+func VolumeNames(volumes []corev1.Volume) []string {
+	names := make([]string, 0, len(volumes))
+	for _, v := range volumes {
+		names = append(names, v.Name)
+	}
+	return names
+}
+
+// Replicas returns the desired replicas of dep, which kubernetes defaults to one
+func Replicas(dep *appsv1.Deployment) int32 {
+	if dep.Spec.Replicas == nil {
+		return 1
+	}
+	return *dep.Spec.Replicas
+}
+
+// Scale sets the replicas of dep. Callers waiting on a replica change call it on every
+// reconcile, as the node's controller may have scaled the deployment back meanwhile.
+func Scale(c controllerclient.Client, dep *appsv1.Deployment, replicas int32) error {
+	if Replicas(dep) == replicas {
+		return nil
+	}
+	orig := dep.DeepCopy()
+	dep.Spec.Replicas = &replicas
+	if err := c.Patch(context.TODO(), dep, client.MergeFrom(orig)); err != nil {
+		return errors.Wrapf(err, "failed to update replica to %d", replicas)
+	}
+	return nil
+}
+
+// Quiesced reports whether dep has no pod left, so its volumes can be mounted by a job
+func Quiesced(c controllerclient.Client, dep *appsv1.Deployment) (bool, error) {
+	if Replicas(dep) != 0 || dep.Status.Replicas != 0 {
+		return false, nil
+	}
+	pods := &corev1.PodList{}
+	if err := c.List(context.TODO(), pods, client.InNamespace(dep.GetNamespace()), client.MatchingLabels{"app": dep.GetName()}); err != nil {
+		return false, errors.Wrap(err, "list pods")
+	}
+	return len(pods.Items) == 0, nil
+}
+
+// TransferJob builds the job which uploads volumes of dep to, or with restore downloads them from, presigned urls.
+// The job fails by itself after deadline
+func TransferJob(name, owner string, dep *appsv1.Deployment, image string, restore bool, transfers []Transfer, deadline time.Duration) *batchv1.Job {
+	pvcs := make(map[string]corev1.Volume)
+	for _, v := range PVCVolumes(dep) {
+		pvcs[v.Name] = v
+	}
+
+	volumes := []corev1.Volume{{Name: "scratch", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}}
+	mounts := []corev1.VolumeMount{{Name: "scratch", MountPath: scratchPath}}
+	envs := make([]corev1.EnvVar, 0, len(transfers))
+	script := []string{"set -e"}
+	// a restore swaps in the extracted data only after every archive is extracted, a failed download or
+	// extract leaves all volumes as they were
+	var swaps []string
+	for i, t := range transfers {
+		volume, ok := pvcs[t.Volume]
+		if !ok {
+			continue
+		}
+		volumes = append(volumes, volume)
+		mounts = append(mounts, corev1.VolumeMount{Name: volume.Name, MountPath: dataPath + "/" + volume.Name, ReadOnly: !restore})
+		env := fmt.Sprintf("URL_%d", i)
+		envs = append(envs, corev1.EnvVar{Name: env, Value: t.URL})
+		if restore {
+			script = append(script, extractScript(volume.Name, env)...)
+			swaps = append(swaps, swapScript(volume.Name)...)
+		} else {
+			script = append(script, archiveScript(volume.Name, env)...)
+		}
+	}
+	script = append(script, swaps...)
+
+	var securityContext *corev1.SecurityContext
+	if containers := dep.Spec.Template.Spec.Containers; len(containers) != 0 {
+		securityContext = containers[0].SecurityContext.DeepCopy()
+	}
+	backoffLimit := int32(0)
+	activeDeadline := int64(deadline / time.Second)
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: dep.GetNamespace(),
+			Labels: map[string]string{
+				"job-name": name,
+				"owner":    owner,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          &backoffLimit,
+			ActiveDeadlineSeconds: &activeDeadline,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"job-name": name},
+				},
+				Spec: corev1.PodSpec{
+					ImagePullSecrets: dep.Spec.Template.Spec.ImagePullSecrets,
+					SecurityContext:  dep.Spec.Template.Spec.SecurityContext.DeepCopy(),
+					RestartPolicy:    corev1.RestartPolicyNever,
+					Containers: []corev1.Container{{
+						Name:            "transfer",
+						Image:           image,
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						Command:         []string{"sh", "-c", strings.Join(script, "\n")},
+						Env:             envs,
+						VolumeMounts:    mounts,
+						SecurityContext: securityContext,
+					}},
+					Volumes: volumes,
+				},
+			},
+		},
+	}
+}
+
+const scratchArchive = scratchPath + "/archive.tar.gz"
+
+func volumeDir(volume string) string {
+	return fmt.Sprintf("'%s/%s'", dataPath, volume)
+}
+
+func extractDir(volume string) string {
+	return fmt.Sprintf("'%s/%s/%s'", dataPath, volume, restoreDir)
+}
+
+func extractScript(volume, urlEnv string) []string {
+	tmp := extractDir(volume)
+	return []string{
+		fmt.Sprintf("echo 'extracting volume %s'", volume),
+		fmt.Sprintf(`curl -sSf -o %s "$%s"`, scratchArchive, urlEnv),
+		fmt.Sprintf("rm -rf %s && mkdir %s", tmp, tmp),
+		fmt.Sprintf("tar xzf %s -C %s", scratchArchive, tmp),
+		"rm -f " + scratchArchive,
+	}
+}
+
+func swapScript(volume string) []string {
+	dir, tmp := volumeDir(volume), extractDir(volume)
+	return []string{
+		fmt.Sprintf("echo 'restoring volume %s'", volume),
+		fmt.Sprintf("find %s -mindepth 1 -maxdepth 1 ! -name %s -exec rm -rf {} +", dir, restoreDir),
+		fmt.Sprintf("find %s -mindepth 1 -maxdepth 1 -exec mv {} %s \\;", tmp, dir),
+		"rmdir " + tmp,
+	}
+}
+
+func archiveScript(volume, urlEnv string) []string {
+	// the object store needs the content length, so the archive is staged first instead of streamed
+	return []string{
+		fmt.Sprintf("echo 'archiving volume %s'", volume),
+		fmt.Sprintf("tar czf %s -C %s .", scratchArchive, volumeDir(volume)),
+		fmt.Sprintf(`curl -sSf -T %s "$%s"`, scratchArchive, urlEnv),
+		"rm -f " + scratchArchive,
+	}
+}
+
+// JobFinished reports whether job has finished and whether it succeeded
+func JobFinished(job *batchv1.Job) (finished bool, succeeded bool) {
+	if job.Status.Succeeded > 0 {
+		return true, true
+	}
+	if job.Status.Failed > 0 {
+		return true, false
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			return true, false
+		}
+	}
+	return false, false
+}
+
+// DeleteJob removes the job with its pods
+func DeleteJob(c controllerclient.Client, namespace, name string) error {
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	err := c.Delete(context.TODO(), job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	return client.IgnoreNotFound(err)
+}
@@ -0,0 +1,251 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util/objectstore"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	CredentialAccessKey = "accessKey"
+	CredentialSecretKey = "secretKey"
+	EncryptionKey       = "encryptionKey"
+)
+
+// Manifest is what an archive keeps besides the volumes, enough to recreate the node
+type Manifest struct {
+	Kind      string            `json:"kind"`
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	// Spec of the node, including its config override
+	Spec json.RawMessage `json:"spec"`
+	// Secrets owned by the node, which hold its crypto material
+	Secrets []ManifestSecret `json:"secrets,omitempty"`
+	// Volumes archived as `<volume>.tar.gz`
+	Volumes []string    `json:"volumes,omitempty"`
+	Time    metav1.Time `json:"time"`
+}
+
+type ManifestSecret struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Type   corev1.SecretType `json:"type,omitempty"`
+	Data   map[string][]byte `json:"data,omitempty"`
+	// Store is set for secrets whose data was read from an external store
+	Store string `json:"store,omitempty"`
+}
+
+// NewStorageClient connects to storage, the operator's minio is used for what storage leaves empty
+func NewStorageClient(c controllerclient.Client, conf *config.Config, namespace string, storage current.BackupStorage) (*objectstore.Client, error) {
+	host, accessKey, secretKey := storage.Host, "", ""
+	if conf.ChaincodeBuildInitConfig != nil {
+		accessKey, secretKey = conf.ChaincodeBuildInitConfig.MinioAccessKey, conf.ChaincodeBuildInitConfig.MinioSecretKey
+		if host == "" {
+			host = conf.ChaincodeBuildInitConfig.MinioHost
+		}
+	}
+	if storage.CredentialSecret != "" {
+		secret := &corev1.Secret{}
+		if err := c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: storage.CredentialSecret}, secret); err != nil {
+			return nil, errors.Wrap(err, "get storage credential")
+		}
+		accessKey, secretKey = string(secret.Data[CredentialAccessKey]), string(secret.Data[CredentialSecretKey])
+	}
+	if host == "" {
+		return nil, errors.New("storage host is not configured")
+	}
+	return objectstore.New(host, storage.Secure, accessKey, secretKey), nil
+}
+
+// ManifestKey reads the key which encrypts the manifests in storage
+func ManifestKey(c controllerclient.Client, namespace string, storage current.BackupStorage) ([]byte, error) {
+	if storage.EncryptionSecret == "" {
+		return nil, errors.New("storage encryption secret is not configured")
+	}
+	secret := &corev1.Secret{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: storage.EncryptionSecret}, secret); err != nil {
+		return nil, errors.Wrap(err, "get storage encryption secret")
+	}
+	key := secret.Data[EncryptionKey]
+	if len(key) != 32 {
+		return nil, errors.Errorf("%s of secret %s must be 32 bytes, get %d", EncryptionKey, storage.EncryptionSecret, len(key))
+	}
+	return key, nil
+}
+
+// Seal encrypts the manifest with AES-256-GCM, the random nonce is prepended to the ciphertext
+func (m *Manifest) Seal(key []byte) ([]byte, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(err, "generate nonce")
+	}
+	return aead.Seal(nonce, nonce, data, nil), nil
+}
+
+// OpenManifest decrypts data sealed by Manifest.Seal
+func OpenManifest(data, key []byte) (*Manifest, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("manifest is too short")
+	}
+	plain, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "decrypt manifest")
+	}
+	manifest := &Manifest{}
+	if err = json.Unmarshal(plain, manifest); err != nil {
+		return nil, errors.Wrap(err, "unmarshal manifest")
+	}
+	return manifest, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "new cipher")
+	}
+	return cipher.NewGCM(block)
+}
+
+// BuildManifest collects the spec and secrets of node, which is the target of kind. The data of secrets kept
+// in an external store is read from store, so the archive is complete on its own.
+func BuildManifest(c controllerclient.Client, store secretstore.Store, kind string, node client.Object, volumes []string) (*Manifest, error) {
+	spec, err := nodeSpec(node)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{
+		Kind:      kind,
+		Name:      node.GetName(),
+		Namespace: node.GetNamespace(),
+		Labels:    node.GetLabels(),
+		Spec:      spec,
+		Volumes:   volumes,
+		Time:      metav1.Now(),
+	}
+
+	secrets := &corev1.SecretList{}
+	if err = c.List(context.TODO(), secrets, client.InNamespace(node.GetNamespace())); err != nil {
+		return nil, errors.Wrap(err, "list secrets")
+	}
+	for _, secret := range secrets.Items {
+		if !ownedBy(secret.GetOwnerReferences(), node) {
+			continue
+		}
+		ms := ManifestSecret{
+			Name:   secret.GetName(),
+			Labels: secret.GetLabels(),
+			Type:   secret.Type,
+			Data:   secret.Data,
+		}
+		if secretstore.IsReference(&secret) {
+			stored, err := store.Get(secret.GetNamespace(), secret.GetName())
+			if err != nil {
+				return nil, errors.Wrapf(err, "read secret %s from store", secret.GetName())
+			}
+			ms.Data = stored.Data
+			ms.Store = secret.Annotations[secretstore.StoreAnnotation]
+		}
+		manifest.Secrets = append(manifest.Secrets, ms)
+	}
+	return manifest, nil
+}
+
+// NewNode rebuilds the node of manifest without any status
+func (m *Manifest) NewNode() (client.Object, error) {
+	node := current.BackupTarget{Kind: m.Kind, Name: m.Name}.NewObject()
+	if node == nil {
+		return nil, errors.Errorf("unsupported kind %s", m.Kind)
+	}
+	if err := m.ApplySpec(node); err != nil {
+		return nil, err
+	}
+	node.SetName(m.Name)
+	node.SetNamespace(m.Namespace)
+	node.SetLabels(m.Labels)
+	return node, nil
+}
+
+// ApplySpec replaces the spec of node with the archived one
+func (m *Manifest) ApplySpec(node client.Object) error {
+	var spec interface{}
+	switch n := node.(type) {
+	case *current.IBPPeer:
+		n.Spec = current.IBPPeerSpec{}
+		spec = &n.Spec
+	case *current.IBPOrderer:
+		n.Spec = current.IBPOrdererSpec{}
+		spec = &n.Spec
+	case *current.IBPCA:
+		n.Spec = current.IBPCASpec{}
+		spec = &n.Spec
+	default:
+		return errors.Errorf("unsupported node %T", node)
+	}
+	return errors.Wrap(json.Unmarshal(m.Spec, spec), "unmarshal node spec")
+}
+
+func nodeSpec(node client.Object) (json.RawMessage, error) {
+	switch n := node.(type) {
+	case *current.IBPPeer:
+		return json.Marshal(n.Spec)
+	case *current.IBPOrderer:
+		return json.Marshal(n.Spec)
+	case *current.IBPCA:
+		return json.Marshal(n.Spec)
+	}
+	return nil, errors.Errorf("unsupported node %T", node)
+}
+
+func ownedBy(refs []metav1.OwnerReference, owner metav1.Object) bool {
+	for _, ref := range refs {
+		if ref.UID == owner.GetUID() {
+			return true
+		}
+	}
+	return false
+}
@@ -39,6 +39,7 @@ import (
 	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
 	cav1 "github.com/IBM-Blockchain/fabric-operator/pkg/apis/ca/v1"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/certificate"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/event"
 	initializer "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/ca"
 	commonconfig "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common/config"
 	controllerclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
@@ -48,6 +49,7 @@ import (
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common/reconcilechecks"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/operatorerrors"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/restart"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/util/pointer"
 	"github.com/IBM-Blockchain/fabric-operator/version"
@@ -153,6 +155,7 @@ type CA struct {
 
 	CertificateManager CertificateManager
 	RenewCertTimers    map[string]*time.Timer
+	SecretStore        secretstore.Store
 
 	Restart RestartManager
 }
@@ -165,17 +168,29 @@ func New(client controllerclient.Client, scheme *runtime.Scheme, config *config.
 		Override: o,
 	}
 	ca.CreateManagers()
-	ca.Initializer = NewInitializer(config.CAInitConfig, scheme, client, ca.GetLabels, config.Operator.CA.Timeouts.HSMInitJob)
-	ca.Restart = restart.New(client, config.Operator.Restart.WaitTime.Get(), config.Operator.Restart.Timeout.Get())
-	ca.CertificateManager = &certificate.CertificateManager{
-		Client: client,
-		Scheme: scheme,
-	}
+	ca.SecretStore = secretstore.New(client, scheme, config.Operator.SecretStore)
+	init := NewInitializer(config.CAInitConfig, scheme, client, ca.GetLabels, config.Operator.CA.Timeouts.HSMInitJob)
+	init.Store = ca.SecretStore
+	ca.Initializer = init
+	restartManager := restart.New(client, config.Operator.Restart.WaitTime.Get(), config.Operator.Restart.Timeout.Get())
+	restartManager.Recorder = config.Recorder()
+	ca.Restart = restartManager
+	certificateManager := certificate.New(client, scheme)
+	certificateManager.Store = ca.SecretStore
+	ca.CertificateManager = certificateManager
 	ca.RenewCertTimers = make(map[string]*time.Timer)
 
 	return ca
 }
 
+// secretStore returns the kubernetes store for CAs created without one
+func (ca *CA) secretStore() secretstore.Store {
+	if ca.SecretStore == nil {
+		return secretstore.NewKubernetes(ca.Client, ca.Scheme)
+	}
+	return ca.SecretStore
+}
+
 func (ca *CA) CreateManagers() {
 	override := ca.Override
 	resourceManager := resourcemanager.New(ca.Client, ca.Scheme)
@@ -533,11 +548,11 @@ func (ca *CA) UpdateConnectionProfile(instance *current.IBPCA) error {
 
 	endpoints := ca.GetEndpoints(instance)
 
-	cacrypto, err := common.GetCACryptoEncoded(ca.Client, instance)
+	cacrypto, err := common.GetCACryptoEncoded(ca.secretStore(), instance)
 	if err != nil {
 		return err
 	}
-	tlscacrypto, err := common.GetTLSCACryptoEncoded(ca.Client, instance)
+	tlscacrypto, err := common.GetTLSCACryptoEncoded(ca.secretStore(), instance)
 	if err != nil {
 		return err
 	}
@@ -663,10 +678,7 @@ func (ca *CA) CreateCACryptoSecret(instance *current.IBPCA, caCrypto map[string]
 	secret.Namespace = instance.Namespace
 	secret.Labels = ca.GetLabels(instance)
 
-	err := ca.Client.Create(context.TODO(), secret, controllerclient.CreateOption{
-		Owner:  instance,
-		Scheme: ca.Scheme,
-	})
+	err := ca.secretStore().Put(secret, instance)
 	if err != nil {
 		return errors.Wrap(err, "failed to create CA crypto secret")
 	}
@@ -684,10 +696,7 @@ func (ca *CA) CreateTLSCACryptoSecret(instance *current.IBPCA, tlscaCrypto map[s
 	secret.Namespace = instance.Namespace
 	secret.Labels = ca.GetLabels(instance)
 
-	err := ca.Client.Create(context.TODO(), secret, controllerclient.CreateOption{
-		Owner:  instance,
-		Scheme: ca.Scheme,
-	})
+	err := ca.secretStore().Put(secret, instance)
 	if err != nil {
 		return errors.Wrap(err, "failed to create TLS CA crypto secret")
 	}
@@ -954,15 +963,17 @@ func (ca *CA) HandleActions(instance *current.IBPCA, update Update) error {
 	orig := instance.DeepCopy()
 
 	if update.RenewTLSCert() {
-		if err := common.BackupCACrypto(ca.Client, ca.Scheme, instance, ca.GetLabels(instance)); err != nil {
+		if err := common.BackupCACrypto(ca.Client, ca.secretStore(), ca.Scheme, instance, ca.GetLabels(instance)); err != nil {
 			return errors.Wrap(err, "failed to backup crypto before renewing cert")
 		}
 
 		if err := ca.RenewCert(instance, ca.GetEndpointsDNS(instance)); err != nil {
 			log.Error(err, "Resetting action flag on failure")
+			event.Warning(ca.Config.Recorder(), instance, event.TLSCertRenewFailed, "failed to renew tls certificate: %s", err)
 			instance.ResetTLSRenew()
 			return err
 		}
+		event.Normal(ca.Config.Recorder(), instance, event.TLSCertRenewed, "renewed tls certificate")
 		instance.ResetTLSRenew()
 	}
 
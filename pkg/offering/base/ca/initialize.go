@@ -33,6 +33,7 @@ import (
 	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/ca/config"
 	caconfig "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/ca/config"
 	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
 
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -56,6 +57,8 @@ type Initialize struct {
 
 	Initializer Initializer
 	Client      k8sclient.Client
+	// Store keeps the crypto secrets, which hold the CA's keys
+	Store secretstore.Store
 }
 
 func NewInitializer(config *initializer.Config, scheme *runtime.Scheme, client k8sclient.Client, labels func(instance v1.Object) map[string]string, timeouts initializer.HSMInitJobTimeouts) *Initialize {
@@ -68,6 +71,14 @@ func NewInitializer(config *initializer.Config, scheme *runtime.Scheme, client k
 	}
 }
 
+// store returns the kubernetes store for initializers created without one
+func (i *Initialize) store() secretstore.Store {
+	if i.Store == nil {
+		return secretstore.NewKubernetes(i.Client, i.Scheme)
+	}
+	return i.Store
+}
+
 func (i *Initialize) HandleEnrollmentCAInit(instance *current.IBPCA, update Update) (*initializer.Response, error) {
 	var err error
 	var resp *initializer.Response
@@ -414,10 +425,7 @@ func (i *Initialize) CreateOrUpdateCryptoSecret(instance *current.IBPCA, caCrypt
 		Type: corev1.SecretTypeOpaque,
 	}
 
-	err := i.Client.CreateOrUpdate(context.TODO(), secret, k8sclient.CreateOrUpdateOption{
-		Owner:  instance,
-		Scheme: i.Scheme,
-	})
+	err := i.store().Put(secret, instance)
 	if err != nil {
 		return errors.Wrap(err, "failed to create/update secret")
 	}
@@ -428,13 +436,7 @@ func (i *Initialize) CreateOrUpdateCryptoSecret(instance *current.IBPCA, caCrypt
 func (i *Initialize) GetCryptoSecret(instance *current.IBPCA, name string) (*corev1.Secret, error) {
 	log.Info(fmt.Sprintf("Getting secret '%s'", name))
 
-	nn := types.NamespacedName{
-		Name:      name,
-		Namespace: instance.GetNamespace(),
-	}
-
-	secret := &corev1.Secret{}
-	err := i.Client.Get(context.TODO(), nn, secret)
+	secret, err := i.store().Get(instance.GetNamespace(), name)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create/update secret")
 	}
@@ -33,6 +33,8 @@ import (
 	"github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources/deployment"
 	dep "github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources/deployment"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources/serviceaccount"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -100,6 +102,13 @@ func (o *Override) CreateDeployment(instance *current.IBPCA, k8sDep *appsv1.Depl
 		caCont.AppendVolumeMountIfMissing("shared", "/data")
 	}
 
+	if o.Config != nil {
+		keyVolumes := []secretstore.KeyVolume{
+			{Volume: "ca-crypto", Secret: instance.Name + "-ca-crypto"},
+			{Volume: "tlsca-crypto", Secret: instance.Name + "-tlsca-crypto"},
+		}
+		common.FetchKeysFromStore(o.Client, o.Config.Operator.SecretStore, deployment, instance.Namespace, keyVolumes)
+	}
 	deployment.AppendSecretVolumeIfMissing("ca-crypto", instance.Name+"-ca-crypto")
 	deployment.AppendSecretVolumeIfMissing("tlsca-crypto", instance.Name+"-tlsca-crypto")
 	deployment.AppendConfigMapVolumeIfMissing("ca-config", instance.Name+"-ca-config")
@@ -19,12 +19,8 @@
 package override
 
 import (
-	"encoding/json"
-	"strings"
-
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
-	v1 "github.com/IBM-Blockchain/fabric-operator/pkg/apis/ca/v1"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
 	corev1 "k8s.io/api/core/v1"
@@ -37,37 +33,7 @@ type Override struct {
 }
 
 func (o *Override) IsPostgres(instance *current.IBPCA) bool {
-	if instance.Spec.ConfigOverride != nil {
-		if instance.Spec.ConfigOverride.CA != nil {
-			caOverrides := &v1.ServerConfig{}
-			err := json.Unmarshal(instance.Spec.ConfigOverride.CA.Raw, caOverrides)
-			if err != nil {
-				return false
-			}
-
-			if caOverrides.DB != nil {
-				if strings.ToLower(caOverrides.DB.Type) == "postgres" {
-					return true
-				}
-			}
-		}
-
-		if instance.Spec.ConfigOverride.TLSCA != nil {
-			tlscaOverrides := &v1.ServerConfig{}
-			err := json.Unmarshal(instance.Spec.ConfigOverride.TLSCA.Raw, tlscaOverrides)
-			if err != nil {
-				return false
-			}
-
-			if tlscaOverrides.DB != nil {
-				if strings.ToLower(tlscaOverrides.DB.Type) == "postgres" {
-					return true
-				}
-			}
-		}
-	}
-
-	return false
+	return instance.UsingPostgres()
 }
 
 func (o *Override) GetAffinity(instance *current.IBPCA) *corev1.Affinity {
@@ -0,0 +1,111 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package certificateinventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/certificate/inventory"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var log = logf.Log.WithName("base_certificateinventory")
+
+type CertificateInventory interface {
+	Reconcile(*current.CertificateInventory) (common.Result, error)
+}
+
+type baseCertificateInventory struct {
+	client controllerclient.Client
+
+	Scheme  *runtime.Scheme
+	Config  *config.Config
+	Metrics *inventory.Metrics
+}
+
+var _ CertificateInventory = &baseCertificateInventory{}
+
+func New(client controllerclient.Client, scheme *runtime.Scheme, conf *config.Config) CertificateInventory {
+	return &baseCertificateInventory{client: client, Scheme: scheme, Config: conf, Metrics: inventory.DefaultMetrics}
+}
+
+// Reconcile refreshes the certificates in status and metrics, then requeues after the refresh interval
+func (b *baseCertificateInventory) Reconcile(instance *current.CertificateInventory) (common.Result, error) {
+	now := time.Now()
+	certs, err := inventory.Collect(b.client, secretstore.New(b.client, b.Scheme, b.Config.Operator.SecretStore), instance.Spec.Namespaces, now, instance.GetExpiringWithin())
+	if err != nil {
+		log.Error(err, "failed to collect certificates", "inventory", instance.GetName())
+		setStatus(instance, current.Error, err.Error())
+	} else {
+		b.Metrics.Set(instance.GetName(), certs)
+		refreshTime := metav1.NewTime(now)
+		instance.Status.LastRefreshTime = &refreshTime
+		instance.Status.Certificates = certs
+		instance.Status.Summary = inventory.Summarize(certs)
+		setStatus(instance, statusType(instance.Status.Summary), message(instance.Status.Summary))
+	}
+
+	if err := b.patchStatus(instance); err != nil {
+		return common.Result{}, err
+	}
+	return common.Result{Result: reconcile.Result{RequeueAfter: instance.GetRefreshInterval()}}, nil
+}
+
+func statusType(summary current.CertificateSummary) current.IBPCRStatusType {
+	switch {
+	case summary.Expired > 0:
+		return current.Error
+	case summary.Expiring > 0:
+		return current.Warning
+	default:
+		return current.Deployed
+	}
+}
+
+func message(summary current.CertificateSummary) string {
+	return fmt.Sprintf("%d certificates, %d expiring and %d expired", summary.Total, summary.Expiring, summary.Expired)
+}
+
+func setStatus(instance *current.CertificateInventory, statusType current.IBPCRStatusType, message string) {
+	instance.Status.Type = statusType
+	instance.Status.Status = current.True
+	instance.Status.Message = message
+	instance.Status.LastHeartbeatTime = metav1.Now()
+}
+
+func (b *baseCertificateInventory) patchStatus(instance *current.CertificateInventory) error {
+	return b.client.PatchStatus(context.TODO(), instance, nil, controllerclient.PatchOption{
+		Resilient: &controllerclient.ResilientPatch{
+			Retry:    2,
+			Into:     &current.CertificateInventory{},
+			Strategy: client.MergeFrom,
+		},
+	})
+}
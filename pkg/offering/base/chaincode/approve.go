@@ -19,18 +19,18 @@
 package chaincode
 
 import (
-	"context"
 	"fmt"
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/connector"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/metrics"
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/resmgmt"
 	lcpackager "github.com/hyperledger/fabric-sdk-go/pkg/fab/ccpackager/lifecycle"
 	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
-	"k8s.io/apimachinery/pkg/types"
 )
 
 const (
@@ -44,6 +44,10 @@ func (c *baseChaincode) ApproveChaincode(instance *current.Chaincode) (string, e
 	if err != nil {
 		return err.Error(), err
 	}
+	collections, err := ChaincodeCollections(instance)
+	if err != nil {
+		return err.Error(), err
+	}
 	packagePath := fmt.Sprintf("%s/%s", ChaincodeStorageDir("", instance), ChaincodePacakgeFile(instance))
 	log.Info(fmt.Sprintf("%s full packagePath %s", method, packagePath))
 
@@ -74,35 +78,12 @@ func (c *baseChaincode) ApproveChaincode(instance *current.Chaincode) (string, e
 		return err.Error(), err
 	}
 
-	log.Info(fmt.Sprintf("%s get network %s", method, ch.Spec.Network))
-	network := current.Network{}
-	if err = c.client.Get(context.TODO(), types.NamespacedName{Name: ch.Spec.Network}, &network); err != nil {
-		log.Error(err, ")")
-		return err.Error(), err
-	}
-
-	orderOrg := network.Labels[networkOrgLabel]
-	log.Info(fmt.Sprintf("%s channel %s' network initiator %s", method, ch.GetName(), orderOrg))
-	orderList, err := getOrderNodes(c.client, orderOrg, network.GetName())
+	selectOne, err := SetChannelOrderer(c.client, connectProfile, ch)
 	if err != nil {
 		log.Error(err, "")
 		return err.Error(), err
 	}
-
-	selectOne := ""
-	for _, o := range orderList.Items {
-		cur := current.NamespacedName{Name: o.GetName(), Namespace: o.GetNamespace()}
-		if err = connectProfile.SetOrderer(c.client, cur); err != nil {
-			log.Error(err, "")
-			continue
-		}
-		selectOne = cur.String()
-		break
-	}
-	if selectOne == "" {
-		err = fmt.Errorf("org %s can't find orderer node", orderOrg)
-		return err.Error(), err
-	}
+	log.Info(fmt.Sprintf("%s channel %s pick orderer %s", method, ch.GetName(), selectOne))
 
 	peerConnector, err := NewChaincodeConnector(connectProfile)
 	if err != nil {
@@ -113,6 +94,8 @@ func (c *baseChaincode) ApproveChaincode(instance *current.Chaincode) (string, e
 
 	buf := strings.Builder{}
 	var finalErr error
+	progress := orgProgress{}
+	defer setOrgApproved(instance, progress)
 	for orgName, p := range orgPeer {
 		peer := current.NamespacedName{Name: p.GetName(), Namespace: p.GetNamespace()}
 		orgAdminCtx := peerConnector.SDK().Context(fabsdk.WithUser(peerAdmin[peer.String()]), fabsdk.WithOrg(orgName))
@@ -122,6 +105,7 @@ func (c *baseChaincode) ApproveChaincode(instance *current.Chaincode) (string, e
 			finalErr = err
 			log.Error(err, out)
 			buf.WriteString(out)
+			progress.peer(orgName, false)
 			continue
 		}
 
@@ -133,6 +117,7 @@ func (c *baseChaincode) ApproveChaincode(instance *current.Chaincode) (string, e
 		if err == nil {
 			log.Info(fmt.Sprintf("%s chaincode %s has been approved on the peer %s", http.MethodHead, instance.GetName(), peer.String()))
 			buf.WriteString(fmt.Sprintf(approveOutputTemplate, peer.Namespace, peer.Name, Approved))
+			progress.peer(orgName, true)
 			continue
 		}
 		log.Error(err, fmt.Sprintf("failed to query apprvoed cc for peer %s, continue to approve logic", peer.String()))
@@ -146,17 +131,23 @@ func (c *baseChaincode) ApproveChaincode(instance *current.Chaincode) (string, e
 			ValidationPlugin:  DefaultValidationPlugin,
 			InitRequired:      instance.Spec.InitRequired,
 			SignaturePolicy:   signedPolicy,
+			CollectionConfig:  collections,
 		}
 
-		if _, err = pc.LifecycleApproveCC(ch.GetChannelID(), req,
-			resmgmt.WithTargetEndpoints(peer.String()), resmgmt.WithOrdererEndpoint(selectOne)); err != nil {
+		start := time.Now()
+		_, err = pc.LifecycleApproveCC(ch.GetChannelID(), req,
+			resmgmt.WithTargetEndpoints(peer.String()), resmgmt.WithOrdererEndpoint(selectOne))
+		metrics.ObserveSDKCall(metrics.OperationApprove, start, err)
+		if err != nil {
 			finalErr = err
 			log.Error(err, fmt.Sprintf("%s failed to approve chaincode %s with req %+v\n", method, instance.GetName(), req))
 			buf.WriteString(fmt.Sprintf(approveOutputTemplate, peer.Namespace, peer.Name, Failed))
+			progress.peer(orgName, false)
 			continue
 		}
 		log.Info(fmt.Sprintf("%s org %s peer %s approved", method, orgName, peer.String()))
 		buf.WriteString(fmt.Sprintf(approveOutputTemplate, peer.Namespace, peer.Name, Approved))
+		progress.peer(orgName, true)
 	}
 
 	return buf.String(), finalErr
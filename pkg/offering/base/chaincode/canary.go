@@ -0,0 +1,186 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chaincode
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/connector"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/metrics"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/resmgmt"
+	lcpackager "github.com/hyperledger/fabric-sdk-go/pkg/fab/ccpackager/lifecycle"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
+)
+
+const (
+	canaryOutputTemplate = `org[%s] canary status %s.`
+)
+
+// CanaryChecker lets the canary organizations run the new package before the new definition is committed.
+// Package IDs are local to an organization, so each canary organization re-approves the committed
+// definition with the new package ID and its peers launch the new chaincode pods while the other
+// organizations keep the old ones. It fails until the new package runs on all peers of the canary organizations.
+func (c *baseChaincode) CanaryChecker(instance *current.Chaincode) (string, error) {
+	method := fmt.Sprintf("%s [base.chaincode.CanaryChecker]", stepPrefix)
+
+	packagePath := fmt.Sprintf("%s/%s", ChaincodeStorageDir("", instance), ChaincodePacakgeFile(instance))
+	packageBytes, err := os.ReadFile(packagePath)
+	if err != nil {
+		log.Error(err, "")
+		return "can't read package file", err
+	}
+	packageID := lcpackager.ComputePackageID(instance.Spec.Label, packageBytes)
+	log.Info(fmt.Sprintf("%s chaincode package id %s", method, packageID))
+
+	ch, err := instance.GetChannel(c.client)
+	if err != nil {
+		log.Error(err, "")
+		return err.Error(), err
+	}
+
+	connectProfile, err := connector.ChannelProfile(c.client, ch.GetName())
+	if err != nil {
+		log.Error(err, "")
+		return err.Error(), err
+	}
+	orgPeer, peerAdmin, err := SetChannelPeerProfile(c.client, connectProfile, ch)
+	if err != nil {
+		log.Error(err, "")
+		return err.Error(), err
+	}
+	selectOne, err := SetChannelOrderer(c.client, connectProfile, ch)
+	if err != nil {
+		log.Error(err, "")
+		return err.Error(), err
+	}
+
+	peerConnector, err := NewChaincodeConnector(connectProfile)
+	if err != nil {
+		log.Error(err, fmt.Sprintf("%s chaincode get new connector error", method))
+		return "chaincode get new connector error", err
+	}
+	defer peerConnector.Close()
+
+	var committed *resmgmt.LifecycleChaincodeDefinition
+	buf := strings.Builder{}
+	pending := make([]string, 0)
+	orgRunning := c.orgRunningFunc(instance, packageID)
+	for _, org := range instance.CanaryOrganizations() {
+		p, ok := orgPeer[org]
+		if !ok {
+			err = fmt.Errorf("canary organization %s is not a member of channel %s", org, ch.GetName())
+			return err.Error(), err
+		}
+		peer := current.NamespacedName{Name: p.GetName(), Namespace: p.GetNamespace()}
+		orgAdminCtx := peerConnector.SDK().Context(fabsdk.WithUser(peerAdmin[peer.String()]), fabsdk.WithOrg(org))
+		pc, err := resmgmt.New(orgAdminCtx)
+		if err != nil {
+			log.Error(err, fmt.Sprintf(canaryOutputTemplate, org, "SdkErr"))
+			return err.Error(), err
+		}
+
+		if committed == nil {
+			committed, err = committedDefinition(pc, ch.GetChannelID(), instance.Spec.ID, peer.String())
+			if err != nil {
+				log.Error(err, "")
+				return err.Error(), err
+			}
+			if committed == nil || committed.Sequence >= instance.Status.Sequence {
+				// nothing older runs on the channel to canary against
+				log.Info(fmt.Sprintf("%s no committed definition older than sequence %d, skip canary", method, instance.Status.Sequence))
+				return "no committed definition to canary against", nil
+			}
+		}
+
+		if err = approveCommittedForOrg(pc, ch.GetChannelID(), committed, packageID, peer.String(), selectOne); err != nil {
+			log.Error(err, fmt.Sprintf("%s org %s failed to approve package %s for sequence %d", method, org, packageID, committed.Sequence))
+			setOrgHealthy(instance, org, false, err.Error())
+			buf.WriteString(fmt.Sprintf(canaryOutputTemplate, org, Failed))
+			pending = append(pending, org)
+			continue
+		}
+		instance.Status.Organization(org).Canary = true
+
+		running, out, err := orgRunning(joinedPeers(ch, org))
+		setOrgHealthy(instance, org, running, out)
+		if err != nil {
+			log.Error(err, "")
+			return err.Error(), err
+		}
+		buf.WriteString(fmt.Sprintf(canaryOutputTemplate, org, out))
+		if !running {
+			pending = append(pending, org)
+		}
+	}
+	if len(pending) > 0 {
+		err = fmt.Errorf("canary organizations %s are not healthy yet", strings.Join(pending, ","))
+		buf.WriteString(err.Error())
+		return buf.String(), err
+	}
+	return buf.String(), nil
+}
+
+// committedDefinition returns the committed definition of chaincode, nil if it is not committed yet
+func committedDefinition(pc *resmgmt.Client, channelID, name, peer string) (*resmgmt.LifecycleChaincodeDefinition, error) {
+	lcd, err := pc.LifecycleQueryCommittedCC(channelID, resmgmt.LifecycleQueryCommittedCCRequest{Name: name}, resmgmt.WithTargetEndpoints(peer))
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf("namespace %s is not defined", name)) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	for i := range lcd {
+		if lcd[i].Name == name {
+			return &lcd[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// approveCommittedForOrg approves the committed definition again with packageID, which only changes the
+// package the peers of the organization run
+func approveCommittedForOrg(pc *resmgmt.Client, channelID string, committed *resmgmt.LifecycleChaincodeDefinition, packageID, peer, orderer string) error {
+	approved, err := pc.LifecycleQueryApprovedCC(channelID, resmgmt.LifecycleQueryApprovedCCRequest{
+		Name:     committed.Name,
+		Sequence: committed.Sequence,
+	}, resmgmt.WithTargetEndpoints(peer))
+	if err == nil && approved.PackageID == packageID {
+		return nil
+	}
+	req := resmgmt.LifecycleApproveCCRequest{
+		Name:                committed.Name,
+		Version:             committed.Version,
+		PackageID:           packageID,
+		Sequence:            committed.Sequence,
+		EndorsementPlugin:   committed.EndorsementPlugin,
+		ValidationPlugin:    committed.ValidationPlugin,
+		SignaturePolicy:     committed.SignaturePolicy,
+		ChannelConfigPolicy: committed.ChannelConfigPolicy,
+		CollectionConfig:    committed.CollectionConfig,
+		InitRequired:        committed.InitRequired,
+	}
+	start := time.Now()
+	_, err = pc.LifecycleApproveCC(channelID, req, resmgmt.WithTargetEndpoints(peer), resmgmt.WithOrdererEndpoint(orderer))
+	metrics.ObserveSDKCall(metrics.OperationApprove, start, err)
+	return err
+}
@@ -25,7 +25,9 @@ import (
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/event"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/metrics"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -55,6 +57,8 @@ type Chaincode interface {
 	Package(*current.Chaincode) error
 	Install(*current.Chaincode) error
 	Approve(*current.Chaincode) error
+	// Canary waits for the canary organizations to run the new package before commit
+	Canary(*current.Chaincode) error
 	Commit(*current.Chaincode) error
 
 	// R check if the chaincode is already running
@@ -78,6 +82,8 @@ func (c *baseChaincode) chaincodeProcess(instance *current.Chaincode) error {
 		return c.Install(instance)
 	case current.ChaincodeCondApproved:
 		return c.Approve(instance)
+	case current.ChaincodeCondCanary:
+		return c.Canary(instance)
 	case current.ChaincodeCondCommitted:
 		return c.Commit(instance)
 	case current.ChaincodeCondRunning:
@@ -144,7 +150,10 @@ func (c *baseChaincode) Package(instance *current.Chaincode) error {
 		Reason:             "chaincode packaged successfully",
 		Message:            "chaincode packaged successfully",
 	}
-	if _, err := c.PackageForK8s(instance); err != nil {
+	start := time.Now()
+	_, err := c.PackageForK8s(instance)
+	metrics.ObserveStep(metrics.KindChaincode, metrics.StepPackage, start, &err)
+	if err != nil {
 		log.Info("an error occurred in the packing process")
 		expectCond.Type = current.ChaincodeCondError
 		expectCond.Status = metav1.ConditionFalse
@@ -153,6 +162,7 @@ func (c *baseChaincode) Package(instance *current.Chaincode) error {
 		expectCond.NextStage = current.ChaincodeCondPackaged
 	}
 	log.Info(fmt.Sprintf("%s package stage with condition %+v", method, expectCond))
+	event.Chaincode(c.Config.Recorder(), instance, current.ChaincodeCondPackaged, expectCond)
 	if len(conditions) == maxCondHistory {
 		for i := 0; i < maxCondHistory-1; i++ {
 			conditions[i] = conditions[i+1]
@@ -176,7 +186,9 @@ func (c *baseChaincode) Install(instance *current.Chaincode) error {
 		Reason:             "chaincode installed successfully",
 		Message:            "chaincode installed successfully",
 	}
+	start := time.Now()
 	reason, err := c.InstallChaincode(instance)
+	metrics.ObserveStep(metrics.KindChaincode, metrics.StepInstall, start, &err)
 	expectCond.Reason = reason
 	if err != nil {
 		log.Info("an error occurred in the intalling process")
@@ -187,6 +199,7 @@ func (c *baseChaincode) Install(instance *current.Chaincode) error {
 		expectCond.NextStage = current.ChaincodeCondInstalled
 	}
 	log.Info(fmt.Sprintf("%s install stage with condition %+v", method, expectCond))
+	event.Chaincode(c.Config.Recorder(), instance, current.ChaincodeCondInstalled, expectCond)
 	if len(conditions) == maxCondHistory {
 		for i := 0; i < maxCondHistory-1; i++ {
 			conditions[i] = conditions[i+1]
@@ -210,7 +223,9 @@ func (c *baseChaincode) Approve(instance *current.Chaincode) error {
 		Reason:             "chaincode approved successfully",
 		Message:            "chaincode approved successfully",
 	}
+	start := time.Now()
 	reason, err := c.ApproveChaincode(instance)
+	metrics.ObserveStep(metrics.KindChaincode, metrics.StepApprove, start, &err)
 	expectCond.Reason = reason
 	if err != nil {
 		log.Info("an error occurred in the approving process")
@@ -221,6 +236,45 @@ func (c *baseChaincode) Approve(instance *current.Chaincode) error {
 		expectCond.NextStage = current.ChaincodeCondApproved
 	}
 	log.Info(fmt.Sprintf("%s approve stage with condition %+v", method, expectCond))
+	event.Chaincode(c.Config.Recorder(), instance, current.ChaincodeCondApproved, expectCond)
+
+	if len(conditions) == maxCondHistory {
+		for i := 0; i < maxCondHistory-1; i++ {
+			conditions[i] = conditions[i+1]
+		}
+		conditions[maxCondHistory-1] = expectCond
+	} else {
+		conditions = append(conditions, expectCond)
+	}
+
+	instance.Status.Conditions = conditions
+	return c.PatchStatus(context.Background(), instance)
+}
+
+func (c *baseChaincode) Canary(instance *current.Chaincode) error {
+	method := fmt.Sprintf("%s base.chaincode.Canary", stepPrefix)
+	conditions := instance.Status.Conditions
+	expectCond := current.ChaincodeCondition{
+		Type:               current.ChaincodeCondCanary,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             "canary organizations are healthy",
+		Message:            "canary organizations are healthy",
+	}
+	start := time.Now()
+	reason, err := c.CanaryChecker(instance)
+	metrics.ObserveStep(metrics.KindChaincode, metrics.StepCanary, start, &err)
+	expectCond.Reason = reason
+	if err != nil {
+		log.Info("canary organizations are not healthy")
+		expectCond.Type = current.ChaincodeCondError
+		expectCond.Status = metav1.ConditionFalse
+		expectCond.LastTransitionTime = metav1.Now()
+		expectCond.Message = "canary organizations are not healthy"
+		expectCond.NextStage = current.ChaincodeCondCanary
+	}
+	log.Info(fmt.Sprintf("%s canary stage with condition %+v", method, expectCond))
+	event.Chaincode(c.Config.Recorder(), instance, current.ChaincodeCondCanary, expectCond)
 
 	if len(conditions) == maxCondHistory {
 		for i := 0; i < maxCondHistory-1; i++ {
@@ -245,7 +299,9 @@ func (c *baseChaincode) Commit(instance *current.Chaincode) error {
 		Reason:             "chaincode committed successfully",
 		Message:            "chaincode committed successfully",
 	}
+	start := time.Now()
 	reason, err := c.CommitChaincode(instance)
+	metrics.ObserveStep(metrics.KindChaincode, metrics.StepCommit, start, &err)
 	expectCond.Reason = reason
 	if err != nil {
 		log.Info("an error occurred in the commiting process")
@@ -257,6 +313,7 @@ func (c *baseChaincode) Commit(instance *current.Chaincode) error {
 	}
 
 	log.Info(fmt.Sprintf("%s commit stage with condition %+v", method, expectCond))
+	event.Chaincode(c.Config.Recorder(), instance, current.ChaincodeCondCommitted, expectCond)
 	if len(conditions) == maxCondHistory {
 		for i := 0; i < maxCondHistory-1; i++ {
 			conditions[i] = conditions[i+1]
@@ -291,7 +348,9 @@ func (c *baseChaincode) R(instance *current.Chaincode) error {
 	}
 	prePhase := instance.Status.Phase
 	instance.Status.Phase = current.ChaincodePhaseRunning
+	start := time.Now()
 	reason, err := c.RunningChecker(instance)
+	metrics.ObserveStep(metrics.KindChaincode, metrics.StepRunning, start, &err)
 
 	expectCond.Reason = reason
 	if err != nil {
@@ -299,10 +358,14 @@ func (c *baseChaincode) R(instance *current.Chaincode) error {
 		expectCond.Status = metav1.ConditionFalse
 		expectCond.LastTransitionTime = metav1.Now()
 		expectCond.Message = reason
+		if rollback := instance.Status.Rollback; rollback != nil && rollback.Sequence == instance.Status.Sequence {
+			expectCond.Message = fmt.Sprintf("rolled back version %s by proposal %s is not running: %s", rollback.Version, rollback.Proposal, reason)
+		}
 		expectCond.NextStage = current.ChaincodeCondRunning
 		instance.Status.Phase = prePhase
 	}
 	log.Info(fmt.Sprintf("%s check running stage with condition %+v", method, expectCond))
+	event.Chaincode(c.Config.Recorder(), instance, current.ChaincodeCondRunning, expectCond)
 	if len(conditions) == maxCondHistory {
 		for i := 0; i < maxCondHistory-1; i++ {
 			conditions[i] = conditions[i+1]
@@ -19,15 +19,15 @@
 package chaincode
 
 import (
-	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/connector"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/metrics"
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/resmgmt"
 	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
-	"k8s.io/apimachinery/pkg/types"
 )
 
 const (
@@ -41,6 +41,10 @@ func (c *baseChaincode) CommitChaincode(instance *current.Chaincode) (string, er
 	if err != nil {
 		return err.Error(), err
 	}
+	collections, err := ChaincodeCollections(instance)
+	if err != nil {
+		return err.Error(), err
+	}
 
 	ch, err := instance.GetChannel(c.client)
 	log.Info(fmt.Sprintf("%s get channel %s info", method, instance.Spec.Channel))
@@ -61,36 +65,18 @@ func (c *baseChaincode) CommitChaincode(instance *current.Chaincode) (string, er
 		return err.Error(), err
 	}
 
-	log.Info(fmt.Sprintf("%s get network %s", method, ch.Spec.Network))
-	network := current.Network{}
-	if err = c.client.Get(context.TODO(), types.NamespacedName{Name: ch.Spec.Network}, &network); err != nil {
+	externalPeers, err := SetExternalChannelPeers(c.client, connectProfile, ch)
+	if err != nil {
 		log.Error(err, "")
 		return err.Error(), err
 	}
 
-	orderOrg := network.Labels[networkOrgLabel]
-	log.Info(fmt.Sprintf("%s channel %s' network initiator %s", method, ch.GetName(), orderOrg))
-	orderList, err := getOrderNodes(c.client, orderOrg, network.GetName())
+	selectOne, err := SetChannelOrderer(c.client, connectProfile, ch)
 	if err != nil {
 		log.Error(err, "")
 		return err.Error(), err
 	}
-
-	selectOne := ""
-	for _, o := range orderList.Items {
-		cur := current.NamespacedName{Name: o.GetName(), Namespace: o.GetNamespace()}
-		if err = connectProfile.SetOrderer(c.client, cur); err != nil {
-			log.Error(err, "")
-			continue
-		}
-
-		selectOne = cur.String()
-		break
-	}
-	if selectOne == "" {
-		err = fmt.Errorf("org %s can't find orderer node", orderOrg)
-		return err.Error(), err
-	}
+	log.Info(fmt.Sprintf("%s channel %s pick orderer %s", method, ch.GetName(), selectOne))
 
 	peerConnector, err := NewChaincodeConnector(connectProfile)
 	if err != nil {
@@ -122,6 +108,7 @@ func (c *baseChaincode) CommitChaincode(instance *current.Chaincode) (string, er
 		}
 		targetPoints = append(targetPoints, peer.String())
 	}
+	targetPoints = append(targetPoints, externalPeers...)
 
 	ccReadinessReq := resmgmt.LifecycleCheckCCCommitReadinessRequest{
 		Name:              instance.Spec.ID,
@@ -130,6 +117,7 @@ func (c *baseChaincode) CommitChaincode(instance *current.Chaincode) (string, er
 		EndorsementPlugin: DefaultEndorsementPlugin,
 		ValidationPlugin:  DefaultValidationPlugin,
 		SignaturePolicy:   signedPoilciy,
+		CollectionConfig:  collections,
 		InitRequired:      instance.Spec.InitRequired,
 	}
 	resp, _ := pc.LifecycleCheckCCCommitReadiness(ch.GetChannelID(), ccReadinessReq, resmgmt.WithTargetEndpoints(peer.String()))
@@ -174,11 +162,15 @@ func (c *baseChaincode) CommitChaincode(instance *current.Chaincode) (string, er
 		EndorsementPlugin: DefaultEndorsementPlugin,
 		ValidationPlugin:  DefaultValidationPlugin,
 		SignaturePolicy:   signedPoilciy,
+		CollectionConfig:  collections,
 		InitRequired:      instance.Spec.InitRequired,
 	}
-	if _, err = pc.LifecycleCommitCC(ch.GetChannelID(), req,
+	start := time.Now()
+	_, err = pc.LifecycleCommitCC(ch.GetChannelID(), req,
 		resmgmt.WithOrdererEndpoint(selectOne),
-		resmgmt.WithTargetEndpoints(targetPoints...)); err != nil {
+		resmgmt.WithTargetEndpoints(targetPoints...))
+	metrics.ObserveSDKCall(metrics.OperationCommit, start, err)
+	if err != nil {
 		log.Error(err, fmt.Sprintf("%s failed to committed chaincode %s with req %+v\n", method, instance.GetName(), req))
 		return err.Error(), err
 	}
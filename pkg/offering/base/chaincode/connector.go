@@ -58,12 +58,43 @@ func getOrderNodes(cli controllerclient.Client, namespace, parentNode string) (*
 	return orderList, err
 }
 
-// SetChannelPeerProfile set the peer's connection information and return the peer's organization admin
+// SetChannelOrderer set the connection information of the first available orderer of channel's network and return it
+func SetChannelOrderer(cli controllerclient.Client, p *connector.Profile, ch *current.Channel) (string, error) {
+	network := current.Network{}
+	if err := cli.Get(context.TODO(), types.NamespacedName{Name: ch.Spec.Network}, &network); err != nil {
+		return "", err
+	}
+
+	orderOrg := network.Labels[networkOrgLabel]
+	orderList, err := getOrderNodes(cli, orderOrg, network.GetName())
+	if err != nil {
+		return "", err
+	}
+	for _, o := range orderList.Items {
+		cur := current.NamespacedName{Name: o.GetName(), Namespace: o.GetNamespace()}
+		if err = p.SetOrderer(cli, cur); err != nil {
+			log.Error(err, "")
+			continue
+		}
+		return cur.String(), nil
+	}
+	return "", fmt.Errorf("org %s can't find orderer node", orderOrg)
+}
+
+// SetChannelPeerProfile set the peer's connection information and return the peer's organization admin.
+// External organizations are skipped, they install and approve chaincodes in their own clusters.
 func SetChannelPeerProfile(cli controllerclient.Client, p *connector.Profile, ch *current.Channel) (map[string]current.IBPPeer, map[string]string, error) {
 	orgPeers := make(map[string]current.IBPPeer)
 	peerAdmin := make(map[string]string)
 	info := p.GetChannel(ch.GetChannelID())
 	for _, memberOrg := range ch.GetMembers() {
+		external, err := current.IsExternalOrganization(context.TODO(), cli, memberOrg.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if external {
+			continue
+		}
 		org := &current.Organization{}
 		if err := cli.Get(context.TODO(), types.NamespacedName{Name: memberOrg.Name}, org); err != nil {
 			return nil, nil, err
@@ -91,3 +122,27 @@ func SetChannelPeerProfile(cli controllerclient.Client, p *connector.Profile, ch
 	p.Channels[ch.GetChannelID()] = info
 	return orgPeers, peerAdmin, nil
 }
+
+// SetExternalChannelPeers set the connection information of external members and return the first peer of each,
+// which endorses the commit of a chaincode approved by them
+func SetExternalChannelPeers(cli controllerclient.Client, p *connector.Profile, ch *current.Channel) ([]string, error) {
+	peers := make([]string, 0)
+	info := p.GetChannel(ch.GetChannelID())
+	for _, memberOrg := range ch.GetMembers() {
+		external, err := current.GetExternalOrganization(context.TODO(), cli, memberOrg.Name)
+		if err != nil {
+			return nil, err
+		}
+		if external == nil || len(external.Spec.Peers) == 0 {
+			continue
+		}
+		if err = p.SetExternalOrganization(external); err != nil {
+			return nil, err
+		}
+		peer := connector.ExternalNodeName(external, external.Spec.Peers[0])
+		info.Peers[peer] = *connector.DefaultPeerInfo()
+		peers = append(peers, peer)
+	}
+	p.Channels[ch.GetChannelID()] = info
+	return peers, nil
+}
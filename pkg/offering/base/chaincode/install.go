@@ -23,9 +23,11 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/connector"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/metrics"
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/resmgmt"
 	"github.com/hyperledger/fabric-sdk-go/pkg/common/errors/retry"
 	lcpackager "github.com/hyperledger/fabric-sdk-go/pkg/fab/ccpackager/lifecycle"
@@ -66,7 +68,7 @@ func (c *baseChaincode) InstallChaincode(instance *current.Chaincode) (string, e
 
 	// TODO: 这里的peer节点，后面需要改进，在chaincode里写明那些Peer安装，然后这里需要做过滤。目前先按照channel里所有 Join的peer进行安装.
 	for _, peer := range ch.Status.PeerConditions {
-		if peer.Type != current.PeerJoined {
+		if !peer.IsJoined() {
 			log.Info(fmt.Sprintf("%s peer node %s does not join channel %s",
 				method, peer.Name, ch.GetChannelID()))
 			continue
@@ -112,8 +114,10 @@ func (c *baseChaincode) InstallChaincode(instance *current.Chaincode) (string, e
 
 	buf := strings.Builder{}
 	var finalErr error
+	progress := orgProgress{}
+	defer setOrgInstalled(instance, progress, packageID)
 	for _, peer := range ch.Status.PeerConditions {
-		if peer.Type != current.PeerJoined {
+		if !peer.IsJoined() {
 			log.Info(fmt.Sprintf("%s peer node %s has not yet joined the channel %s", method, peer.Name, ch.GetName()))
 			continue
 		}
@@ -124,6 +128,7 @@ func (c *baseChaincode) InstallChaincode(instance *current.Chaincode) (string, e
 			out := fmt.Sprintf(installOutputTemplate, peer.Namespace, peer.Name, "SdkErr")
 			log.Error(err, out)
 			buf.WriteString(out)
+			progress.peer(peer.Namespace, false)
 			continue
 		}
 
@@ -133,6 +138,7 @@ func (c *baseChaincode) InstallChaincode(instance *current.Chaincode) (string, e
 			log.Info(fmt.Sprintf("%s chaincode have been installed on peer %s", method, peer.Name))
 			out := fmt.Sprintf(installOutputTemplate, peer.Namespace, peer.Name, Installed)
 			buf.WriteString(out)
+			progress.peer(peer.Namespace, true)
 			continue
 		}
 		if !strings.Contains(err.Error(), fmt.Sprintf("chaincode install package '%s' not found", packageID)) {
@@ -140,6 +146,7 @@ func (c *baseChaincode) InstallChaincode(instance *current.Chaincode) (string, e
 			log.Error(err, fmt.Sprintf("%s failed to query installed pakcage %s", method, packageID))
 			out := fmt.Sprintf(installOutputTemplate, peer.Namespace, peer.Name, QueryFailed)
 			buf.WriteString(out)
+			progress.peer(peer.Namespace, false)
 			continue
 		}
 
@@ -149,14 +156,19 @@ func (c *baseChaincode) InstallChaincode(instance *current.Chaincode) (string, e
 			Label:   instance.Spec.Label,
 			Package: chaincodeBytes,
 		}
-		if _, err = pc.LifecycleInstallCC(req, resmgmt.WithTargetEndpoints(peer.String()), resmgmt.WithRetry(retry.DefaultResMgmtOpts)); err != nil {
+		start := time.Now()
+		_, err = pc.LifecycleInstallCC(req, resmgmt.WithTargetEndpoints(peer.String()), resmgmt.WithRetry(retry.DefaultResMgmtOpts))
+		metrics.ObserveSDKCall(metrics.OperationInstall, start, err)
+		if err != nil {
 			finalErr = err
 			out := fmt.Sprintf(installOutputTemplate, peer.Namespace, peer.Name, Failed)
 			log.Error(err, fmt.Sprintf("%s failed to intall cc with req %+v", method, req))
 			buf.WriteString(out)
+			progress.peer(peer.Namespace, false)
 			continue
 		}
 		buf.WriteString(fmt.Sprintf(installOutputTemplate, peer.Namespace, peer.Name, Installed))
+		progress.peer(peer.Namespace, true)
 	}
 
 	return buf.String(), finalErr
@@ -0,0 +1,127 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chaincode
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// orgProgress collects the result of each peer of a stage by organization,
+// an organization succeeds only if all of its peers succeed
+type orgProgress map[string]bool
+
+func (p orgProgress) peer(org string, ok bool) {
+	if prev, found := p[org]; found {
+		ok = ok && prev
+	}
+	p[org] = ok
+}
+
+// orgs returns the organizations in a stable order
+func (p orgProgress) orgs() []string {
+	orgs := make([]string, 0, len(p))
+	for org := range p {
+		orgs = append(orgs, org)
+	}
+	sort.Strings(orgs)
+	return orgs
+}
+
+// setOrgInstalled records the install result of each organization in instance status
+func setOrgInstalled(instance *current.Chaincode, progress orgProgress, packageID string) {
+	for _, org := range progress.orgs() {
+		status := instance.Status.Organization(org)
+		if status.Installed != progress[org] || status.PackageID != packageID {
+			status.LastTransitionTime = metav1.Now()
+		}
+		status.Installed = progress[org]
+		status.PackageID = packageID
+	}
+}
+
+// setOrgApproved records the approve result of each organization in instance status
+func setOrgApproved(instance *current.Chaincode, progress orgProgress) {
+	for _, org := range progress.orgs() {
+		status := instance.Status.Organization(org)
+		if status.Approved != progress[org] {
+			status.LastTransitionTime = metav1.Now()
+		}
+		status.Approved = progress[org]
+	}
+}
+
+// setOrgHealthy records whether the package runs on the peers of organization
+func setOrgHealthy(instance *current.Chaincode, org string, healthy bool, message string) {
+	status := instance.Status.Organization(org)
+	if status.Healthy != healthy {
+		status.LastTransitionTime = metav1.Now()
+	}
+	status.Healthy = healthy
+	status.Message = message
+}
+
+// peerChaincodeRunning checks if the chaincode pod of package started by the external builder runs for peer
+func peerChaincodeRunning(cli controllerclient.Client, peer current.NamespacedName, packageID string) (bool, error) {
+	pod := &v1.Pod{}
+	name := PodName(peer.Namespace, peer.Name, packageID)
+	if err := cli.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: peer.Namespace}, pod); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return pod.Status.Phase == v1.PodRunning, nil
+}
+
+// joinedPeers returns the peers of org joined to channel
+func joinedPeers(ch *current.Channel, org string) []current.NamespacedName {
+	peers := make([]current.NamespacedName, 0)
+	for _, p := range ch.Status.PeerConditions {
+		if p.Namespace == org && p.IsJoined() {
+			peers = append(peers, p.NamespacedName)
+		}
+	}
+	return peers
+}
+
+// orgChaincodeRunning checks the chaincode pods of package on peers
+func orgChaincodeRunning(cli controllerclient.Client, peers []current.NamespacedName, packageID string) (bool, string, error) {
+	if len(peers) == 0 {
+		return false, "no peer joined to channel", nil
+	}
+	for _, p := range peers {
+		running, err := peerChaincodeRunning(cli, p, packageID)
+		if err != nil {
+			return false, err.Error(), err
+		}
+		if !running {
+			return false, fmt.Sprintf("chaincode is not running on peer %s", p.String()), nil
+		}
+	}
+	return true, fmt.Sprintf("chaincode is running on %d peers", len(peers)), nil
+}
@@ -0,0 +1,133 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chaincode
+
+import (
+	"context"
+	"testing"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	cmocks "github.com/IBM-Blockchain/fabric-operator/controllers/mocks"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestNextCondCanary(t *testing.T) {
+	instance := &current.Chaincode{}
+	instance.Status.Conditions = []current.ChaincodeCondition{{Type: current.ChaincodeCondApproved}}
+	if next := current.NextCond(instance); next != current.ChaincodeCondCommitted {
+		t.Fatalf("expect %s without canary get %s", current.ChaincodeCondCommitted, next)
+	}
+
+	instance.Spec.Canary = &current.ChaincodeCanary{Organizations: []string{"org1"}}
+	if next := current.NextCond(instance); next != current.ChaincodeCondCanary {
+		t.Fatalf("expect %s with canary get %s", current.ChaincodeCondCanary, next)
+	}
+	instance.Status.Conditions = append(instance.Status.Conditions, current.ChaincodeCondition{Type: current.ChaincodeCondCanary})
+	if next := current.NextCond(instance); next != current.ChaincodeCondCommitted {
+		t.Fatalf("expect %s after canary get %s", current.ChaincodeCondCommitted, next)
+	}
+	instance.Status.Conditions = append(instance.Status.Conditions, current.ChaincodeCondition{Type: current.ChaincodeCondError, NextStage: current.ChaincodeCondCanary})
+	if next := current.NextCond(instance); next != current.ChaincodeCondCanary {
+		t.Fatalf("expect to retry %s get %s", current.ChaincodeCondCanary, next)
+	}
+}
+
+func TestOrganizationProgress(t *testing.T) {
+	instance := &current.Chaincode{}
+	instance.Status.Sequence = 2
+
+	progress := orgProgress{}
+	progress.peer("org1", true)
+	progress.peer("org1", false)
+	progress.peer("org2", true)
+	setOrgInstalled(instance, progress, "label:abc")
+
+	if n := len(instance.Status.Organizations); n != 2 {
+		t.Fatalf("expect 2 organizations get %d", n)
+	}
+	if org1 := instance.Status.Organization("org1"); org1.Installed || org1.PackageID != "label:abc" {
+		t.Errorf("org1 has a failed peer, get %+v", org1)
+	}
+	if org2 := instance.Status.Organization("org2"); !org2.Installed || org2.Sequence != 2 {
+		t.Errorf("org2 installed on all peers, get %+v", org2)
+	}
+
+	setOrgApproved(instance, orgProgress{"org2": true})
+	setOrgHealthy(instance, "org2", true, "running")
+	if org2 := instance.Status.Organization("org2"); !org2.Approved || !org2.Healthy {
+		t.Errorf("org2 approved and healthy, get %+v", org2)
+	}
+
+	// a new sequence starts over
+	instance.Status.Sequence = 3
+	if org2 := instance.Status.Organization("org2"); org2.Installed || org2.Approved || org2.Healthy || org2.Sequence != 3 {
+		t.Errorf("expect org2 reset at sequence 3, get %+v", org2)
+	}
+	if n := len(instance.Status.Organizations); n != 2 {
+		t.Fatalf("expect 2 organizations get %d", n)
+	}
+}
+
+func TestOrgChaincodeRunning(t *testing.T) {
+	peer1 := current.NamespacedName{Namespace: "org1", Name: "peer1"}
+	peer2 := current.NamespacedName{Namespace: "org1", Name: "peer2"}
+	ch := &current.Channel{}
+	ch.Status.PeerConditions = []current.PeerCondition{
+		{NamespacedName: peer1, Type: current.PeerJoined},
+		{NamespacedName: peer2, Type: current.PeerAnchored},
+		{NamespacedName: current.NamespacedName{Namespace: "org1", Name: "peer3"}, Type: current.PeerError},
+		{NamespacedName: current.NamespacedName{Namespace: "org2", Name: "peer1"}, Type: current.PeerJoined},
+	}
+	packageID := "label:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	pods := map[string]corev1.PodPhase{
+		PodName("org1", "peer1", packageID): corev1.PodRunning,
+		PodName("org1", "peer2", packageID): corev1.PodPending,
+	}
+	c := &cmocks.Client{}
+	c.GetStub = func(ctx context.Context, key types.NamespacedName, obj client.Object) error {
+		phase, ok := pods[key.Name]
+		if !ok {
+			return k8serrors.NewNotFound(schema.GroupResource{Resource: "pods"}, key.Name)
+		}
+		obj.(*corev1.Pod).Status.Phase = phase
+		return nil
+	}
+
+	peers := joinedPeers(ch, "org1")
+	if len(peers) != 2 {
+		t.Fatalf("expect 2 joined peers of org1 get %v", peers)
+	}
+	if running, out, err := orgChaincodeRunning(c, peers, packageID); err != nil || running {
+		t.Fatalf("expect peer2 not running, get %t %s %v", running, out, err)
+	}
+
+	pods[PodName("org1", "peer2", packageID)] = corev1.PodRunning
+	if running, out, err := orgChaincodeRunning(c, peers, packageID); err != nil || !running {
+		t.Fatalf("expect org1 running, get %t %s %v", running, out, err)
+	}
+
+	if running, _, err := orgChaincodeRunning(c, joinedPeers(ch, "org3"), packageID); err != nil || running {
+		t.Fatal("expect an organization without peers not running")
+	}
+}
@@ -22,24 +22,24 @@ import (
 	"archive/tar"
 	"bytes"
 	"compress/gzip"
+	"encoding/json"
 	"fmt"
 	"os"
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
-	k8sPackageType  = "k8s"
-	caasPakcageType = "caas"
+	k8sPackageType   = "k8s"
+	ccaasPackageType = "ccaas"
 
 	imageJson = `{
   "name": "%s",
   "digest": "%s"
-}`
-	connectionJson = `{
-  "address": "%s",
-  "dial_timeout": "10s",
-  "tls_required": false
 }`
 	metadataJson = `{
   "type": "%s",
@@ -47,10 +47,54 @@ const (
 }`
 )
 
+// connection is the connection.json of a ccaas package, peers use it to connect to the chaincode server
+type connection struct {
+	Address            string `json:"address"`
+	DialTimeout        string `json:"dial_timeout"`
+	TLSRequired        bool   `json:"tls_required"`
+	ClientAuthRequired bool   `json:"client_auth_required"`
+	RootCert           string `json:"root_cert,omitempty"`
+}
+
+// ConnectionJSON returns the connection.json pointing to the chaincode server, rootCert is the tls CA
+// certificate which issues the server certificate.
+func ConnectionJSON(instance *current.Chaincode, rootCert []byte) ([]byte, error) {
+	conn := connection{
+		Address:     ServerAddress(instance),
+		DialTimeout: "10s",
+		TLSRequired: !instance.Spec.Server.DisableTLS,
+	}
+	if conn.TLSRequired {
+		conn.RootCert = string(rootCert)
+	}
+	return json.MarshalIndent(conn, "", "  ")
+}
+
+// packageCode returns the package type and the files of code.tar.gz
+func (c *baseChaincode) packageCode(instance *current.Chaincode) (string, []compressItem, error) {
+	if !instance.IsCCaaS() {
+		imageContent := fmt.Sprintf(imageJson, instance.Spec.Images.Name, instance.Spec.Images.Digest)
+		return k8sPackageType, []compressItem{{file: "image.json", content: []byte(imageContent)}}, nil
+	}
+
+	var rootCert []byte
+	if !instance.Spec.Server.DisableTLS {
+		org := instance.Spec.Server.Organization
+		tlsca, err := common.GetTLSCACryptoBytes(secretstore.New(c.client, c.Scheme, c.Config.Operator.SecretStore), &current.IBPCA{ObjectMeta: metav1.ObjectMeta{Name: org, Namespace: ServerNamespace(instance)}})
+		if err != nil {
+			return "", nil, errors.Wrapf(err, "failed to get tls CA of organization %s", org)
+		}
+		rootCert = tlsca.Cert
+	}
+	content, err := ConnectionJSON(instance, rootCert)
+	if err != nil {
+		return "", nil, err
+	}
+	return ccaasPackageType, []compressItem{{file: "connection.json", content: content}}, nil
+}
+
 func (c *baseChaincode) PackageForK8s(instance *current.Chaincode) (string, error) {
 	method := fmt.Sprintf("%s [base.chaincode.PackageForK8s]", stepPrefix)
-	imageName := instance.Spec.Images.Name
-	imageSha256 := instance.Spec.Images.Digest
 
 	tmpDir := ChaincodeStorageDir("", instance)
 	log.Info(fmt.Sprintf("%s package store dir %s\n", method, tmpDir))
@@ -66,12 +110,10 @@ func (c *baseChaincode) PackageForK8s(instance *current.Chaincode) (string, erro
 	gw := gzip.NewWriter(&buf)
 	tw := tar.NewWriter(gw)
 
-	imageContent := fmt.Sprintf(imageJson, imageName, imageSha256)
-	compressItems := []compressItem{
-		{
-			file:    "image.json",
-			content: []byte(imageContent),
-		},
+	packageType, compressItems, err := c.packageCode(instance)
+	if err != nil {
+		log.Error(err, "")
+		return err.Error(), err
 	}
 	log.Info(fmt.Sprintf("%s starting to compress first level", method))
 	log.Info(fmt.Sprintf("%s compressItems %+v\n", method, compressItems))
@@ -83,7 +125,7 @@ func (c *baseChaincode) PackageForK8s(instance *current.Chaincode) (string, erro
 	nextGW := gzip.NewWriter(&b)
 	nextTw := tar.NewWriter(nextGW)
 
-	metadataContent := fmt.Sprintf(metadataJson, k8sPackageType, instance.Spec.Label)
+	metadataContent := fmt.Sprintf(metadataJson, packageType, instance.Spec.Label)
 	compressItems = []compressItem{
 		{
 			file:    "code.tar.gz",
@@ -31,7 +31,6 @@ import (
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/resmgmt"
 	lcpackager "github.com/hyperledger/fabric-sdk-go/pkg/fab/ccpackager/lifecycle"
 	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
-	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -164,18 +163,27 @@ func (c *baseChaincode) RunningChecker(instance *current.Chaincode) (string, err
 		return fmt.Sprintf("chaincode %s have not been approved", instance.GetName()), fmt.Errorf("chaincode %s have not been approved", instance.GetName())
 	}
 
-	for _, p := range ch.Status.PeerConditions {
-		if p.Type == current.PeerJoined && resp.Approvals[p.Namespace] {
-			name := PodName(peer.Namespace, peer.Name, packageID)
-			pod := &v1.Pod{}
-			if err = c.client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: peer.Namespace}, pod); err != nil {
-				log.Error(err, "")
-				return err.Error(), err
-			}
-			if pod.Status.Phase != v1.PodRunning {
-				err = fmt.Errorf("chaincode is not running on this peer node %s", peer.Name)
-				return err.Error(), err
-			}
+	orgRunning := c.orgRunningFunc(instance, packageID)
+	for _, member := range ch.GetMembers() {
+		peers := joinedPeers(ch, member.Name)
+		if !resp.Approvals[member.Name] || len(peers) == 0 {
+			continue
+		}
+		running, out, err := orgRunning(peers)
+		setOrgHealthy(instance, member.Name, running, out)
+		if err != nil {
+			log.Error(err, "")
+			return err.Error(), err
+		}
+		if !running {
+			err = fmt.Errorf("org %s: %s", member.Name, out)
+			return err.Error(), err
+		}
+	}
+	if instance.IsCCaaS() {
+		if err = c.CleanupServers(instance); err != nil {
+			log.Error(err, fmt.Sprintf("%s failed to delete chaincode servers of other versions", method))
+			return err.Error(), err
 		}
 	}
 	return "", nil
@@ -0,0 +1,432 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chaincode
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	commonconfig "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common/config"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common/enroller"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	serverContainer = "chaincode"
+	serverTLSVolume = "tls"
+	serverTLSDir    = "/etc/hyperledger/fabric/chaincode/tls"
+
+	// serverCertRenewBefore renews the tls certificate of a chaincode server before it expires
+	serverCertRenewBefore = 30 * 24 * time.Hour
+)
+
+var (
+	dnsLabelRegExp   = regexp.MustCompile("[^a-z0-9-]")
+	labelValueRegExp = regexp.MustCompile("[^a-zA-Z0-9-_.]")
+)
+
+// ServerName returns the name of the deployment, service and tls secret of a chaincode version in ccaas mode.
+// Each version gets its own server, so a new version can run alongside the committed one.
+func ServerName(instance *current.Chaincode) string {
+	name := dnsLabelRegExp.ReplaceAllString(strings.ToLower(fmt.Sprintf("cc-%s-%s", instance.GetName(), instance.Spec.Version)), "-")
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return strings.TrimRight(name, "-")
+}
+
+// ServerNamespace returns the namespace of the organization running the chaincode server
+func ServerNamespace(instance *current.Chaincode) string {
+	org := &current.Organization{ObjectMeta: metav1.ObjectMeta{Name: instance.Spec.Server.Organization}}
+	return org.GetUserNamespace()
+}
+
+// ServerHosts returns the hosts of the chaincode server service, the first one is the address peers connect to
+func ServerHosts(instance *current.Chaincode) []string {
+	name, ns := ServerName(instance), ServerNamespace(instance)
+	return []string{
+		fmt.Sprintf("%s.%s.svc.cluster.local", name, ns),
+		fmt.Sprintf("%s.%s.svc", name, ns),
+		fmt.Sprintf("%s.%s", name, ns),
+		name,
+	}
+}
+
+// ServerAddress returns the address peers connect to
+func ServerAddress(instance *current.Chaincode) string {
+	return fmt.Sprintf("%s:%d", ServerHosts(instance)[0], instance.Spec.Server.GetPort())
+}
+
+func serverLabels(instance *current.Chaincode) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "fabric",
+		"app.kubernetes.io/component":  "chaincode-server",
+		"app.kubernetes.io/managed-by": "fabric-operator",
+		current.ChaincodeNameLabel:     instance.GetName(),
+		current.ChaincodeVersionLabel:  labelValueRegExp.ReplaceAllString(instance.Spec.Version, "-"),
+	}
+}
+
+// ServerTLS returns the tls certificate, key and the root certificate of the chaincode server.
+// The certificate is enrolled from the tls CA of the server organization and renewed before it expires.
+func (c *baseChaincode) ServerTLS(instance *current.Chaincode) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Name: ServerName(instance), Namespace: ServerNamespace(instance)}
+	err := c.client.Get(context.TODO(), key, secret)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+	exists := err == nil
+	if exists && serverCertValid(secret.Data[corev1.TLSCertKey], ServerHosts(instance), time.Now()) {
+		return secret, nil
+	}
+
+	org := &current.Organization{}
+	if err = c.client.Get(context.TODO(), types.NamespacedName{Name: instance.Spec.Server.Organization}, org); err != nil {
+		return nil, errors.Wrapf(err, "failed to get organization %s", instance.Spec.Server.Organization)
+	}
+	profile, err := c.caConnectionProfile(org)
+	if err != nil {
+		return nil, err
+	}
+	enrollment, err := ServerEnrollment(instance, org, profile)
+	if err != nil {
+		return nil, err
+	}
+	crypto, err := enrollServer(enrollment)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to enroll tls certificate from tls CA of organization %s", org.GetName())
+	}
+	if len(crypto.CACerts) == 0 {
+		return nil, errors.Errorf("tls CA of organization %s returned no root certificate", org.GetName())
+	}
+
+	secret.Name, secret.Namespace = key.Name, key.Namespace
+	secret.Labels = serverLabels(instance)
+	secret.Type = corev1.SecretTypeTLS
+	secret.Data = map[string][]byte{
+		corev1.TLSCertKey:       crypto.SignCert,
+		corev1.TLSPrivateKeyKey: crypto.Keystore,
+		"ca.crt":                crypto.CACerts[0],
+	}
+	if exists {
+		return secret, c.client.Update(context.TODO(), secret)
+	}
+	return secret, c.client.Create(context.TODO(), secret, controllerclient.CreateOption{Owner: instance, Scheme: c.Scheme})
+}
+
+func (c *baseChaincode) caConnectionProfile(org *current.Organization) (*current.CAConnectionProfile, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.client.Get(context.TODO(), org.GetCAConnectinProfile(), cm); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ca connection profile of organization %s", org.GetName())
+	}
+	profile := &current.CAConnectionProfile{}
+	if err := json.Unmarshal(cm.BinaryData["profile.json"], profile); err != nil {
+		return nil, errors.Wrapf(err, "invalid ca connection profile of organization %s", org.GetName())
+	}
+	return profile, nil
+}
+
+// ServerEnrollment returns the enrollment of the chaincode server tls identity against the tls CA of org.
+// The identity is registered under the organization admin, the same way peers and orderers get theirs.
+func ServerEnrollment(instance *current.Chaincode, org *current.Organization, profile *current.CAConnectionProfile) (*current.Enrollment, error) {
+	if org.Spec.AdminToken == "" {
+		return nil, errors.Errorf("organization %s has no admin token to enroll the chaincode server", org.GetName())
+	}
+	caURL, err := url.Parse(profile.Endpoints.API)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse ca url")
+	}
+	enrollID := ServerName(instance)
+	return &current.Enrollment{
+		CAName: "tlsca",
+		CAHost: caURL.Hostname(),
+		CAPort: caURL.Port(),
+		CATLS: &current.CATLS{
+			CACert: profile.TLS.Cert,
+		},
+		EnrollID:     enrollID,
+		EnrollSecret: enrollID,
+		EnrollUser:   org.Spec.Admin,
+		EnrollToken:  org.Spec.AdminToken,
+		CSR:          &current.CSR{Hosts: ServerHosts(instance)},
+	}, nil
+}
+
+// enrollServer enrolls with the tls CA, the key is generated in a temporary directory removed afterwards
+func enrollServer(enrollment *current.Enrollment) (*commonconfig.Response, error) {
+	caTLS, err := enrollment.GetCATLSBytes()
+	if err != nil {
+		return nil, err
+	}
+	storagePath, err := os.MkdirTemp("", "chaincode-server-")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create enrollment directory")
+	}
+	defer os.RemoveAll(storagePath)
+
+	caClient := enroller.NewFabCAClient(enrollment, storagePath, nil, caTLS)
+	return commonconfig.GenerateCrypto(enroller.New(enroller.NewSWEnroller(caClient)))
+}
+
+// serverCertValid checks the certificate covers hosts and is not about to expire
+func serverCertValid(certPEM []byte, hosts []string, now time.Time) bool {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return false
+	}
+	for _, h := range hosts {
+		if cert.VerifyHostname(h) != nil {
+			return false
+		}
+	}
+	return now.Add(serverCertRenewBefore).Before(cert.NotAfter)
+}
+
+// ServerDeployment returns the deployment running the chaincode server of package
+func ServerDeployment(instance *current.Chaincode, packageID string) *appsv1.Deployment {
+	server := instance.Spec.Server
+	labels := serverLabels(instance)
+	port := server.GetPort()
+	replicas := server.GetReplicas()
+
+	image := instance.Spec.Images.Name
+	if instance.Spec.Images.Digest != "" {
+		image = fmt.Sprintf("%s@%s", image, instance.Spec.Images.Digest)
+	}
+	env := []corev1.EnvVar{
+		{Name: "CHAINCODE_ID", Value: packageID},
+		{Name: "CHAINCODE_SERVER_ADDRESS", Value: fmt.Sprintf("0.0.0.0:%d", port)},
+		{Name: "CHAINCODE_TLS_DISABLED", Value: strconv.FormatBool(server.DisableTLS)},
+	}
+	container := corev1.Container{
+		Name:  serverContainer,
+		Image: image,
+		Ports: []corev1.ContainerPort{{Name: serverContainer, ContainerPort: port, Protocol: corev1.ProtocolTCP}},
+		ReadinessProbe: &corev1.Probe{
+			Handler:             corev1.Handler{TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(int(port))}},
+			InitialDelaySeconds: 5,
+			PeriodSeconds:       10,
+		},
+	}
+	podSpec := corev1.PodSpec{}
+	if !server.DisableTLS {
+		env = append(env,
+			corev1.EnvVar{Name: "CHAINCODE_TLS_CERT", Value: serverTLSDir + "/" + corev1.TLSCertKey},
+			corev1.EnvVar{Name: "CHAINCODE_TLS_KEY", Value: serverTLSDir + "/" + corev1.TLSPrivateKeyKey},
+		)
+		container.VolumeMounts = []corev1.VolumeMount{{Name: serverTLSVolume, MountPath: serverTLSDir, ReadOnly: true}}
+		podSpec.Volumes = []corev1.Volume{{
+			Name:         serverTLSVolume,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: ServerName(instance)}},
+		}}
+	}
+	container.Env = append(env, server.Env...)
+	if server.Resources != nil {
+		container.Resources = *server.Resources
+	}
+	podSpec.Containers = []corev1.Container{container}
+	if instance.Spec.Images.PullSecret != "" {
+		podSpec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: instance.Spec.Images.PullSecret}}
+	}
+
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ServerName(instance),
+			Namespace: ServerNamespace(instance),
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       podSpec,
+			},
+		},
+	}
+}
+
+// ServerService returns the service peers connect to
+func ServerService(instance *current.Chaincode) *corev1.Service {
+	labels := serverLabels(instance)
+	port := instance.Spec.Server.GetPort()
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ServerName(instance),
+			Namespace: ServerNamespace(instance),
+			Labels:    labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeClusterIP,
+			Selector: labels,
+			Ports: []corev1.ServicePort{{
+				Name:       serverContainer,
+				Port:       port,
+				TargetPort: intstr.FromInt(int(port)),
+				Protocol:   corev1.ProtocolTCP,
+			}},
+		},
+	}
+}
+
+// ReconcileServer creates or updates the deployment and service of the chaincode server of package
+func (c *baseChaincode) ReconcileServer(instance *current.Chaincode, packageID string) (*appsv1.Deployment, error) {
+	if !instance.Spec.Server.DisableTLS {
+		if _, err := c.ServerTLS(instance); err != nil {
+			return nil, err
+		}
+	}
+
+	service := ServerService(instance)
+	err := c.client.Get(context.TODO(), client.ObjectKeyFromObject(service), &corev1.Service{})
+	if k8serrors.IsNotFound(err) {
+		err = c.client.Create(context.TODO(), service, controllerclient.CreateOption{Owner: instance, Scheme: c.Scheme})
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create chaincode server service")
+	}
+
+	desired := ServerDeployment(instance, packageID)
+	dep := &appsv1.Deployment{}
+	err = c.client.Get(context.TODO(), client.ObjectKeyFromObject(desired), dep)
+	if k8serrors.IsNotFound(err) {
+		if err = c.client.Create(context.TODO(), desired, controllerclient.CreateOption{Owner: instance, Scheme: c.Scheme}); err != nil {
+			return nil, errors.Wrap(err, "failed to create chaincode server deployment")
+		}
+		return desired, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	dep.Labels = desired.Labels
+	dep.Spec.Replicas = desired.Spec.Replicas
+	dep.Spec.Template = desired.Spec.Template
+	if err = c.client.Update(context.TODO(), dep); err != nil {
+		return nil, errors.Wrap(err, "failed to update chaincode server deployment")
+	}
+	return dep, nil
+}
+
+// ServerReady checks all replicas of the chaincode server deployment are updated and available
+func ServerReady(dep *appsv1.Deployment) (bool, string) {
+	want := int32(1)
+	if dep.Spec.Replicas != nil {
+		want = *dep.Spec.Replicas
+	}
+	if dep.Status.ObservedGeneration < dep.Generation {
+		return false, fmt.Sprintf("chaincode server %s is rolling out", dep.GetName())
+	}
+	if dep.Status.UpdatedReplicas < want || dep.Status.AvailableReplicas < want {
+		return false, fmt.Sprintf("chaincode server %s has %d/%d available replicas", dep.GetName(), dep.Status.AvailableReplicas, want)
+	}
+	return true, fmt.Sprintf("chaincode server %s is running", dep.GetName())
+}
+
+// CleanupServers deletes the chaincode servers of other versions once the current one is running
+func (c *baseChaincode) CleanupServers(instance *current.Chaincode) error {
+	keep := ServerName(instance)
+	selector := client.MatchingLabels{current.ChaincodeNameLabel: instance.GetName()}
+	ns := client.InNamespace(ServerNamespace(instance))
+
+	deps := &appsv1.DeploymentList{}
+	if err := c.client.List(context.TODO(), deps, selector, ns); err != nil {
+		return err
+	}
+	for i := range deps.Items {
+		if deps.Items[i].GetName() != keep {
+			if err := c.client.Delete(context.TODO(), &deps.Items[i]); err != nil && !k8serrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+	services := &corev1.ServiceList{}
+	if err := c.client.List(context.TODO(), services, selector, ns); err != nil {
+		return err
+	}
+	for i := range services.Items {
+		if services.Items[i].GetName() != keep {
+			if err := c.client.Delete(context.TODO(), &services.Items[i]); err != nil && !k8serrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+	secrets := &corev1.SecretList{}
+	if err := c.client.List(context.TODO(), secrets, selector, ns); err != nil {
+		return err
+	}
+	for i := range secrets.Items {
+		if secrets.Items[i].GetName() != keep {
+			if err := c.client.Delete(context.TODO(), &secrets.Items[i]); err != nil && !k8serrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// orgRunningFunc returns the check whether the chaincode of package runs for an organization's peers.
+// In ccaas mode all organizations connect to the same operator managed server, which is reconciled and checked once.
+func (c *baseChaincode) orgRunningFunc(instance *current.Chaincode, packageID string) func(peers []current.NamespacedName) (bool, string, error) {
+	if !instance.IsCCaaS() {
+		return func(peers []current.NamespacedName) (bool, string, error) {
+			return orgChaincodeRunning(c.client, peers, packageID)
+		}
+	}
+	var (
+		checked bool
+		running bool
+		out     string
+		err     error
+	)
+	return func(_ []current.NamespacedName) (bool, string, error) {
+		if !checked {
+			checked = true
+			var dep *appsv1.Deployment
+			if dep, err = c.ReconcileServer(instance, packageID); err != nil {
+				out = err.Error()
+				return running, out, err
+			}
+			running, out = ServerReady(dep)
+		}
+		return running, out, err
+	}
+}
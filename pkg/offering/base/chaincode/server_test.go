@@ -0,0 +1,176 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chaincode
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util/testcert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ccaasChaincode() *current.Chaincode {
+	return &current.Chaincode{
+		ObjectMeta: metav1.ObjectMeta{Name: "basic"},
+		Spec: current.ChaincodeSpec{
+			Version: "v1.0",
+			Mode:    current.ChaincodeModeCCaaS,
+			Images:  current.ChaincodeImage{Name: "hyperledger/basic", Digest: "sha256:abc", PullSecret: "regcred"},
+			Server:  &current.ChaincodeServer{Organization: "org1"},
+		},
+	}
+}
+
+func TestServerName(t *testing.T) {
+	instance := ccaasChaincode()
+	if name := ServerName(instance); name != "cc-basic-v1-0" {
+		t.Errorf("expect cc-basic-v1-0 get %s", name)
+	}
+	if addr := ServerAddress(instance); addr != "cc-basic-v1-0.org1.svc.cluster.local:7052" {
+		t.Errorf("unexpected address %s", addr)
+	}
+
+	instance.Name = strings.Repeat("a", 70)
+	if name := ServerName(instance); len(name) > 63 || strings.HasSuffix(name, "-") {
+		t.Errorf("invalid name %s", name)
+	}
+}
+
+func TestServerEnrollment(t *testing.T) {
+	instance := ccaasChaincode()
+	org := &current.Organization{
+		ObjectMeta: metav1.ObjectMeta{Name: "org1"},
+		Spec:       current.OrganizationSpec{Admin: "admin", AdminToken: "token"},
+	}
+	profile := &current.CAConnectionProfile{
+		Endpoints: current.CAEndpoints{API: "https://org1-ca.example.com:443"},
+		TLS:       &current.ConnectionProfileTLS{Cert: "Y2VydA=="},
+	}
+
+	enrollment, err := ServerEnrollment(instance, org, profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.CAName != "tlsca" || enrollment.CAHost != "org1-ca.example.com" || enrollment.CAPort != "443" {
+		t.Errorf("unexpected tls CA %s at %s:%s", enrollment.CAName, enrollment.CAHost, enrollment.CAPort)
+	}
+	if enrollment.EnrollID != ServerName(instance) || enrollment.EnrollUser != "admin" || enrollment.EnrollToken != "token" {
+		t.Errorf("server identity should be registered under the organization admin, get %s by %s", enrollment.EnrollID, enrollment.EnrollUser)
+	}
+	if enrollment.CSR == nil || !reflect.DeepEqual(enrollment.CSR.Hosts, ServerHosts(instance)) {
+		t.Errorf("certificate should cover the server hosts, get %v", enrollment.CSR)
+	}
+
+	org.Spec.AdminToken = ""
+	if _, err = ServerEnrollment(instance, org, profile); err == nil {
+		t.Error("expect error without admin token")
+	}
+}
+
+func TestServerCertValid(t *testing.T) {
+	instance := ccaasChaincode()
+	hosts := ServerHosts(instance)
+	now := time.Now()
+
+	certPEM := testcert.New(t, hosts[0], nil, testcert.Hosts(hosts...), testcert.NotAfter(now.Add(365*24*time.Hour))).PEM
+	if !serverCertValid(certPEM, hosts, now) {
+		t.Error("fresh certificate should be valid")
+	}
+	expiring := testcert.New(t, hosts[0], nil, testcert.Hosts(hosts...), testcert.NotAfter(now.Add(serverCertRenewBefore/2))).PEM
+	if serverCertValid(expiring, hosts, now) {
+		t.Error("certificate about to expire should be renewed")
+	}
+	if serverCertValid(certPEM, append(hosts, "other.org2.svc"), now) {
+		t.Error("certificate should not be valid for other hosts")
+	}
+	if serverCertValid([]byte("invalid"), hosts, now) {
+		t.Error("invalid certificate should be renewed")
+	}
+}
+
+func TestServerDeployment(t *testing.T) {
+	instance := ccaasChaincode()
+	dep := ServerDeployment(instance, "basic_1:abc")
+	if dep.Namespace != "org1" || *dep.Spec.Replicas != 1 {
+		t.Errorf("unexpected deployment %s/%s replicas %d", dep.Namespace, dep.Name, *dep.Spec.Replicas)
+	}
+	c := dep.Spec.Template.Spec.Containers[0]
+	if c.Image != "hyperledger/basic@sha256:abc" {
+		t.Errorf("unexpected image %s", c.Image)
+	}
+	env := map[string]string{}
+	for _, e := range c.Env {
+		env[e.Name] = e.Value
+	}
+	if env["CHAINCODE_ID"] != "basic_1:abc" || env["CHAINCODE_SERVER_ADDRESS"] != "0.0.0.0:7052" || env["CHAINCODE_TLS_DISABLED"] != "false" {
+		t.Errorf("unexpected env %v", env)
+	}
+	if env["CHAINCODE_TLS_CERT"] == "" || len(dep.Spec.Template.Spec.Volumes) != 1 {
+		t.Error("expect tls mounted")
+	}
+	if dep.Spec.Template.Spec.ImagePullSecrets[0].Name != "regcred" {
+		t.Error("expect pull secret")
+	}
+
+	instance.Spec.Server.DisableTLS = true
+	dep = ServerDeployment(instance, "basic_1:abc")
+	if len(dep.Spec.Template.Spec.Volumes) != 0 {
+		t.Error("expect no tls volume")
+	}
+}
+
+func TestServerReady(t *testing.T) {
+	replicas := int32(2)
+	dep := &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Replicas: &replicas}}
+	dep.Status.UpdatedReplicas, dep.Status.AvailableReplicas = 2, 1
+	if ready, _ := ServerReady(dep); ready {
+		t.Error("expect not ready with 1/2 replicas")
+	}
+	dep.Status.AvailableReplicas = 2
+	if ready, _ := ServerReady(dep); !ready {
+		t.Error("expect ready")
+	}
+}
+
+func TestConnectionJSON(t *testing.T) {
+	instance := ccaasChaincode()
+	content, err := ConnectionJSON(instance, []byte("root"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := connection{}
+	if err = json.Unmarshal(content, &conn); err != nil {
+		t.Fatal(err)
+	}
+	if conn.Address != ServerAddress(instance) || !conn.TLSRequired || conn.RootCert != "root" {
+		t.Errorf("unexpected connection %+v", conn)
+	}
+
+	instance.Spec.Server.DisableTLS = true
+	content, _ = ConnectionJSON(instance, []byte("root"))
+	if strings.Contains(string(content), "root_cert") {
+		t.Errorf("expect no root cert without tls: %s", content)
+	}
+}
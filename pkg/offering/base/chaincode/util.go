@@ -27,6 +27,7 @@ import (
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
 	common1 "github.com/hyperledger/fabric-protos-go/common"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
 	"github.com/hyperledger/fabric-sdk-go/third_party/github.com/hyperledger/fabric/common/policydsl"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -61,3 +62,48 @@ func ChaincodeEndorsePolicy(cli controllerclient.Client, instance *current.Chain
 
 	return policydsl.FromString(policy.Spec.Value)
 }
+
+// ChaincodeCollections converts the private data collections of chaincode into collection configs
+func ChaincodeCollections(instance *current.Chaincode) ([]*pb.CollectionConfig, error) {
+	if len(instance.Spec.Collections) == 0 {
+		return nil, nil
+	}
+	configs := make([]*pb.CollectionConfig, 0, len(instance.Spec.Collections))
+	for _, c := range instance.Spec.Collections {
+		memberPolicy, err := policydsl.FromString(c.Policy)
+		if err != nil {
+			return nil, fmt.Errorf("collection %s: invalid policy %s: %w", c.Name, c.Policy, err)
+		}
+		staticConfig := &pb.StaticCollectionConfig{
+			Name: c.Name,
+			MemberOrgsPolicy: &pb.CollectionPolicyConfig{
+				Payload: &pb.CollectionPolicyConfig_SignaturePolicy{SignaturePolicy: memberPolicy},
+			},
+			RequiredPeerCount: c.RequiredPeerCount,
+			MaximumPeerCount:  c.MaxPeerCount,
+			BlockToLive:       c.BlockToLive,
+			MemberOnlyRead:    c.MemberOnlyRead,
+			MemberOnlyWrite:   c.MemberOnlyWrite,
+		}
+		if ep := c.EndorsementPolicy; ep != nil {
+			switch {
+			case ep.SignaturePolicy != "":
+				endorsePolicy, err := policydsl.FromString(ep.SignaturePolicy)
+				if err != nil {
+					return nil, fmt.Errorf("collection %s: invalid endorsement policy %s: %w", c.Name, ep.SignaturePolicy, err)
+				}
+				staticConfig.EndorsementPolicy = &pb.ApplicationPolicy{
+					Type: &pb.ApplicationPolicy_SignaturePolicy{SignaturePolicy: endorsePolicy},
+				}
+			case ep.ChannelConfigPolicy != "":
+				staticConfig.EndorsementPolicy = &pb.ApplicationPolicy{
+					Type: &pb.ApplicationPolicy_ChannelConfigPolicyReference{ChannelConfigPolicyReference: ep.ChannelConfigPolicy},
+				}
+			}
+		}
+		configs = append(configs, &pb.CollectionConfig{
+			Payload: &pb.CollectionConfig_StaticCollectionConfig{StaticCollectionConfig: staticConfig},
+		})
+	}
+	return configs, nil
+}
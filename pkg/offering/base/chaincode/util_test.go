@@ -0,0 +1,94 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package chaincode
+
+import (
+	"testing"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestChaincodeCollections(t *testing.T) {
+	instance := &current.Chaincode{}
+	if configs, err := ChaincodeCollections(instance); err != nil || configs != nil {
+		t.Fatalf("expect no collection config, get %v %v", configs, err)
+	}
+
+	instance.Spec.Collections = []current.Collection{
+		{
+			Name:              "private",
+			Policy:            "OR('org1.member','org2.member')",
+			RequiredPeerCount: 1,
+			MaxPeerCount:      2,
+			BlockToLive:       100,
+			MemberOnlyRead:    true,
+			EndorsementPolicy: &current.CollectionEndorsementPolicy{SignaturePolicy: "OR('org1.peer')"},
+		},
+		{
+			Name:              "shared",
+			Policy:            "OR('org1.member')",
+			EndorsementPolicy: &current.CollectionEndorsementPolicy{ChannelConfigPolicy: "/Channel/Application/Endorsement"},
+		},
+	}
+	configs, err := ChaincodeCollections(instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(configs) != 2 {
+		t.Fatalf("expect 2 collection configs, get %d", len(configs))
+	}
+	private := configs[0].GetStaticCollectionConfig()
+	if private.Name != "private" || private.RequiredPeerCount != 1 || private.MaximumPeerCount != 2 ||
+		private.BlockToLive != 100 || !private.MemberOnlyRead || private.MemberOnlyWrite {
+		t.Fatalf("unexpected collection config %+v", private)
+	}
+	if ids := private.MemberOrgsPolicy.GetSignaturePolicy().Identities; len(ids) != 2 {
+		t.Fatalf("expect 2 member identities, get %d", len(ids))
+	}
+	if private.EndorsementPolicy.GetSignaturePolicy() == nil {
+		t.Fatal("expect signature endorsement policy")
+	}
+	if ref := configs[1].GetStaticCollectionConfig().EndorsementPolicy.GetChannelConfigPolicyReference(); ref != "/Channel/Application/Endorsement" {
+		t.Fatalf("unexpected channel config policy reference %s", ref)
+	}
+
+	instance.Spec.Collections[1].Policy = "OR(org1.member"
+	if _, err = ChaincodeCollections(instance); err == nil {
+		t.Fatal("expect error on invalid policy")
+	}
+}
+
+func TestGetHistory(t *testing.T) {
+	now := metav1.Now()
+	later := metav1.NewTime(now.Add(time.Minute))
+	instance := &current.Chaincode{}
+	instance.Status.History = []current.ChaincodeHistory{
+		{Version: "0.1", Image: current.ChaincodeImage{Name: "cc", Digest: "a"}, UpgradeTime: now},
+		{Version: "0.2", Image: current.ChaincodeImage{Name: "cc", Digest: "b"}, UpgradeTime: now},
+		{Version: "0.1", Image: current.ChaincodeImage{Name: "cc", Digest: "c"}, UpgradeTime: later},
+	}
+	history, ok := instance.GetHistory("0.1")
+	if !ok || history.Image.Digest != "c" {
+		t.Fatalf("expect the latest entry of 0.1, get %+v", history)
+	}
+	if _, ok = instance.GetHistory("0.3"); ok {
+		t.Fatal("expect no entry of 0.3")
+	}
+}
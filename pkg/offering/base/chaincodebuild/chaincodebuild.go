@@ -109,7 +109,7 @@ func (chaincodeBuild *BaseChaincodeBuild) PreReconcileChecks(instance *current.C
 	log.Info(fmt.Sprintf("PreReconcileChecks on ChaincodeBuild %s", instance.GetName()))
 
 	if !instance.Spec.HasPipelineSource() {
-		return errors.New("invalid chaincode build.must provide a pipeline source(Git/Minio)")
+		return errors.New("invalid chaincode build.must provide a pipeline source(Git/Minio/OCI/HTTP)")
 	}
 
 	return nil
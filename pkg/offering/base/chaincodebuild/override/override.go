@@ -19,15 +19,23 @@
 package override
 
 import (
+	"fmt"
+
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/apis/deployer"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util/image"
+	"github.com/pkg/errors"
 	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DefaultLanguagePipeline is the tekton pipeline of language builds if not configured
+const DefaultLanguagePipeline = "chaincodebuild-language"
+
 type Override struct {
 	Client controllerclient.Client
 
@@ -67,6 +75,22 @@ func (o *Override) CreateChaincodeBuildPipelineRun(instance *current.ChaincodeBu
 
 	pipelineRun.Spec.Params = pipelineRunSpec.ToPipelineParams()
 
+	if pipelineRunSpec.LanguageBuild != nil {
+		builderImage, err := BuilderImage(o.Config.Operator.Versions, pipelineRunSpec.LanguageBuild.Language)
+		if err != nil {
+			return err
+		}
+		pipelineRun.Spec.Params = append(pipelineRun.Spec.Params, pipelinev1beta1.Param{
+			Name:  "BUILDER_IMAGE",
+			Value: *pipelinev1beta1.NewArrayOrString(builderImage),
+		})
+		pipeline := o.Config.ChaincodeBuildInitConfig.LanguagePipeline
+		if pipeline == "" {
+			pipeline = DefaultLanguagePipeline
+		}
+		pipelineRun.Spec.PipelineRef = &pipelinev1beta1.PipelineRef{Name: pipeline}
+	}
+
 	pipelineRun.Spec.Workspaces = []pipelinev1beta1.WorkspaceBinding{
 		{
 			Name:    "source-ws",
@@ -86,6 +110,37 @@ func (o *Override) CreateChaincodeBuildPipelineRun(instance *current.ChaincodeBu
 	return nil
 }
 
+// BuilderImage returns the chaincode environment image of language from the default peer version
+func BuilderImage(versions *deployer.Versions, language current.ChaincodeLanguage) (string, error) {
+	if versions == nil {
+		return "", errors.New("no peer versions configured for language build")
+	}
+	for _, v := range versions.Peer {
+		if !v.Default {
+			continue
+		}
+		var name, tag string
+		switch language {
+		case current.ChaincodeLanguageGo:
+			name, tag = v.Image.GoEnvImage, v.Image.GoEnvTag
+		case current.ChaincodeLanguageNode:
+			name, tag = v.Image.NodeEnvImage, v.Image.NodeEnvTag
+		case current.ChaincodeLanguageJava:
+			name, tag = v.Image.JavaEnvImage, v.Image.JavaEnvTag
+		default:
+			return "", fmt.Errorf("unsupported chaincode language %s", language)
+		}
+		if name == "" {
+			return "", fmt.Errorf("no %s builder image in peer version %s", language, v.Version)
+		}
+		if tag == "" {
+			return name, nil
+		}
+		return image.Format(name, tag), nil
+	}
+	return "", errors.New("no default peer version for language build")
+}
+
 func (o *Override) ChaincodeBuildPVC(object v1.Object, pvc *corev1.PersistentVolumeClaim, action resources.Action) error {
 	instance := object.(*current.ChaincodeBuild)
 	switch action {
@@ -0,0 +1,218 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package override
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/apis/deployer"
+	ccbinit "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/chaincodebuild"
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func testVersions() *deployer.Versions {
+	return &deployer.Versions{
+		Peer: map[string]deployer.VersionPeer{
+			"2.4.7-1": {
+				Default: true,
+				Version: "2.4.7-1",
+				Image: deployer.PeerImages{
+					GoEnvImage:   "hyperledger/fabric-ccenv",
+					GoEnvTag:     "2.4.7",
+					NodeEnvImage: "hyperledger/fabric-nodeenv",
+					NodeEnvTag:   "2.4",
+				},
+			},
+		},
+	}
+}
+
+func params(run *pipelinev1beta1.PipelineRun) map[string]string {
+	m := map[string]string{}
+	for _, p := range run.Spec.Params {
+		m[p.Name] = p.Value.StringVal
+	}
+	return m
+}
+
+func TestBuilderImage(t *testing.T) {
+	img, err := BuilderImage(testVersions(), current.ChaincodeLanguageGo)
+	if err != nil || img != "hyperledger/fabric-ccenv:2.4.7" {
+		t.Errorf("unexpected go builder image %s, %v", img, err)
+	}
+	if _, err = BuilderImage(testVersions(), current.ChaincodeLanguageJava); err == nil {
+		t.Error("expect error without java builder image")
+	}
+	if _, err = BuilderImage(nil, current.ChaincodeLanguageGo); err == nil {
+		t.Error("expect error without versions")
+	}
+}
+
+func TestLanguageBuildPipelineRun(t *testing.T) {
+	o := &Override{Config: &config.Config{ChaincodeBuildInitConfig: &ccbinit.Config{PipelinRunNamespace: "tekton"}}}
+	o.Config.Operator.Versions = testVersions()
+
+	instance := &current.ChaincodeBuild{ObjectMeta: metav1.ObjectMeta{Name: "basic"}}
+	instance.Spec.PipelineRunSpec = current.PipelineRunSpec{
+		HTTP:          &current.HTTP{URL: "https://example.com/basic.tar.gz"},
+		LanguageBuild: &current.LanguageBuild{Language: current.ChaincodeLanguageNode, AppImage: "example/basic", PushSecret: "push"},
+	}
+	run := &pipelinev1beta1.PipelineRun{Spec: pipelinev1beta1.PipelineRunSpec{PipelineRef: &pipelinev1beta1.PipelineRef{Name: "chaincodebuild-kaniko"}}}
+	if err := o.CreateChaincodeBuildPipelineRun(instance, run); err != nil {
+		t.Fatal(err)
+	}
+	if run.Spec.PipelineRef.Name != DefaultLanguagePipeline {
+		t.Errorf("expect pipeline %s get %s", DefaultLanguagePipeline, run.Spec.PipelineRef.Name)
+	}
+	p := params(run)
+	if p["SOURCE"] != "http" || p["SOURCE_HTTP_URL"] != "https://example.com/basic.tar.gz" {
+		t.Errorf("unexpected source params %v", p)
+	}
+	if p["LANGUAGE"] != "node" || p["MODULE_PATH"] != "." || p["APP_IMAGE"] != "example/basic" || p["BUILDER_IMAGE"] != "hyperledger/fabric-nodeenv:2.4" {
+		t.Errorf("unexpected build params %v", p)
+	}
+	if _, ok := p["DOCKERFILE"]; ok {
+		t.Error("language build should not have dockerfile")
+	}
+	if run.Spec.Workspaces[1].Secret.SecretName != "push" {
+		t.Error("expect push secret of language build")
+	}
+}
+
+func TestValidatePipeline(t *testing.T) {
+	spec := current.ChaincodeBuildSpec{}
+	spec.PipelineRunSpec.Git = &current.Git{URL: "https://example.com/basic.git"}
+	spec.PipelineRunSpec.OCI = &current.OCI{Reference: "example/basic-src:v1"}
+	if err := spec.ValidatePipelineSource(); err == nil {
+		t.Error("expect error with two sources")
+	}
+	spec.PipelineRunSpec.Git = nil
+	if err := spec.ValidatePipelineSource(); err != nil {
+		t.Error(err)
+	}
+	if err := spec.ValidatePipelineBuild(); err == nil {
+		t.Error("expect error without build")
+	}
+	spec.PipelineRunSpec.LanguageBuild = &current.LanguageBuild{Language: current.ChaincodeLanguageGo, AppImage: "example/basic"}
+	if err := spec.ValidatePipelineBuild(); err != nil {
+		t.Error(err)
+	}
+	spec.PipelineRunSpec.Dockerbuild = &current.Dockerbuild{AppImage: "example/basic"}
+	if err := spec.ValidatePipelineBuild(); err == nil {
+		t.Error("expect error with both builds")
+	}
+}
+
+func TestShippedPipelines(t *testing.T) {
+	files, err := filepath.Glob("../../../../../definitions/chaincodebuild/pipelines/*.yaml")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no pipeline definitions: %v", err)
+	}
+	pipelines := map[string]*pipelinev1beta1.Pipeline{}
+	tasks := map[string]*pipelinev1beta1.Task{}
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		meta := &metav1.TypeMeta{}
+		if err = yaml.Unmarshal(data, meta); err != nil {
+			t.Fatalf("%s: %v", f, err)
+		}
+		switch meta.Kind {
+		case "Pipeline":
+			pipeline := &pipelinev1beta1.Pipeline{}
+			err = yaml.UnmarshalStrict(data, pipeline)
+			pipelines[pipeline.Name] = pipeline
+		case "Task":
+			task := &pipelinev1beta1.Task{}
+			err = yaml.UnmarshalStrict(data, task)
+			tasks[task.Name] = task
+		default:
+			t.Fatalf("%s: unexpected kind %s", f, meta.Kind)
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", f, err)
+		}
+	}
+
+	o := &Override{Config: &config.Config{ChaincodeBuildInitConfig: &ccbinit.Config{PipelinRunNamespace: "tekton"}}}
+	o.Config.Operator.Versions = testVersions()
+	sources := []current.PipelineRunSpec{
+		{Git: &current.Git{URL: "https://example.com/basic.git"}},
+		{Minio: &current.Minio{Bucket: "bestchains", Object: "basic"}},
+		{OCI: &current.OCI{Reference: "example/basic-src:v1"}},
+		{HTTP: &current.HTTP{URL: "https://example.com/basic.tar.gz"}},
+	}
+	for _, source := range sources {
+		for _, build := range []current.PipelineRunSpec{
+			{Dockerbuild: &current.Dockerbuild{AppImage: "example/basic", Dockerfile: "Dockerfile", Context: "."}},
+			{LanguageBuild: &current.LanguageBuild{Language: current.ChaincodeLanguageGo, AppImage: "example/basic"}},
+		} {
+			instance := &current.ChaincodeBuild{ObjectMeta: metav1.ObjectMeta{Name: "basic"}}
+			instance.Spec.PipelineRunSpec = source
+			instance.Spec.PipelineRunSpec.Dockerbuild = build.Dockerbuild
+			instance.Spec.PipelineRunSpec.LanguageBuild = build.LanguageBuild
+			run := &pipelinev1beta1.PipelineRun{Spec: pipelinev1beta1.PipelineRunSpec{PipelineRef: &pipelinev1beta1.PipelineRef{Name: "chaincodebuild-kaniko"}}}
+			if err := o.CreateChaincodeBuildPipelineRun(instance, run); err != nil {
+				t.Fatal(err)
+			}
+			pipeline, found := pipelines[run.Spec.PipelineRef.Name]
+			if !found {
+				t.Fatalf("pipeline %s is not shipped", run.Spec.PipelineRef.Name)
+			}
+			declared := map[string]bool{}
+			for _, p := range pipeline.Spec.Params {
+				declared[p.Name] = true
+			}
+			for _, p := range run.Spec.Params {
+				if !declared[p.Name] {
+					t.Errorf("pipeline %s does not declare param %s", pipeline.Name, p.Name)
+				}
+			}
+			for _, w := range run.Spec.Workspaces {
+				if !hasWorkspace(pipeline, w.Name) {
+					t.Errorf("pipeline %s does not declare workspace %s", pipeline.Name, w.Name)
+				}
+			}
+		}
+	}
+	for _, pipeline := range pipelines {
+		for _, pt := range pipeline.Spec.Tasks {
+			if _, found := tasks[pt.TaskRef.Name]; !found {
+				t.Errorf("task %s of pipeline %s is not shipped", pt.TaskRef.Name, pipeline.Name)
+			}
+		}
+	}
+}
+
+func hasWorkspace(pipeline *pipelinev1beta1.Pipeline, name string) bool {
+	for _, w := range pipeline.Spec.Workspaces {
+		if w.Name == name {
+			return true
+		}
+	}
+	return false
+}
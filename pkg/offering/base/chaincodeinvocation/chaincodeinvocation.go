@@ -0,0 +1,336 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package chaincodeinvocation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/connector"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/base/chaincode"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel/invoke"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/ledger"
+	contextApi "github.com/hyperledger/fabric-sdk-go/pkg/common/providers/context"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var log = logf.Log.WithName("base_chaincodeinvocation")
+
+const (
+	// ConfirmTimeout is how long after submission a transaction not found on the ledger is looked up again
+	ConfirmTimeout = 5 * time.Minute
+	// ConfirmInterval is how often a submitted transaction is looked up on the ledger
+	ConfirmInterval = 10 * time.Second
+)
+
+type ChaincodeInvocation interface {
+	Reconcile(*current.ChaincodeInvocation) (common.Result, error)
+}
+
+// Invoker sends a chaincode request to the target peers. An endorsed transaction is sent to the orderer
+// only after submit records its transaction id.
+type Invoker interface {
+	Invoke(request channel.Request, query bool, submit func(txID string) error, targets ...string) (channel.Response, error)
+}
+
+type baseChaincodeInvocation struct {
+	client controllerclient.Client
+
+	Scheme *runtime.Scheme
+	Config *config.Config
+}
+
+var _ ChaincodeInvocation = &baseChaincodeInvocation{}
+
+func New(client controllerclient.Client, scheme *runtime.Scheme, conf *config.Config) ChaincodeInvocation {
+	return &baseChaincodeInvocation{client: client, Scheme: scheme, Config: conf}
+}
+
+// Reconcile runs the invocation once and records its result. A submitted invocation is never sent again,
+// its result is confirmed from the ledger instead.
+func (c *baseChaincodeInvocation) Reconcile(instance *current.ChaincodeInvocation) (common.Result, error) {
+	switch instance.Status.Phase {
+	case current.InvocationSucceeded, current.InvocationFailed:
+		return common.Result{}, nil
+	case current.InvocationRunning:
+		result, err := c.confirm(instance)
+		if err != nil {
+			return common.Result{}, err
+		}
+		if result.Requeue || result.RequeueAfter > 0 {
+			return result, nil
+		}
+		return result, c.patchStatus(instance)
+	}
+
+	if err := c.invoke(instance); err != nil {
+		log.Error(err, "chaincode invocation failed", "invocation", instance.GetName())
+		SetInvocationResult(instance, channel.Response{}, err)
+	}
+	result := common.Result{}
+	if instance.Status.Phase == current.InvocationRunning {
+		result.RequeueAfter = ConfirmInterval
+	}
+	return result, c.patchStatus(instance)
+}
+
+func (c *baseChaincodeInvocation) patchStatus(instance *current.ChaincodeInvocation) error {
+	return c.client.PatchStatus(context.TODO(), instance, nil, controllerclient.PatchOption{
+		Resilient: &controllerclient.ResilientPatch{
+			Retry:    3,
+			Into:     &current.ChaincodeInvocation{},
+			Strategy: client.MergeFrom,
+		},
+	})
+}
+
+// connect returns a connector and the channel context of the organization admin, with the chaincode id and target peers
+func (c *baseChaincodeInvocation) connect(instance *current.ChaincodeInvocation) (*connector.Connector, contextApi.ChannelProvider, string, []string, error) {
+	cc := &current.Chaincode{}
+	if err := c.client.Get(context.TODO(), types.NamespacedName{Name: instance.Spec.Chaincode}, cc); err != nil {
+		return nil, nil, "", nil, errors.Wrap(err, "get chaincode")
+	}
+	ch, err := cc.GetChannel(c.client)
+	if err != nil {
+		return nil, nil, "", nil, errors.Wrap(err, "get channel")
+	}
+	org := &current.Organization{}
+	if err = c.client.Get(context.TODO(), types.NamespacedName{Name: instance.Spec.Organization}, org); err != nil {
+		return nil, nil, "", nil, errors.Wrap(err, "get organization")
+	}
+
+	profile, err := connector.ChannelProfile(c.client, ch.GetName())
+	if err != nil {
+		return nil, nil, "", nil, err
+	}
+	orgPeer, _, err := chaincode.SetChannelPeerProfile(c.client, profile, ch)
+	if err != nil {
+		return nil, nil, "", nil, errors.Wrap(err, "set channel peers")
+	}
+	if !instance.Spec.Query {
+		if _, err = chaincode.SetChannelOrderer(c.client, profile, ch); err != nil {
+			return nil, nil, "", nil, errors.Wrap(err, "set channel orderer")
+		}
+	}
+	targets, err := TargetPeers(instance, orgPeer)
+	if err != nil {
+		return nil, nil, "", nil, err
+	}
+
+	con, err := chaincode.NewChaincodeConnector(profile)
+	if err != nil {
+		return nil, nil, "", nil, errors.Wrap(err, "new connector")
+	}
+	return con, con.SDK().ChannelContext(ch.GetChannelID(), fabsdk.WithUser(org.Spec.Admin), fabsdk.WithOrg(org.GetName())), cc.Spec.ID, targets, nil
+}
+
+func (c *baseChaincodeInvocation) invoke(instance *current.ChaincodeInvocation) error {
+	transient, err := c.transientMap(instance)
+	if err != nil {
+		return err
+	}
+	con, channelContext, chaincodeID, targets, err := c.connect(instance)
+	if err != nil {
+		return err
+	}
+	defer con.Close()
+	channelClient, err := channel.New(channelContext)
+	if err != nil {
+		return errors.Wrap(err, "new channel client")
+	}
+
+	Call(&channelInvoker{client: channelClient}, instance, chaincodeID, targets, transient, func(txID string) error {
+		MarkSubmitted(instance, txID)
+		return c.patchStatus(instance)
+	})
+	return nil
+}
+
+// transientMap reads the transient data from the secret in the organization's namespace
+func (c *baseChaincodeInvocation) transientMap(instance *current.ChaincodeInvocation) (map[string][]byte, error) {
+	if instance.Spec.TransientSecret == "" {
+		return nil, nil
+	}
+	secret := &corev1.Secret{}
+	if err := c.client.Get(context.TODO(), types.NamespacedName{Namespace: instance.Spec.Organization, Name: instance.Spec.TransientSecret}, secret); err != nil {
+		return nil, errors.Wrapf(err, "get transient secret %s", instance.Spec.TransientSecret)
+	}
+	return secret.Data, nil
+}
+
+// confirm looks up the submitted transaction on the ledger
+func (c *baseChaincodeInvocation) confirm(instance *current.ChaincodeInvocation) (common.Result, error) {
+	con, channelContext, _, targets, err := c.connect(instance)
+	if err != nil {
+		return common.Result{}, err
+	}
+	defer con.Close()
+	ledgerClient, err := ledger.New(channelContext)
+	if err != nil {
+		return common.Result{}, errors.Wrap(err, "new ledger client")
+	}
+	tx, err := ledgerClient.QueryTransaction(fab.TransactionID(instance.Status.TxID), ledger.WithTargetEndpoints(targets...))
+	return Confirm(instance, tx, err, time.Now()), nil
+}
+
+// TargetPeers picks the peer of each target organization, all channel members are targeted by default
+func TargetPeers(instance *current.ChaincodeInvocation, orgPeer map[string]current.IBPPeer) ([]string, error) {
+	orgs := instance.Spec.TargetOrganizations
+	if len(orgs) == 0 {
+		for org := range orgPeer {
+			orgs = append(orgs, org)
+		}
+	}
+	targets := make([]string, 0, len(orgs))
+	for _, org := range orgs {
+		p, ok := orgPeer[org]
+		if !ok {
+			return nil, errors.Errorf("organization %s has no peer in channel", org)
+		}
+		targets = append(targets, current.NamespacedName{Name: p.GetName(), Namespace: p.GetNamespace()}.String())
+	}
+	return targets, nil
+}
+
+// Call sends the invocation through invoker and records the result in instance's status.
+// submit is called with the transaction id before the transaction is sent to the orderer.
+func Call(invoker Invoker, instance *current.ChaincodeInvocation, chaincodeID string, targets []string, transient map[string][]byte, submit func(txID string) error) {
+	request := channel.Request{
+		ChaincodeID:  chaincodeID,
+		Fcn:          instance.Spec.Function,
+		Args:         make([][]byte, 0, len(instance.Spec.Args)),
+		TransientMap: transient,
+	}
+	for _, arg := range instance.Spec.Args {
+		request.Args = append(request.Args, []byte(arg))
+	}
+
+	log.Info(fmt.Sprintf("invoke chaincode %s function %s on %v", chaincodeID, request.Fcn, targets), "invocation", instance.GetName(), "query", instance.Spec.Query)
+	response, err := invoker.Invoke(request, instance.Spec.Query, submit, targets...)
+	if err != nil && instance.Status.Phase == current.InvocationRunning {
+		// the submitted transaction may still be committed, its result is confirmed from the ledger
+		instance.Status.Message = err.Error()
+		return
+	}
+	SetInvocationResult(instance, response, err)
+}
+
+// MarkSubmitted records the phase Running with the transaction id about to be sent to the orderer
+func MarkSubmitted(instance *current.ChaincodeInvocation, txID string) {
+	instance.Status = current.ChaincodeInvocationStatus{
+		Phase:      current.InvocationRunning,
+		TxID:       txID,
+		SubmitTime: metav1.Now(),
+	}
+}
+
+// Confirm records the result of a submitted invocation from its transaction tx on the ledger.
+// A transaction not found is looked up again until ConfirmTimeout after submission.
+func Confirm(instance *current.ChaincodeInvocation, tx *pb.ProcessedTransaction, err error, now time.Time) common.Result {
+	if err != nil {
+		if now.Sub(instance.Status.SubmitTime.Time) < ConfirmTimeout {
+			return common.Result{Result: reconcile.Result{RequeueAfter: ConfirmInterval}}
+		}
+		instance.Status.Phase = current.InvocationFailed
+		instance.Status.Message = fmt.Sprintf("transaction %s not found on the ledger: %s", instance.Status.TxID, err.Error())
+		instance.Status.CompletionTime = metav1.NewTime(now)
+		return common.Result{}
+	}
+	code := pb.TxValidationCode(tx.GetValidationCode())
+	instance.Status.ValidationCode = code.String()
+	instance.Status.Phase = current.InvocationSucceeded
+	if code != pb.TxValidationCode_VALID {
+		instance.Status.Phase = current.InvocationFailed
+		instance.Status.Message = fmt.Sprintf("transaction %s is invalid", instance.Status.TxID)
+	}
+	instance.Status.CompletionTime = metav1.NewTime(now)
+	return common.Result{}
+}
+
+// SetInvocationResult records the transaction id, endorsements, validation code and payload of response
+func SetInvocationResult(instance *current.ChaincodeInvocation, response channel.Response, err error) {
+	status := current.ChaincodeInvocationStatus{
+		Phase:          current.InvocationSucceeded,
+		TxID:           string(response.TransactionID),
+		Payload:        string(response.Payload),
+		SubmitTime:     instance.Status.SubmitTime,
+		CompletionTime: metav1.Now(),
+	}
+	if !instance.Spec.Query && response.TransactionID != "" {
+		status.ValidationCode = response.TxValidationCode.String()
+	}
+	for _, r := range response.Responses {
+		endorsement := current.EndorsementResponse{Peer: r.Endorser, Status: r.Status}
+		if r.ProposalResponse != nil {
+			endorsement.Message = r.ProposalResponse.GetResponse().GetMessage()
+		}
+		status.Endorsements = append(status.Endorsements, endorsement)
+	}
+	if err != nil {
+		status.Phase = current.InvocationFailed
+		status.Message = err.Error()
+	}
+	instance.Status = status
+}
+
+type channelInvoker struct {
+	client *channel.Client
+}
+
+func (i *channelInvoker) Invoke(request channel.Request, query bool, submit func(txID string) error, targets ...string) (channel.Response, error) {
+	if query {
+		return i.client.Query(request, channel.WithTargetEndpoints(targets...))
+	}
+	// the handlers of channel.Client.Execute, with submit between the signature validation and the commit
+	handler := invoke.NewSelectAndEndorseHandler(
+		invoke.NewEndorsementValidationHandler(
+			invoke.NewSignatureValidationHandler(&submitHandler{submit: submit, next: invoke.NewCommitHandler()}),
+		),
+	)
+	return i.client.InvokeHandler(handler, request, channel.WithTargetEndpoints(targets...))
+}
+
+// submitHandler records the transaction id of an endorsed transaction before next sends it to the orderer
+type submitHandler struct {
+	submit func(txID string) error
+	next   invoke.Handler
+}
+
+func (h *submitHandler) Handle(requestContext *invoke.RequestContext, clientContext *invoke.ClientContext) {
+	if err := h.submit(string(requestContext.Response.TransactionID)); err != nil {
+		requestContext.Error = errors.Wrap(err, "failed to record the transaction before submitting")
+		return
+	}
+	h.next.Handle(requestContext, clientContext)
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package chaincodeinvocation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakePeer endorses every request with the function name as payload
+type fakePeer struct {
+	request   channel.Request
+	query     bool
+	targets   []string
+	err       error
+	commitErr error
+	submitted string
+}
+
+func (p *fakePeer) Invoke(request channel.Request, query bool, submit func(txID string) error, targets ...string) (channel.Response, error) {
+	p.request, p.query, p.targets = request, query, targets
+	if p.err != nil {
+		return channel.Response{}, p.err
+	}
+	if !query {
+		if err := submit("tx1"); err != nil {
+			return channel.Response{}, err
+		}
+		p.submitted = "tx1"
+		if p.commitErr != nil {
+			return channel.Response{}, p.commitErr
+		}
+	}
+	response := channel.Response{Payload: []byte(request.Fcn), TransactionID: "tx1"}
+	for _, t := range targets {
+		response.Responses = append(response.Responses, &fab.TransactionProposalResponse{
+			Endorser:         t,
+			Status:           200,
+			ProposalResponse: &pb.ProposalResponse{Response: &pb.Response{Status: 200, Message: "ok"}},
+		})
+	}
+	if !query {
+		response.TxValidationCode = pb.TxValidationCode_VALID
+	}
+	return response, nil
+}
+
+func TestCall(t *testing.T) {
+	instance := &current.ChaincodeInvocation{
+		Spec: current.ChaincodeInvocationSpec{
+			Function: "PutValue",
+			Args:     []string{"hello"},
+		},
+	}
+	submit := func(txID string) error {
+		MarkSubmitted(instance, txID)
+		return nil
+	}
+	peer := &fakePeer{}
+	Call(peer, instance, "go-contract", []string{"org1/peer1", "org2/peer1"}, map[string][]byte{"secret": []byte("value")}, submit)
+
+	if peer.request.ChaincodeID != "go-contract" || peer.request.Fcn != "PutValue" || string(peer.request.Args[0]) != "hello" ||
+		string(peer.request.TransientMap["secret"]) != "value" || peer.query {
+		t.Fatalf("unexpected request %+v", peer.request)
+	}
+	status := instance.Status
+	if status.Phase != current.InvocationSucceeded || status.TxID != "tx1" || status.Payload != "PutValue" ||
+		status.ValidationCode != pb.TxValidationCode_VALID.String() || status.SubmitTime.IsZero() {
+		t.Fatalf("unexpected status %+v", status)
+	}
+	if len(status.Endorsements) != 2 || status.Endorsements[1].Peer != "org2/peer1" || status.Endorsements[1].Message != "ok" {
+		t.Fatalf("unexpected endorsements %+v", status.Endorsements)
+	}
+
+	query := &current.ChaincodeInvocation{Spec: current.ChaincodeInvocationSpec{Function: "GetValue", Query: true}}
+	Call(peer, query, "go-contract", []string{"org1/peer1"}, nil, nil)
+	if !peer.query || query.Status.ValidationCode != "" || query.Status.Payload != "GetValue" {
+		t.Fatalf("unexpected query status %+v", query.Status)
+	}
+
+	failed := &current.ChaincodeInvocation{Spec: current.ChaincodeInvocationSpec{Function: "PutValue"}}
+	Call(&fakePeer{err: errors.New("endorsement failure")}, failed, "go-contract", []string{"org1/peer1"}, nil, nil)
+	if failed.Status.Phase != current.InvocationFailed || failed.Status.Message != "endorsement failure" {
+		t.Fatalf("unexpected failed status %+v", failed.Status)
+	}
+
+	// nothing is sent to the orderer if the transaction id can not be recorded
+	unrecorded := &current.ChaincodeInvocation{Spec: current.ChaincodeInvocationSpec{Function: "PutValue"}}
+	peer = &fakePeer{}
+	Call(peer, unrecorded, "go-contract", []string{"org1/peer1"}, nil, func(string) error { return errors.New("conflict") })
+	if peer.submitted != "" || unrecorded.Status.Phase != current.InvocationFailed {
+		t.Fatalf("expect unrecorded transaction not submitted, get %+v", unrecorded.Status)
+	}
+
+	// a submitted transaction whose commit is not confirmed stays running
+	pending := &current.ChaincodeInvocation{Spec: current.ChaincodeInvocationSpec{Function: "PutValue"}}
+	Call(&fakePeer{commitErr: errors.New("commit timeout")}, pending, "go-contract", []string{"org1/peer1"}, nil, func(txID string) error {
+		MarkSubmitted(pending, txID)
+		return nil
+	})
+	if pending.Status.Phase != current.InvocationRunning || pending.Status.TxID != "tx1" || pending.Status.Message != "commit timeout" {
+		t.Fatalf("expect submitted invocation running, get %+v", pending.Status)
+	}
+}
+
+func TestConfirm(t *testing.T) {
+	submitted := func() *current.ChaincodeInvocation {
+		instance := &current.ChaincodeInvocation{}
+		MarkSubmitted(instance, "tx1")
+		return instance
+	}
+	now := time.Now()
+
+	instance := submitted()
+	if result := Confirm(instance, nil, errors.New("not found"), now); result.RequeueAfter != ConfirmInterval || instance.Status.Phase != current.InvocationRunning {
+		t.Fatalf("expect transaction looked up again, get %+v", instance.Status)
+	}
+	if result := Confirm(instance, nil, errors.New("not found"), now.Add(ConfirmTimeout+time.Second)); result.RequeueAfter != 0 || instance.Status.Phase != current.InvocationFailed {
+		t.Fatalf("expect transaction not found failed, get %+v", instance.Status)
+	}
+
+	instance = submitted()
+	Confirm(instance, &pb.ProcessedTransaction{ValidationCode: int32(pb.TxValidationCode_VALID)}, nil, now)
+	if instance.Status.Phase != current.InvocationSucceeded || instance.Status.ValidationCode != pb.TxValidationCode_VALID.String() {
+		t.Fatalf("expect valid transaction succeeded, get %+v", instance.Status)
+	}
+
+	instance = submitted()
+	Confirm(instance, &pb.ProcessedTransaction{ValidationCode: int32(pb.TxValidationCode_MVCC_READ_CONFLICT)}, nil, now)
+	if instance.Status.Phase != current.InvocationFailed || instance.Status.ValidationCode != pb.TxValidationCode_MVCC_READ_CONFLICT.String() {
+		t.Fatalf("expect invalid transaction failed, get %+v", instance.Status)
+	}
+}
+
+func TestTargetPeers(t *testing.T) {
+	peer := func(namespace, name string) current.IBPPeer {
+		return current.IBPPeer{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+	orgPeer := map[string]current.IBPPeer{"org1": peer("org1", "peer1"), "org2": peer("org2", "peer1")}
+
+	instance := &current.ChaincodeInvocation{}
+	if targets, err := TargetPeers(instance, orgPeer); err != nil || len(targets) != 2 {
+		t.Fatalf("expect all members targeted, get %v %v", targets, err)
+	}
+	instance.Spec.TargetOrganizations = []string{"org2"}
+	if targets, err := TargetPeers(instance, orgPeer); err != nil || len(targets) != 1 || targets[0] != (current.NamespacedName{Namespace: "org2", Name: "peer1"}).String() {
+		t.Fatalf("unexpected targets %v %v", targets, err)
+	}
+	instance.Spec.TargetOrganizations = []string{"org3"}
+	if _, err := TargetPeers(instance, orgPeer); err == nil {
+		t.Fatal("expect error for organization without peer")
+	}
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/connector"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/event"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/hyperledger/fabric-config/configtx"
+	proto_common "github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/pkg/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReconcileAnchorPeers sets the anchor peers of each member listed in instance.Spec.AnchorPeers
+func (baseChan *BaseChannel) ReconcileAnchorPeers(instance *current.Channel) error {
+	if len(instance.Spec.AnchorPeers) == 0 {
+		return nil
+	}
+
+	orgs := make([]string, 0, len(instance.Spec.AnchorPeers))
+	anchors := make(map[string]current.MemberAnchorPeers, len(instance.Spec.AnchorPeers))
+	for _, anchor := range instance.Spec.AnchorPeers {
+		orgs = append(orgs, anchor.Organization)
+		anchors[anchor.Organization] = anchor
+	}
+	err := baseChan.UpdateMemberConfigs(instance, "anchor peers", orgs,
+		func(config *proto_common.Config, org string) (*proto_common.Config, error) {
+			addresses, err := baseChan.GetAnchorPeerAddresses(anchors[org].Peers)
+			if err != nil {
+				return nil, err
+			}
+			return ApplyAnchorPeers(config, org, addresses)
+		},
+		func(org string, _ string) error {
+			baseChan.SetAnchorPeerConditions(instance, anchors[org])
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	err = baseChan.Client.PatchStatus(context.TODO(), instance, nil, controllerclient.PatchOption{
+		Resilient: &controllerclient.ResilientPatch{
+			Retry:    2,
+			Into:     &current.Channel{},
+			Strategy: client.MergeFrom,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to patch channel status")
+	}
+
+	return nil
+}
+
+// SetAnchorPeerConditions marks the joined peers of a member as anchored or joined
+func (baseChan *BaseChannel) SetAnchorPeerConditions(instance *current.Channel, anchor current.MemberAnchorPeers) {
+	anchored := make(map[string]bool, len(anchor.Peers))
+	for _, p := range anchor.Peers {
+		anchored[p.String()] = true
+	}
+	for i, condition := range instance.Status.PeerConditions {
+		if condition.Namespace != anchor.Organization || !condition.IsJoined() {
+			continue
+		}
+		conditionType := current.PeerJoined
+		if anchored[condition.String()] {
+			conditionType = current.PeerAnchored
+		}
+		if condition.Type == conditionType {
+			continue
+		}
+		condition.Type = conditionType
+		condition.Status = v1.ConditionTrue
+		condition.Reason = string(conditionType)
+		condition.LastTransitionTime = v1.Now()
+		instance.Status.PeerConditions[i] = condition
+		if conditionType == current.PeerAnchored {
+			event.Normal(baseChan.Config.Recorder(), instance, event.PeerAnchored, "peer %s is an anchor peer", condition.String())
+		}
+	}
+}
+
+// GetAnchorPeerAddresses resolves the endpoint of anchor peers
+func (baseChan *BaseChannel) GetAnchorPeerAddresses(peers []current.NamespacedName) ([]configtx.Address, error) {
+	addresses := make([]configtx.Address, 0, len(peers))
+	for _, p := range peers {
+		endpoint, err := connector.GetNodeEndpoint(baseChan.Client, p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get endpoint of anchor peer %s", p.String())
+		}
+		address, err := ParseAddress(endpoint.URL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "anchor peer %s", p.String())
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+// ParseAddress returns the host and port of a peer url like grpcs://host:port
+func ParseAddress(rawURL string) (configtx.Address, error) {
+	hostPort := rawURL
+	if u, err := url.Parse(rawURL); err == nil && u.Host != "" {
+		hostPort = u.Host
+	}
+	host, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return configtx.Address{}, errors.Wrapf(err, "invalid endpoint %s", rawURL)
+	}
+	portNum, err := strconv.Atoi(port)
+	if err != nil {
+		return configtx.Address{}, errors.Wrapf(err, "invalid port in endpoint %s", rawURL)
+	}
+	return configtx.Address{Host: host, Port: portNum}, nil
+}
+
+// ApplyAnchorPeers returns a copy of original where the anchor peers of org are replaced by desired
+func ApplyAnchorPeers(original *proto_common.Config, org string, desired []configtx.Address) (*proto_common.Config, error) {
+	c := configtx.New(original)
+	updated := c.UpdatedConfig()
+	if _, ok := updated.ChannelGroup.Groups[channelconfig.ApplicationGroupKey].Groups[org]; !ok {
+		return nil, errors.Errorf("organization %s not in channel config", org)
+	}
+	appOrg := c.Application().Organization(org)
+
+	existing, err := appOrg.AnchorPeers()
+	if err != nil {
+		return nil, err
+	}
+	key := func(a configtx.Address) string { return fmt.Sprintf("%s:%d", a.Host, a.Port) }
+	want := make(map[string]bool, len(desired))
+	for _, a := range desired {
+		want[key(a)] = true
+	}
+	have := make(map[string]bool, len(existing))
+	for _, a := range existing {
+		have[key(a)] = true
+		if !want[key(a)] {
+			if err = appOrg.RemoveAnchorPeer(a); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, a := range desired {
+		if !have[key(a)] {
+			if err = appOrg.AddAnchorPeer(a); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return updated, nil
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"strings"
+	"testing"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/event"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-config/configtx"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestApplyAnchorPeers(t *testing.T) {
+	original := testConfig(t)
+	peer1 := configtx.Address{Host: "org1-peer1", Port: 443}
+	peer2 := configtx.Address{Host: "org1-peer2", Port: 443}
+
+	updated, err := ApplyAnchorPeers(original, "org1", []configtx.Address{peer1, peer2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := configtx.New(updated)
+	if peers, _ := c.Application().Organization("org1").AnchorPeers(); len(peers) != 2 {
+		t.Fatalf("expect 2 anchor peers get %v", peers)
+	}
+
+	again, err := ApplyAnchorPeers(updated, "org1", []configtx.Address{peer2, peer1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(updated, again) {
+		t.Fatal("expect no change when anchor peers already set")
+	}
+
+	removed, err := ApplyAnchorPeers(updated, "org1", []configtx.Address{peer2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c = configtx.New(removed)
+	if peers, _ := c.Application().Organization("org1").AnchorPeers(); len(peers) != 1 || peers[0] != peer2 {
+		t.Fatalf("expect only %v get %v", peer2, peers)
+	}
+
+	if _, err = ApplyAnchorPeers(original, "org2", nil); err == nil {
+		t.Fatal("expect error for an organization out of the channel")
+	}
+}
+
+func TestSetAnchorPeerConditions(t *testing.T) {
+	peer1 := current.NamespacedName{Namespace: "org1", Name: "peer1"}
+	peer2 := current.NamespacedName{Namespace: "org1", Name: "peer2"}
+	peer3 := current.NamespacedName{Namespace: "org1", Name: "peer3"}
+	instance := &current.Channel{}
+	instance.Status.PeerConditions = []current.PeerCondition{
+		{NamespacedName: peer1, Type: current.PeerJoined},
+		{NamespacedName: peer2, Type: current.PeerAnchored},
+		{NamespacedName: peer3, Type: current.PeerError},
+	}
+
+	recorder := record.NewFakeRecorder(10)
+	baseChan := &BaseChannel{Config: &config.Config{EventRecorder: recorder}}
+	baseChan.SetAnchorPeerConditions(instance, current.MemberAnchorPeers{Organization: "org1", Peers: []current.NamespacedName{peer1, peer3}})
+
+	expect := []current.PeerConditionType{current.PeerAnchored, current.PeerJoined, current.PeerError}
+	for i, condition := range instance.Status.PeerConditions {
+		if condition.Type != expect[i] {
+			t.Errorf("expect %s to be %s get %s", condition.String(), expect[i], condition.Type)
+		}
+	}
+	if len(recorder.Events) != 1 {
+		t.Fatalf("expect 1 event get %d", len(recorder.Events))
+	}
+	if e := <-recorder.Events; !strings.HasPrefix(e, "Normal "+event.PeerAnchored+" ") {
+		t.Errorf("unexpected event %q", e)
+	}
+}
+
+func TestParseAddress(t *testing.T) {
+	for rawURL, expect := range map[string]configtx.Address{
+		"grpcs://org1-peer1.example.com:443": {Host: "org1-peer1.example.com", Port: 443},
+		"org1-peer1:7051":                    {Host: "org1-peer1", Port: 7051},
+	} {
+		address, err := ParseAddress(rawURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if address != expect {
+			t.Fatalf("expect %v get %v", expect, address)
+		}
+	}
+	if _, err := ParseAddress("grpcs://org1-peer1"); err == nil {
+		t.Fatal("expect error for url without port")
+	}
+}
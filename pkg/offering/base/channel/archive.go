@@ -0,0 +1,531 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/event"
+	chaninit "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/channel"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Archiving a channel:
+//  1. takes a ledger snapshot on one joined peer per organization
+//  2. saves the last config block and removes the channel from all orderers but the keeper,
+//     which keeps the blocks so that the others can replicate them when unarchived
+//  3. restarts the peers to unjoin the channel(see IBPPeer spec.unjoinChannels)
+//
+// Unarchiving runs the other way around: the removed orderers join again with the saved config
+// block, then the peers restart without unjoining and join again, from the snapshot if any.
+
+const (
+	// peerContainer is the name of the peer container in peer's deployment
+	peerContainer = "peer"
+	// unjoinContainer is the name of the init container which unjoins channels
+	unjoinContainer = "unjoin"
+	// configBlockKey is the key of the config block in the archive configmap
+	configBlockKey = "config.block"
+)
+
+// ReconcileArchive moves an archive or unarchive forward. A failed step is recorded in the
+// archive status and retried on the next reconcile.
+func (baseChan *BaseChannel) ReconcileArchive(instance *current.Channel) error {
+	archive := instance.Status.Archive
+	phase := archive.Phase
+
+	var err error
+	switch phase {
+	case current.ArchiveSnapshotting:
+		err = baseChan.SnapshotPeers(instance)
+	case current.ArchiveRemovingOrderers:
+		err = baseChan.RemoveOrderers(instance)
+	case current.ArchiveUnjoiningPeers:
+		err = baseChan.UnjoinPeers(instance)
+	case current.UnarchiveRestoringOrderers:
+		err = baseChan.RestoreOrderers(instance)
+	case current.UnarchiveRejoiningPeers:
+		err = baseChan.RejoinPeers(instance)
+	default:
+		return nil
+	}
+	if err != nil {
+		log.Error(err, "failed to reconcile channel archive", "channel", instance.GetName(), "phase", phase)
+		if archive.Message != err.Error() {
+			event.Warning(baseChan.Config.Recorder(), instance, event.ChannelArchiveFailed, "%s: %s", phase, err)
+		}
+		archive.Message = err.Error()
+	}
+
+	err = baseChan.Client.PatchStatus(context.TODO(), instance, nil, controllerclient.PatchOption{
+		Resilient: &controllerclient.ResilientPatch{
+			Retry:    2,
+			Into:     &current.Channel{},
+			Strategy: client.MergeFrom,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to patch channel status")
+	}
+	return nil
+}
+
+// SnapshotPeers snapshots the channel ledger on the first joined peer of each organization
+func (baseChan *BaseChannel) SnapshotPeers(instance *current.Channel) error {
+	archive := instance.Status.Archive
+	channelID := instance.GetChannelID()
+
+	for _, p := range instance.Spec.Peers {
+		if _, snapshot := archive.GetSnapshot(p.Namespace); snapshot != nil {
+			continue
+		}
+		if _, condition := instance.GetPeerCondition(p); condition.IsJoined() {
+			archive.Snapshots = append(archive.Snapshots, current.PeerSnapshot{NamespacedName: p})
+		}
+	}
+
+	completed := true
+	for i := range archive.Snapshots {
+		snapshot := &archive.Snapshots[i]
+		if snapshot.Completed {
+			continue
+		}
+		completed = false
+		if err := baseChan.snapshotPeer(instance, snapshot); err != nil {
+			return errors.Wrapf(err, "snapshot on peer %s", snapshot.String())
+		}
+		if snapshot.Completed {
+			baseChan.setPeerCondition(instance, snapshot.NamespacedName, current.PeerSnapshotted, fmt.Sprintf("snapshot at block %d", snapshot.BlockNumber))
+			event.Normal(baseChan.Config.Recorder(), instance, event.PeerSnapshotted, "peer %s took a snapshot of channel %s at block %d", snapshot.String(), channelID, snapshot.BlockNumber)
+		}
+	}
+	if !completed {
+		return nil
+	}
+
+	archive.SetPhase(current.ArchiveRemovingOrderers)
+	return nil
+}
+
+// snapshotPeer requests a snapshot at the last committed block, then waits until the peer generates it
+func (baseChan *BaseChannel) snapshotPeer(instance *current.Channel, snapshot *current.PeerSnapshot) error {
+	channelID := instance.GetChannelID()
+	admin, err := baseChan.newPeerAdmin(instance, snapshot.NamespacedName)
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	if snapshot.Path == "" {
+		rootDir, err := baseChan.GetSnapshotsRootDir(snapshot.NamespacedName)
+		if err != nil {
+			return err
+		}
+		info, err := admin.ChainInfo(channelID)
+		if err != nil {
+			return err
+		}
+		blockNumber := info.Height - 1
+		err = admin.GenerateSnapshot(channelID, blockNumber)
+		// the request survived a failed status patch
+		if err != nil && !strings.Contains(err.Error(), "duplicate") {
+			return err
+		}
+		snapshot.BlockNumber = blockNumber
+		snapshot.Path = SnapshotPath(rootDir, channelID, blockNumber)
+	}
+
+	pendings, err := admin.PendingSnapshots(channelID)
+	if err != nil {
+		return err
+	}
+	for _, pending := range pendings {
+		if pending == snapshot.BlockNumber {
+			return nil
+		}
+	}
+	snapshot.Completed = true
+	return nil
+}
+
+// RemoveOrderers saves the last config block, then removes the channel from all orderers but the keeper
+func (baseChan *BaseChannel) RemoveOrderers(instance *current.Channel) error {
+	archive := instance.Status.Archive
+	channelID := instance.GetChannelID()
+
+	ordererorg, parent, clusterNodes, err := baseChan.getOrderers(instance)
+	if err != nil {
+		return err
+	}
+	keeper := ArchiveKeeper(parent, clusterNodes.Items)
+	if keeper == nil {
+		return errors.New("no orderer serves the channel")
+	}
+	archive.Keeper = &current.NamespacedName{Name: keeper.GetName(), Namespace: keeper.GetNamespace()}
+
+	if archive.ConfigBlock == nil {
+		if archive.ConfigBlock, err = baseChan.saveConfigBlock(instance, ordererorg); err != nil {
+			return errors.Wrap(err, "save config block")
+		}
+	}
+
+	osn, err := chaninit.NewOSNAdmin(baseChan.Client, ordererorg, clusterNodes.Items...)
+	if err != nil {
+		return err
+	}
+	for _, node := range clusterNodes.Items {
+		if node.GetName() == keeper.GetName() || chaninit.IsLeavingNode(parent, &node) {
+			continue
+		}
+		if err = osn.Remove(node.GetName(), channelID); err != nil {
+			return errors.Wrapf(err, "remove channel from orderer %s", node.GetName())
+		}
+		removed := current.NamespacedName{Name: node.GetName(), Namespace: node.GetNamespace()}
+		if !containsName(archive.RemovedOrderers, removed) {
+			archive.RemovedOrderers = append(archive.RemovedOrderers, removed)
+			event.Normal(baseChan.Config.Recorder(), instance, event.OrdererChannelRemoved, "orderer %s removed channel %s", removed.String(), channelID)
+		}
+	}
+
+	archive.SetPhase(current.ArchiveUnjoiningPeers)
+	return nil
+}
+
+// UnjoinPeers restarts the channel peers to unjoin the channel, and waits until none of them serves it
+func (baseChan *BaseChannel) UnjoinPeers(instance *current.Channel) error {
+	archive := instance.Status.Archive
+	channelID := instance.GetChannelID()
+
+	unjoined := true
+	for _, p := range instance.Spec.Peers {
+		if _, condition := instance.GetPeerCondition(p); condition.Type == current.PeerArchived {
+			continue
+		}
+		unjoined = false
+
+		peer := &current.IBPPeer{}
+		if err := baseChan.Client.Get(context.TODO(), types.NamespacedName{Namespace: p.Namespace, Name: p.Name}, peer); err != nil {
+			return err
+		}
+		if peer.SetUnjoinChannel(channelID, true) {
+			if err := baseChan.Client.Update(context.TODO(), peer); err != nil {
+				return errors.Wrapf(err, "update peer %s", p.String())
+			}
+			baseChan.setPeerCondition(instance, p, current.PeerUnjoining, "restarting to unjoin the channel")
+			continue
+		}
+
+		joined, err := baseChan.peerJoined(instance, p)
+		if err != nil {
+			// the peer is restarting
+			baseChan.setPeerCondition(instance, p, current.PeerUnjoining, err.Error())
+			continue
+		}
+		if joined {
+			baseChan.setPeerCondition(instance, p, current.PeerUnjoining, "waiting for the peer to restart")
+			continue
+		}
+		baseChan.setPeerCondition(instance, p, current.PeerArchived, "")
+		event.Normal(baseChan.Config.Recorder(), instance, event.PeerUnjoined, "peer %s unjoined channel %s", p.String(), channelID)
+	}
+	if !unjoined {
+		return nil
+	}
+
+	archive.SetPhase(current.ArchiveCompleted)
+	event.Normal(baseChan.Config.Recorder(), instance, event.ChannelArchived, "channel %s archived by proposal %s", channelID, archive.Proposal)
+	return nil
+}
+
+// RestoreOrderers joins the removed orderers into the channel with the saved config block.
+// They replicate the blocks from the keeper.
+func (baseChan *BaseChannel) RestoreOrderers(instance *current.Channel) error {
+	archive := instance.Status.Archive
+	channelID := instance.GetChannelID()
+
+	if len(archive.RemovedOrderers) != 0 {
+		if archive.ConfigBlock == nil {
+			return errors.New("config block of the archived channel not found")
+		}
+		cm := &corev1.ConfigMap{}
+		if err := baseChan.Client.Get(context.TODO(), types.NamespacedName{Namespace: archive.ConfigBlock.Namespace, Name: archive.ConfigBlock.Name}, cm); err != nil {
+			return errors.Wrap(err, "get config block")
+		}
+		block := cm.BinaryData[configBlockKey]
+
+		for len(archive.RemovedOrderers) != 0 {
+			removed := archive.RemovedOrderers[0]
+			node := current.IBPOrderer{}
+			if err := baseChan.Client.Get(context.TODO(), types.NamespacedName{Namespace: removed.Namespace, Name: removed.Name}, &node); err != nil {
+				if !k8serrors.IsNotFound(err) {
+					return err
+				}
+			} else {
+				osn, err := chaninit.NewOSNAdmin(baseChan.Client, removed.Namespace, node)
+				if err != nil {
+					return err
+				}
+				_, err = osn.QueryChannel(node.GetName(), channelID)
+				if err == chaninit.ErrChannelNotFound {
+					err = osn.Join(node.GetName(), block)
+				}
+				if err != nil {
+					return errors.Wrapf(err, "join orderer %s", removed.String())
+				}
+				event.Normal(baseChan.Config.Recorder(), instance, event.OrdererChannelRestored, "orderer %s joined channel %s", removed.String(), channelID)
+			}
+			archive.RemovedOrderers = archive.RemovedOrderers[1:]
+		}
+	}
+
+	archive.SetPhase(current.UnarchiveRejoiningPeers)
+	return nil
+}
+
+// RejoinPeers restarts the channel peers without unjoining the channel, then joins them into the channel.
+// Peers which took a snapshot join from it, the others replicate the channel from the orderers.
+func (baseChan *BaseChannel) RejoinPeers(instance *current.Channel) error {
+	archive := instance.Status.Archive
+	channelID := instance.GetChannelID()
+
+	rejoined := true
+	for _, p := range instance.Spec.Peers {
+		if _, condition := instance.GetPeerCondition(p); condition.IsJoined() {
+			continue
+		}
+		rejoined = false
+
+		peer := &current.IBPPeer{}
+		if err := baseChan.Client.Get(context.TODO(), types.NamespacedName{Namespace: p.Namespace, Name: p.Name}, peer); err != nil {
+			return err
+		}
+		if peer.SetUnjoinChannel(channelID, false) {
+			if err := baseChan.Client.Update(context.TODO(), peer); err != nil {
+				return errors.Wrapf(err, "update peer %s", p.String())
+			}
+			baseChan.setPeerCondition(instance, p, current.PeerArchived, "restarting to rejoin the channel")
+			continue
+		}
+		// joining before the restart would be undone by the unjoin init container
+		restarted, err := baseChan.peerRestarted(p, channelID)
+		if err != nil {
+			return err
+		}
+		if !restarted {
+			baseChan.setPeerCondition(instance, p, current.PeerArchived, "waiting for the peer to restart")
+			continue
+		}
+
+		joined, err := baseChan.peerJoined(instance, p)
+		if err == nil && !joined {
+			if snapshot := archive.SnapshotOf(p); snapshot != nil {
+				err = baseChan.joinBySnapshot(instance, p, snapshot.Path)
+			} else {
+				err = baseChan.JoinChannel(instance.GetName(), channelID, p)
+			}
+		}
+		if err != nil {
+			baseChan.setPeerCondition(instance, p, current.PeerArchived, err.Error())
+			continue
+		}
+		baseChan.setPeerCondition(instance, p, current.PeerJoined, "")
+		event.Normal(baseChan.Config.Recorder(), instance, event.PeerJoined, "peer %s joined", p.String())
+	}
+	if !rejoined {
+		return nil
+	}
+
+	for _, anchor := range instance.Spec.AnchorPeers {
+		baseChan.SetAnchorPeerConditions(instance, anchor)
+	}
+	if archive.ConfigBlock != nil {
+		cm := &corev1.ConfigMap{}
+		cm.Name = archive.ConfigBlock.Name
+		cm.Namespace = archive.ConfigBlock.Namespace
+		if err := baseChan.Client.Delete(context.TODO(), cm); err != nil && !k8serrors.IsNotFound(err) {
+			return errors.Wrap(err, "delete config block")
+		}
+		archive.ConfigBlock = nil
+	}
+	archive.SetPhase(current.UnarchiveCompleted)
+	event.Normal(baseChan.Config.Recorder(), instance, event.ChannelUnarchived, "channel %s unarchived by proposal %s", channelID, archive.Proposal)
+	return nil
+}
+
+// ArchiveKeeper returns the orderer which keeps the archived channel, the cluster node with the lowest node number
+func ArchiveKeeper(parent *current.IBPOrderer, clusterNodes []current.IBPOrderer) *current.IBPOrderer {
+	var keeper *current.IBPOrderer
+	for i := range clusterNodes {
+		node := &clusterNodes[i]
+		if node.Spec.NodeNumber == nil || chaninit.IsLeavingNode(parent, node) {
+			continue
+		}
+		if keeper == nil || *node.Spec.NodeNumber < *keeper.Spec.NodeNumber {
+			keeper = node
+		}
+	}
+	return keeper
+}
+
+// getOrderers returns the orderer organization and cluster nodes of channel's network
+func (baseChan *BaseChannel) getOrderers(instance *current.Channel) (string, *current.IBPOrderer, *current.IBPOrdererList, error) {
+	network := &current.Network{}
+	err := baseChan.Client.Get(context.TODO(), types.NamespacedName{Name: instance.Spec.Network}, network)
+	if err != nil {
+		return "", nil, nil, errors.Wrap(err, "get channel's network")
+	}
+	ordererorg := network.Labels["bestchains.network.initiator"]
+	parent, err := baseChan.Initializer.GetParentNode(ordererorg, network.GetName())
+	if err != nil {
+		return "", nil, nil, err
+	}
+	clusterNodes, err := baseChan.Initializer.GetClusterNodes(ordererorg, network.GetName())
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return ordererorg, parent, clusterNodes, nil
+}
+
+// saveConfigBlock stores the last config block of channel in a configmap of the orderer organization
+func (baseChan *BaseChannel) saveConfigBlock(instance *current.Channel, ordererorg string) (*current.NamespacedName, error) {
+	block, err := baseChan.GetConfigBlockBytes(instance)
+	if err != nil {
+		return nil, err
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      fmt.Sprintf("chan-%s-archive", instance.GetName()),
+			Namespace: ordererorg,
+		},
+		BinaryData: map[string][]byte{
+			configBlockKey: block,
+		},
+	}
+	if err = baseChan.Client.CreateOrUpdate(context.TODO(), cm); err != nil {
+		return nil, err
+	}
+	return &current.NamespacedName{Name: cm.GetName(), Namespace: cm.GetNamespace()}, nil
+}
+
+// peerJoined returns true if the peer serves the channel
+func (baseChan *BaseChannel) peerJoined(instance *current.Channel, peer current.NamespacedName) (bool, error) {
+	admin, err := baseChan.newPeerAdmin(instance, peer)
+	if err != nil {
+		return false, err
+	}
+	defer admin.Close()
+
+	channels, err := admin.JoinedChannels()
+	if err != nil {
+		return false, err
+	}
+	for _, ch := range channels {
+		if ch == instance.GetChannelID() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (baseChan *BaseChannel) joinBySnapshot(instance *current.Channel, peer current.NamespacedName, snapshotDir string) error {
+	admin, err := baseChan.newPeerAdmin(instance, peer)
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	return admin.JoinBySnapshot(snapshotDir)
+}
+
+// peerRestarted returns true if the peer deployment no longer unjoins channelID and all its pods are updated
+func (baseChan *BaseChannel) peerRestarted(peer current.NamespacedName, channelID string) (bool, error) {
+	deploy := &appsv1.Deployment{}
+	err := baseChan.Client.Get(context.TODO(), types.NamespacedName{Namespace: peer.Namespace, Name: peer.Name}, deploy)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range deploy.Spec.Template.Spec.InitContainers {
+		if c.Name == unjoinContainer && len(c.Command) != 0 && UnjoinsChannel(c.Command[len(c.Command)-1], channelID) {
+			return false, nil
+		}
+	}
+	return DeploymentRolledOut(deploy), nil
+}
+
+// UnjoinsChannel returns true if the command of unjoin container unjoins channelID
+func UnjoinsChannel(cmd string, channelID string) bool {
+	for _, field := range strings.Fields(strings.SplitN(cmd, ";", 2)[0]) {
+		if field == channelID {
+			return true
+		}
+	}
+	return false
+}
+
+// DeploymentRolledOut returns true if the pods of deployment all run its latest template
+func DeploymentRolledOut(deploy *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if deploy.Spec.Replicas != nil {
+		replicas = *deploy.Spec.Replicas
+	}
+	status := deploy.Status
+	return status.ObservedGeneration >= deploy.Generation &&
+		status.UpdatedReplicas == replicas &&
+		status.Replicas == replicas &&
+		status.AvailableReplicas == replicas
+}
+
+// setPeerCondition sets the condition of a channel peer
+func (baseChan *BaseChannel) setPeerCondition(instance *current.Channel, peer current.NamespacedName, conditionType current.PeerConditionType, message string) {
+	index, condition := instance.GetPeerCondition(peer)
+	if condition.Type == conditionType && condition.Message == message {
+		return
+	}
+	if condition.Type != conditionType {
+		condition.LastTransitionTime = v1.Now()
+	}
+	condition.Type = conditionType
+	condition.Status = v1.ConditionTrue
+	condition.Reason = string(conditionType)
+	condition.Message = message
+	if index != -1 {
+		instance.Status.PeerConditions[index] = condition
+	} else {
+		instance.Status.PeerConditions = append(instance.Status.PeerConditions, condition)
+	}
+}
+
+func containsName(names []current.NamespacedName, name current.NamespacedName) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	cmocks "github.com/IBM-Blockchain/fabric-operator/controllers/mocks"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func clusterNode(name string, number int) current.IBPOrderer {
+	node := current.IBPOrderer{}
+	node.Name = name
+	node.Namespace = "orderer"
+	node.Spec.NodeNumber = &number
+	return node
+}
+
+func TestArchiveKeeper(t *testing.T) {
+	parent := &current.IBPOrderer{}
+	parent.Spec.ClusterSize = 3
+	nodes := []current.IBPOrderer{clusterNode("node2", 2), clusterNode("node1", 1), clusterNode("node3", 3), clusterNode("node4", 4)}
+
+	if keeper := ArchiveKeeper(parent, nodes); keeper == nil || keeper.Name != "node1" {
+		t.Fatalf("expect node1 as keeper but got %v", keeper)
+	}
+	// node4 is leaving the cluster
+	if keeper := ArchiveKeeper(parent, nodes[3:]); keeper != nil {
+		t.Fatalf("expect no keeper but got %s", keeper.Name)
+	}
+}
+
+func TestSnapshotPath(t *testing.T) {
+	if path := SnapshotPath("/data/peer/snapshots", "channel1", 12); path != "/data/peer/snapshots/completed/channel1/12" {
+		t.Fatalf("unexpected snapshot path %s", path)
+	}
+}
+
+func TestUnjoinsChannel(t *testing.T) {
+	cmd := "for ch in channel1 channel2; do peer node unjoin -c $ch || true; done"
+	if !UnjoinsChannel(cmd, "channel2") {
+		t.Fatal("expect channel2 unjoined")
+	}
+	if UnjoinsChannel(cmd, "channel") || UnjoinsChannel(cmd, "peer") {
+		t.Fatal("expect only listed channels unjoined")
+	}
+}
+
+func TestDeploymentRolledOut(t *testing.T) {
+	replicas := int32(1)
+	deploy := &appsv1.Deployment{}
+	deploy.Generation = 2
+	deploy.Spec.Replicas = &replicas
+	deploy.Status = appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}
+	if !DeploymentRolledOut(deploy) {
+		t.Fatal("expect deployment rolled out")
+	}
+	// old pod still running
+	deploy.Status.Replicas = 2
+	if DeploymentRolledOut(deploy) {
+		t.Fatal("expect deployment rolling")
+	}
+	// new template not observed yet
+	deploy.Status = appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}
+	if DeploymentRolledOut(deploy) {
+		t.Fatal("expect deployment not observed")
+	}
+}
+
+// peerClient serves IBPPeers from peers, keyed by namespace/name, and records their updates in it
+func peerClient(peers map[string]*current.IBPPeer) *cmocks.Client {
+	c := &cmocks.Client{}
+	c.GetStub = func(ctx context.Context, key types.NamespacedName, obj client.Object) error {
+		peer := obj.(*current.IBPPeer)
+		if p, ok := peers[key.String()]; ok {
+			p.DeepCopyInto(peer)
+		}
+		peer.Name = key.Name
+		peer.Namespace = key.Namespace
+		return nil
+	}
+	c.UpdateStub = func(ctx context.Context, obj client.Object, opts ...controllerclient.UpdateOption) error {
+		peer := obj.(*current.IBPPeer)
+		peers[types.NamespacedName{Namespace: peer.Namespace, Name: peer.Name}.String()] = peer.DeepCopy()
+		return nil
+	}
+	return c
+}
+
+func TestUnjoinPeersUpdatesPeerSpec(t *testing.T) {
+	peers := map[string]*current.IBPPeer{}
+	baseChan := &BaseChannel{Client: peerClient(peers)}
+
+	peer1 := current.NamespacedName{Name: "peer1", Namespace: "org1"}
+	peer2 := current.NamespacedName{Name: "peer2", Namespace: "org2"}
+	instance := &current.Channel{}
+	instance.Name = "channel1"
+	instance.Spec.Peers = []current.NamespacedName{peer1, peer2}
+	instance.Status.PeerConditions = []current.PeerCondition{{NamespacedName: peer2, Type: current.PeerArchived}}
+	instance.Status.Archive = &current.ChannelArchive{Phase: current.ArchiveUnjoiningPeers}
+
+	if err := baseChan.UnjoinPeers(instance); err != nil {
+		t.Fatal(err)
+	}
+	if instance.Status.Archive.Phase != current.ArchiveUnjoiningPeers {
+		t.Fatalf("expect waiting for peers but got phase %s", instance.Status.Archive.Phase)
+	}
+	updated, ok := peers["org1/peer1"]
+	if !ok || !reflect.DeepEqual(updated.Spec.UnjoinChannels, []string{instance.GetChannelID()}) {
+		t.Fatalf("expect peer1 to unjoin the channel but got %v", updated)
+	}
+	if _, ok = peers["org2/peer2"]; ok {
+		t.Fatal("expect archived peer2 untouched")
+	}
+	if _, condition := instance.GetPeerCondition(peer1); condition.Type != current.PeerUnjoining {
+		t.Fatalf("unexpected condition of peer1 %s", condition.Type)
+	}
+}
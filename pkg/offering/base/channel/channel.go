@@ -22,6 +22,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
@@ -29,6 +30,7 @@ import (
 	chaninit "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/channel"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/orderer/configtx"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/metrics"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
 	bcrbac "github.com/IBM-Blockchain/fabric-operator/pkg/rbac"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
@@ -37,7 +39,6 @@ import (
 	"github.com/hyperledger/fabric-config/protolator"
 	proto_common "github.com/hyperledger/fabric-protos-go/common"
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/resmgmt"
-	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/msp"
 	"github.com/hyperledger/fabric-sdk-go/pkg/fab/resource"
 	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
 	"github.com/hyperledger/fabric/common/channelconfig"
@@ -47,6 +48,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 var log = logf.Log.WithName("base_channel")
@@ -57,6 +59,8 @@ type Update interface {
 	SpecUpdated() bool
 	MemberUpdated() bool
 	PeerUpdated() bool
+	ConfigUpdated() bool
+	AnchorPeerUpdated() bool
 }
 
 //go:generate counterfeiter -o mocks/override.go -fake-name Override . Override
@@ -111,8 +115,8 @@ func (baseChan *BaseChannel) CreateManagers() {
 }
 
 // PreReconcileChecks on Channel upon Update
-func (baseChan *BaseChannel) PreReconcileChecks(instance *current.Channel, update Update) error {
-	var err error
+func (baseChan *BaseChannel) PreReconcileChecks(instance *current.Channel, update Update) (err error) {
+	defer metrics.ObserveStep(metrics.KindChannel, metrics.StepPreReconcileChecks, time.Now(), &err)
 	log.Info(fmt.Sprintf("PreReconcileChecks on Channel %s", instance.GetName()))
 
 	if !instance.HasNetwork() {
@@ -147,7 +151,8 @@ func (baseChan *BaseChannel) PreReconcileChecks(instance *current.Channel, updat
 }
 
 // Initialize on Channel upon Update
-func (baseChan *BaseChannel) Initialize(instance *current.Channel, update Update) error {
+func (baseChan *BaseChannel) Initialize(instance *current.Channel, update Update) (err error) {
+	defer metrics.ObserveStep(metrics.KindChannel, metrics.StepInitialize, time.Now(), &err)
 	if instance.Status.Type != current.ChannelCreated {
 		err := baseChan.Initializer.CreateChannel(instance)
 		if err != nil {
@@ -159,9 +164,8 @@ func (baseChan *BaseChannel) Initialize(instance *current.Channel, update Update
 }
 
 // ReconcileManagers on Channel upon Update
-func (baseChan *BaseChannel) ReconcileManagers(instance *current.Channel, update Update) error {
-	var err error
-
+func (baseChan *BaseChannel) ReconcileManagers(instance *current.Channel, update Update) (err error) {
+	defer metrics.ObserveStep(metrics.KindChannel, metrics.StepReconcileManagers, time.Now(), &err)
 	// set channel's owner reference to its network
 	err = baseChan.ReconcileOwnerReference(instance, update)
 	if err != nil {
@@ -174,8 +178,20 @@ func (baseChan *BaseChannel) ReconcileManagers(instance *current.Channel, update
 		return err
 	}
 
+	// archive or unarchive after the proposal passed, other updates wait until it finishes
+	if instance.ArchiveInProgress() {
+		return baseChan.ReconcileArchive(instance)
+	}
+
 	// member changed
 	if update.MemberUpdated() {
+		// remove members before regenerating connection profile, their admins may sign the config update
+		if instance.HasType() {
+			err = baseChan.RemoveChannelMembers(instance)
+			if err != nil {
+				return errors.Wrap(err, "failed to remove channel members")
+			}
+		}
 		err = baseChan.ReconcileConnectionProfile(instance, update)
 		if err != nil {
 			return err
@@ -190,8 +206,8 @@ func (baseChan *BaseChannel) ReconcileManagers(instance *current.Channel, update
 	}
 
 	// Reconcile peer if peer updated
-	// - join new peer into channel
-	if update.PeerUpdated() {
+	// - join new peer into channel, unless channel archived
+	if update.PeerUpdated() && instance.Status.Type != current.ChannelArchived {
 		for _, p := range instance.Spec.Peers {
 			err = baseChan.ReconcilePeer(instance, p)
 			if err != nil {
@@ -205,11 +221,43 @@ func (baseChan *BaseChannel) ReconcileManagers(instance *current.Channel, update
 		}
 	}
 
+	// Set anchor peers after peers joined
+	if update.PeerUpdated() || update.AnchorPeerUpdated() {
+		err = baseChan.ReconcileAnchorPeers(instance)
+		if err != nil {
+			return errors.Wrap(err, "failed to reconcile anchor peers")
+		}
+	}
+
+	// Submit config update if channel config updated
+	if update.ConfigUpdated() {
+		err = baseChan.ReconcileChannelConfig(instance)
+		if err != nil {
+			return errors.Wrap(err, "failed to reconcile channel config")
+		}
+	}
+
+	// Push the approved CRLs of members into their MSPs
+	if instance.HasType() && instance.Status.Type != current.ChannelArchived {
+		err = baseChan.ReconcileRevocations(instance)
+		if err != nil {
+			return errors.Wrap(err, "failed to reconcile revocations")
+		}
+	}
+
+	// Record config blocks submitted above(or by others) into config history
+	if instance.HasType() {
+		if err = baseChan.ReconcileConfigHistory(instance); err != nil {
+			log.Error(err, "failed to reconcile channel config history", "channel", instance.GetName())
+		}
+	}
+
 	return nil
 }
 
-// CheckStates on Channel(do nothing)
-func (baseChan *BaseChannel) CheckStates(instance *current.Channel, update Update) (common.Result, error) {
+// CheckStates on Channel, requeue until archive or unarchive finishes
+func (baseChan *BaseChannel) CheckStates(instance *current.Channel, update Update) (result common.Result, err error) {
+	defer metrics.ObserveStep(metrics.KindChannel, metrics.StepCheckStates, time.Now(), &err)
 	if !instance.HasType() {
 		return common.Result{
 			Status: &current.CRStatus{
@@ -219,6 +267,12 @@ func (baseChan *BaseChannel) CheckStates(instance *current.Channel, update Updat
 		}, nil
 	}
 
+	if instance.ArchiveInProgress() {
+		return common.Result{
+			Result: reconcile.Result{Requeue: true},
+		}, nil
+	}
+
 	return common.Result{}, nil
 }
 
@@ -273,6 +327,14 @@ func (baseChan *BaseChannel) ReconcileConnectionProfile(instance *current.Channe
 	}
 	// Connection profile which only have relevant org's admin credentials
 	for _, org := range instance.Spec.Members {
+		// external organizations get connection profiles in their own clusters
+		external, err := current.IsExternalOrganization(context.TODO(), baseChan.Client, org.Name)
+		if err != nil {
+			return err
+		}
+		if external {
+			continue
+		}
 		p := profile.DeepCopy()
 		err = baseChan.GenerateConnProfileForOrg(instance, p, org.Name)
 		if err != nil {
@@ -297,6 +359,10 @@ func (baseChan *BaseChannel) GenerateChannelConnProfile(clientOrg string, channe
 	if err != nil {
 		return nil, err
 	}
+	parentOrderer, err := baseChan.Initializer.GetParentNode(ordererorg, network.GetName())
+	if err != nil {
+		return nil, err
+	}
 
 	// default connprofile with default client
 	basedir := baseChan.Initializer.GetStoragePath(channel)
@@ -314,7 +380,7 @@ func (baseChan *BaseChannel) GenerateChannelConnProfile(clientOrg string, channe
 	for _, p := range channel.Status.PeerConditions {
 		// only joined peer can be appended into connection profile
 		// only peer in spec can be appended into connection profile
-		if p.Type != current.PeerJoined || !peersInSpec[p.String()] {
+		if !p.IsJoined() || !peersInSpec[p.String()] {
 			continue
 		}
 		err = profile.SetPeer(baseChan.Client, p.NamespacedName)
@@ -331,6 +397,10 @@ func (baseChan *BaseChannel) GenerateChannelConnProfile(clientOrg string, channe
 
 	// Orderers
 	for _, o := range clusterNodes.Items {
+		// nodes leaving the cluster may have been removed from channel already
+		if chaninit.IsLeavingNode(parentOrderer, &o) {
+			continue
+		}
 		err = profile.SetOrderer(baseChan.Client, current.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()})
 		if err != nil {
 			return nil, err
@@ -343,6 +413,16 @@ func (baseChan *BaseChannel) GenerateChannelConnProfile(clientOrg string, channe
 		orgs[index] = m.GetName()
 	}
 	for _, org := range orgs {
+		external, err := current.GetExternalOrganization(context.TODO(), baseChan.Client, org)
+		if err != nil {
+			return nil, err
+		}
+		if external != nil {
+			if err = profile.SetExternalOrganization(external); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		adminUser, err := baseChan.GetOrgAdminCredentials(org)
 		if err != nil {
 			return nil, err
@@ -524,17 +604,23 @@ func (baseChan *BaseChannel) GetChannelConnector(c controllerclient.Client, inst
 }
 
 func (baseChan *BaseChannel) GetChannelConfig(con *connector.Connector, instance *current.Channel, org *current.Organization) (client *resmgmt.Client, config *proto_common.Config, err error) {
-	adminContext := con.SDK().Context(fabsdk.WithUser(org.Spec.Admin), fabsdk.WithOrg(org.GetName()))
-	client, err = resmgmt.New(adminContext)
+	var block *proto_common.Block
+	client, block, err = baseChan.GetChannelConfigBlock(con, instance, org)
 	if err != nil {
 		return
 	}
-	var block *proto_common.Block
-	block, err = client.QueryConfigBlockFromOrderer(instance.GetChannelID())
+	config, err = resource.ExtractConfigFromBlock(block)
+	return
+}
+
+// GetChannelConfigBlock queries the latest config block from orderer with org's admin
+func (baseChan *BaseChannel) GetChannelConfigBlock(con *connector.Connector, instance *current.Channel, org *current.Organization) (client *resmgmt.Client, block *proto_common.Block, err error) {
+	adminContext := con.SDK().Context(fabsdk.WithUser(org.Spec.Admin), fabsdk.WithOrg(org.GetName()))
+	client, err = resmgmt.New(adminContext)
 	if err != nil {
 		return
 	}
-	config, err = resource.ExtractConfigFromBlock(block)
+	block, err = client.QueryConfigBlockFromOrderer(instance.GetChannelID())
 	return
 }
 
@@ -570,53 +656,22 @@ func (baseChan *BaseChannel) AddMemberToChan(client *resmgmt.Client, instance *c
 		}
 	}
 
-	// calculate  configUpdate and get its envlope bytes
-	configUpdate, err := resmgmt.CalculateConfigUpdate(instance.GetChannelID(), currentConfig, modifiedConfig)
-	if err != nil {
-		return errors.Wrap(err, "calculate config update error")
-	}
-	configEnvelopeBytes, err := GetConfigEnvelopeBytes(configUpdate)
-	if err != nil {
-		return errors.Wrap(err, "get config envelope bytes error")
-	}
-	configReader := bytes.NewReader(configEnvelopeBytes)
-
-	// get all signingIdentities needed
-	signIdentities := make([]msp.SigningIdentity, 0)
-	orgCon, err := baseChan.GetChannelConnector(baseChan.Client, instance, "")
-	if err != nil {
-		return errors.Wrap(err, "get channel connector error")
-	}
-	defer orgCon.Close()
+	// all members except the new ones sign the update
+	signers := make([]string, 0, len(instance.Spec.Members))
 	for _, member := range instance.Spec.Members {
 		if util.ContainsValue(member.Name, orgNames) {
 			log.Info("skip org sign config update, because of new org to channel", "org", member.GetName())
 			continue
 		}
-		msg := fmt.Sprintf("org: %s ", member.GetName())
-		organization := &current.Organization{}
-		organization.Name = member.GetName()
-		if err = baseChan.Client.Get(context.TODO(), types.NamespacedName{Name: organization.GetName()}, organization); err != nil {
-			return errors.Wrap(err, msg+"get org error")
-		}
-		clientContext := orgCon.SDK().Context(fabsdk.WithUser(organization.Spec.Admin), fabsdk.WithOrg(organization.GetName()))
-		cctx, err := clientContext()
-		if err != nil {
-			return err
-		}
-		signIdentities = append(signIdentities, cctx)
+		signers = append(signers, member.GetName())
 	}
 
 	// update channel config
-	txID, err := client.SaveChannel(resmgmt.SaveChannelRequest{
-		ChannelID:         instance.GetChannelID(),
-		ChannelConfig:     configReader,
-		SigningIdentities: signIdentities,
-	})
+	txID, err := baseChan.SubmitConfigUpdate(client, instance, currentConfig, modifiedConfig, signers)
 	if err != nil {
-		return errors.Wrap(err, "save channel error")
+		return err
 	}
-	log.Info(fmt.Sprintf("update channel config to update member in txID:%s", txID.TransactionID), "channel", instance.GetName(), "newMember", orgNames)
+	log.Info(fmt.Sprintf("update channel config to update member in txID:%s", txID), "channel", instance.GetName(), "newMember", orgNames)
 	return nil
 }
 
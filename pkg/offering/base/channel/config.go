@@ -0,0 +1,397 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/connector"
+	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-config/configtx"
+	proto_common "github.com/hyperledger/fabric-protos-go/common"
+	proto_orderer "github.com/hyperledger/fabric-protos-go/orderer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/resmgmt"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/msp"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/pkg/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReconcileChannelConfig submits a config update when the channel configuration
+// on the ordering service differs from instance.Spec.Config
+func (baseChan *BaseChannel) ReconcileChannelConfig(instance *current.Channel) error {
+	if instance.Spec.Config == nil {
+		return nil
+	}
+
+	org, err := baseChan.GetNetworkInitiatorOrg(instance)
+	if err != nil {
+		return errors.Wrap(err, "cant get network initiator org")
+	}
+	con, err := baseChan.GetChannelConnector(baseChan.Client, instance, org.GetName())
+	if err != nil {
+		return errors.Wrap(err, "cant get channel connector")
+	}
+	defer con.Close()
+	resClient, channelConfig, err := baseChan.GetChannelConfig(con, instance, org)
+	if err != nil {
+		return errors.Wrap(err, "cant get channel config")
+	}
+
+	modifiedConfig, err := ApplyChannelConfig(channelConfig, instance.Spec.Config)
+	if err != nil {
+		return errors.Wrap(err, "cant apply channel config")
+	}
+	if proto.Equal(channelConfig, modifiedConfig) {
+		log.Info("channel config already up to date", "channel", instance.GetName())
+		return nil
+	}
+
+	// orderer org signs as well since orderer and channel values are updated under its admins policy
+	signers := []string{org.GetName()}
+	for _, m := range instance.Spec.Members {
+		if m.GetName() != org.GetName() {
+			signers = append(signers, m.GetName())
+		}
+	}
+	txID, err := baseChan.SubmitConfigUpdate(resClient, instance, channelConfig, modifiedConfig, signers)
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("update channel config in txID:%s", txID), "channel", instance.GetName(), "proposal", instance.Spec.Config.Proposal)
+
+	instance.Status.AppliedConfig = &current.AppliedChannelConfig{
+		Sequence:  channelConfig.Sequence + 1,
+		TxID:      txID,
+		Proposal:  instance.Spec.Config.Proposal,
+		AppliedAt: v1.Now(),
+	}
+	return baseChan.Client.PatchStatus(context.TODO(), instance, nil, k8sclient.PatchOption{
+		Resilient: &k8sclient.ResilientPatch{
+			Retry:    2,
+			Into:     &current.Channel{},
+			Strategy: client.MergeFrom,
+		},
+	})
+}
+
+// ApplyChannelConfig returns a copy of original updated with the fields set in config.
+// Values already matching config are left untouched.
+func ApplyChannelConfig(original *proto_common.Config, config *current.ChannelConfig) (*proto_common.Config, error) {
+	c := configtx.New(original)
+	updated := c.UpdatedConfig()
+
+	if config.Orderer != nil {
+		ordererGroup, ok := updated.ChannelGroup.Groups[channelconfig.OrdererGroupKey]
+		if !ok {
+			return nil, errors.New("channel config has no orderer group")
+		}
+		if err := applyOrdererConfig(ordererGroup, config.Orderer); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.ApplicationCapabilities != nil {
+		application := c.Application()
+		existing, err := application.Capabilities()
+		if err != nil {
+			return nil, err
+		}
+		if err = replaceCapabilities(existing, config.ApplicationCapabilities, application.AddCapability, application.RemoveCapability); err != nil {
+			return nil, errors.Wrap(err, "application capabilities")
+		}
+	}
+
+	if config.ChannelCapabilities != nil {
+		channel := c.Channel()
+		existing, err := channel.Capabilities()
+		if err != nil {
+			return nil, err
+		}
+		if err = replaceCapabilities(existing, config.ChannelCapabilities, channel.AddCapability, channel.RemoveCapability); err != nil {
+			return nil, errors.Wrap(err, "channel capabilities")
+		}
+	}
+
+	if len(config.ACLs) != 0 {
+		application := c.Application()
+		acls, err := application.ACLs()
+		if err != nil {
+			return nil, err
+		}
+		changed := false
+		for resource, policy := range config.ACLs {
+			if acls[resource] != policy {
+				acls[resource] = policy
+				changed = true
+			}
+		}
+		if changed {
+			if err = application.SetACLs(acls); err != nil {
+				return nil, errors.Wrap(err, "set acls")
+			}
+		}
+	}
+
+	return updated, nil
+}
+
+func applyOrdererConfig(ordererGroup *proto_common.ConfigGroup, config *current.ChannelOrdererConfig) error {
+	if config.BatchTimeout != "" {
+		timeout, err := time.ParseDuration(config.BatchTimeout)
+		if err != nil {
+			return errors.Wrap(err, "invalid batch timeout")
+		}
+		batchTimeout := &proto_orderer.BatchTimeout{}
+		if err = unmarshalValue(ordererGroup, channelconfig.BatchTimeoutKey, batchTimeout); err != nil {
+			return err
+		}
+		if existing, err := time.ParseDuration(batchTimeout.Timeout); err != nil || existing != timeout {
+			batchTimeout.Timeout = timeout.String()
+			if err = marshalValue(ordererGroup, channelconfig.BatchTimeoutKey, batchTimeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	if config.BatchSize != nil {
+		batchSize := &proto_orderer.BatchSize{}
+		if err := unmarshalValue(ordererGroup, channelconfig.BatchSizeKey, batchSize); err != nil {
+			return err
+		}
+		modified := proto.Clone(batchSize).(*proto_orderer.BatchSize)
+		if config.BatchSize.MaxMessageCount != 0 {
+			modified.MaxMessageCount = config.BatchSize.MaxMessageCount
+		}
+		if config.BatchSize.AbsoluteMaxBytes != 0 {
+			modified.AbsoluteMaxBytes = config.BatchSize.AbsoluteMaxBytes
+		}
+		if config.BatchSize.PreferredMaxBytes != 0 {
+			modified.PreferredMaxBytes = config.BatchSize.PreferredMaxBytes
+		}
+		if modified.PreferredMaxBytes > modified.AbsoluteMaxBytes {
+			return errors.Errorf("preferred max bytes %d exceeds absolute max bytes %d", modified.PreferredMaxBytes, modified.AbsoluteMaxBytes)
+		}
+		if !proto.Equal(batchSize, modified) {
+			if err := marshalValue(ordererGroup, channelconfig.BatchSizeKey, modified); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func unmarshalValue(group *proto_common.ConfigGroup, key string, msg proto.Message) error {
+	value, ok := group.Values[key]
+	if !ok {
+		return errors.Errorf("config value %s not found", key)
+	}
+	return errors.Wrapf(proto.Unmarshal(value.Value, msg), "unmarshal config value %s", key)
+}
+
+// marshalValue keeps the version and mod policy of the value, the version is bumped when computing the config update
+func marshalValue(group *proto_common.ConfigGroup, key string, msg proto.Message) error {
+	raw, err := proto.Marshal(msg)
+	if err != nil {
+		return errors.Wrapf(err, "marshal config value %s", key)
+	}
+	group.Values[key].Value = raw
+	return nil
+}
+
+func replaceCapabilities(existing []string, desired []string, add func(string) error, remove func(string) error) error {
+	want := make(map[string]bool, len(desired))
+	for _, c := range desired {
+		want[c] = true
+	}
+	have := make(map[string]bool, len(existing))
+	for _, c := range existing {
+		have[c] = true
+		if !want[c] {
+			if err := remove(c); err != nil {
+				return err
+			}
+		}
+	}
+	for _, c := range desired {
+		if !have[c] {
+			if err := add(c); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// SubmitConfigUpdate computes the update from currentConfig to modifiedConfig, signs it
+// with the admin of each signer organization and sends it to the ordering service
+func (baseChan *BaseChannel) SubmitConfigUpdate(resClient *resmgmt.Client, instance *current.Channel, currentConfig, modifiedConfig *proto_common.Config, signers []string) (string, error) {
+	configUpdate, err := resmgmt.CalculateConfigUpdate(instance.GetChannelID(), currentConfig, modifiedConfig)
+	if err != nil {
+		return "", errors.Wrap(err, "calculate config update error")
+	}
+	configEnvelopeBytes, err := GetConfigEnvelopeBytes(configUpdate)
+	if err != nil {
+		return "", errors.Wrap(err, "get config envelope bytes error")
+	}
+	signers, external, err := baseChan.LocalSigners(signers)
+	if err != nil {
+		return "", err
+	}
+	if len(signers) == 0 {
+		return "", errors.Errorf("config update can only be signed by external organizations %v, whose admins sign in their own clusters", external)
+	}
+	if len(external) > 0 {
+		log.Info("config update is not signed by external organizations", "channel", instance.GetName(), "organizations", external)
+	}
+
+	orgCon, err := baseChan.GetSignerConnector(instance, signers)
+	if err != nil {
+		return "", errors.Wrap(err, "get channel connector error")
+	}
+	defer orgCon.Close()
+	signIdentities, err := baseChan.GetSigningIdentities(orgCon, signers)
+	if err != nil {
+		return "", err
+	}
+
+	txID, err := resClient.SaveChannel(resmgmt.SaveChannelRequest{
+		ChannelID:         instance.GetChannelID(),
+		ChannelConfig:     bytes.NewReader(configEnvelopeBytes),
+		SigningIdentities: signIdentities,
+	})
+	if err != nil {
+		if len(external) > 0 {
+			return "", errors.Wrapf(err, "save channel error, the update lacks the signatures of external organizations %v", external)
+		}
+		return "", errors.Wrap(err, "save channel error")
+	}
+	return string(txID.TransactionID), nil
+}
+
+// UpdateMemberConfigs fetches the channel config once as the network initiator, then submits a separate
+// config update for each of orgs, computed by apply and signed by the admin of that org only.
+// Each update only touches the group of its own org, so all of them can be computed from the fetched config.
+// done is called once the config of an org is up to date, with the txID of its update if one was submitted.
+func (baseChan *BaseChannel) UpdateMemberConfigs(instance *current.Channel, subject string, orgs []string,
+	apply func(config *proto_common.Config, org string) (*proto_common.Config, error), done func(org string, txID string) error) error {
+	initiator, err := baseChan.GetNetworkInitiatorOrg(instance)
+	if err != nil {
+		return errors.Wrap(err, "cant get network initiator org")
+	}
+	con, err := baseChan.GetChannelConnector(baseChan.Client, instance, initiator.GetName())
+	if err != nil {
+		return errors.Wrap(err, "cant get channel connector")
+	}
+	defer con.Close()
+	resClient, channelConfig, err := baseChan.GetChannelConfig(con, instance, initiator)
+	if err != nil {
+		return errors.Wrap(err, "cant get channel config")
+	}
+
+	for _, org := range orgs {
+		modifiedConfig, err := apply(channelConfig, org)
+		if err != nil {
+			return errors.Wrapf(err, "cant apply %s of %s", subject, org)
+		}
+		var txID string
+		if !proto.Equal(channelConfig, modifiedConfig) {
+			txID, err = baseChan.SubmitConfigUpdate(resClient, instance, channelConfig, modifiedConfig, []string{org})
+			if err != nil {
+				return errors.Wrapf(err, "update %s of %s", subject, org)
+			}
+			log.Info(fmt.Sprintf("update %s in txID:%s", subject, txID), "channel", instance.GetName(), "org", org)
+		}
+		if err = done(org, txID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LocalSigners splits signers into local organizations and external organizations, whose admins can only sign in their own clusters.
+// The update is accepted as long as the local signatures satisfy the channel's modification policy,
+// otherwise SubmitConfigUpdate reports the external organizations which did not sign.
+func (baseChan *BaseChannel) LocalSigners(signers []string) ([]string, []string, error) {
+	local := make([]string, 0, len(signers))
+	external := make([]string, 0)
+	for _, signer := range signers {
+		isExternal, err := current.IsExternalOrganization(context.TODO(), baseChan.Client, signer)
+		if err != nil {
+			return nil, nil, err
+		}
+		if isExternal {
+			external = append(external, signer)
+			continue
+		}
+		local = append(local, signer)
+	}
+	return local, external, nil
+}
+
+// GetSignerConnector returns a channel connector which holds the admin credentials of all signers,
+// including organizations already dropped from the channel's connection profile(e.g. members being removed)
+func (baseChan *BaseChannel) GetSignerConnector(instance *current.Channel, signers []string) (*connector.Connector, error) {
+	profile, err := connector.ChannelProfile(baseChan.Client, instance.GetName())
+	if err != nil {
+		return nil, err
+	}
+	for _, signer := range signers {
+		if _, ok := profile.Organizations[signer]; ok {
+			continue
+		}
+		adminUser, err := baseChan.GetOrgAdminCredentials(signer)
+		if err != nil {
+			return nil, err
+		}
+		profile.SetOrganization(signer, nil, adminUser)
+	}
+	profile.Client.Organization = ""
+	return connector.NewConnector(func() ([]byte, error) {
+		return profile.Marshal(connector.YAML)
+	})
+}
+
+// GetSigningIdentities returns the admin signing identity of each organization
+func (baseChan *BaseChannel) GetSigningIdentities(con *connector.Connector, orgNames []string) ([]msp.SigningIdentity, error) {
+	signIdentities := make([]msp.SigningIdentity, 0, len(orgNames))
+	for _, orgName := range orgNames {
+		msg := fmt.Sprintf("org: %s ", orgName)
+		organization := &current.Organization{}
+		if err := baseChan.Client.Get(context.TODO(), types.NamespacedName{Name: orgName}, organization); err != nil {
+			return nil, errors.Wrap(err, msg+"get org error")
+		}
+		clientContext := con.SDK().Context(fabsdk.WithUser(organization.Spec.Admin), fabsdk.WithOrg(organization.GetName()))
+		cctx, err := clientContext()
+		if err != nil {
+			return nil, errors.Wrap(err, msg+"get client context error")
+		}
+		signIdentities = append(signIdentities, cctx)
+	}
+	return signIdentities, nil
+}
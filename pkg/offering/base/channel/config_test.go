@@ -0,0 +1,141 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	cmocks "github.com/IBM-Blockchain/fabric-operator/controllers/mocks"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-config/configtx"
+	proto_common "github.com/hyperledger/fabric-protos-go/common"
+	proto_orderer "github.com/hyperledger/fabric-protos-go/orderer"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func value(t *testing.T, msg proto.Message) *proto_common.ConfigValue {
+	raw, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &proto_common.ConfigValue{Value: raw, ModPolicy: "Admins"}
+}
+
+func testConfig(t *testing.T) *proto_common.Config {
+	return &proto_common.Config{
+		Sequence: 3,
+		ChannelGroup: &proto_common.ConfigGroup{
+			Groups: map[string]*proto_common.ConfigGroup{
+				channelconfig.OrdererGroupKey: {
+					Values: map[string]*proto_common.ConfigValue{
+						channelconfig.BatchSizeKey:    value(t, &proto_orderer.BatchSize{MaxMessageCount: 10, AbsoluteMaxBytes: 1000, PreferredMaxBytes: 500}),
+						channelconfig.BatchTimeoutKey: value(t, &proto_orderer.BatchTimeout{Timeout: "2s"}),
+					},
+				},
+				channelconfig.ApplicationGroupKey: {
+					Groups: map[string]*proto_common.ConfigGroup{
+						"org1": {Values: map[string]*proto_common.ConfigValue{}},
+					},
+					Values: map[string]*proto_common.ConfigValue{
+						channelconfig.CapabilitiesKey: value(t, &proto_common.Capabilities{
+							Capabilities: map[string]*proto_common.Capability{"V1_4_2": {}},
+						}),
+					},
+				},
+			},
+			Values: map[string]*proto_common.ConfigValue{},
+		},
+	}
+}
+
+func TestApplyChannelConfig(t *testing.T) {
+	original := testConfig(t)
+	config := &current.ChannelConfig{
+		Orderer: &current.ChannelOrdererConfig{
+			BatchTimeout: "1s",
+			BatchSize:    &current.BatchSize{MaxMessageCount: 100},
+		},
+		ApplicationCapabilities: []string{"V2_0"},
+		ChannelCapabilities:     []string{"V2_0"},
+	}
+
+	updated, err := ApplyChannelConfig(original, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(original, testConfig(t)) {
+		t.Fatal("expect original config untouched")
+	}
+
+	c := configtx.New(updated)
+	batchSize := &proto_orderer.BatchSize{}
+	if err = unmarshalValue(updated.ChannelGroup.Groups[channelconfig.OrdererGroupKey], channelconfig.BatchSizeKey, batchSize); err != nil {
+		t.Fatal(err)
+	}
+	if batchSize.MaxMessageCount != 100 || batchSize.AbsoluteMaxBytes != 1000 {
+		t.Fatalf("unexpected batch size %+v", batchSize)
+	}
+	batchTimeout := &proto_orderer.BatchTimeout{}
+	if err = unmarshalValue(updated.ChannelGroup.Groups[channelconfig.OrdererGroupKey], channelconfig.BatchTimeoutKey, batchTimeout); err != nil {
+		t.Fatal(err)
+	}
+	if batchTimeout.Timeout != "1s" {
+		t.Fatalf("expect batch timeout 1s get %s", batchTimeout.Timeout)
+	}
+	if caps, _ := c.Application().Capabilities(); len(caps) != 1 || caps[0] != "V2_0" {
+		t.Fatalf("unexpected application capabilities %v", caps)
+	}
+	if caps, _ := c.Channel().Capabilities(); len(caps) != 1 || caps[0] != "V2_0" {
+		t.Fatalf("unexpected channel capabilities %v", caps)
+	}
+
+	// applying the same config again is a no-op
+	again, err := ApplyChannelConfig(updated, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(updated, again) {
+		t.Fatal("expect no change when config already applied")
+	}
+}
+
+func TestLocalSigners(t *testing.T) {
+	c := &cmocks.Client{}
+	c.GetStub = func(ctx context.Context, key types.NamespacedName, obj client.Object) error {
+		if key.Name == "org3" {
+			return nil
+		}
+		return k8serrors.NewNotFound(schema.GroupResource{Resource: "externalorganizations"}, key.Name)
+	}
+	baseChan := &BaseChannel{Client: c}
+	local, external, err := baseChan.LocalSigners([]string{"org1", "org3", "org2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(local, []string{"org1", "org2"}) || !reflect.DeepEqual(external, []string{"org3"}) {
+		t.Errorf("unexpected signers local %v external %v", local, external)
+	}
+}
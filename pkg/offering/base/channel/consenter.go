@@ -0,0 +1,222 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"bytes"
+	"fmt"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/golang/protobuf/proto"
+	proto_common "github.com/hyperledger/fabric-protos-go/common"
+	proto_orderer "github.com/hyperledger/fabric-protos-go/orderer"
+	"github.com/hyperledger/fabric-protos-go/orderer/etcdraft"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/pkg/errors"
+)
+
+// UpdateOrdererConfig applies update on a copy of the channel config and submits the change with the
+// orderer organization(network initiator)'s signature. Nothing submitted if update reports no change.
+func (baseChan *BaseChannel) UpdateOrdererConfig(instance *current.Channel, update func(config *proto_common.Config) (bool, error)) (bool, error) {
+	org, err := baseChan.GetNetworkInitiatorOrg(instance)
+	if err != nil {
+		return false, errors.Wrap(err, "cant get network initiator org")
+	}
+	con, err := baseChan.GetChannelConnector(baseChan.Client, instance, org.GetName())
+	if err != nil {
+		return false, errors.Wrap(err, "cant get channel connector")
+	}
+	defer con.Close()
+	resClient, channelConfig, err := baseChan.GetChannelConfig(con, instance, org)
+	if err != nil {
+		return false, errors.Wrap(err, "cant get channel config")
+	}
+
+	modifiedConfig := proto.Clone(channelConfig).(*proto_common.Config)
+	changed, err := update(modifiedConfig)
+	if err != nil || !changed {
+		return false, err
+	}
+	txID, err := baseChan.SubmitConfigUpdate(resClient, instance, channelConfig, modifiedConfig, []string{org.GetName()})
+	if err != nil {
+		return false, err
+	}
+	log.Info(fmt.Sprintf("update orderer config in txID:%s", txID), "channel", instance.GetName())
+	return true, nil
+}
+
+// GetConfigBlockBytes returns the latest config block of channel, which is used to join orderers into channel
+func (baseChan *BaseChannel) GetConfigBlockBytes(instance *current.Channel) ([]byte, error) {
+	org, err := baseChan.GetNetworkInitiatorOrg(instance)
+	if err != nil {
+		return nil, errors.Wrap(err, "cant get network initiator org")
+	}
+	con, err := baseChan.GetChannelConnector(baseChan.Client, instance, org.GetName())
+	if err != nil {
+		return nil, errors.Wrap(err, "cant get channel connector")
+	}
+	defer con.Close()
+	_, block, err := baseChan.GetChannelConfigBlock(con, instance, org)
+	if err != nil {
+		return nil, errors.Wrap(err, "cant get channel config block")
+	}
+	return proto.Marshal(block)
+}
+
+// Consenters returns the raft consenters in channel config
+func Consenters(config *proto_common.Config) ([]*etcdraft.Consenter, error) {
+	_, metadata, err := consensusMetadata(config)
+	if err != nil {
+		return nil, err
+	}
+	return metadata.Consenters, nil
+}
+
+// SetConsenter adds consenter into(or replaces its certs) or removes it from the raft consenter set of config.
+// Consenters are identified by host and port. Returns true if config changed.
+func SetConsenter(config *proto_common.Config, consenter *etcdraft.Consenter, present bool) (bool, error) {
+	consensusType, metadata, err := consensusMetadata(config)
+	if err != nil {
+		return false, err
+	}
+
+	consenters := make([]*etcdraft.Consenter, 0, len(metadata.Consenters)+1)
+	changed, found := false, false
+	for _, c := range metadata.Consenters {
+		if c.Host != consenter.Host || c.Port != consenter.Port {
+			consenters = append(consenters, c)
+			continue
+		}
+		found = true
+		if !present {
+			changed = true
+			continue
+		}
+		if !bytes.Equal(c.ClientTlsCert, consenter.ClientTlsCert) || !bytes.Equal(c.ServerTlsCert, consenter.ServerTlsCert) {
+			changed = true
+			c = consenter
+		}
+		consenters = append(consenters, c)
+	}
+	if present && !found {
+		changed = true
+		consenters = append(consenters, consenter)
+	}
+	if !changed {
+		return false, nil
+	}
+	if len(consenters) == 0 {
+		return false, errors.New("cant remove the last consenter")
+	}
+
+	metadata.Consenters = consenters
+	if consensusType.Metadata, err = proto.Marshal(metadata); err != nil {
+		return false, errors.Wrap(err, "marshal etcdraft metadata")
+	}
+	ordererGroup := config.ChannelGroup.Groups[channelconfig.OrdererGroupKey]
+	return true, marshalValue(ordererGroup, channelconfig.ConsensusTypeKey, consensusType)
+}
+
+func consensusMetadata(config *proto_common.Config) (*proto_orderer.ConsensusType, *etcdraft.ConfigMetadata, error) {
+	ordererGroup, ok := config.GetChannelGroup().GetGroups()[channelconfig.OrdererGroupKey]
+	if !ok {
+		return nil, nil, errors.New("channel config has no orderer group")
+	}
+	consensusType := &proto_orderer.ConsensusType{}
+	if err := unmarshalValue(ordererGroup, channelconfig.ConsensusTypeKey, consensusType); err != nil {
+		return nil, nil, err
+	}
+	if consensusType.Type != "etcdraft" {
+		return nil, nil, errors.Errorf("consensus type %s is not supported", consensusType.Type)
+	}
+	metadata := &etcdraft.ConfigMetadata{}
+	if err := proto.Unmarshal(consensusType.Metadata, metadata); err != nil {
+		return nil, nil, errors.Wrap(err, "unmarshal etcdraft metadata")
+	}
+	return consensusType, metadata, nil
+}
+
+// OrdererEndpoints returns the endpoints of orderer organization org in channel config
+func OrdererEndpoints(config *proto_common.Config, org string) ([]string, error) {
+	addresses := &proto_common.OrdererAddresses{}
+	orgGroup, err := ordererOrgGroup(config, org)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := orgGroup.Values[channelconfig.EndpointsKey]; !ok {
+		return nil, nil
+	}
+	if err = unmarshalValue(orgGroup, channelconfig.EndpointsKey, addresses); err != nil {
+		return nil, err
+	}
+	return addresses.Addresses, nil
+}
+
+// SetOrdererEndpoint adds address into or removes it from the endpoints of orderer organization org.
+// Returns true if config changed.
+func SetOrdererEndpoint(config *proto_common.Config, org string, address string, present bool) (bool, error) {
+	endpoints, err := OrdererEndpoints(config, org)
+	if err != nil {
+		return false, err
+	}
+	addresses := make([]string, 0, len(endpoints)+1)
+	found := false
+	for _, e := range endpoints {
+		if e == address {
+			found = true
+			if !present {
+				continue
+			}
+		}
+		addresses = append(addresses, e)
+	}
+	if found == present {
+		return false, nil
+	}
+	if present {
+		addresses = append(addresses, address)
+	}
+
+	orgGroup, _ := ordererOrgGroup(config, org)
+	raw, err := proto.Marshal(&proto_common.OrdererAddresses{Addresses: addresses})
+	if err != nil {
+		return false, errors.Wrap(err, "marshal orderer addresses")
+	}
+	if value, ok := orgGroup.Values[channelconfig.EndpointsKey]; ok {
+		value.Value = raw
+	} else {
+		orgGroup.Values[channelconfig.EndpointsKey] = &proto_common.ConfigValue{Value: raw, ModPolicy: channelconfig.AdminsPolicyKey}
+	}
+	return true, nil
+}
+
+func ordererOrgGroup(config *proto_common.Config, org string) (*proto_common.ConfigGroup, error) {
+	ordererGroup, ok := config.GetChannelGroup().GetGroups()[channelconfig.OrdererGroupKey]
+	if !ok {
+		return nil, errors.New("channel config has no orderer group")
+	}
+	orgGroup, ok := ordererGroup.Groups[org]
+	if !ok {
+		return nil, errors.Errorf("orderer organization %s not found in channel config", org)
+	}
+	if orgGroup.Values == nil {
+		orgGroup.Values = make(map[string]*proto_common.ConfigValue)
+	}
+	return orgGroup, nil
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	proto_common "github.com/hyperledger/fabric-protos-go/common"
+	proto_orderer "github.com/hyperledger/fabric-protos-go/orderer"
+	"github.com/hyperledger/fabric-protos-go/orderer/etcdraft"
+	"github.com/hyperledger/fabric/common/channelconfig"
+)
+
+func raftConfig(t *testing.T, hosts ...string) *proto_common.Config {
+	metadata := &etcdraft.ConfigMetadata{}
+	for _, h := range hosts {
+		metadata.Consenters = append(metadata.Consenters, &etcdraft.Consenter{Host: h, Port: 443, ClientTlsCert: []byte(h), ServerTlsCert: []byte(h)})
+	}
+	raw, err := proto.Marshal(metadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := testConfig(t)
+	ordererGroup := config.ChannelGroup.Groups[channelconfig.OrdererGroupKey]
+	ordererGroup.Values[channelconfig.ConsensusTypeKey] = value(t, &proto_orderer.ConsensusType{Type: "etcdraft", Metadata: raw})
+	ordererGroup.Groups = map[string]*proto_common.ConfigGroup{
+		"orderer": {Values: map[string]*proto_common.ConfigValue{}},
+	}
+	return config
+}
+
+func consenterHosts(t *testing.T, config *proto_common.Config) []string {
+	consenters, err := Consenters(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hosts := make([]string, len(consenters))
+	for i, c := range consenters {
+		hosts[i] = c.Host
+	}
+	return hosts
+}
+
+func TestSetConsenter(t *testing.T) {
+	config := raftConfig(t, "node1", "node2")
+
+	changed, err := SetConsenter(config, &etcdraft.Consenter{Host: "node3", Port: 443}, true)
+	if err != nil || !changed {
+		t.Fatalf("expect node3 added, changed %v err %v", changed, err)
+	}
+	if hosts := consenterHosts(t, config); !reflect.DeepEqual(hosts, []string{"node1", "node2", "node3"}) {
+		t.Fatalf("unexpected consenters %v", hosts)
+	}
+
+	// same certs, nothing to do
+	changed, err = SetConsenter(config, &etcdraft.Consenter{Host: "node1", Port: 443, ClientTlsCert: []byte("node1"), ServerTlsCert: []byte("node1")}, true)
+	if err != nil || changed {
+		t.Fatalf("expect node1 unchanged, changed %v err %v", changed, err)
+	}
+
+	// renewed certs replace the existing consenter
+	changed, err = SetConsenter(config, &etcdraft.Consenter{Host: "node1", Port: 443, ClientTlsCert: []byte("new"), ServerTlsCert: []byte("new")}, true)
+	if err != nil || !changed {
+		t.Fatalf("expect node1 updated, changed %v err %v", changed, err)
+	}
+	consenters, _ := Consenters(config)
+	if string(consenters[0].ClientTlsCert) != "new" {
+		t.Fatalf("expect node1 cert renewed but got %s", consenters[0].ClientTlsCert)
+	}
+
+	changed, err = SetConsenter(config, &etcdraft.Consenter{Host: "node2", Port: 443}, false)
+	if err != nil || !changed {
+		t.Fatalf("expect node2 removed, changed %v err %v", changed, err)
+	}
+	if hosts := consenterHosts(t, config); !reflect.DeepEqual(hosts, []string{"node1", "node3"}) {
+		t.Fatalf("unexpected consenters %v", hosts)
+	}
+
+	changed, err = SetConsenter(config, &etcdraft.Consenter{Host: "node2", Port: 443}, false)
+	if err != nil || changed {
+		t.Fatalf("expect removing absent consenter no change, changed %v err %v", changed, err)
+	}
+
+	config = raftConfig(t, "node1")
+	if _, err = SetConsenter(config, &etcdraft.Consenter{Host: "node1", Port: 443}, false); err == nil {
+		t.Fatal("expect error when removing the last consenter")
+	}
+}
+
+func TestSetOrdererEndpoint(t *testing.T) {
+	config := raftConfig(t, "node1")
+
+	changed, err := SetOrdererEndpoint(config, "orderer", "node1:443", true)
+	if err != nil || !changed {
+		t.Fatalf("expect endpoint added, changed %v err %v", changed, err)
+	}
+	changed, err = SetOrdererEndpoint(config, "orderer", "node2:443", true)
+	if err != nil || !changed {
+		t.Fatalf("expect endpoint added, changed %v err %v", changed, err)
+	}
+	changed, err = SetOrdererEndpoint(config, "orderer", "node2:443", true)
+	if err != nil || changed {
+		t.Fatalf("expect existing endpoint unchanged, changed %v err %v", changed, err)
+	}
+	endpoints, err := OrdererEndpoints(config, "orderer")
+	if err != nil || !reflect.DeepEqual(endpoints, []string{"node1:443", "node2:443"}) {
+		t.Fatalf("unexpected endpoints %v err %v", endpoints, err)
+	}
+
+	changed, err = SetOrdererEndpoint(config, "orderer", "node1:443", false)
+	if err != nil || !changed {
+		t.Fatalf("expect endpoint removed, changed %v err %v", changed, err)
+	}
+	endpoints, _ = OrdererEndpoints(config, "orderer")
+	if !reflect.DeepEqual(endpoints, []string{"node2:443"}) {
+		t.Fatalf("unexpected endpoints %v", endpoints)
+	}
+
+	if _, err = SetOrdererEndpoint(config, "unknown", "node1:443", true); err == nil {
+		t.Fatal("expect error for unknown orderer organization")
+	}
+}
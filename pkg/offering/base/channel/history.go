@@ -0,0 +1,425 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-config/configtx"
+	proto_common "github.com/hyperledger/fabric-protos-go/common"
+	proto_msp "github.com/hyperledger/fabric-protos-go/msp"
+	proto_orderer "github.com/hyperledger/fabric-protos-go/orderer"
+	"github.com/hyperledger/fabric-protos-go/orderer/etcdraft"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/ledger"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/pkg/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReconcileConfigHistory records the config blocks of the channel committed since the last observed one
+func (baseChan *BaseChannel) ReconcileConfigHistory(instance *current.Channel) error {
+	org, err := baseChan.GetNetworkInitiatorOrg(instance)
+	if err != nil {
+		return errors.Wrap(err, "cant get network initiator org")
+	}
+	con, err := baseChan.GetChannelConnector(baseChan.Client, instance, org.GetName())
+	if err != nil {
+		return errors.Wrap(err, "cant get channel connector")
+	}
+	defer con.Close()
+	_, block, err := baseChan.GetChannelConfigBlock(con, instance, org)
+	if err != nil {
+		return errors.Wrap(err, "cant get channel config block")
+	}
+
+	if latest := instance.LatestConfigRecord(); latest != nil && latest.BlockNumber >= block.GetHeader().GetNumber() && instance.Status.ObservedConfig != nil {
+		return nil
+	}
+	ledgerClient, err := ledger.New(con.SDK().ChannelContext(instance.GetChannelID(), fabsdk.WithUser(org.Spec.Admin), fabsdk.WithOrg(org.GetName())))
+	if err != nil {
+		return errors.Wrap(err, "cant get ledger client")
+	}
+	blocks, err := ConfigBlocksSince(block, instance.LatestConfigRecord(), func(number uint64) (*proto_common.Block, error) {
+		return ledgerClient.QueryBlock(number)
+	})
+	if err != nil {
+		return err
+	}
+	for _, b := range blocks {
+		record, observed, err := RecordConfigBlock(b, instance.Status.ObservedConfig)
+		if err != nil {
+			return err
+		}
+		log.Info(fmt.Sprintf("observed config block %d with %d changes", record.BlockNumber, len(record.Changes)), "channel", instance.GetName())
+
+		instance.AddConfigRecord(*record)
+		instance.Status.ObservedConfig = observed
+	}
+	return baseChan.Client.PatchStatus(context.TODO(), instance, nil, k8sclient.PatchOption{
+		Resilient: &k8sclient.ResilientPatch{
+			Retry:    2,
+			Into:     &current.Channel{},
+			Strategy: client.MergeFrom,
+		},
+	})
+}
+
+// ConfigBlocksSince returns the config blocks committed after the recorded one up to latest, oldest first.
+// The config block before each one is found by the last config index of the block preceding it.
+// Without a record, or when more config blocks were committed than kept in history, only the
+// latest MaxChannelConfigHistory blocks are returned.
+func ConfigBlocksSince(latest *proto_common.Block, recorded *current.ChannelConfigRecord, queryBlock func(number uint64) (*proto_common.Block, error)) ([]*proto_common.Block, error) {
+	blocks := []*proto_common.Block{latest}
+	for block := latest; len(blocks) < current.MaxChannelConfigHistory; {
+		number := block.GetHeader().GetNumber()
+		if number == 0 {
+			break
+		}
+		previous, err := queryBlock(number - 1)
+		if err != nil {
+			return nil, errors.Wrapf(err, "query block %d", number-1)
+		}
+		index, err := lastConfigIndex(previous)
+		if err != nil {
+			return nil, errors.Wrapf(err, "block %d", number-1)
+		}
+		if recorded != nil && index <= recorded.BlockNumber {
+			break
+		}
+		if index != previous.GetHeader().GetNumber() {
+			if previous, err = queryBlock(index); err != nil {
+				return nil, errors.Wrapf(err, "query config block %d", index)
+			}
+		}
+		block = previous
+		blocks = append(blocks, block)
+	}
+	for i, j := 0, len(blocks)-1; i < j; i, j = i+1, j-1 {
+		blocks[i], blocks[j] = blocks[j], blocks[i]
+	}
+	return blocks, nil
+}
+
+// lastConfigIndex returns the number of the last config block as of block. Orderers of v2 record it
+// in the SIGNATURES metadata, older ones in the LAST_CONFIG metadata.
+func lastConfigIndex(block *proto_common.Block) (uint64, error) {
+	metadata := block.GetMetadata().GetMetadata()
+	if len(metadata) > int(proto_common.BlockMetadataIndex_SIGNATURES) {
+		md := &proto_common.Metadata{}
+		if err := proto.Unmarshal(metadata[proto_common.BlockMetadataIndex_SIGNATURES], md); err != nil {
+			return 0, errors.Wrap(err, "unmarshal signatures metadata")
+		}
+		obm := &proto_common.OrdererBlockMetadata{}
+		if err := proto.Unmarshal(md.Value, obm); err == nil && obm.LastConfig != nil {
+			return obm.LastConfig.Index, nil
+		}
+	}
+	if len(metadata) > int(proto_common.BlockMetadataIndex_LAST_CONFIG) {
+		md := &proto_common.Metadata{}
+		if err := proto.Unmarshal(metadata[proto_common.BlockMetadataIndex_LAST_CONFIG], md); err != nil {
+			return 0, errors.Wrap(err, "unmarshal last config metadata")
+		}
+		lc := &proto_common.LastConfig{}
+		if err := proto.Unmarshal(md.Value, lc); err != nil {
+			return 0, errors.Wrap(err, "unmarshal last config")
+		}
+		return lc.Index, nil
+	}
+	return 0, errors.New("no last config in block metadata")
+}
+
+// RecordConfigBlock decodes a config block into a config record which holds the changes against previous(flattened config).
+// The flattened config of this block is returned as well.
+func RecordConfigBlock(block *proto_common.Block, previous map[string]string) (*current.ChannelConfigRecord, map[string]string, error) {
+	if block.GetHeader() == nil || len(block.GetData().GetData()) == 0 {
+		return nil, nil, errors.New("invalid config block")
+	}
+	envelope := &proto_common.Envelope{}
+	if err := proto.Unmarshal(block.Data.Data[0], envelope); err != nil {
+		return nil, nil, errors.Wrap(err, "unmarshal envelope")
+	}
+	payload := &proto_common.Payload{}
+	if err := proto.Unmarshal(envelope.Payload, payload); err != nil {
+		return nil, nil, errors.Wrap(err, "unmarshal payload")
+	}
+	if payload.Header == nil {
+		return nil, nil, errors.New("config block without header")
+	}
+	chdr := &proto_common.ChannelHeader{}
+	if err := proto.Unmarshal(payload.Header.ChannelHeader, chdr); err != nil {
+		return nil, nil, errors.Wrap(err, "unmarshal channel header")
+	}
+	if proto_common.HeaderType(chdr.Type) != proto_common.HeaderType_CONFIG {
+		return nil, nil, errors.Errorf("block %d is not a config block", block.Header.Number)
+	}
+	configEnvelope := &proto_common.ConfigEnvelope{}
+	if err := proto.Unmarshal(payload.Data, configEnvelope); err != nil {
+		return nil, nil, errors.Wrap(err, "unmarshal config envelope")
+	}
+	if configEnvelope.Config == nil {
+		return nil, nil, errors.New("config envelope without config")
+	}
+
+	observed, err := FlattenConfig(configEnvelope.Config)
+	if err != nil {
+		return nil, nil, err
+	}
+	record := &current.ChannelConfigRecord{
+		Sequence:    configEnvelope.Config.Sequence,
+		BlockNumber: block.Header.Number,
+		TxID:        chdr.TxId,
+		Changes:     DiffConfig(previous, observed),
+	}
+	if chdr.Timestamp != nil {
+		record.Timestamp = v1.NewTime(chdr.Timestamp.AsTime())
+	}
+	// the genesis block has no config update
+	if configEnvelope.LastUpdate != nil {
+		record.Creator, record.Signers, err = configUpdateSigners(configEnvelope.LastUpdate)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return record, observed, nil
+}
+
+// configUpdateSigners returns the MSP IDs of the submitter and signers of a config update envelope
+func configUpdateSigners(envelope *proto_common.Envelope) (string, []string, error) {
+	payload := &proto_common.Payload{}
+	if err := proto.Unmarshal(envelope.Payload, payload); err != nil {
+		return "", nil, errors.Wrap(err, "unmarshal config update payload")
+	}
+	var creator string
+	if payload.Header != nil {
+		shdr := &proto_common.SignatureHeader{}
+		if err := proto.Unmarshal(payload.Header.SignatureHeader, shdr); err != nil {
+			return "", nil, errors.Wrap(err, "unmarshal config update signature header")
+		}
+		creator = mspIDOf(shdr.Creator)
+	}
+	updateEnvelope := &proto_common.ConfigUpdateEnvelope{}
+	if err := proto.Unmarshal(payload.Data, updateEnvelope); err != nil {
+		return "", nil, errors.Wrap(err, "unmarshal config update envelope")
+	}
+	signers := make([]string, 0, len(updateEnvelope.Signatures))
+	for _, signature := range updateEnvelope.Signatures {
+		shdr := &proto_common.SignatureHeader{}
+		if err := proto.Unmarshal(signature.SignatureHeader, shdr); err != nil {
+			return "", nil, errors.Wrap(err, "unmarshal config signature header")
+		}
+		signers = append(signers, mspIDOf(shdr.Creator))
+	}
+	return creator, signers, nil
+}
+
+func mspIDOf(creator []byte) string {
+	identity := &proto_msp.SerializedIdentity{}
+	if err := proto.Unmarshal(creator, identity); err != nil {
+		return ""
+	}
+	return identity.Mspid
+}
+
+// FlattenConfig flattens the organizations, policies, orderer settings and capabilities of config into path/value pairs,
+// e.g. Application/Organizations/org1/Policies/Admins: OR('org1.admin')
+func FlattenConfig(config *proto_common.Config) (map[string]string, error) {
+	if config.ChannelGroup == nil {
+		return nil, errors.New("config without channel group")
+	}
+	values := make(map[string]string)
+	c := configtx.New(config)
+
+	capabilities, err := c.Channel().Capabilities()
+	if err != nil {
+		return nil, err
+	}
+	setList(values, "Channel/Capabilities", capabilities)
+	policies, err := c.Channel().Policies()
+	if err != nil {
+		return nil, err
+	}
+	setPolicies(values, "Channel", policies)
+
+	if application, ok := config.ChannelGroup.Groups[channelconfig.ApplicationGroupKey]; ok {
+		if err = flattenApplication(values, c.Application(), application); err != nil {
+			return nil, errors.Wrap(err, "application")
+		}
+	}
+	if orderer, ok := config.ChannelGroup.Groups[channelconfig.OrdererGroupKey]; ok {
+		if err = flattenOrderer(values, c.Orderer(), orderer); err != nil {
+			return nil, errors.Wrap(err, "orderer")
+		}
+	}
+	return values, nil
+}
+
+func flattenApplication(values map[string]string, app *configtx.ApplicationGroup, group *proto_common.ConfigGroup) error {
+	capabilities, err := app.Capabilities()
+	if err != nil {
+		return err
+	}
+	setList(values, "Application/Capabilities", capabilities)
+	policies, err := app.Policies()
+	if err != nil {
+		return err
+	}
+	setPolicies(values, "Application", policies)
+	if _, ok := group.Values[channelconfig.ACLsKey]; ok {
+		acls, err := app.ACLs()
+		if err != nil {
+			return err
+		}
+		for resource, policy := range acls {
+			values[path.Join("Application/ACLs", resource)] = policy
+		}
+	}
+
+	for name, orgGroup := range group.Groups {
+		prefix := path.Join("Application/Organizations", name)
+		values[prefix] = mspNameOf(orgGroup, name)
+		org := app.Organization(name)
+		policies, err := org.Policies()
+		if err != nil {
+			return errors.Wrapf(err, "organization %s", name)
+		}
+		setPolicies(values, prefix, policies)
+		anchorPeers, err := org.AnchorPeers()
+		if err != nil {
+			return err
+		}
+		addresses := make([]string, len(anchorPeers))
+		for i, p := range anchorPeers {
+			addresses[i] = fmt.Sprintf("%s:%d", p.Host, p.Port)
+		}
+		setList(values, path.Join(prefix, "AnchorPeers"), addresses)
+	}
+	return nil
+}
+
+func flattenOrderer(values map[string]string, orderer *configtx.OrdererGroup, group *proto_common.ConfigGroup) error {
+	capabilities, err := orderer.Capabilities()
+	if err != nil {
+		return err
+	}
+	setList(values, "Orderer/Capabilities", capabilities)
+	policies, err := orderer.Policies()
+	if err != nil {
+		return err
+	}
+	setPolicies(values, "Orderer", policies)
+
+	batchSize := &proto_orderer.BatchSize{}
+	if err = unmarshalValue(group, channelconfig.BatchSizeKey, batchSize); err == nil {
+		values["Orderer/BatchSize/MaxMessageCount"] = fmt.Sprint(batchSize.MaxMessageCount)
+		values["Orderer/BatchSize/AbsoluteMaxBytes"] = fmt.Sprint(batchSize.AbsoluteMaxBytes)
+		values["Orderer/BatchSize/PreferredMaxBytes"] = fmt.Sprint(batchSize.PreferredMaxBytes)
+	}
+	batchTimeout := &proto_orderer.BatchTimeout{}
+	if err = unmarshalValue(group, channelconfig.BatchTimeoutKey, batchTimeout); err == nil {
+		values["Orderer/BatchTimeout"] = batchTimeout.Timeout
+	}
+	consensusType := &proto_orderer.ConsensusType{}
+	if err = unmarshalValue(group, channelconfig.ConsensusTypeKey, consensusType); err == nil {
+		values["Orderer/ConsensusType"] = consensusType.Type
+		values["Orderer/ConsensusState"] = consensusType.State.String()
+		if consensusType.Type == "etcdraft" {
+			metadata := &etcdraft.ConfigMetadata{}
+			if err = proto.Unmarshal(consensusType.Metadata, metadata); err != nil {
+				return errors.Wrap(err, "unmarshal etcdraft metadata")
+			}
+			consenters := make([]string, len(metadata.Consenters))
+			for i, consenter := range metadata.Consenters {
+				consenters[i] = fmt.Sprintf("%s:%d", consenter.Host, consenter.Port)
+			}
+			setList(values, "Orderer/Consenters", consenters)
+		}
+	}
+
+	for name, orgGroup := range group.Groups {
+		prefix := path.Join("Orderer/Organizations", name)
+		values[prefix] = mspNameOf(orgGroup, name)
+		policies, err := orderer.Organization(name).Policies()
+		if err != nil {
+			return errors.Wrapf(err, "organization %s", name)
+		}
+		setPolicies(values, prefix, policies)
+		endpoints := &proto_common.OrdererAddresses{}
+		if err = unmarshalValue(orgGroup, channelconfig.EndpointsKey, endpoints); err == nil {
+			setList(values, path.Join(prefix, "Endpoints"), endpoints.Addresses)
+		}
+	}
+	return nil
+}
+
+// mspNameOf returns the MSP ID of an organization group, falls back to the group name
+func mspNameOf(group *proto_common.ConfigGroup, name string) string {
+	mspConfig := &proto_msp.MSPConfig{}
+	if err := unmarshalValue(group, channelconfig.MSPKey, mspConfig); err != nil {
+		return name
+	}
+	fabricConfig := &proto_msp.FabricMSPConfig{}
+	if err := proto.Unmarshal(mspConfig.Config, fabricConfig); err != nil || fabricConfig.Name == "" {
+		return name
+	}
+	return fabricConfig.Name
+}
+
+func setPolicies(values map[string]string, prefix string, policies map[string]configtx.Policy) {
+	for name, policy := range policies {
+		values[path.Join(prefix, "Policies", name)] = policy.Rule
+	}
+}
+
+// setList sets a sorted, comma separated list. Empty lists are skipped.
+func setList(values map[string]string, key string, list []string) {
+	if len(list) == 0 {
+		return
+	}
+	sorted := append([]string{}, list...)
+	sort.Strings(sorted)
+	values[key] = strings.Join(sorted, ",")
+}
+
+// DiffConfig compares two flattened configs, changes are sorted by path
+func DiffConfig(previous, observed map[string]string) []current.ChannelConfigChange {
+	changes := make([]current.ChannelConfigChange, 0)
+	for p, value := range observed {
+		if old, ok := previous[p]; !ok || old != value {
+			changes = append(changes, current.ChannelConfigChange{Path: p, Old: old, New: value})
+		}
+	}
+	for p, old := range previous {
+		if _, ok := observed[p]; !ok {
+			changes = append(changes, current.ChannelConfigChange{Path: p, Old: old})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
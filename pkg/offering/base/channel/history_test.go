@@ -0,0 +1,195 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"fmt"
+	"testing"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-config/configtx"
+	proto_common "github.com/hyperledger/fabric-protos-go/common"
+	proto_msp "github.com/hyperledger/fabric-protos-go/msp"
+)
+
+func mustMarshal(t *testing.T, msg proto.Message) []byte {
+	raw, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func creator(t *testing.T, mspID string) []byte {
+	return mustMarshal(t, &proto_msp.SerializedIdentity{Mspid: mspID})
+}
+
+func configBlock(t *testing.T, number uint64, config *proto_common.Config, submitter string, signers ...string) *proto_common.Block {
+	update := &proto_common.ConfigUpdateEnvelope{}
+	for _, s := range signers {
+		update.Signatures = append(update.Signatures, &proto_common.ConfigSignature{
+			SignatureHeader: mustMarshal(t, &proto_common.SignatureHeader{Creator: creator(t, s)}),
+		})
+	}
+	lastUpdate := &proto_common.Envelope{Payload: mustMarshal(t, &proto_common.Payload{
+		Header: &proto_common.Header{SignatureHeader: mustMarshal(t, &proto_common.SignatureHeader{Creator: creator(t, submitter)})},
+		Data:   mustMarshal(t, update),
+	})}
+	payload := &proto_common.Payload{
+		Header: &proto_common.Header{
+			ChannelHeader: mustMarshal(t, &proto_common.ChannelHeader{Type: int32(proto_common.HeaderType_CONFIG), TxId: fmt.Sprintf("tx%d", number)}),
+		},
+		Data: mustMarshal(t, &proto_common.ConfigEnvelope{Config: config, LastUpdate: lastUpdate}),
+	}
+	return &proto_common.Block{
+		Header: &proto_common.BlockHeader{Number: number},
+		Data:   &proto_common.BlockData{Data: [][]byte{mustMarshal(t, &proto_common.Envelope{Payload: mustMarshal(t, payload)})}},
+	}
+}
+
+func TestRecordConfigBlock(t *testing.T) {
+	original := testConfig(t)
+	first, observed, err := RecordConfigBlock(configBlock(t, 0, original, "orderer"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if observed["Orderer/BatchTimeout"] != "2s" || observed["Orderer/BatchSize/MaxMessageCount"] != "10" ||
+		observed["Application/Capabilities"] != "V1_4_2" || observed["Application/Organizations/org1"] != "org1" {
+		t.Fatalf("unexpected flattened config %v", observed)
+	}
+	if len(first.Changes) != len(observed) || first.Creator != "orderer" || first.Sequence != 3 {
+		t.Fatalf("expect every item added in first record, got %+v", first)
+	}
+
+	updated, err := ApplyChannelConfig(original, &current.ChannelConfig{
+		Orderer:                 &current.ChannelOrdererConfig{BatchTimeout: "1s"},
+		ApplicationCapabilities: []string{"V2_0"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := configtx.New(updated)
+	if err = c.Application().Organization("org1").SetPolicy("Admins", "Admins", configtx.Policy{Type: configtx.SignaturePolicyType, Rule: "OR('org1.admin','org1.peer')"}); err != nil {
+		t.Fatal(err)
+	}
+	updated = c.UpdatedConfig()
+	updated.ChannelGroup.Groups["Application"].Groups["org2"] = &proto_common.ConfigGroup{Values: map[string]*proto_common.ConfigValue{}}
+
+	second, _, err := RecordConfigBlock(configBlock(t, 5, updated, "org1", "org1", "org2"), observed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.BlockNumber != 5 || second.TxID != "tx5" || second.Creator != "org1" || len(second.Signers) != 2 || second.Signers[1] != "org2" {
+		t.Fatalf("unexpected record %+v", second)
+	}
+	expected := []current.ChannelConfigChange{
+		{Path: "Application/Capabilities", Old: "V1_4_2", New: "V2_0"},
+		{Path: "Application/Organizations/org1/Policies/Admins", New: "OR('org1.admin', 'org1.peer')"},
+		{Path: "Application/Organizations/org2", New: "org2"},
+		{Path: "Orderer/BatchTimeout", Old: "2s", New: "1s"},
+	}
+	if len(second.Changes) != len(expected) {
+		t.Fatalf("expect changes %+v, got %+v", expected, second.Changes)
+	}
+	for i := range expected {
+		if second.Changes[i] != expected[i] {
+			t.Errorf("expect change %+v, got %+v", expected[i], second.Changes[i])
+		}
+	}
+
+	removed := DiffConfig(map[string]string{"Application/Organizations/org1": "org1"}, map[string]string{})
+	if len(removed) != 1 || removed[0].Old != "org1" || removed[0].New != "" {
+		t.Errorf("unexpected removal %+v", removed)
+	}
+
+	if _, _, err = RecordConfigBlock(&proto_common.Block{Header: &proto_common.BlockHeader{}}, nil); err == nil {
+		t.Error("expect error on empty block")
+	}
+}
+
+func TestAddConfigRecord(t *testing.T) {
+	instance := &current.Channel{}
+	if instance.LatestConfigRecord() != nil {
+		t.Fatal("expect no record")
+	}
+	changes := make([]current.ChannelConfigChange, current.MaxChannelConfigChanges+5)
+	for i := 0; i < current.MaxChannelConfigHistory+3; i++ {
+		instance.AddConfigRecord(current.ChannelConfigRecord{BlockNumber: uint64(i), Changes: changes})
+	}
+	if len(instance.Status.ConfigHistory) != current.MaxChannelConfigHistory {
+		t.Fatalf("expect %d records, got %d", current.MaxChannelConfigHistory, len(instance.Status.ConfigHistory))
+	}
+	latest := instance.LatestConfigRecord()
+	if latest.BlockNumber != uint64(current.MaxChannelConfigHistory+2) || instance.Status.ConfigHistory[0].BlockNumber != 3 {
+		t.Errorf("unexpected history bounds %d..%d", instance.Status.ConfigHistory[0].BlockNumber, latest.BlockNumber)
+	}
+	if len(latest.Changes) != current.MaxChannelConfigChanges || latest.Truncated != 5 {
+		t.Errorf("expect changes truncated, got %d changes and %d truncated", len(latest.Changes), latest.Truncated)
+	}
+}
+
+func withLastConfig(t *testing.T, block *proto_common.Block, index uint64, legacy bool) *proto_common.Block {
+	metadata := make([][]byte, proto_common.BlockMetadataIndex_COMMIT_HASH+1)
+	if legacy {
+		metadata[proto_common.BlockMetadataIndex_LAST_CONFIG] = mustMarshal(t, &proto_common.Metadata{Value: mustMarshal(t, &proto_common.LastConfig{Index: index})})
+	} else {
+		metadata[proto_common.BlockMetadataIndex_SIGNATURES] = mustMarshal(t, &proto_common.Metadata{
+			Value: mustMarshal(t, &proto_common.OrdererBlockMetadata{LastConfig: &proto_common.LastConfig{Index: index}}),
+		})
+	}
+	block.Metadata = &proto_common.BlockMetadata{Metadata: metadata}
+	return block
+}
+
+func TestConfigBlocksSince(t *testing.T) {
+	for _, legacy := range []bool{false, true} {
+		// config blocks 0, 3, 5 and 6
+		chain := make([]*proto_common.Block, 7)
+		lastConfig := uint64(0)
+		for n := uint64(0); n < 7; n++ {
+			if n == 0 || n == 3 || n == 5 || n == 6 {
+				lastConfig = n
+			}
+			chain[n] = withLastConfig(t, &proto_common.Block{Header: &proto_common.BlockHeader{Number: n}}, lastConfig, legacy)
+		}
+		query := func(number uint64) (*proto_common.Block, error) { return chain[number], nil }
+		numbers := func(recorded *current.ChannelConfigRecord) []uint64 {
+			blocks, err := ConfigBlocksSince(chain[6], recorded, query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := make([]uint64, len(blocks))
+			for i, b := range blocks {
+				res[i] = b.Header.Number
+			}
+			return res
+		}
+
+		if got := fmt.Sprint(numbers(nil)); got != "[0 3 5 6]" {
+			t.Errorf("legacy %v: expect every config block without record, got %s", legacy, got)
+		}
+		if got := fmt.Sprint(numbers(&current.ChannelConfigRecord{BlockNumber: 0})); got != "[3 5 6]" {
+			t.Errorf("legacy %v: expect blocks after genesis, got %s", legacy, got)
+		}
+		if got := fmt.Sprint(numbers(&current.ChannelConfigRecord{BlockNumber: 5})); got != "[6]" {
+			t.Errorf("legacy %v: expect only the latest block, got %s", legacy, got)
+		}
+	}
+}
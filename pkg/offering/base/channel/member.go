@@ -0,0 +1,262 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/connector"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/event"
+	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
+	"github.com/golang/protobuf/proto"
+	proto_common "github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/resmgmt"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RemoveChannelMembers removes organizations which are in channel config but no longer in instance.Spec.Members:
+//   - submit a config update which deletes their MSP from application group
+//   - remove their peers and users from connection profiles
+//   - set their peers to unjoin the channel, which they do at the restart this triggers
+//   - delete their peers' conditions
+func (baseChan *BaseChannel) RemoveChannelMembers(instance *current.Channel) error {
+	org, err := baseChan.GetNetworkInitiatorOrg(instance)
+	if err != nil {
+		return errors.Wrap(err, "cant get network initiator org")
+	}
+	con, err := baseChan.GetChannelConnector(baseChan.Client, instance, org.GetName())
+	if err != nil {
+		return errors.Wrap(err, "cant get channel connector")
+	}
+	defer con.Close()
+	resClient, channelConfig, err := baseChan.GetChannelConfig(con, instance, org)
+	if err != nil {
+		return errors.Wrap(err, "cant get channel config")
+	}
+
+	removed := RemovedMembers(channelConfig, instance.Spec.Members)
+	if len(removed) != 0 {
+		log.Info(fmt.Sprintf("removedMembers:%s", removed), "channel", instance.GetName())
+		if err = baseChan.RemoveMemberFromChan(resClient, instance, channelConfig, removed); err != nil {
+			return errors.Wrap(err, "cant remove member from channel config")
+		}
+	}
+
+	if err = baseChan.RemoveMembersFromConnProfile(instance); err != nil {
+		return errors.Wrap(err, "cant remove members from connection profile")
+	}
+	if err = baseChan.UnjoinRemovedMemberPeers(instance); err != nil {
+		return errors.Wrap(err, "cant unjoin peers of removed members")
+	}
+	return baseChan.RemoveMemberPeerConditions(instance)
+}
+
+// RemovedMembers returns organizations in application group of config but not in members
+func RemovedMembers(config *proto_common.Config, members []current.Member) []string {
+	application, ok := config.GetChannelGroup().GetGroups()[channelconfig.ApplicationGroupKey]
+	if !ok {
+		return nil
+	}
+	memberNames := make([]string, len(members))
+	for i, m := range members {
+		memberNames[i] = m.GetName()
+	}
+	removed := make([]string, 0)
+	for org := range application.Groups {
+		if !util.ContainsValue(org, memberNames) {
+			removed = append(removed, org)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+// RemovalSigners returns the organizations to sign the config update which removes orgs from channel.
+// Application admins policy requires a majority of all current organizations(including the removed ones),
+// so removed organizations sign as well when remaining members are not enough.
+func RemovalSigners(config *proto_common.Config, members []current.Member, removed []string) []string {
+	application := config.GetChannelGroup().GetGroups()[channelconfig.ApplicationGroupKey]
+	majority := len(application.GetGroups())/2 + 1
+
+	signers := make([]string, 0, majority)
+	for _, m := range members {
+		// members not in config yet can not sign
+		if _, ok := application.GetGroups()[m.GetName()]; ok {
+			signers = append(signers, m.GetName())
+		}
+	}
+	for _, org := range removed {
+		if len(signers) >= majority {
+			break
+		}
+		signers = append(signers, org)
+	}
+	return signers
+}
+
+// RemoveMemberFromChan submits a config update which removes orgNames from application group
+func (baseChan *BaseChannel) RemoveMemberFromChan(resClient *resmgmt.Client, instance *current.Channel, currentConfig *proto_common.Config, orgNames []string) error {
+	modifiedConfig := proto.Clone(currentConfig).(*proto_common.Config)
+	applicationGroup := modifiedConfig.ChannelGroup.Groups[channelconfig.ApplicationGroupKey]
+	for _, orgName := range orgNames {
+		delete(applicationGroup.Groups, orgName)
+	}
+
+	signers := RemovalSigners(currentConfig, instance.Spec.Members, orgNames)
+	txID, err := baseChan.SubmitConfigUpdate(resClient, instance, currentConfig, modifiedConfig, signers)
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("update channel config to remove member in txID:%s", txID), "channel", instance.GetName(), "removedMember", orgNames, "signers", signers)
+	return nil
+}
+
+// RemoveMembersFromConnProfile removes peers and users of organizations which are no longer members from
+// channel's connection profile, and deletes their own connection profiles
+func (baseChan *BaseChannel) RemoveMembersFromConnProfile(instance *current.Channel) error {
+	profile, err := connector.ChannelProfile(baseChan.Client, instance.GetName())
+	if err != nil {
+		return err
+	}
+	removed := make([]string, 0)
+	for org := range profile.Organizations {
+		if !isChannelMember(instance, org) {
+			removed = append(removed, org)
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+
+	RemoveOrganizationsFromProfile(profile, instance.GetChannelID(), removed...)
+	yamlBinaryData, err := profile.Marshal(connector.YAML)
+	if err != nil {
+		return err
+	}
+	jsonBinaryData, err := profile.Marshal(connector.JSON)
+	if err != nil {
+		return err
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      instance.GetConnectionPorfile(),
+			Namespace: baseChan.Config.Operator.Namespace,
+		},
+		BinaryData: map[string][]byte{
+			"profile.yaml": yamlBinaryData,
+			"profile.json": jsonBinaryData,
+		},
+	}
+	err = baseChan.Client.CreateOrUpdate(context.TODO(), cm, k8sclient.CreateOrUpdateOption{
+		Owner:  instance,
+		Scheme: baseChan.Scheme,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, org := range removed {
+		orgCM := &corev1.ConfigMap{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      instance.GetConnectionPorfile(),
+				Namespace: org,
+			},
+		}
+		if err = baseChan.Client.Delete(context.TODO(), orgCM); err != nil && !k8serrors.IsNotFound(err) {
+			return errors.Wrapf(err, "delete connection profile of %s", org)
+		}
+	}
+	return nil
+}
+
+// RemoveOrganizationsFromProfile removes orgs along with their channel peers from profile
+func RemoveOrganizationsFromProfile(profile *connector.Profile, channelID string, orgs ...string) {
+	for _, org := range orgs {
+		orgInfo := profile.GetOrganization(org)
+		profile.RemoveChannelPeers(channelID, orgInfo.Peers...)
+		profile.RemoveOrganizationPeers(org)
+		profile.RemoveOrganization(org)
+	}
+}
+
+// UnjoinRemovedMemberPeers adds the channel to the unjoin channels of peers whose organization is no longer a member.
+// It runs before their conditions are deleted, which are the only record of the peers that joined
+func (baseChan *BaseChannel) UnjoinRemovedMemberPeers(instance *current.Channel) error {
+	channelID := instance.GetChannelID()
+	for _, p := range instance.Status.PeerConditions {
+		if isChannelMember(instance, p.Namespace) {
+			continue
+		}
+		peer := &current.IBPPeer{}
+		if err := baseChan.Client.Get(context.TODO(), types.NamespacedName{Namespace: p.Namespace, Name: p.Name}, peer); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if !peer.SetUnjoinChannel(channelID, true) {
+			continue
+		}
+		if err := baseChan.Client.Update(context.TODO(), peer); err != nil {
+			return errors.Wrapf(err, "update peer %s", p.String())
+		}
+		event.Normal(baseChan.Config.Recorder(), instance, event.PeerUnjoined, "peer %s of removed organization %s unjoins the channel", p.String(), p.Namespace)
+	}
+	return nil
+}
+
+// RemoveMemberPeerConditions deletes conditions of peers which belong to organizations no longer in channel
+func (baseChan *BaseChannel) RemoveMemberPeerConditions(instance *current.Channel) error {
+	conditions := make([]current.PeerCondition, 0, len(instance.Status.PeerConditions))
+	for _, p := range instance.Status.PeerConditions {
+		if isChannelMember(instance, p.Namespace) {
+			conditions = append(conditions, p)
+		}
+	}
+	if len(conditions) == len(instance.Status.PeerConditions) {
+		return nil
+	}
+	instance.Status.PeerConditions = conditions
+	return baseChan.Client.PatchStatus(context.TODO(), instance, nil, k8sclient.PatchOption{
+		Resilient: &k8sclient.ResilientPatch{
+			Retry:    2,
+			Into:     &current.Channel{},
+			Strategy: client.MergeFrom,
+		},
+	})
+}
+
+func isChannelMember(instance *current.Channel, org string) bool {
+	for _, m := range instance.Spec.Members {
+		if m.GetName() == org {
+			return true
+		}
+	}
+	return false
+}
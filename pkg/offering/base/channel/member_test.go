@@ -0,0 +1,157 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"reflect"
+	"testing"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/connector"
+	proto_common "github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric/common/channelconfig"
+)
+
+func applicationConfig(orgs ...string) *proto_common.Config {
+	groups := make(map[string]*proto_common.ConfigGroup)
+	for _, org := range orgs {
+		groups[org] = &proto_common.ConfigGroup{}
+	}
+	return &proto_common.Config{
+		ChannelGroup: &proto_common.ConfigGroup{
+			Groups: map[string]*proto_common.ConfigGroup{
+				channelconfig.ApplicationGroupKey: {Groups: groups},
+			},
+		},
+	}
+}
+
+func members(orgs ...string) []current.Member {
+	ms := make([]current.Member, len(orgs))
+	for i, org := range orgs {
+		ms[i] = current.Member{Name: org}
+	}
+	return ms
+}
+
+func TestRemovedMembers(t *testing.T) {
+	config := applicationConfig("org1", "org2", "org3")
+
+	removed := RemovedMembers(config, members("org1", "org4"))
+	if !reflect.DeepEqual(removed, []string{"org2", "org3"}) {
+		t.Fatalf("unexpected removed members %v", removed)
+	}
+	if removed = RemovedMembers(config, members("org1", "org2", "org3")); len(removed) != 0 {
+		t.Fatalf("expect no removed members but got %v", removed)
+	}
+}
+
+func TestRemovalSigners(t *testing.T) {
+	tests := []struct {
+		name    string
+		orgs    []string
+		members []string
+		removed []string
+		signers []string
+	}{
+		{
+			name:    "remaining members are enough",
+			orgs:    []string{"org1", "org2", "org3"},
+			members: []string{"org1", "org2"},
+			removed: []string{"org3"},
+			signers: []string{"org1", "org2"},
+		},
+		{
+			name:    "removed org signs for majority",
+			orgs:    []string{"org1", "org2"},
+			members: []string{"org1"},
+			removed: []string{"org2"},
+			signers: []string{"org1", "org2"},
+		},
+		{
+			name:    "new members can not sign",
+			orgs:    []string{"org1", "org2", "org3"},
+			members: []string{"org1", "org4"},
+			removed: []string{"org2", "org3"},
+			signers: []string{"org1", "org2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signers := RemovalSigners(applicationConfig(tt.orgs...), members(tt.members...), tt.removed)
+			if !reflect.DeepEqual(signers, tt.signers) {
+				t.Fatalf("expect signers %v but got %v", tt.signers, signers)
+			}
+		})
+	}
+}
+
+func TestRemoveOrganizationsFromProfile(t *testing.T) {
+	profile := connector.DefaultProfile("/tmp", "org1")
+	profile.SetOrganization("org1", []string{"org1-peer1"})
+	profile.SetOrganization("org2", []string{"org2-peer1"})
+	profile.Peers["org1-peer1"] = connector.NodeEndpoint{}
+	profile.Peers["org2-peer1"] = connector.NodeEndpoint{}
+	profile.SetChannel("channel", current.NamespacedName{Namespace: "org1", Name: "peer1"}, current.NamespacedName{Namespace: "org2", Name: "peer1"})
+
+	RemoveOrganizationsFromProfile(profile, "channel", "org2")
+
+	if _, ok := profile.Organizations["org2"]; ok {
+		t.Fatal("org2 should be removed from organizations")
+	}
+	if _, ok := profile.Peers["org2-peer1"]; ok {
+		t.Fatal("org2-peer1 should be removed from peers")
+	}
+	if _, ok := profile.Channels["channel"].Peers["org2-peer1"]; ok {
+		t.Fatal("org2-peer1 should be removed from channel peers")
+	}
+	if _, ok := profile.Channels["channel"].Peers["org1-peer1"]; !ok {
+		t.Fatal("org1-peer1 should stay in channel peers")
+	}
+}
+
+func TestUnjoinRemovedMemberPeers(t *testing.T) {
+	peers := map[string]*current.IBPPeer{}
+	baseChan := &BaseChannel{Client: peerClient(peers)}
+
+	peer1 := current.NamespacedName{Name: "peer1", Namespace: "org1"}
+	peer2 := current.NamespacedName{Name: "peer2", Namespace: "org2"}
+	instance := &current.Channel{}
+	instance.Name = "channel1"
+	instance.Spec.Members = members("org1")
+	instance.Status.PeerConditions = []current.PeerCondition{{NamespacedName: peer1, Type: current.PeerJoined}, {NamespacedName: peer2, Type: current.PeerJoined}}
+
+	if err := baseChan.UnjoinRemovedMemberPeers(instance); err != nil {
+		t.Fatal(err)
+	}
+	if updated, ok := peers["org2/peer2"]; !ok || !reflect.DeepEqual(updated.Spec.UnjoinChannels, []string{instance.GetChannelID()}) {
+		t.Fatalf("expect peer2 of the removed org2 to unjoin the channel but got %v", updated)
+	}
+	if _, ok := peers["org1/peer1"]; ok {
+		t.Fatal("expect peer1 of member org1 untouched")
+	}
+
+	// the peer joins back when org2 is a member again
+	if err := baseChan.clearUnjoinChannel(instance, peer2); err != nil {
+		t.Fatal(err)
+	}
+	if updated := peers["org2/peer2"]; len(updated.Spec.UnjoinChannels) != 0 {
+		t.Fatalf("expect the unjoin cleared but got %v", updated.Spec.UnjoinChannels)
+	}
+}
@@ -26,7 +26,9 @@ import (
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/connector"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/event"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/metrics"
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/resmgmt"
 	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
 	"github.com/pkg/errors"
@@ -59,10 +61,15 @@ func (baseChan *BaseChannel) ReconcilePeer(instance *current.Channel, peer curre
 		return errors.Wrap(err, "check peer")
 	}
 	index, condition := instance.GetPeerCondition(peer)
-	if condition.Type == current.PeerJoined {
+	if condition.IsJoined() {
 		return nil
 	}
 
+	if err = baseChan.clearUnjoinChannel(instance, peer); err != nil {
+		return err
+	}
+
+	previous := condition
 	err = baseChan.JoinChannel(instance.GetName(), instance.GetChannelID(), peer)
 	if err != nil && !strings.Contains(err.Error(), errPeerAlreadyJoined.Error()) {
 		log.Error(err, "failed to reconcile peer", "peer", peer.String())
@@ -70,11 +77,15 @@ func (baseChan *BaseChannel) ReconcilePeer(instance *current.Channel, peer curre
 		condition.Status = v1.ConditionTrue
 		condition.Reason = err.Error()
 		condition.LastTransitionTime = v1.Now()
+		if previous.Type != condition.Type || previous.Reason != condition.Reason {
+			event.Warning(baseChan.Config.Recorder(), instance, event.PeerJoinFailed, "peer %s failed to join: %s", peer.String(), err)
+		}
 	} else {
 		condition.Type = current.PeerJoined
 		condition.Status = v1.ConditionTrue
 		condition.Reason = string(current.PeerJoined)
 		condition.LastTransitionTime = v1.Now()
+		event.Normal(baseChan.Config.Recorder(), instance, event.PeerJoined, "peer %s joined", peer.String())
 	}
 
 	if index != -1 {
@@ -96,6 +107,22 @@ func (baseChan *BaseChannel) ReconcilePeer(instance *current.Channel, peer curre
 	return nil
 }
 
+// clearUnjoinChannel keeps a peer, which unjoined the channel when its organization was removed, from
+// unjoining again at its next start after it joins back
+func (baseChan *BaseChannel) clearUnjoinChannel(instance *current.Channel, peer current.NamespacedName) error {
+	ibppeer := &current.IBPPeer{}
+	if err := baseChan.Client.Get(context.TODO(), types.NamespacedName{Namespace: peer.Namespace, Name: peer.Name}, ibppeer); err != nil {
+		return errors.Wrapf(err, "get peer %s", peer.String())
+	}
+	if !ibppeer.SetUnjoinChannel(instance.GetChannelID(), false) {
+		return nil
+	}
+	if err := baseChan.Client.Update(context.TODO(), ibppeer); err != nil {
+		return errors.Wrapf(err, "update peer %s", peer.String())
+	}
+	return nil
+}
+
 // CheckPeer make sure peer is at good status
 func (baseChan *BaseChannel) CheckPeer(peer current.NamespacedName) error {
 	var err error
@@ -139,7 +166,9 @@ func (baseChan *BaseChannel) JoinChannel(channelName, channelID string, peer cur
 	if err != nil {
 		return err
 	}
+	start := time.Now()
 	err = client.JoinChannel(channelID, resmgmt.WithTargetEndpoints(peer.String()))
+	metrics.ObserveSDKCall(metrics.OperationJoin, start, err)
 	if err != nil {
 		return errors.Wrap(err, "failed to join peer into channel")
 	}
@@ -0,0 +1,160 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/event"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/hyperledger/fabric-config/configtx"
+	proto_common "github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReconcileRevocations pushes the approved CRL of each member into its MSP in the channel config
+func (baseChan *BaseChannel) ReconcileRevocations(instance *current.Channel) error {
+	names := make([]string, 0)
+	orgs := make(map[string]*current.Organization)
+	crls := make(map[string][]byte)
+	for _, m := range instance.Spec.Members {
+		org := &current.Organization{}
+		err := baseChan.Client.Get(context.TODO(), types.NamespacedName{Name: m.GetName()}, org)
+		if err != nil {
+			// external organizations distribute their CRLs by themselves
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		revocation := org.Status.Revocation
+		if revocation == nil || !revocation.Approved || revocation.ChannelCRL(instance.GetName()) == revocation.CRL {
+			continue
+		}
+		secret := &corev1.Secret{}
+		if err = baseChan.Client.Get(context.TODO(), org.GetCRL(), secret); err != nil {
+			return errors.Wrapf(err, "cant get CRL of %s", org.GetName())
+		}
+		// wait for the organization to record the CRL just generated
+		if current.CRLDigest(secret.Data[current.CRLKey]) != revocation.CRL {
+			continue
+		}
+		names = append(names, org.GetName())
+		orgs[org.GetName()] = org
+		crls[org.GetName()] = secret.Data[current.CRLKey]
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	return baseChan.UpdateMemberConfigs(instance, "CRL", names,
+		func(config *proto_common.Config, org string) (*proto_common.Config, error) {
+			return ApplyCRL(config, org, crls[org])
+		},
+		func(name string, txID string) error {
+			org := orgs[name]
+			event.Normal(baseChan.Config.Recorder(), instance, event.CRLUpdated, "CRL %s of %s is in channel config", org.Status.Revocation.CRL, name)
+
+			org.Status.Revocation.SetChannel(current.ChannelRevocation{
+				Channel:   instance.GetName(),
+				CRL:       org.Status.Revocation.CRL,
+				TxID:      txID,
+				UpdatedAt: v1.Now(),
+			})
+			err := baseChan.Client.PatchStatus(context.TODO(), org, nil, controllerclient.PatchOption{
+				Resilient: &controllerclient.ResilientPatch{
+					Retry:    2,
+					Into:     &current.Organization{},
+					Strategy: client.MergeFrom,
+				},
+			})
+			return errors.Wrapf(err, "failed to patch status of %s", name)
+		})
+}
+
+// ApplyCRL replaces the CRLs issued by the same CA as crlPEM with it in org's MSP,
+// both in application and orderer groups the org belongs to
+func ApplyCRL(original *proto_common.Config, org string, crlPEM []byte) (*proto_common.Config, error) {
+	crl, err := x509.ParseCRL(crlPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid CRL")
+	}
+
+	c := configtx.New(original)
+	updated := c.UpdatedConfig()
+	found := false
+	if group, ok := updated.ChannelGroup.Groups[channelconfig.ApplicationGroupKey]; ok && group.Groups[org] != nil {
+		found = true
+		appOrg := c.Application().Organization(org)
+		msp, err := appOrg.MSP()
+		if err != nil {
+			return nil, err
+		}
+		if revocationList, changed := replaceCRL(msp.RevocationList, crl); changed {
+			msp.RevocationList = revocationList
+			if err = appOrg.SetMSP(msp); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if group, ok := updated.ChannelGroup.Groups[channelconfig.OrdererGroupKey]; ok && group.Groups[org] != nil {
+		found = true
+		ordererOrg := c.Orderer().Organization(org)
+		msp, err := ordererOrg.MSP()
+		if err != nil {
+			return nil, err
+		}
+		if revocationList, changed := replaceCRL(msp.RevocationList, crl); changed {
+			msp.RevocationList = revocationList
+			if err = ordererOrg.SetMSP(msp); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !found {
+		return nil, errors.Errorf("organization %s not in channel config", org)
+	}
+	return updated, nil
+}
+
+// replaceCRL replaces the CRLs issued by the issuer of crl with crl, changed is false if crl is already there
+func replaceCRL(existing []*pkix.CertificateList, crl *pkix.CertificateList) ([]*pkix.CertificateList, bool) {
+	issuer := crl.TBSCertList.Issuer.String()
+	replaced := make([]*pkix.CertificateList, 0, len(existing)+1)
+	for _, l := range existing {
+		if l.TBSCertList.Issuer.String() != issuer {
+			replaced = append(replaced, l)
+			continue
+		}
+		if bytes.Equal(l.TBSCertList.Raw, crl.TBSCertList.Raw) {
+			return existing, false
+		}
+	}
+	return append(replaced, crl), true
+}
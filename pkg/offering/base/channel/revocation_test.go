@@ -0,0 +1,130 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util/testcert"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-config/configtx"
+	proto_common "github.com/hyperledger/fabric-protos-go/common"
+	proto_msp "github.com/hyperledger/fabric-protos-go/msp"
+	"github.com/hyperledger/fabric/common/channelconfig"
+)
+
+// crl returns a CRL of ca revoking the serial numbers revoked
+func crl(t *testing.T, ca *testcert.Identity, number int64, revoked ...int64) []byte {
+	template := &x509.RevocationList{
+		Number:     big.NewInt(number),
+		ThisUpdate: time.Now(),
+		NextUpdate: time.Now().Add(time.Hour),
+	}
+	for _, serial := range revoked {
+		template.RevokedCertificates = append(template.RevokedCertificates, pkix.RevokedCertificate{SerialNumber: big.NewInt(serial), RevocationTime: time.Now()})
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, template, ca.Cert, ca.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der})
+}
+
+func mspGroup(t *testing.T, name string, ca *testcert.Identity) *proto_common.ConfigGroup {
+	conf, err := proto.Marshal(&proto_msp.FabricMSPConfig{
+		Name:      name,
+		RootCerts: [][]byte{ca.PEM},
+		CryptoConfig: &proto_msp.FabricCryptoConfig{
+			SignatureHashFamily:            "SHA2",
+			IdentityIdentifierHashFunction: "SHA256",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &proto_common.ConfigGroup{
+		Values: map[string]*proto_common.ConfigValue{
+			channelconfig.MSPKey: value(t, &proto_msp.MSPConfig{Config: conf}),
+		},
+		ModPolicy: "Admins",
+	}
+}
+
+func TestApplyCRL(t *testing.T) {
+	revocationLists := func(msp configtx.MSP, err error) []*pkix.CertificateList {
+		if err != nil {
+			t.Fatal(err)
+		}
+		return msp.RevocationList
+	}
+
+	ca1, ca2 := testcert.New(t, "ca1", nil), testcert.New(t, "ca2", nil)
+	original := testConfig(t)
+	original.ChannelGroup.Groups[channelconfig.ApplicationGroupKey].Groups["org1"] = mspGroup(t, "org1", ca1)
+	original.ChannelGroup.Groups[channelconfig.OrdererGroupKey].Groups = map[string]*proto_common.ConfigGroup{
+		"org1": mspGroup(t, "org1", ca1),
+		"org2": mspGroup(t, "org2", ca2),
+	}
+
+	updated, err := ApplyCRL(original, "org1", crl(t, ca1, 1, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := configtx.New(updated)
+	if crls := revocationLists(c.Application().Organization("org1").MSP()); len(crls) != 1 || len(crls[0].TBSCertList.RevokedCertificates) != 1 {
+		t.Fatalf("expect 1 CRL in application org get %v", crls)
+	}
+	if crls := revocationLists(c.Orderer().Organization("org1").MSP()); len(crls) != 1 {
+		t.Fatalf("expect 1 CRL in orderer org get %v", crls)
+	}
+	if crls := revocationLists(c.Orderer().Organization("org2").MSP()); len(crls) != 0 {
+		t.Fatalf("expect no CRL in other orgs get %v", crls)
+	}
+
+	crl := crl(t, ca1, 2, 10, 11)
+	replaced, err := ApplyCRL(updated, "org1", crl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c = configtx.New(replaced)
+	if crls := revocationLists(c.Application().Organization("org1").MSP()); len(crls) != 1 || len(crls[0].TBSCertList.RevokedCertificates) != 2 {
+		t.Fatalf("expect the CRL of the same CA replaced get %v", crls)
+	}
+
+	again, err := ApplyCRL(replaced, "org1", crl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(replaced, again) {
+		t.Fatal("expect no change when CRL already set")
+	}
+
+	if _, err = ApplyCRL(original, "org3", crl); err == nil {
+		t.Fatal("expect error for an organization out of the channel")
+	}
+	if _, err = ApplyCRL(original, "org1", []byte("invalid")); err == nil {
+		t.Fatal("expect error for an invalid CRL")
+	}
+}
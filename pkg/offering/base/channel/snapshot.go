@@ -0,0 +1,259 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package channel
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/connector"
+	"github.com/golang/protobuf/proto"
+	proto_common "github.com/hyperledger/fabric-protos-go/common"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	fabcontext "github.com/hyperledger/fabric-sdk-go/pkg/common/providers/context"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	contextImpl "github.com/hyperledger/fabric-sdk-go/pkg/context"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fab/comm"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fab/txn"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// peerRequestTimeout limits a single request to a peer's system services
+	peerRequestTimeout = 30 * time.Second
+	// snapshotsRootDirEnv is the env of peer container which overrides `ledger.snapshots.rootDir`
+	snapshotsRootDirEnv = "CORE_LEDGER_SNAPSHOTS_ROOTDIR"
+)
+
+// SnapshotPath returns the directory of a completed snapshot, see `ledger.snapshots.rootDir` in core.yaml
+func SnapshotPath(rootDir string, channelID string, blockNumber uint64) string {
+	return filepath.Join(rootDir, "completed", channelID, strconv.FormatUint(blockNumber, 10))
+}
+
+// peerAdmin calls the system services of a peer with its organization admin's identity
+type peerAdmin struct {
+	con    *connector.Connector
+	ctx    fabcontext.Client
+	config *fab.PeerConfig
+	target fab.Peer
+}
+
+// newPeerAdmin connects to peer with the channel connection profile of peer's organization
+func (baseChan *BaseChannel) newPeerAdmin(instance *current.Channel, peer current.NamespacedName) (*peerAdmin, error) {
+	organization := &current.Organization{}
+	err := baseChan.Client.Get(context.TODO(), types.NamespacedName{Name: peer.Namespace}, organization)
+	if err != nil {
+		return nil, err
+	}
+	con, err := connector.NewConnector(baseChan.ConnectorProfile(instance.GetName(), instance.GetChannelID(), peer))
+	if err != nil {
+		return nil, err
+	}
+	admin := &peerAdmin{con: con}
+	admin.ctx, err = con.SDK().Context(fabsdk.WithUser(organization.Spec.Admin), fabsdk.WithOrg(peer.Namespace))()
+	if err != nil {
+		admin.Close()
+		return nil, errors.Wrap(err, "failed to get admin context")
+	}
+	config, ok := admin.ctx.EndpointConfig().PeerConfig(peer.String())
+	if !ok {
+		admin.Close()
+		return nil, errors.Errorf("peer %s not found in connection profile", peer.String())
+	}
+	admin.config = config
+	admin.target, err = admin.ctx.InfraProvider().CreatePeerFromConfig(&fab.NetworkPeer{PeerConfig: *config})
+	if err != nil {
+		admin.Close()
+		return nil, errors.Wrap(err, "failed to create peer")
+	}
+	return admin, nil
+}
+
+func (admin *peerAdmin) Close() {
+	admin.con.Close()
+}
+
+// invoke sends a proposal to a system chaincode of the peer and returns the response payload
+func (admin *peerAdmin) invoke(chaincodeID string, fcn string, args ...[]byte) ([]byte, error) {
+	reqCtx, cancel := contextImpl.NewRequest(admin.ctx, contextImpl.WithTimeout(peerRequestTimeout))
+	defer cancel()
+
+	txh, err := txn.NewHeader(admin.ctx, fab.SystemChannel)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create transaction header")
+	}
+	proposal, err := txn.CreateChaincodeInvokeProposal(txh, fab.ChaincodeInvokeRequest{
+		ChaincodeID: chaincodeID,
+		Fcn:         fcn,
+		Args:        args,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create proposal")
+	}
+	responses, err := txn.SendProposal(reqCtx, proposal, []fab.ProposalProcessor{admin.target})
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s.%s failed", chaincodeID, fcn)
+	}
+	if responses[0].Status != http.StatusOK {
+		return nil, errors.Errorf("%s.%s failed with status %d", chaincodeID, fcn, responses[0].Status)
+	}
+	return responses[0].ProposalResponse.GetResponse().GetPayload(), nil
+}
+
+// ChainInfo returns the ledger height of channel on the peer
+func (admin *peerAdmin) ChainInfo(channelID string) (*proto_common.BlockchainInfo, error) {
+	payload, err := admin.invoke("qscc", "GetChainInfo", []byte(channelID))
+	if err != nil {
+		return nil, err
+	}
+	info := &proto_common.BlockchainInfo{}
+	if err = proto.Unmarshal(payload, info); err != nil {
+		return nil, errors.Wrap(err, "invalid chain info")
+	}
+	return info, nil
+}
+
+// JoinedChannels returns the channels the peer has joined
+func (admin *peerAdmin) JoinedChannels() ([]string, error) {
+	payload, err := admin.invoke("cscc", "GetChannels")
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.ChannelQueryResponse{}
+	if err = proto.Unmarshal(payload, response); err != nil {
+		return nil, errors.Wrap(err, "invalid channel query response")
+	}
+	channels := make([]string, len(response.Channels))
+	for i, ch := range response.Channels {
+		channels[i] = ch.ChannelId
+	}
+	return channels, nil
+}
+
+// JoinBySnapshot starts joining the channel from a snapshot directory on the peer.
+// The peer keeps bootstrapping the ledger after it returns.
+func (admin *peerAdmin) JoinBySnapshot(snapshotDir string) error {
+	_, err := admin.invoke("cscc", "JoinChainBySnapshot", []byte(snapshotDir))
+	return err
+}
+
+// snapshotClient dials the snapshot service of the peer
+func (admin *peerAdmin) snapshotClient() (pb.SnapshotClient, func(), error) {
+	conn, err := comm.NewConnection(admin.ctx, admin.config.URL, comm.OptsFromPeerConfig(admin.config)...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pb.NewSnapshotClient(conn.ClientConn()), conn.Close, nil
+}
+
+// sign wraps a snapshot request with the admin's signature
+func (admin *peerAdmin) sign(request proto.Message) (*pb.SignedSnapshotRequest, error) {
+	requestBytes, err := proto.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := admin.ctx.SigningManager().Sign(requestBytes, admin.ctx.PrivateKey())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign snapshot request")
+	}
+	return &pb.SignedSnapshotRequest{Request: requestBytes, Signature: signature}, nil
+}
+
+func (admin *peerAdmin) signatureHeader(channelID string) (*proto_common.SignatureHeader, error) {
+	txh, err := txn.NewHeader(admin.ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+	return txn.CreateSignatureHeader(txh)
+}
+
+// GenerateSnapshot submits a request to snapshot the channel ledger once blockNumber is committed
+func (admin *peerAdmin) GenerateSnapshot(channelID string, blockNumber uint64) error {
+	client, closeFunc, err := admin.snapshotClient()
+	if err != nil {
+		return err
+	}
+	defer closeFunc()
+
+	header, err := admin.signatureHeader(channelID)
+	if err != nil {
+		return err
+	}
+	signed, err := admin.sign(&pb.SnapshotRequest{SignatureHeader: header, ChannelId: channelID, BlockNumber: blockNumber})
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), peerRequestTimeout)
+	defer cancel()
+	_, err = client.Generate(ctx, signed)
+	return err
+}
+
+// PendingSnapshots returns the block numbers of the snapshots not generated yet
+func (admin *peerAdmin) PendingSnapshots(channelID string) ([]uint64, error) {
+	client, closeFunc, err := admin.snapshotClient()
+	if err != nil {
+		return nil, err
+	}
+	defer closeFunc()
+
+	header, err := admin.signatureHeader(channelID)
+	if err != nil {
+		return nil, err
+	}
+	signed, err := admin.sign(&pb.SnapshotQuery{SignatureHeader: header, ChannelId: channelID})
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), peerRequestTimeout)
+	defer cancel()
+	response, err := client.QueryPendings(ctx, signed)
+	if err != nil {
+		return nil, err
+	}
+	return response.BlockNumbers, nil
+}
+
+// GetSnapshotsRootDir returns where the peer keeps its ledger snapshots. Snapshots must be on the
+// peer's volume, otherwise they are lost when the peer restarts to unjoin the channel.
+func (baseChan *BaseChannel) GetSnapshotsRootDir(peer current.NamespacedName) (string, error) {
+	deploy := &appsv1.Deployment{}
+	err := baseChan.Client.Get(context.TODO(), types.NamespacedName{Namespace: peer.Namespace, Name: peer.Name}, deploy)
+	if err != nil {
+		return "", err
+	}
+	for _, c := range deploy.Spec.Template.Spec.Containers {
+		if c.Name != peerContainer {
+			continue
+		}
+		for _, env := range c.Env {
+			if env.Name == snapshotsRootDirEnv && env.Value != "" {
+				return env.Value, nil
+			}
+		}
+	}
+	return "", errors.Errorf("peer %s does not keep ledger snapshots on its volume, update the peer to set %s", peer.String(), snapshotsRootDirEnv)
+}
@@ -107,12 +107,14 @@ type Console struct {
 }
 
 func New(client k8sclient.Client, scheme *runtime.Scheme, config *config.Config, o Override) *Console {
+	restartManager := restart.New(client, config.Operator.Restart.WaitTime.Get(), config.Operator.Restart.Timeout.Get())
+	restartManager.Recorder = config.Recorder()
 	console := &Console{
 		Client:   client,
 		Scheme:   scheme,
 		Config:   config,
 		Override: o,
-		Restart:  restart.New(client, config.Operator.Restart.WaitTime.Get(), config.Operator.Restart.Timeout.Get()),
+		Restart:  restartManager,
 	}
 
 	console.CreateManagers()
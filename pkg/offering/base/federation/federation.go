@@ -21,10 +21,12 @@ package federation
 import (
 	"context"
 	"fmt"
+	"time"
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/metrics"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/operatorerrors"
 	bcrbac "github.com/IBM-Blockchain/fabric-operator/pkg/rbac"
@@ -116,7 +118,8 @@ func (federation *BaseFederation) Reconcile(instance *current.Federation, update
 }
 
 // PreReconcileChecks on Federation upon Update
-func (federation *BaseFederation) PreReconcileChecks(instance *current.Federation, update Update) error {
+func (federation *BaseFederation) PreReconcileChecks(instance *current.Federation, update Update) (err error) {
+	defer metrics.ObserveStep(metrics.KindFederation, metrics.StepPreReconcileChecks, time.Now(), &err)
 	log.Info(fmt.Sprintf("PreReconcileChecks on Federation %s", instance.GetName()))
 
 	if !instance.HasInitiator() {
@@ -135,12 +138,14 @@ func (federation *BaseFederation) PreReconcileChecks(instance *current.Federatio
 }
 
 // Initialize on Federation upon Update
-func (federation *BaseFederation) Initialize(instance *current.Federation, update Update) error {
+func (federation *BaseFederation) Initialize(instance *current.Federation, update Update) (err error) {
+	defer metrics.ObserveStep(metrics.KindFederation, metrics.StepInitialize, time.Now(), &err)
 	return nil
 }
 
 // ReconcileManagers on Federation upon Update
-func (federation *BaseFederation) ReconcileManagers(instance *current.Federation, update Update) error {
+func (federation *BaseFederation) ReconcileManagers(instance *current.Federation, update Update) (err error) {
+	defer metrics.ObserveStep(metrics.KindFederation, metrics.StepReconcileManagers, time.Now(), &err)
 	if update.MemberUpdated() {
 		err := federation.RBACManager.Reconcile(bcrbac.Federation, instance, bcrbac.ResourceUpdate)
 		if err != nil {
@@ -179,7 +184,8 @@ func (federation *BaseFederation) ReconcileRBAC() error {
 }
 
 // CheckStates on Federation
-func (federation *BaseFederation) CheckStates(instance *current.Federation, update Update) (common.Result, error) {
+func (federation *BaseFederation) CheckStates(instance *current.Federation, update Update) (result common.Result, err error) {
+	defer metrics.ObserveStep(metrics.KindFederation, metrics.StepCheckStates, time.Now(), &err)
 	status := instance.Status.CRStatus
 	if !instance.HasType() {
 		status.Type = current.FederationPending
@@ -21,12 +21,14 @@ package network
 import (
 	"context"
 	"fmt"
+	"time"
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources"
 	resourcemanager "github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources/manager"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/metrics"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/operatorerrors"
 	bcrbac "github.com/IBM-Blockchain/fabric-operator/pkg/rbac"
@@ -128,7 +130,8 @@ func (network *BaseNetwork) Reconcile(instance *current.Network, update Update)
 }
 
 // PreReconcileChecks on Network upon Update
-func (network *BaseNetwork) PreReconcileChecks(instance *current.Network, update Update) error {
+func (network *BaseNetwork) PreReconcileChecks(instance *current.Network, update Update) (err error) {
+	defer metrics.ObserveStep(metrics.KindNetwork, metrics.StepPreReconcileChecks, time.Now(), &err)
 	log.Info(fmt.Sprintf("PreReconcileChecks on Network %s", instance.GetName()))
 
 	if !instance.HasOrder() {
@@ -164,13 +167,14 @@ func (network *BaseNetwork) PreReconcileChecks(instance *current.Network, update
 }
 
 // Initialize on Network upon Update
-func (network *BaseNetwork) Initialize(instance *current.Network, update Update) error {
+func (network *BaseNetwork) Initialize(instance *current.Network, update Update) (err error) {
+	defer metrics.ObserveStep(metrics.KindNetwork, metrics.StepInitialize, time.Now(), &err)
 	return nil
 }
 
 // ReconcileManagers on Network upon Update
-func (network *BaseNetwork) ReconcileManagers(instance *current.Network, update Update) error {
-	var err error
+func (network *BaseNetwork) ReconcileManagers(instance *current.Network, update Update) (err error) {
+	defer metrics.ObserveStep(metrics.KindNetwork, metrics.StepReconcileManagers, time.Now(), &err)
 	if update.MemberUpdated() {
 		err = network.RBACManager.Reconcile(bcrbac.Network, instance, bcrbac.ResourceUpdate)
 		if err != nil {
@@ -182,7 +186,8 @@ func (network *BaseNetwork) ReconcileManagers(instance *current.Network, update
 }
 
 // CheckStates on Network
-func (network *BaseNetwork) CheckStates(instance *current.Network, update Update) (common.Result, error) {
+func (network *BaseNetwork) CheckStates(instance *current.Network, update Update) (result common.Result, err error) {
+	defer metrics.ObserveStep(metrics.KindNetwork, metrics.StepCheckStates, time.Now(), &err)
 	status := instance.Status.CRStatus
 	if !instance.HasType() {
 		status.Type = current.Created
@@ -29,6 +29,16 @@ type Update struct {
 	certificateUpdatedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	ClusterSizeUpdatedStub        func() bool
+	clusterSizeUpdatedMutex       sync.RWMutex
+	clusterSizeUpdatedArgsForCall []struct {
+	}
+	clusterSizeUpdatedReturns struct {
+		result1 bool
+	}
+	clusterSizeUpdatedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	ConfigOverridesUpdatedStub        func() bool
 	configOverridesUpdatedMutex       sync.RWMutex
 	configOverridesUpdatedArgsForCall []struct {
@@ -347,6 +357,58 @@ func (fake *Update) CertificateUpdatedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *Update) ClusterSizeUpdated() bool {
+	fake.clusterSizeUpdatedMutex.Lock()
+	ret, specificReturn := fake.clusterSizeUpdatedReturnsOnCall[len(fake.clusterSizeUpdatedArgsForCall)]
+	fake.clusterSizeUpdatedArgsForCall = append(fake.clusterSizeUpdatedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ClusterSizeUpdated", []interface{}{})
+	fake.clusterSizeUpdatedMutex.Unlock()
+	if fake.ClusterSizeUpdatedStub != nil {
+		return fake.ClusterSizeUpdatedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.clusterSizeUpdatedReturns
+	return fakeReturns.result1
+}
+
+func (fake *Update) ClusterSizeUpdatedCallCount() int {
+	fake.clusterSizeUpdatedMutex.RLock()
+	defer fake.clusterSizeUpdatedMutex.RUnlock()
+	return len(fake.clusterSizeUpdatedArgsForCall)
+}
+
+func (fake *Update) ClusterSizeUpdatedCalls(stub func() bool) {
+	fake.clusterSizeUpdatedMutex.Lock()
+	defer fake.clusterSizeUpdatedMutex.Unlock()
+	fake.ClusterSizeUpdatedStub = stub
+}
+
+func (fake *Update) ClusterSizeUpdatedReturns(result1 bool) {
+	fake.clusterSizeUpdatedMutex.Lock()
+	defer fake.clusterSizeUpdatedMutex.Unlock()
+	fake.ClusterSizeUpdatedStub = nil
+	fake.clusterSizeUpdatedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *Update) ClusterSizeUpdatedReturnsOnCall(i int, result1 bool) {
+	fake.clusterSizeUpdatedMutex.Lock()
+	defer fake.clusterSizeUpdatedMutex.Unlock()
+	fake.ClusterSizeUpdatedStub = nil
+	if fake.clusterSizeUpdatedReturnsOnCall == nil {
+		fake.clusterSizeUpdatedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.clusterSizeUpdatedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *Update) ConfigOverridesUpdated() bool {
 	fake.configOverridesUpdatedMutex.Lock()
 	ret, specificReturn := fake.configOverridesUpdatedReturnsOnCall[len(fake.configOverridesUpdatedArgsForCall)]
@@ -1446,6 +1508,8 @@ func (fake *Update) Invocations() map[string][][]interface{} {
 	defer fake.certificateCreatedMutex.RUnlock()
 	fake.certificateUpdatedMutex.RLock()
 	defer fake.certificateUpdatedMutex.RUnlock()
+	fake.clusterSizeUpdatedMutex.RLock()
+	defer fake.clusterSizeUpdatedMutex.RUnlock()
 	fake.configOverridesUpdatedMutex.RLock()
 	defer fake.configOverridesUpdatedMutex.RUnlock()
 	fake.cryptoBackupNeededMutex.RLock()
@@ -32,6 +32,7 @@ import (
 	"github.com/IBM-Blockchain/fabric-operator/pkg/action"
 	commonapi "github.com/IBM-Blockchain/fabric-operator/pkg/apis/common"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/certificate"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/event"
 	commoninit "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common"
 	commonconfig "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common/config"
 	initializer "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/orderer"
@@ -47,6 +48,8 @@ import (
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common/reconcilechecks"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/operatorerrors"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/restart"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/user"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
 	"github.com/IBM-Blockchain/fabric-operator/version"
 	"github.com/pkg/errors"
@@ -130,6 +133,7 @@ type Update interface {
 	NodeOUUpdated() bool
 	ImagesUpdated() bool
 	FabricVersionUpdated() bool
+	ClusterSizeUpdated() bool
 }
 
 type IBPOrderer interface {
@@ -220,12 +224,7 @@ func NewNode(client controllerclient.Client, scheme *runtime.Scheme, config *con
 	}
 	n.CreateManagers()
 
-	validator := &validator.Validator{
-		Client: client,
-	}
-
-	n.Initializer = initializer.New(client, scheme, config.OrdererInitConfig, name, validator)
-	n.CertificateManager = certificate.New(client, scheme)
+	n.setInitializerAndCertificateManager()
 
 	return n
 }
@@ -242,14 +241,25 @@ func NewNodeWithOverrides(client controllerclient.Client, scheme *runtime.Scheme
 	}
 	n.CreateManagers()
 
+	n.setInitializerAndCertificateManager()
+
+	return n
+}
+
+func (n *Node) setInitializerAndCertificateManager() {
+	store := secretstore.New(n.Client, n.Scheme, n.Config.Operator.SecretStore)
 	validator := &validator.Validator{
-		Client: client,
+		Client: n.Client,
+		Store:  store,
 	}
 
-	n.Initializer = initializer.New(client, scheme, config.OrdererInitConfig, name, validator)
-	n.CertificateManager = certificate.New(client, scheme)
+	init := initializer.New(n.Client, n.Scheme, n.Config.OrdererInitConfig, n.Name, validator)
+	init.SecretManager.Store = store
+	n.Initializer = init
 
-	return n
+	certificateManager := certificate.New(n.Client, n.Scheme)
+	certificateManager.Store = store
+	n.CertificateManager = certificateManager
 }
 
 func (n *Node) CreateManagers() {
@@ -321,6 +331,11 @@ func (n *Node) Reconcile(instance *current.IBPOrderer, update Update) (common.Re
 		return common.Result{}, operatorerrors.Wrap(err, operatorerrors.OrdererInitilizationFailed, "failed to initialize orderer node")
 	}
 
+	err = n.ReconcileUser(instance)
+	if err != nil {
+		return common.Result{}, errors.Wrap(err, "failed to reconcile user")
+	}
+
 	err = n.ReconcileManagers(instance, update, nil)
 	if err != nil {
 		return common.Result{}, errors.Wrap(err, "failed to reconcile managers")
@@ -461,6 +476,38 @@ func (n *Node) PreReconcileChecks(instance *current.IBPOrderer, update Update) (
 	return updated, nil
 }
 
+// ReconcileUser records the expiry of node's enrollment certificate on its identity once enrolled.
+// The identity is registered by the network the node belongs to
+func (n *Node) ReconcileUser(instance *current.IBPOrderer) error {
+	if n.Config.OrganizationInitConfig == nil || !n.Config.OrganizationInitConfig.IAMEnabled {
+		return nil
+	}
+	secret := instance.Spec.Secret
+	if secret == nil || secret.Enrollment == nil || secret.Enrollment.Component == nil || secret.Enrollment.Component.EnrollID == "" {
+		return nil
+	}
+	org := &current.Organization{ObjectMeta: v1.ObjectMeta{Name: instance.Spec.OrgName}}
+	if err := n.Client.Get(context.TODO(), client.ObjectKeyFromObject(org), org); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	ecert := &corev1.Secret{}
+	err := n.Client.Get(context.TODO(), types.NamespacedName{Namespace: instance.Namespace, Name: fmt.Sprintf("%s-%s-signcert", commoninit.ECERT, instance.Name)}, ecert)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if len(ecert.Data["cert.pem"]) == 0 {
+		return nil
+	}
+	return user.SetIdentityExpiry(n.Client, org, secret.Enrollment.Component.EnrollID, ecert.Data["cert.pem"])
+}
+
 func (n *Node) Initialize(instance *current.IBPOrderer, update Update) error {
 	var err error
 
@@ -1685,7 +1732,9 @@ func (n *Node) ReenrollTLSCertNewKey(instance *current.IBPOrderer) error {
 }
 
 func (n *Node) reenrollCert(instance *current.IBPOrderer, certType commoninit.SecretType, newKey bool) error {
-	return action.Reenroll(n, n.Client, certType, instance, newKey)
+	err := action.Reenroll(n, n.Client, certType, instance, newKey)
+	event.Reenroll(n.Config.Recorder(), instance, string(certType), newKey, err)
+	return err
 }
 
 func (n *Node) RestartAction(instance *current.IBPOrderer) error {
@@ -89,6 +89,8 @@ type Orderer struct {
 }
 
 func New(client k8sclient.Client, scheme *runtime.Scheme, config *config.Config, o Override) *Orderer {
+	restartManager := restart.New(client, config.Operator.Restart.WaitTime.Get(), config.Operator.Restart.Timeout.Get())
+	restartManager.Recorder = config.Recorder()
 	orderer := &Orderer{
 		Client: client,
 		Scheme: scheme,
@@ -100,7 +102,7 @@ func New(client k8sclient.Client, scheme *runtime.Scheme, config *config.Config,
 		},
 		Override:        o,
 		RenewCertTimers: make(map[string]*time.Timer),
-		RestartManager:  restart.New(client, config.Operator.Restart.WaitTime.Get(), config.Operator.Restart.Timeout.Get()),
+		RestartManager:  restartManager,
 	}
 	orderer.CreateManagers()
 	return orderer
@@ -250,6 +252,10 @@ func (o *Orderer) ReconcileCluster(instance *current.IBPOrderer, update Update,
 		}
 	}
 
+	if update.ClusterSizeUpdated() || instance.Status.ResizingTo != 0 || (IsClusterRunning(instance) && len(nodes.Items) != 0 && len(nodes.Items) != size) {
+		return o.ReconcileClusterSize(instance, nodes)
+	}
+
 	for _, node := range nodes.Items {
 		log.Info(fmt.Sprintf("GetClusterNodes returned node '%s'", node.Name))
 	}
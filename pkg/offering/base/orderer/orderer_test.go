@@ -35,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -113,6 +114,51 @@ var _ = Describe("Base Orderer", func() {
 		})
 	})
 
+	Context("cluster size", func() {
+		It("requires quorum of the cluster", func() {
+			Expect(baseorderer.Quorum(1)).To(Equal(1))
+			Expect(baseorderer.Quorum(2)).To(Equal(2))
+			Expect(baseorderer.Quorum(3)).To(Equal(2))
+			Expect(baseorderer.Quorum(4)).To(Equal(3))
+			Expect(baseorderer.Quorum(5)).To(Equal(3))
+		})
+
+		It("only scales clusters which have been deployed", func() {
+			Expect(baseorderer.IsClusterRunning(instance)).To(BeFalse())
+			instance.Status.Type = current.Deploying
+			Expect(baseorderer.IsClusterRunning(instance)).To(BeFalse())
+			instance.Status.Type = current.Deployed
+			Expect(baseorderer.IsClusterRunning(instance)).To(BeTrue())
+		})
+
+		It("returns error if cluster is not using channel participation", func() {
+			_, err := orderer.ReconcileClusterSize(instance, current.IBPOrdererList{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("channel participation"))
+		})
+
+		It("returns error if cluster size is less than one", func() {
+			instance.Spec.UseChannelLess = pointer.Bool(true)
+			instance.Spec.ClusterSize = 0
+			_, err := orderer.ReconcileClusterSize(instance, current.IBPOrdererList{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("at least one node"))
+		})
+
+		It("records resize progress in status until resized", func() {
+			Expect(orderer.SetResizingTo(instance, 3)).To(Succeed())
+			Expect(instance.Status.ResizingTo).To(Equal(3))
+			Expect(mockKubeClient.PatchStatusCallCount()).To(Equal(1))
+
+			Expect(orderer.SetResizingTo(instance, 3)).To(Succeed())
+			Expect(mockKubeClient.PatchStatusCallCount()).To(Equal(1))
+
+			Expect(orderer.SetResizingTo(instance, 0)).To(Succeed())
+			Expect(instance.Status.ResizingTo).To(Equal(0))
+			Expect(mockKubeClient.PatchStatusCallCount()).To(Equal(2))
+		})
+	})
+
 	Context("check csr hosts", func() {
 		It("adds csr hosts if not present", func() {
 			instance = &current.IBPOrderer{
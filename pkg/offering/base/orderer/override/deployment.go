@@ -33,6 +33,7 @@ import (
 	dep "github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources/deployment"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources/serviceaccount"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -148,6 +149,14 @@ func (o *Override) CreateDeployment(instance *current.IBPOrderer, k8sDep *appsv1
 	}
 
 	configOverride := co.(OrdererConfig)
+	keyVolumes := []secretstore.KeyVolume{{Volume: "tls-keystore", Secret: fmt.Sprintf("tls-%s-keystore", instance.Name)}}
+	if !configOverride.UsingPKCS11() {
+		keyVolumes = append(keyVolumes, secretstore.KeyVolume{Volume: "ecert-keystore", Secret: fmt.Sprintf("ecert-%s-keystore", instance.Name)})
+	}
+	if o.Config != nil {
+		common.FetchKeysFromStore(o.Client, o.Config.Operator.SecretStore, deployment, instance.Namespace, keyVolumes)
+	}
+
 	if !configOverride.UsingPKCS11() {
 		deployment.AppendSecretVolumeIfMissing("ecert-keystore", fmt.Sprintf("ecert-%s-keystore", instance.Name))
 		orderer.AppendVolumeMountIfMissing("ecert-keystore", "/certs/msp/keystore")
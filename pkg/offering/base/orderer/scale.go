@@ -0,0 +1,347 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package baseorderer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/connector"
+	chaninit "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/channel"
+	basechannel "github.com/IBM-Blockchain/fabric-operator/pkg/offering/base/channel"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
+	proto_common "github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/orderer/etcdraft"
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var requeueResult = common.Result{
+	Result: reconcile.Result{
+		Requeue: true,
+	},
+}
+
+// IsClusterRunning returns true if the cluster has been deployed once, which means
+// its nodes must be added to or removed from channels one by one
+func IsClusterRunning(instance *current.IBPOrderer) bool {
+	switch instance.Status.Type {
+	case current.Deployed, current.Warning, current.Error:
+		return true
+	}
+	return false
+}
+
+// Quorum returns the minimal number of consenters a raft cluster of size needs to make progress
+func Quorum(size int) int {
+	return size/2 + 1
+}
+
+// ReconcileClusterSize grows or shrinks a running raft cluster one node at a time until
+// it matches instance.Spec.ClusterSize.
+//
+// Adding a node creates it, adds its tls cert to the consenter set of every channel, joins it
+// into the channel and waits until it catches up with the other consenters, then adds its endpoint.
+// Removing a node runs the same steps in reverse and never removes a consenter if the remaining
+// healthy consenters are not enough for quorum.
+func (o *Orderer) ReconcileClusterSize(instance *current.IBPOrderer, nodes current.IBPOrdererList) (common.Result, error) {
+	if !instance.Spec.IsUsingChannelLess() {
+		return common.Result{}, errors.New("cluster size can only be updated for orderers using channel participation")
+	}
+	size := instance.Spec.ClusterSize
+	if size < 1 {
+		return common.Result{}, errors.New("cluster must have at least one node")
+	}
+	// a node created but not added yet makes the cluster look resized, so progress is kept
+	// in status until every node is a consenter
+	if err := o.SetResizingTo(instance, size); err != nil {
+		return common.Result{}, err
+	}
+
+	items := nodes.Items
+	sort.Slice(items, func(i, j int) bool {
+		return *items[i].Spec.NodeNumber < *items[j].Spec.NodeNumber
+	})
+	desired := make([]*current.IBPOrderer, 0, size)
+	leaving := make([]*current.IBPOrderer, 0)
+	for index := range items {
+		if chaninit.IsLeavingNode(instance, &items[index]) {
+			leaving = append(leaving, &items[index])
+		} else {
+			desired = append(desired, &items[index])
+		}
+	}
+
+	channels, err := o.GetClusterChannels(instance)
+	if err != nil {
+		return common.Result{}, err
+	}
+	osn, err := chaninit.NewOSNAdmin(o.Client, instance.GetNamespace())
+	if err != nil {
+		return common.Result{}, err
+	}
+	for index := range items {
+		if items[index].Status.Type != current.Deployed {
+			continue
+		}
+		if err = osn.AddTarget(&items[index]); err != nil {
+			return common.Result{}, errors.Wrapf(err, "failed to add osnadmin target %s", items[index].GetName())
+		}
+	}
+	baseChan := basechannel.New(o.Client, o.Scheme, o.Config, nil)
+
+	// shrink from the highest node
+	if len(leaving) != 0 {
+		node := leaving[len(leaving)-1]
+		log.Info(fmt.Sprintf("Removing node '%s' from cluster '%s'", node.GetName(), instance.GetName()))
+		if err = o.RemoveConsenterNode(instance, baseChan, osn, channels, desired, node); err != nil {
+			return common.Result{}, errors.Wrapf(err, "failed to remove node %s", node.GetName())
+		}
+		if err = o.Client.Delete(context.TODO(), node); err != nil && !k8serrors.IsNotFound(err) {
+			return common.Result{}, err
+		}
+		return requeueResult, nil
+	}
+
+	for _, node := range desired {
+		if node.Status.Type != current.Deployed {
+			log.Info(fmt.Sprintf("Node '%s' hasn't deployed yet, requeue request", node.GetName()))
+			return requeueResult, nil
+		}
+		done, err := o.AddConsenterNode(instance, baseChan, osn, channels, desired, node)
+		if err != nil {
+			return common.Result{}, errors.Wrapf(err, "failed to add node %s", node.GetName())
+		}
+		if !done {
+			return requeueResult, nil
+		}
+	}
+
+	if len(desired) < size {
+		number := 1
+		if len(desired) != 0 {
+			number = *desired[len(desired)-1].Spec.NodeNumber + 1
+		}
+		log.Info(fmt.Sprintf("Adding node %d to cluster '%s'", number, instance.GetName()))
+		if err = o.CreateNodeCR(instance, number); err != nil && !k8serrors.IsAlreadyExists(err) {
+			return common.Result{}, err
+		}
+		return requeueResult, nil
+	}
+
+	log.Info(fmt.Sprintf("Cluster '%s' has %d consenters as desired", instance.GetName(), size))
+	return common.Result{}, o.SetResizingTo(instance, 0)
+}
+
+// SetResizingTo records the size cluster is being resized to in its status, 0 once resized
+func (o *Orderer) SetResizingTo(instance *current.IBPOrderer, size int) error {
+	if instance.Status.ResizingTo == size {
+		return nil
+	}
+	patch := client.MergeFrom(instance.DeepCopy())
+	instance.Status.ResizingTo = size
+	return o.Client.PatchStatus(context.TODO(), instance, patch)
+}
+
+// GetClusterChannels returns channels served by the cluster. Parent orderer is named after its network.
+func (o *Orderer) GetClusterChannels(instance *current.IBPOrderer) ([]current.Channel, error) {
+	channelList := &current.ChannelList{}
+	if err := o.Client.List(context.TODO(), channelList); err != nil {
+		return nil, err
+	}
+	channels := make([]current.Channel, 0)
+	for _, ch := range channelList.Items {
+		if ch.Spec.Network == instance.GetName() && ch.HasType() {
+			channels = append(channels, ch)
+		}
+	}
+	return channels, nil
+}
+
+// AddConsenterNode adds node into the consenter set and endpoints of each channel. Returns false if
+// node is still catching up with other consenters.
+func (o *Orderer) AddConsenterNode(instance *current.IBPOrderer, baseChan *basechannel.BaseChannel, osn *chaninit.OSNAdmin, channels []current.Channel, desired []*current.IBPOrderer, node *current.IBPOrderer) (bool, error) {
+	consenter, err := chaninit.GetConsenter(o.Client, instance.GetName(), node)
+	if err != nil {
+		return false, err
+	}
+	address := chaninit.ConsenterAddress(consenter)
+
+	for index := range channels {
+		ch := &channels[index]
+		channelID := ch.GetChannelID()
+
+		height, err := ConsentersHeight(osn, desired, node, channelID)
+		if err != nil {
+			return false, err
+		}
+		if height == 0 {
+			log.Info(fmt.Sprintf("Channel '%s' not served by cluster '%s' yet", channelID, instance.GetName()))
+			continue
+		}
+
+		if _, err = baseChan.UpdateOrdererConfig(ch, func(config *proto_common.Config) (bool, error) {
+			return basechannel.SetConsenter(config, consenter, true)
+		}); err != nil {
+			return false, errors.Wrapf(err, "add consenter to channel %s", channelID)
+		}
+
+		info, err := osn.QueryChannel(node.GetName(), channelID)
+		if err == chaninit.ErrChannelNotFound {
+			block, err := baseChan.GetConfigBlockBytes(ch)
+			if err != nil {
+				return false, err
+			}
+			if err = osn.Join(node.GetName(), block); err != nil {
+				return false, errors.Wrapf(err, "join channel %s", channelID)
+			}
+			log.Info(fmt.Sprintf("Node '%s' joined channel '%s', waiting for it to catch up", node.GetName(), channelID))
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if !info.IsActiveConsenter() || info.Height < height {
+			log.Info(fmt.Sprintf("Node '%s' is catching up in channel '%s': status %s, relation %s, height %d/%d", node.GetName(), channelID, info.Status, info.ConsensusRelation, info.Height, height))
+			return false, nil
+		}
+
+		changed, err := baseChan.UpdateOrdererConfig(ch, func(config *proto_common.Config) (bool, error) {
+			return basechannel.SetOrdererEndpoint(config, instance.GetNamespace(), address, true)
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "add orderer endpoint to channel %s", channelID)
+		}
+		if changed {
+			if err = baseChan.ReconcileConnectionProfile(ch, nil); err != nil {
+				return false, errors.Wrapf(err, "reconcile connection profile of channel %s", channelID)
+			}
+		}
+	}
+	return true, nil
+}
+
+// RemoveConsenterNode removes node's endpoint and consenter from each channel, then removes the channel from node
+func (o *Orderer) RemoveConsenterNode(instance *current.IBPOrderer, baseChan *basechannel.BaseChannel, osn *chaninit.OSNAdmin, channels []current.Channel, desired []*current.IBPOrderer, node *current.IBPOrderer) error {
+	host := chaninit.ConsenterHost(instance.GetName(), node)
+	nodeKey := current.NamespacedName{Namespace: node.GetNamespace(), Name: node.GetName()}.String()
+
+	for index := range channels {
+		ch := &channels[index]
+		channelID := ch.GetChannelID()
+
+		// stop clients from connecting to node first
+		profile, err := connector.ChannelProfile(o.Client, ch.GetName())
+		if err != nil {
+			return err
+		}
+		if _, ok := profile.Orderers[nodeKey]; ok {
+			if err = baseChan.ReconcileConnectionProfile(ch, nil); err != nil {
+				return errors.Wrapf(err, "reconcile connection profile of channel %s", channelID)
+			}
+		}
+
+		if _, err = baseChan.UpdateOrdererConfig(ch, func(config *proto_common.Config) (bool, error) {
+			return basechannel.SetOrdererEndpoint(config, instance.GetNamespace(), fmt.Sprintf("%s:%d", host, chaninit.ConsenterPort), false)
+		}); err != nil {
+			return errors.Wrapf(err, "remove orderer endpoint from channel %s", channelID)
+		}
+
+		if _, err = baseChan.UpdateOrdererConfig(ch, func(config *proto_common.Config) (bool, error) {
+			consenters, err := basechannel.Consenters(config)
+			if err != nil {
+				return false, err
+			}
+			if !hasConsenter(consenters, host) {
+				return false, nil
+			}
+			healthy := HealthyConsenters(osn, desired, channelID)
+			if healthy < Quorum(len(consenters)-1) {
+				return false, errors.Errorf("only %d healthy consenters remain in channel %s, %d required", healthy, channelID, Quorum(len(consenters)-1))
+			}
+			return basechannel.SetConsenter(config, &etcdraft.Consenter{Host: host, Port: chaninit.ConsenterPort}, false)
+		}); err != nil {
+			return errors.Wrapf(err, "remove consenter from channel %s", channelID)
+		}
+
+		if _, err = osn.GetTarget(node.GetName()); err == nil {
+			if err = osn.Remove(node.GetName(), channelID); err != nil {
+				return errors.Wrapf(err, "remove channel %s from node", channelID)
+			}
+		}
+	}
+	return nil
+}
+
+// ConsentersHeight returns the highest ledger height of channel among active consenters except node.
+// Zero returned if no consenter serves the channel.
+func ConsentersHeight(osn *chaninit.OSNAdmin, consenters []*current.IBPOrderer, node *current.IBPOrderer, channelID string) (uint64, error) {
+	var height uint64
+	for _, c := range consenters {
+		if c.GetName() == node.GetName() {
+			continue
+		}
+		if _, err := osn.GetTarget(c.GetName()); err != nil {
+			continue
+		}
+		info, err := osn.QueryChannel(c.GetName(), channelID)
+		if err == chaninit.ErrChannelNotFound {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		if info.IsActiveConsenter() && info.Height > height {
+			height = info.Height
+		}
+	}
+	return height, nil
+}
+
+// HealthyConsenters counts the nodes which are active consenters of channel
+func HealthyConsenters(osn *chaninit.OSNAdmin, nodes []*current.IBPOrderer, channelID string) int {
+	healthy := 0
+	for _, n := range nodes {
+		if _, err := osn.GetTarget(n.GetName()); err != nil {
+			continue
+		}
+		info, err := osn.QueryChannel(n.GetName(), channelID)
+		if err != nil {
+			log.Info(fmt.Sprintf("Node '%s' is unhealthy in channel '%s': %s", n.GetName(), channelID, err))
+			continue
+		}
+		if info.IsActiveConsenter() {
+			healthy++
+		}
+	}
+	return healthy
+}
+
+func hasConsenter(consenters []*etcdraft.Consenter, host string) bool {
+	for _, c := range consenters {
+		if c.Host == host {
+			return true
+		}
+	}
+	return false
+}
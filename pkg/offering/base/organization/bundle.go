@@ -0,0 +1,90 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package organization
+
+import (
+	"context"
+	"fmt"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/event"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/orgbundle"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReconcileBundle exports the bundle of organization and imports the bundles
+// labeled in organization's namespace
+func (organization *BaseOrganization) ReconcileBundle(instance *current.Organization) error {
+	if err := organization.ExportBundle(instance); err != nil {
+		// bundle is for other clusters, it should not block the organization
+		log.Error(err, fmt.Sprintf("failed to export bundle of organization %s", instance.GetName()))
+	}
+	return organization.ImportBundles(instance)
+}
+
+// ExportBundle saves the bundle of organization to configmap `<organization>-bundle`
+func (organization *BaseOrganization) ExportBundle(instance *current.Organization) error {
+	secret := &corev1.Secret{}
+	err := organization.Client.Get(context.TODO(), instance.GetMSPCrypto(), secret)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// admin not enrolled yet
+			return nil
+		}
+		return err
+	}
+	bundle, err := orgbundle.Export(organization.Client, instance)
+	if err != nil {
+		return err
+	}
+	data, err := bundle.Marshal()
+	if err != nil {
+		return err
+	}
+	cm := &corev1.ConfigMap{}
+	cm.Name = instance.GetBundle().Name
+	cm.Namespace = instance.GetBundle().Namespace
+	cm.Labels = organization.GetLabels(instance)
+	cm.Data = map[string]string{orgbundle.BundleKey: string(data)}
+	return organization.Client.CreateOrUpdate(context.TODO(), cm)
+}
+
+// ImportBundles imports the bundles in configmaps labeled with `bestchains.organization.bundle: import`
+// as ExternalOrganizations. Results are recorded as events on the organization.
+func (organization *BaseOrganization) ImportBundles(instance *current.Organization) error {
+	cms := &corev1.ConfigMapList{}
+	err := organization.Client.List(context.TODO(), cms, client.InNamespace(instance.GetUserNamespace()), client.MatchingLabels{orgbundle.ImportLabel: orgbundle.ImportLabelValue})
+	if err != nil {
+		return errors.Wrap(err, "failed to list bundles to import")
+	}
+	for _, cm := range cms.Items {
+		external, changed, err := orgbundle.Import(organization.Client, []byte(cm.Data[orgbundle.BundleKey]))
+		if err != nil {
+			event.Warning(organization.Config.Recorder(), instance, event.BundleImportFailed, "failed to import bundle %s: %s", cm.GetName(), err)
+			continue
+		}
+		if changed {
+			event.Normal(organization.Config.Recorder(), instance, event.BundleImported, "bundle %s imported as external organization %s", cm.GetName(), external.GetName())
+		}
+	}
+	return nil
+}
@@ -28,6 +28,7 @@ import (
 	"time"
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/certificate/revoker"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common"
 	commonconfig "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common/config"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common/secretmanager"
@@ -241,3 +242,32 @@ func (i *Initializer) GetCAConnectinProfile(instance *current.Organization) (*cu
 	}
 	return connectionProfile, nil
 }
+
+// Revoke revokes enrollmentIDs in organization's CA as its admin and returns the CRL generated afterwards
+func (i *Initializer) Revoke(instance *current.Organization, enrollmentIDs []string, reason string) ([]byte, error) {
+	profile, err := i.GetCAConnectinProfile(instance)
+	if err != nil {
+		return nil, err
+	}
+	enrollmentSpec, err := i.GetAdminEnrollmentSpec(instance, profile)
+	if err != nil {
+		return nil, err
+	}
+	secret := &corev1.Secret{}
+	if err = i.Client.Get(context.TODO(), instance.GetMSPCrypto(), secret); err != nil {
+		return nil, errors.Wrap(err, "failed to get admin crypto")
+	}
+
+	adminStoragePath := i.GetAdminStoragePath(instance)
+	defer i.RemoveAdminStoragePath(adminStoragePath)
+	r, err := revoker.New(enrollmentSpec.ClientAuth, adminStoragePath, "", secret.Data["admin-signcert"], secret.Data["admin-keystore"])
+	if err != nil {
+		return nil, err
+	}
+	for _, enrollmentID := range enrollmentIDs {
+		if err = r.Revoke(enrollmentID, reason); err != nil {
+			return nil, err
+		}
+	}
+	return r.GenCRL()
+}
@@ -58,6 +58,26 @@ type Update struct {
 	clientsUpdatedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	RolesRemovedStub        func() string
+	rolesRemovedMutex       sync.RWMutex
+	rolesRemovedArgsForCall []struct {
+	}
+	rolesRemovedReturns struct {
+		result1 string
+	}
+	rolesRemovedReturnsOnCall map[int]struct {
+		result1 string
+	}
+	RolesUpdatedStub        func() bool
+	rolesUpdatedMutex       sync.RWMutex
+	rolesUpdatedArgsForCall []struct {
+	}
+	rolesUpdatedReturns struct {
+		result1 bool
+	}
+	rolesUpdatedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	SpecUpdatedStub        func() bool
 	specUpdatedMutex       sync.RWMutex
 	specUpdatedArgsForCall []struct {
@@ -347,6 +367,112 @@ func (fake *Update) ClientsUpdatedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *Update) RolesRemoved() string {
+	fake.rolesRemovedMutex.Lock()
+	ret, specificReturn := fake.rolesRemovedReturnsOnCall[len(fake.rolesRemovedArgsForCall)]
+	fake.rolesRemovedArgsForCall = append(fake.rolesRemovedArgsForCall, struct {
+	}{})
+	stub := fake.RolesRemovedStub
+	fakeReturns := fake.rolesRemovedReturns
+	fake.recordInvocation("RolesRemoved", []interface{}{})
+	fake.rolesRemovedMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Update) RolesRemovedCallCount() int {
+	fake.rolesRemovedMutex.RLock()
+	defer fake.rolesRemovedMutex.RUnlock()
+	return len(fake.rolesRemovedArgsForCall)
+}
+
+func (fake *Update) RolesRemovedCalls(stub func() string) {
+	fake.rolesRemovedMutex.Lock()
+	defer fake.rolesRemovedMutex.Unlock()
+	fake.RolesRemovedStub = stub
+}
+
+func (fake *Update) RolesRemovedReturns(result1 string) {
+	fake.rolesRemovedMutex.Lock()
+	defer fake.rolesRemovedMutex.Unlock()
+	fake.RolesRemovedStub = nil
+	fake.rolesRemovedReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *Update) RolesRemovedReturnsOnCall(i int, result1 string) {
+	fake.rolesRemovedMutex.Lock()
+	defer fake.rolesRemovedMutex.Unlock()
+	fake.RolesRemovedStub = nil
+	if fake.rolesRemovedReturnsOnCall == nil {
+		fake.rolesRemovedReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.rolesRemovedReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *Update) RolesUpdated() bool {
+	fake.rolesUpdatedMutex.Lock()
+	ret, specificReturn := fake.rolesUpdatedReturnsOnCall[len(fake.rolesUpdatedArgsForCall)]
+	fake.rolesUpdatedArgsForCall = append(fake.rolesUpdatedArgsForCall, struct {
+	}{})
+	stub := fake.RolesUpdatedStub
+	fakeReturns := fake.rolesUpdatedReturns
+	fake.recordInvocation("RolesUpdated", []interface{}{})
+	fake.rolesUpdatedMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Update) RolesUpdatedCallCount() int {
+	fake.rolesUpdatedMutex.RLock()
+	defer fake.rolesUpdatedMutex.RUnlock()
+	return len(fake.rolesUpdatedArgsForCall)
+}
+
+func (fake *Update) RolesUpdatedCalls(stub func() bool) {
+	fake.rolesUpdatedMutex.Lock()
+	defer fake.rolesUpdatedMutex.Unlock()
+	fake.RolesUpdatedStub = stub
+}
+
+func (fake *Update) RolesUpdatedReturns(result1 bool) {
+	fake.rolesUpdatedMutex.Lock()
+	defer fake.rolesUpdatedMutex.Unlock()
+	fake.RolesUpdatedStub = nil
+	fake.rolesUpdatedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *Update) RolesUpdatedReturnsOnCall(i int, result1 bool) {
+	fake.rolesUpdatedMutex.Lock()
+	defer fake.rolesUpdatedMutex.Unlock()
+	fake.RolesUpdatedStub = nil
+	if fake.rolesUpdatedReturnsOnCall == nil {
+		fake.rolesUpdatedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.rolesUpdatedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *Update) SpecUpdated() bool {
 	fake.specUpdatedMutex.Lock()
 	ret, specificReturn := fake.specUpdatedReturnsOnCall[len(fake.specUpdatedArgsForCall)]
@@ -466,6 +592,10 @@ func (fake *Update) Invocations() map[string][][]interface{} {
 	defer fake.clientsRemovedMutex.RUnlock()
 	fake.clientsUpdatedMutex.RLock()
 	defer fake.clientsUpdatedMutex.RUnlock()
+	fake.rolesRemovedMutex.RLock()
+	defer fake.rolesRemovedMutex.RUnlock()
+	fake.rolesUpdatedMutex.RLock()
+	defer fake.rolesUpdatedMutex.RUnlock()
 	fake.specUpdatedMutex.RLock()
 	defer fake.specUpdatedMutex.RUnlock()
 	fake.tokenUpdatedMutex.RLock()
@@ -22,12 +22,14 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources/manager"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/metrics"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/base/organization/override"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/operatorerrors"
@@ -37,6 +39,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -54,6 +57,8 @@ type Update interface {
 	AdminTransfered() string
 	ClientsUpdated() bool
 	ClientsRemoved() string
+	RolesUpdated() bool
+	RolesRemoved() string
 	CAStatusUpdated() bool
 }
 
@@ -106,6 +111,8 @@ type BaseOrganization struct {
 	ClientClusterRoleBindingManager resources.Manager
 
 	CAManager resources.Manager
+
+	Revoker Revoker
 }
 
 func New(client controllerclient.Client, scheme *runtime.Scheme, config *config.Config) *BaseOrganization {
@@ -123,6 +130,7 @@ func New(client controllerclient.Client, scheme *runtime.Scheme, config *config.
 	}
 
 	base.Initializer = NewInitializer(config.OrganizationInitConfig, scheme, client, base.GetLabels)
+	base.Revoker = base.Initializer
 
 	base.CreateManagers()
 
@@ -168,7 +176,8 @@ func (organization *BaseOrganization) Reconcile(instance *current.Organization,
 }
 
 // PreReconcileChecks on Organization upon Update
-func (organization *BaseOrganization) PreReconcileChecks(instance *current.Organization, update Update) error {
+func (organization *BaseOrganization) PreReconcileChecks(instance *current.Organization, update Update) (err error) {
+	defer metrics.ObserveStep(metrics.KindOrganization, metrics.StepPreReconcileChecks, time.Now(), &err)
 	log.Info(fmt.Sprintf("PreReconcileChecks on Organization %s", instance.GetName()))
 
 	if !instance.HasAdmin() {
@@ -179,15 +188,15 @@ func (organization *BaseOrganization) PreReconcileChecks(instance *current.Organ
 }
 
 // Initialize on Organization upon Update
-func (organization *BaseOrganization) Initialize(instance *current.Organization, update Update) error {
+func (organization *BaseOrganization) Initialize(instance *current.Organization, update Update) (err error) {
+	defer metrics.ObserveStep(metrics.KindOrganization, metrics.StepInitialize, time.Now(), &err)
 	log.Info(fmt.Sprintf("Checking if organization '%s' needs initialization", instance.GetName()))
 	return nil
 }
 
 // ReconcileManagers on Organization upon Update
-func (organization *BaseOrganization) ReconcileManagers(instance *current.Organization, update Update) error {
-	var err error
-
+func (organization *BaseOrganization) ReconcileManagers(instance *current.Organization, update Update) (err error) {
+	defer metrics.ObserveStep(metrics.KindOrganization, metrics.StepReconcileManagers, time.Now(), &err)
 	err = organization.CreateNamespace(instance)
 	if err != nil {
 		return err
@@ -215,14 +224,31 @@ func (organization *BaseOrganization) ReconcileManagers(instance *current.Organi
 		}
 	}
 
+	err = organization.ReconcileAdminIdentity(instance)
+	if err != nil {
+		return err
+	}
+
+	// After crypto, a transferred admin is revoked as the new admin rather than revoking itself
+	err = organization.ReconcileRevocation(instance)
+	if err != nil {
+		return err
+	}
+
+	err = organization.ReconcileBundle(instance)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // RecnocleRBAC on current organization,including:
 // - Create admin role and client role
 // - Create admin/client clusterrole if not exists
-// - Create/update admin clusterrole binding if admin updated
+// - Create/update admin clusterrole binding if admin or delegated admins updated
 // - Create/update client clusterrole binding if clients updated
+// - Create/update/delete rbac of declared roles if roles updated
 func (organization *BaseOrganization) ReconcileRBAC(instance *current.Organization, update Update) error {
 	var err error
 
@@ -248,7 +274,7 @@ func (organization *BaseOrganization) ReconcileRBAC(instance *current.Organizati
 		return err
 	}
 
-	if update.AdminUpdated() {
+	if update.AdminUpdated() || update.RolesUpdated() {
 		// reconcile AdminRoleBinding
 		err = organization.AdminRoleBindingManager.Reconcile(instance, true)
 		if err != nil {
@@ -275,9 +301,36 @@ func (organization *BaseOrganization) ReconcileRBAC(instance *current.Organizati
 		}
 	}
 
+	if update.RolesUpdated() {
+		err = organization.ReconcileRoles(instance)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// ReconcileAdminIdentity records the expiry of admin's enrollment certificate on its Identity
+func (organization *BaseOrganization) ReconcileAdminIdentity(instance *current.Organization) error {
+	if !organization.Config.OrganizationInitConfig.IAMEnabled {
+		return nil
+	}
+	secret := &corev1.Secret{}
+	err := organization.Client.Get(context.TODO(), instance.GetMSPCrypto(), secret)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	signcert := secret.Data["admin-signcert"]
+	if len(signcert) == 0 {
+		return nil
+	}
+	return user.SetIdentityExpiry(organization.Client, instance, instance.Spec.Admin, signcert)
+}
+
 // ReconcileUsers handles User's annotation change
 func (organization *BaseOrganization) ReconcileUsers(instance *current.Organization, update Update) error {
 	var err error
@@ -320,11 +373,42 @@ func (organization *BaseOrganization) ReconcileUsers(instance *current.Organizat
 		}
 	}
 
+	// Set/Remove annotations of delegated admins and users of declared roles
+	if update.RolesUpdated() && organization.Config.OrganizationInitConfig.IAMEnabled {
+		for _, admin := range instance.Spec.Admins {
+			if admin == instance.Spec.Admin {
+				continue
+			}
+			err = user.Reconcile(organization.Client, admin, instance.GetName(), "", user.IDTypeOf(bcrbac.Admin), user.Add)
+			if err != nil {
+				return err
+			}
+		}
+		for _, role := range instance.Spec.Roles {
+			for _, u := range role.Users {
+				err = user.Reconcile(organization.Client, u, instance.GetName(), "", user.IDTypeOf(bcrbac.RoleType(role.Name)), user.Add)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if update.RolesRemoved() != "" {
+			for _, u := range strings.Split(update.RolesRemoved(), ",") {
+				err = user.Reconcile(organization.Client, u, instance.GetName(), "", user.CLIENT, user.Remove)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
 // CheckStates on Organization
-func (organization *BaseOrganization) CheckStates(instance *current.Organization, update Update) (common.Result, error) {
+func (organization *BaseOrganization) CheckStates(instance *current.Organization, update Update) (result common.Result, err error) {
+	defer metrics.ObserveStep(metrics.KindOrganization, metrics.StepCheckStates, time.Now(), &err)
 	if update.CAStatusUpdated() && instance.Status.Type != current.Error {
 		ca := &current.IBPCA{}
 		ca.Namespace = instance.GetCA().Namespace
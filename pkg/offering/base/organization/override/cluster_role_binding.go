@@ -42,9 +42,10 @@ func (o *Override) SyncAdminClusterRoleBinding(instance *current.Organization, c
 
 	crb.Name = bcrbac.GetClusterRoleBinding(namespaced, bcrbac.Admin).Name
 
-	// only one Admin
-	crb.Subjects = []rbacv1.Subject{
-		common.GetDefaultSubject(instance.Spec.Admin, namespaced.Namespace, o.SubjectKind),
+	admins := instance.GetAdmins()
+	crb.Subjects = make([]rbacv1.Subject, len(admins))
+	for i, admin := range admins {
+		crb.Subjects[i] = common.GetDefaultSubject(admin, namespaced.Namespace, o.SubjectKind)
 	}
 
 	crb.RoleRef = bcrbac.ClusterRoleRef(namespaced, bcrbac.Admin)
@@ -36,16 +36,17 @@ func (o *Override) AdminRoleBinding(object v1.Object, rb *rbacv1.RoleBinding, ac
 	return nil
 }
 
-// Sync Admin RoleBinding based on Organization.Spec.Admin
+// Sync Admin RoleBinding based on Organization.Spec.Admin and Organization.Spec.Admins
 func (o *Override) SyncAdminRoleBinding(instance *current.Organization, rb *rbacv1.RoleBinding) error {
 	namespaced := instance.GetNamespaced()
 
 	rb.Name = bcrbac.GetRoleBinding(namespaced, bcrbac.Admin).Name
 	rb.Namespace = namespaced.Namespace
 
-	// Only one `Admin`
-	rb.Subjects = []rbacv1.Subject{
-		common.GetDefaultSubject(instance.Spec.Admin, namespaced.Namespace, o.SubjectKind),
+	admins := instance.GetAdmins()
+	rb.Subjects = make([]rbacv1.Subject, len(admins))
+	for i, admin := range admins {
+		rb.Subjects[i] = common.GetDefaultSubject(admin, namespaced.Namespace, o.SubjectKind)
 	}
 
 	rb.RoleRef = bcrbac.RoleRef(namespaced, bcrbac.Admin)
@@ -0,0 +1,147 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package organization
+
+import (
+	"context"
+	"strings"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/certificate/revoker"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/event"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/user"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Revoker revokes identities in organization's CA and returns the CRL generated afterwards
+type Revoker interface {
+	Revoke(instance *current.Organization, enrollmentIDs []string, reason string) ([]byte, error)
+}
+
+// ReconcileRevocation on current organization,including:
+// - revoke admin/client identities whose users are no longer bound to the organization in CA
+// - store the CRL generated by CA and record it in status
+// - mark the CRL approved once all admins approved it
+func (organization *BaseOrganization) ReconcileRevocation(instance *current.Organization) error {
+	if !organization.Config.OrganizationInitConfig.IAMEnabled {
+		return nil
+	}
+
+	revoking, err := organization.RevokingIdentities(instance)
+	if err != nil {
+		return err
+	}
+
+	latest := &current.Organization{}
+	if err = organization.Client.Get(context.TODO(), client.ObjectKeyFromObject(instance), latest); err != nil {
+		return err
+	}
+	before := latest.Status.Revocation
+	revocation := &current.OrganizationRevocation{}
+	if before != nil {
+		revocation = before.DeepCopy()
+	}
+
+	if len(revoking) > 0 {
+		enrollmentIDs := make([]string, len(revoking))
+		for i, identity := range revoking {
+			enrollmentIDs[i] = identity.Spec.EnrollmentID
+		}
+		crl, err := organization.Revoker.Revoke(instance, enrollmentIDs, revoker.ReasonAffiliationChanged)
+		if err != nil {
+			event.Warning(organization.Config.Recorder(), instance, event.RevocationFailed, "revoke %s: %s", strings.Join(enrollmentIDs, ","), err)
+			return errors.Wrap(err, "failed to revoke identities")
+		}
+		if err = organization.SaveCRL(instance, crl); err != nil {
+			return err
+		}
+		for _, identity := range revoking {
+			if err = user.RevokeIdentity(organization.Client, identity, revoker.ReasonAffiliationChanged); err != nil {
+				return err
+			}
+			event.Normal(organization.Config.Recorder(), instance, event.IdentityRevoked, "identity %s of user %s revoked", identity.Spec.EnrollmentID, identity.Spec.User)
+		}
+		now := v1.Now()
+		revocation.Revoked = append(revocation.Revoked, enrollmentIDs...)
+		revocation.CRL = current.CRLDigest(crl)
+		revocation.GeneratedAt = &now
+	}
+
+	latest.Status.Revocation = revocation
+	approved := latest.RevocationApproved()
+	if approved && !revocation.Approved {
+		event.Normal(organization.Config.Recorder(), instance, event.CRLApproved, "CRL %s approved", revocation.CRL)
+	}
+	revocation.Approved = approved
+
+	if revocation.CRL == "" || equality.Semantic.DeepEqual(before, revocation) {
+		return nil
+	}
+	return organization.Client.PatchStatus(context.TODO(), latest, nil, controllerclient.PatchOption{
+		Resilient: &controllerclient.ResilientPatch{
+			Retry:    2,
+			Into:     &current.Organization{},
+			Strategy: client.MergeFrom,
+		},
+	})
+}
+
+// RevokingIdentities returns the admin/client identities not revoked yet whose users are no longer bound to the organization,
+// the ones marked Revoking upon removal included
+func (organization *BaseOrganization) RevokingIdentities(instance *current.Organization) ([]*current.Identity, error) {
+	identities := &current.IdentityList{}
+	err := organization.Client.List(context.TODO(), identities, client.InNamespace(instance.GetUserNamespace()), client.MatchingLabels{
+		current.IdentityOrganizationLabel: instance.GetName(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list identities")
+	}
+
+	bound := instance.GetRoleUsers()
+	revoking := make([]*current.Identity, 0)
+	for i := range identities.Items {
+		identity := &identities.Items[i]
+		if identity.IsRevoked() || (identity.Spec.Type != current.AdminIdentity && identity.Spec.Type != current.ClientIdentity) {
+			continue
+		}
+		if _, ok := bound[identity.Spec.User]; ok {
+			continue
+		}
+		revoking = append(revoking, identity)
+	}
+	return revoking, nil
+}
+
+// SaveCRL stores crl in secret `<organization>-crl`
+func (organization *BaseOrganization) SaveCRL(instance *current.Organization, crl []byte) error {
+	secret := &corev1.Secret{}
+	secret.Name = instance.GetCRL().Name
+	secret.Namespace = instance.GetCRL().Namespace
+	secret.Labels = organization.GetLabels(instance)
+	secret.Data = map[string][]byte{current.CRLKey: crl}
+	if err := organization.Client.CreateOrUpdate(context.TODO(), secret); err != nil {
+		return errors.Wrap(err, "failed to save CRL")
+	}
+	return nil
+}
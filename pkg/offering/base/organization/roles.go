@@ -0,0 +1,188 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package organization
+
+import (
+	"context"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
+	bcrbac "github.com/IBM-Blockchain/fabric-operator/pkg/rbac"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
+	"github.com/pkg/errors"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReconcileRoles creates/updates the Role, RoleBinding, ClusterRole and ClusterRoleBinding of each role
+// declared in organization and deletes the ones of roles no longer declared
+func (organization *BaseOrganization) ReconcileRoles(instance *current.Organization) error {
+	// admin's clusterrole records the cluster scope resources organization takes part in
+	adminClusterRole := &rbacv1.ClusterRole{}
+	err := organization.Client.Get(context.TODO(), bcrbac.GetClusterRole(instance.GetNamespaced(), bcrbac.Admin), adminClusterRole)
+	if err != nil {
+		return err
+	}
+	adminRole := &rbacv1.Role{}
+	err = organization.Client.Get(context.TODO(), instance.GetAdminRole(), adminRole)
+	if err != nil {
+		return err
+	}
+
+	declared := make(map[string]bool, len(instance.Spec.Roles))
+	for _, role := range instance.Spec.Roles {
+		declared[role.Name] = true
+		def := bcrbac.DefinitionOf(role)
+		// roles are created by the operator, which skips the escalation check of kubernetes
+		if !util.PolicyRulesCover(adminRole.Rules, def.Rules...) {
+			return errors.Errorf("rules of role %s are not granted to admins", role.Name)
+		}
+		err = organization.reconcileRole(instance, def, role.Users, adminClusterRole.Rules)
+		if err != nil {
+			return errors.Wrapf(err, "failed to reconcile role %s", role.Name)
+		}
+	}
+
+	return organization.deleteUndeclaredRoles(instance, declared)
+}
+
+func (organization *BaseOrganization) reconcileRole(instance *current.Organization, def bcrbac.RoleDefinition, users []string, adminRules []rbacv1.PolicyRule) error {
+	namespaced := instance.GetNamespaced()
+	meta := func(key types.NamespacedName) v1.ObjectMeta {
+		labels := map[string]string{
+			bcrbac.OrganizationLabel: instance.GetName(),
+			bcrbac.RoleLabel:         def.Type.String(),
+		}
+		for k, v := range organization.GetLabels(instance) {
+			labels[k] = v
+		}
+		return v1.ObjectMeta{
+			Name:            key.Name,
+			Namespace:       key.Namespace,
+			Labels:          labels,
+			OwnerReferences: []v1.OwnerReference{bcrbac.OwnerReference(bcrbac.Organization, instance)},
+		}
+	}
+
+	subjects := make([]rbacv1.Subject, len(users))
+	for i, u := range users {
+		subjects[i] = common.GetDefaultSubject(u, namespaced.Namespace, "")
+	}
+
+	role := &rbacv1.Role{
+		ObjectMeta: meta(bcrbac.GetRole(namespaced, def.Type)),
+		Rules:      def.Rules,
+	}
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: meta(bcrbac.GetRoleBinding(namespaced, def.Type)),
+		Subjects:   subjects,
+		RoleRef:    bcrbac.RoleRef(namespaced, def.Type),
+	}
+	clusterRole := &rbacv1.ClusterRole{
+		ObjectMeta: meta(bcrbac.GetClusterRole(namespaced, def.Type)),
+		Rules:      clusterRules(def, adminRules),
+	}
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: meta(bcrbac.GetClusterRoleBinding(namespaced, def.Type)),
+		Subjects:   subjects,
+		RoleRef:    bcrbac.ClusterRoleRef(namespaced, def.Type),
+	}
+
+	for _, obj := range []client.Object{role, roleBinding, clusterRole, clusterRoleBinding} {
+		if err := organization.Client.CreateOrUpdate(context.TODO(), obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clusterRules grants the verbs of role on each cluster scope resource admin's rules refer to,
+// as long as admin holds them as well
+func clusterRules(def bcrbac.RoleDefinition, adminRules []rbacv1.PolicyRule) []rbacv1.PolicyRule {
+	rules := make([]rbacv1.PolicyRule, 0)
+	for _, rule := range adminRules {
+		for _, kind := range bcrbac.SyncedResources {
+			if len(rule.Resources) != 1 || rule.Resources[0] != kind.String() {
+				continue
+			}
+			verbs := make([]bcrbac.Verb, 0)
+			for _, verb := range def.Verbs(kind, false) {
+				if util.ContainsValue(verb.String(), rule.Verbs) {
+					verbs = append(verbs, verb)
+				}
+			}
+			if len(verbs) == 0 {
+				continue
+			}
+			objects := make([]v1.Object, len(rule.ResourceNames))
+			for i, name := range rule.ResourceNames {
+				objects[i] = &v1.ObjectMeta{Name: name}
+			}
+			rules = append(rules, bcrbac.PolicyRule(kind, objects, verbs))
+		}
+	}
+	return rules
+}
+
+// deleteUndeclaredRoles deletes the rbac resources of organization's roles which are not in declared
+func (organization *BaseOrganization) deleteUndeclaredRoles(instance *current.Organization, declared map[string]bool) error {
+	selector := client.MatchingLabels{bcrbac.OrganizationLabel: instance.GetName()}
+
+	roles := &rbacv1.RoleList{}
+	roleBindings := &rbacv1.RoleBindingList{}
+	clusterRoles := &rbacv1.ClusterRoleList{}
+	clusterRoleBindings := &rbacv1.ClusterRoleBindingList{}
+	for _, list := range []client.ObjectList{roles, roleBindings} {
+		if err := organization.Client.List(context.TODO(), list, selector, client.InNamespace(instance.GetUserNamespace())); err != nil {
+			return err
+		}
+	}
+	for _, list := range []client.ObjectList{clusterRoles, clusterRoleBindings} {
+		if err := organization.Client.List(context.TODO(), list, selector); err != nil {
+			return err
+		}
+	}
+
+	objects := make([]client.Object, 0)
+	for i := range roles.Items {
+		objects = append(objects, &roles.Items[i])
+	}
+	for i := range roleBindings.Items {
+		objects = append(objects, &roleBindings.Items[i])
+	}
+	for i := range clusterRoles.Items {
+		objects = append(objects, &clusterRoles.Items[i])
+	}
+	for i := range clusterRoleBindings.Items {
+		objects = append(objects, &clusterRoleBindings.Items[i])
+	}
+	for _, obj := range objects {
+		if declared[obj.GetLabels()[bcrbac.RoleLabel]] {
+			continue
+		}
+		log.Info("delete rbac of undeclared role", "organization", instance.GetName(), "name", obj.GetName())
+		if err := organization.Client.Delete(context.TODO(), obj); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
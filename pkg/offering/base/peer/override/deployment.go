@@ -33,6 +33,7 @@ import (
 	dep "github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources/deployment"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources/serviceaccount"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/util/image"
 	"github.com/IBM-Blockchain/fabric-operator/version"
@@ -55,8 +56,12 @@ const (
 	COUCHDBINIT = "couchdbinit"
 	CCLAUNCHER  = "chaincode-launcher"
 	HSMCLIENT   = "hsm-client"
+	UNJOIN      = "unjoin"
 )
 
+// SnapshotsRootDir keeps ledger snapshots on the peer's volume, so that they survive restarts
+const SnapshotsRootDir = "/data/peer/snapshots"
+
 type CoreConfig interface {
 	UsingPKCS11() bool
 }
@@ -223,6 +228,14 @@ func (o *Override) CreateDeployment(instance *current.IBPPeer, k8sDep *appsv1.De
 	}
 
 	configOverride := co.(CoreConfig)
+	keyVolumes := []secretstore.KeyVolume{{Volume: "tls-keystore", Secret: fmt.Sprintf("tls-%s-keystore", instance.Name)}}
+	if !configOverride.UsingPKCS11() {
+		keyVolumes = append(keyVolumes, secretstore.KeyVolume{Volume: "ecert-keystore", Secret: fmt.Sprintf("ecert-%s-keystore", instance.Name)})
+	}
+	if o.Config != nil {
+		common.FetchKeysFromStore(o.Client, o.Config.Operator.SecretStore, deployment, instance.Namespace, keyVolumes)
+	}
+
 	if !configOverride.UsingPKCS11() {
 		deployment.AppendSecretVolumeIfMissing("ecert-keystore", fmt.Sprintf("ecert-%s-keystore", instance.Name))
 		peerContainer.AppendVolumeMountIfMissing("ecert-keystore", "/certs/msp/keystore")
@@ -294,7 +307,7 @@ func (o *Override) CreateDeployment(instance *current.IBPPeer, k8sDep *appsv1.De
 		}
 	}
 
-	return nil
+	return o.UnjoinContainer(instance, deployment)
 }
 
 func (o *Override) V1Deployment(instance *current.IBPPeer, deployment *dep.Deployment) error {
@@ -667,6 +680,39 @@ func (o *Override) UpdateDeployment(instance *current.IBPPeer, k8sDep *appsv1.De
 		}
 	}
 
+	return o.UnjoinContainer(instance, deployment)
+}
+
+// UnjoinContainer runs `peer node unjoin` for spec.unjoinChannels before the peer starts, as
+// unjoin requires the peer to be stopped. The init container is a copy of the peer container,
+// so it sees the same config and ledger. It is removed when there is nothing to unjoin.
+func (o *Override) UnjoinContainer(instance *current.IBPPeer, deployment *dep.Deployment) error {
+	if len(instance.Spec.UnjoinChannels) == 0 {
+		deployment.RemoveInitContainer(UNJOIN)
+		return nil
+	}
+
+	peerContainer, err := deployment.GetContainer(PEER)
+	if err != nil {
+		return errors.New("peer container not found in deployment spec")
+	}
+	unjoin := peerContainer.Container.DeepCopy()
+	unjoin.Name = UNJOIN
+	unjoin.Ports = nil
+	unjoin.LivenessProbe = nil
+	unjoin.ReadinessProbe = nil
+	unjoin.StartupProbe = nil
+	unjoin.Lifecycle = nil
+	unjoin.Args = nil
+	// unjoin fails if the peer has no such ledger, which is fine
+	cmd := fmt.Sprintf("for ch in %s; do peer node unjoin -c $ch || true; done", strings.Join(instance.Spec.UnjoinChannels, " "))
+	unjoin.Command = []string{"sh", "-c", cmd}
+
+	if _, err = deployment.GetContainer(UNJOIN); err == nil {
+		deployment.UpdateInitContainer(*container.New(unjoin))
+	} else {
+		deployment.AddInitContainer(*container.New(unjoin))
+	}
 	return nil
 }
 
@@ -733,6 +779,8 @@ func (o *Override) CommonDeploymentOverrides(instance *current.IBPPeer, deployme
 		}
 	}
 
+	peerContainer.AppendEnvIfMissing("CORE_LEDGER_SNAPSHOTS_ROOTDIR", SnapshotsRootDir)
+
 	externalAddress := instance.Spec.PeerExternalEndpoint
 	// Set external address to "do-not-set" in Peer CR spec to disable Service discovery
 	if externalAddress != "" && externalAddress != "do-not-set" {
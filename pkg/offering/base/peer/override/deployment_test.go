@@ -678,6 +678,40 @@ var _ = Describe("Base Peer Deployment Overrides", func() {
 			Expect(init.Command).To(Equal([]string{"bash", "-c", cmd}))
 		})
 
+		Context("unjoin channels", func() {
+			BeforeEach(func() {
+				instance.Spec.UnjoinChannels = []string{"channel1", "channel2"}
+			})
+
+			It("adds an init container which unjoins the channels", func() {
+				err := overrider.Deployment(instance, k8sDep, resources.Update)
+				Expect(err).NotTo(HaveOccurred())
+
+				peer, err := deployment.GetContainer(override.PEER)
+				Expect(err).NotTo(HaveOccurred())
+				unjoin, err := deployment.GetContainer(override.UNJOIN)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(unjoin.Image).To(Equal(peer.Image))
+				Expect(unjoin.Env).To(Equal(peer.Env))
+				Expect(unjoin.VolumeMounts).To(Equal(peer.VolumeMounts))
+				Expect(unjoin.Ports).To(BeEmpty())
+				Expect(unjoin.ReadinessProbe).To(BeNil())
+				Expect(unjoin.Command).To(Equal([]string{"sh", "-c", "for ch in channel1 channel2; do peer node unjoin -c $ch || true; done"}))
+				Expect(deployment.Spec.Template.Spec.InitContainers[0].Name).To(Equal(override.INIT))
+			})
+
+			It("removes the init container when no channel is left", func() {
+				err := overrider.Deployment(instance, k8sDep, resources.Update)
+				Expect(err).NotTo(HaveOccurred())
+
+				instance.Spec.UnjoinChannels = nil
+				err = overrider.Deployment(instance, k8sDep, resources.Update)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = deployment.GetContainer(override.UNJOIN)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
 		Context("images", func() {
 			var (
 				image *current.PeerImages
@@ -33,6 +33,7 @@ import (
 	"github.com/IBM-Blockchain/fabric-operator/pkg/action"
 	commonapi "github.com/IBM-Blockchain/fabric-operator/pkg/apis/common"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/certificate"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/event"
 	commoninit "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common"
 	commonconfig "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common/config"
 	initializer "github.com/IBM-Blockchain/fabric-operator/pkg/initializer/peer"
@@ -48,6 +49,7 @@ import (
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common/reconcilechecks"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/operatorerrors"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/restart"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/user"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
 	"github.com/IBM-Blockchain/fabric-operator/version"
@@ -207,6 +209,7 @@ type Peer struct {
 
 	CertificateManager CertificateManager
 	RenewCertTimers    map[string]*time.Timer
+	SecretStore        secretstore.Store
 
 	Restart RestartManager
 }
@@ -221,21 +224,36 @@ func New(client controllerclient.Client, scheme *runtime.Scheme, config *config.
 
 	p.CreateManagers()
 
+	p.SecretStore = secretstore.New(client, scheme, config.Operator.SecretStore)
 	validator := &validator.Validator{
 		Client: client,
+		Store:  p.SecretStore,
 	}
 
 	init := initializer.New(config.PeerInitConfig, scheme, client, p.GetLabels, validator, config.Operator.Peer.Timeouts.EnrollJob)
+	init.SecretManager.Store = p.SecretStore
 	p.Initializer = init
 
-	p.CertificateManager = certificate.New(client, scheme)
+	certificateManager := certificate.New(client, scheme)
+	certificateManager.Store = p.SecretStore
+	p.CertificateManager = certificateManager
 	p.RenewCertTimers = make(map[string]*time.Timer)
 
-	p.Restart = restart.New(client, config.Operator.Restart.WaitTime.Get(), config.Operator.Restart.Timeout.Get())
+	restartManager := restart.New(client, config.Operator.Restart.WaitTime.Get(), config.Operator.Restart.Timeout.Get())
+	restartManager.Recorder = config.Recorder()
+	p.Restart = restartManager
 
 	return p
 }
 
+// secretStore returns the kubernetes store for peers created without one
+func (p *Peer) secretStore() secretstore.Store {
+	if p.SecretStore == nil {
+		return secretstore.NewKubernetes(p.Client, p.Scheme)
+	}
+	return p.SecretStore
+}
+
 func (p *Peer) CreateManagers() {
 	override := p.Override
 	resourceManager := resourcemanager.New(p.Client, p.Scheme)
@@ -485,7 +503,19 @@ func (p *Peer) ReconcileUser(instance *current.IBPPeer, update Update) (err erro
 		return err
 	}
 
-	return nil
+	// record the expiry of peer's enrollment certificate once enrolled
+	ecert := &corev1.Secret{}
+	err = p.Client.Get(context.TODO(), types.NamespacedName{Namespace: instance.Namespace, Name: fmt.Sprintf("%s-%s-signcert", commoninit.ECERT, instance.Name)}, ecert)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if len(ecert.Data["cert.pem"]) == 0 {
+		return nil
+	}
+	return user.SetIdentityExpiry(p.Client, org, instance.GetName(), ecert.Data["cert.pem"])
 }
 
 func (p *Peer) InitializeUpdateConfigOverride(instance *current.IBPPeer, initPeer *initializer.Peer) error {
@@ -1557,7 +1587,9 @@ func (p *Peer) ReenrollTLSCertNewKey(instance *current.IBPPeer) error {
 }
 
 func (p *Peer) reenrollCert(instance *current.IBPPeer, certType commoninit.SecretType, newKey bool) error {
-	return action.Reenroll(p, p.Client, certType, instance, newKey)
+	err := action.Reenroll(p, p.Client, certType, instance, newKey)
+	event.Reenroll(p.Config.Recorder(), instance, string(certType), newKey, err)
+	return err
 }
 
 func (p *Peer) RestartAction(instance *current.IBPPeer) error {
@@ -1649,7 +1681,7 @@ func (p *Peer) CustomLogic(instance *current.IBPPeer, update Update) (*current.C
 	// Check if crypto needs to be backed up before an update overrides exisitng secrets
 	if update.CryptoBackupNeeded() {
 		log.Info("Performing backup of TLS and ecert crypto")
-		err = common.BackupCrypto(p.Client, p.Scheme, instance, p.GetLabels(instance))
+		err = common.BackupCrypto(p.Client, p.secretStore(), p.Scheme, instance, p.GetLabels(instance))
 		if err != nil {
 			return status, nil, errors.Wrap(err, "failed to backup TLS and ecert crypto")
 		}
@@ -1740,7 +1772,7 @@ func (p *Peer) SetCertificateTimer(instance *current.IBPPeer, certType commonini
 			return
 		}
 
-		err = common.BackupCrypto(p.Client, p.Scheme, instance, p.GetLabels(instance))
+		err = common.BackupCrypto(p.Client, p.secretStore(), p.Scheme, instance, p.GetLabels(instance))
 		if err != nil {
 			log.Error(err, "failed to backup crypto before renewing cert")
 			return
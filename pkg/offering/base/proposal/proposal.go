@@ -23,10 +23,12 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
 	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/metrics"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
 	bcrbac "github.com/IBM-Blockchain/fabric-operator/pkg/rbac"
 	"github.com/pkg/errors"
@@ -81,7 +83,8 @@ func (p *BaseProposal) CreateManagers() {
 	p.RBACManager = bcrbac.NewRBACManager(p.Client, nil)
 }
 
-func (p *BaseProposal) PreReconcileChecks(instance *current.Proposal) (bool, error) {
+func (p *BaseProposal) PreReconcileChecks(instance *current.Proposal) (update bool, err error) {
+	defer metrics.ObserveStep(metrics.KindProposal, metrics.StepPreReconcileChecks, time.Now(), &err)
 	// todo add
 	return false, nil
 }
@@ -103,6 +106,7 @@ func (p *BaseProposal) Reconcile(instance *current.Proposal) (result common.Resu
 }
 
 func (c *BaseProposal) ReconcileManagers(ctx context.Context, instance *current.Proposal) (err error) {
+	defer metrics.ObserveStep(metrics.KindProposal, metrics.StepReconcileManagers, time.Now(), &err)
 	if err = c.ReconcileOwnerReference(instance); err != nil {
 		return errors.Wrap(err, "failed OwerReference reconciliation")
 	}
@@ -183,6 +187,14 @@ func (c *BaseProposal) CreateVoteIfNotExists(ctx context.Context, instance *curr
 	}
 	wg := sync.WaitGroup{}
 	for _, org := range organizations {
+		// external organizations vote with signed artifacts in proposal spec instead
+		external, err := current.IsExternalOrganization(ctx, c.Client, org)
+		if err != nil {
+			return err
+		}
+		if external {
+			continue
+		}
 		wg.Add(1)
 		go func(orgName string) {
 			defer func() {
@@ -214,7 +226,7 @@ func (c *BaseProposal) CreateVoteIfNotExists(ctx context.Context, instance *curr
 				}
 			} else {
 				if org.Name == instance.Spec.InitiatorOrganization {
-					if pointer.BoolDeref(vote.Spec.Decision, false) {
+					if pointer.BoolDeref(vote.Spec.Decision, false) || vote.Spec.Abstain {
 						return
 					}
 					vote.Spec.Decision = pointer.Bool(true)
@@ -0,0 +1,77 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package renewalpolicy
+
+import (
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newNode(kind current.RenewalKind) client.Object {
+	switch kind {
+	case "IBPOrderer":
+		return &current.IBPOrderer{}
+	case "IBPCA":
+		return &current.IBPCA{}
+	default:
+		return &current.IBPPeer{}
+	}
+}
+
+// renewing returns true if a reenroll or renew action of the node is set
+func renewing(node client.Object) bool {
+	switch n := node.(type) {
+	case *current.IBPPeer:
+		r := n.Spec.Action.Reenroll
+		return r.Ecert || r.EcertNewKey || r.TLSCert || r.TLSCertNewKey
+	case *current.IBPOrderer:
+		r := n.Spec.Action.Reenroll
+		return r.Ecert || r.EcertNewKey || r.TLSCert || r.TLSCertNewKey
+	case *current.IBPCA:
+		return n.Spec.Action.Renew.TLSCert
+	}
+	return false
+}
+
+// setAction sets the action renewing the certificate of certType. A CA always renews its tls certificate with a new key
+func setAction(node client.Object, certType current.CertificateType, newKey bool) {
+	switch n := node.(type) {
+	case *current.IBPPeer:
+		r := &n.Spec.Action.Reenroll
+		setReenroll(certType, newKey, &r.Ecert, &r.EcertNewKey, &r.TLSCert, &r.TLSCertNewKey)
+	case *current.IBPOrderer:
+		r := &n.Spec.Action.Reenroll
+		setReenroll(certType, newKey, &r.Ecert, &r.EcertNewKey, &r.TLSCert, &r.TLSCertNewKey)
+	case *current.IBPCA:
+		n.Spec.Action.Renew.TLSCert = true
+	}
+}
+
+func setReenroll(certType current.CertificateType, newKey bool, ecert, ecertNewKey, tlsCert, tlsCertNewKey *bool) {
+	switch {
+	case certType == current.CertificateEcert && newKey:
+		*ecertNewKey = true
+	case certType == current.CertificateEcert:
+		*ecert = true
+	case newKey:
+		*tlsCertNewKey = true
+	default:
+		*tlsCert = true
+	}
+}
@@ -0,0 +1,280 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package renewalpolicy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/certificate/inventory"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util/cron"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var log = logf.Log.WithName("base_renewalpolicy")
+
+const (
+	// PollInterval is how often running renewals are checked
+	PollInterval = 15 * time.Second
+	// RecheckInterval is how often certificates are checked for expiry when nothing runs
+	RecheckInterval = 30 * time.Minute
+	// RenewalTimeout fails renewals whose node has not picked up the reenroll action
+	RenewalTimeout = 10 * time.Minute
+	// RetryInterval is how long a failed renewal waits before it is retried
+	RetryInterval = time.Hour
+)
+
+type RenewalPolicy interface {
+	Reconcile(*current.RenewalPolicy) (common.Result, error)
+}
+
+type baseRenewalPolicy struct {
+	client controllerclient.Client
+
+	Scheme *runtime.Scheme
+	Config *config.Config
+}
+
+var _ RenewalPolicy = &baseRenewalPolicy{}
+
+func New(client controllerclient.Client, scheme *runtime.Scheme, conf *config.Config) RenewalPolicy {
+	return &baseRenewalPolicy{client: client, Scheme: scheme, Config: conf}
+}
+
+// Reconcile follows running renewals, then starts renewals of due certificates while a
+// maintenance window is open and fewer than maxConcurrent renewals run
+func (b *baseRenewalPolicy) Reconcile(instance *current.RenewalPolicy) (common.Result, error) {
+	now := time.Now()
+	b.follow(instance, now)
+
+	open, next := Window(instance.Spec.MaintenanceWindows, now)
+	instance.Status.WindowOpen = open
+	instance.Status.NextWindow = nil
+	if !next.IsZero() {
+		nextWindow := metav1.NewTime(next)
+		instance.Status.NextWindow = &nextWindow
+	}
+
+	due, err := b.due(instance, now)
+	if err != nil {
+		log.Error(err, "failed to find due certificates", "policy", instance.GetName())
+		setStatus(instance, current.Error, err.Error())
+		if err := b.patchStatus(instance); err != nil {
+			return common.Result{}, err
+		}
+		return common.Result{Result: reconcile.Result{RequeueAfter: PollInterval}}, nil
+	}
+
+	if open && !instance.Spec.Suspend {
+		for len(due) > 0 && len(instance.Status.InProgress) < instance.GetMaxConcurrent() {
+			b.start(instance, due[0], now)
+			due = due[1:]
+		}
+	}
+	instance.Status.Pending = len(due)
+	setStatus(instance, current.Deployed, fmt.Sprintf("%d renewals in progress, %d pending", len(instance.Status.InProgress), instance.Status.Pending))
+
+	if err := b.patchStatus(instance); err != nil {
+		return common.Result{}, err
+	}
+	return common.Result{Result: reconcile.Result{RequeueAfter: requeueAfter(instance, now)}}, nil
+}
+
+func requeueAfter(instance *current.RenewalPolicy, now time.Time) time.Duration {
+	if len(instance.Status.InProgress) > 0 {
+		return PollInterval
+	}
+	if instance.Status.Pending > 0 && !instance.Status.WindowOpen && instance.Status.NextWindow != nil {
+		if wait := instance.Status.NextWindow.Sub(now); wait < RecheckInterval {
+			return wait
+		}
+	}
+	return RecheckInterval
+}
+
+// Window reports whether a maintenance window is open at now and when the next one opens.
+// Without windows renewals may start any time
+func Window(windows []current.MaintenanceWindow, now time.Time) (bool, time.Time) {
+	if len(windows) == 0 {
+		return true, time.Time{}
+	}
+	open := false
+	var next time.Time
+	for _, w := range windows {
+		schedule, err := cron.Parse(w.Schedule)
+		if err != nil {
+			continue
+		}
+		// the window is open when it started within its duration before now
+		if start := schedule.Next(now.Add(-w.Duration.Duration)); !start.IsZero() && !start.After(now) {
+			open = true
+		}
+		if start := schedule.Next(now); !start.IsZero() && (next.IsZero() || start.Before(next)) {
+			next = start
+		}
+	}
+	return open, next
+}
+
+// candidate is a due certificate of a node
+type candidate struct {
+	target   current.RenewalTarget
+	notAfter metav1.Time
+}
+
+// due returns the certificates covered by the policy expiring within renewBefore, soonest first.
+// Nodes with a reenroll action already set are skipped until it finishes, failed renewals until RetryInterval passed
+func (b *baseRenewalPolicy) due(instance *current.RenewalPolicy, now time.Time) ([]candidate, error) {
+	certs, err := inventory.Collect(b.client, secretstore.New(b.client, b.Scheme, b.Config.Operator.SecretStore), instance.Spec.Namespaces, now, instance.GetRenewBefore())
+	if err != nil {
+		return nil, err
+	}
+
+	var due []candidate
+	for _, cert := range certs {
+		if cert.State == current.CertificateValid || cert.Kind == "Organization" {
+			continue
+		}
+		target := current.RenewalTarget{
+			Kind:      current.RenewalKind(cert.Kind),
+			Namespace: cert.Namespace,
+			Name:      cert.Name,
+			CertType:  cert.Type,
+		}
+		if instance.Running(target) || failedSince(instance, target, now.Add(-RetryInterval)) {
+			continue
+		}
+		node := newNode(target.Kind)
+		if err := b.client.Get(context.TODO(), types.NamespacedName{Namespace: target.Namespace, Name: target.Name}, node); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		covered, err := instance.Covers(cert.Kind, cert.Type, node)
+		if err != nil {
+			return nil, err
+		}
+		if !covered || renewing(node) {
+			continue
+		}
+		due = append(due, candidate{target: target, notAfter: cert.NotAfter})
+	}
+	return due, nil
+}
+
+// failedSince returns true if a renewal of target failed after since
+func failedSince(instance *current.RenewalPolicy, target current.RenewalTarget, since time.Time) bool {
+	for _, result := range instance.Status.History {
+		if result.RenewalTarget == target && !result.Succeeded && result.Time.After(since) {
+			return true
+		}
+	}
+	return false
+}
+
+// start sets the reenroll action of the node, the node's controller runs it and resets the action
+func (b *baseRenewalPolicy) start(instance *current.RenewalPolicy, c candidate, now time.Time) {
+	target := c.target
+	node := newNode(target.Kind)
+	err := b.client.Get(context.TODO(), types.NamespacedName{Namespace: target.Namespace, Name: target.Name}, node)
+	if err == nil {
+		orig := node.DeepCopyObject().(client.Object)
+		setAction(node, target.CertType, instance.Spec.NewKey)
+		err = b.client.Patch(context.TODO(), node, client.MergeFrom(orig))
+	}
+	if err != nil {
+		log.Error(err, "failed to start renewal", "policy", instance.GetName(), "kind", target.Kind, "node", target.Name)
+		instance.Record(current.RenewalResult{RenewalTarget: target, Time: metav1.NewTime(now), Message: err.Error()})
+		return
+	}
+
+	log.Info(fmt.Sprintf("Renewing %s certificate of %s '%s/%s'", target.CertType, target.Kind, target.Namespace, target.Name))
+	instance.Status.InProgress = append(instance.Status.InProgress, current.RenewalRun{
+		RenewalTarget:    target,
+		StartTime:        metav1.NewTime(now),
+		PreviousNotAfter: c.notAfter,
+	})
+}
+
+// follow records renewals which finished. A renewal succeeded when the certificate expires later than before,
+// the node is read before the certificate as the reenroll updates the certificate before it resets the action
+func (b *baseRenewalPolicy) follow(instance *current.RenewalPolicy, now time.Time) {
+	var running []current.RenewalRun
+	for _, run := range instance.Status.InProgress {
+		result := current.RenewalResult{RenewalTarget: run.RenewalTarget, Time: metav1.NewTime(now)}
+
+		node := newNode(run.Kind)
+		if err := b.client.Get(context.TODO(), types.NamespacedName{Namespace: run.Namespace, Name: run.Name}, node); err != nil {
+			if !k8serrors.IsNotFound(err) {
+				log.Error(err, "failed to get node", "kind", run.Kind, "node", run.Name)
+				running = append(running, run)
+				continue
+			}
+			result.Message = "node not found"
+			instance.Record(result)
+			continue
+		}
+
+		cert, err := inventory.Read(secretstore.New(b.client, b.Scheme, b.Config.Operator.SecretStore), string(run.Kind), run.Namespace, run.Name, run.CertType)
+		switch {
+		case err == nil && cert.NotAfter.After(run.PreviousNotAfter.Time):
+			result.Succeeded = true
+			result.Message = fmt.Sprintf("certificate expires at %s", cert.NotAfter.UTC().Format(time.RFC3339))
+		case !renewing(node):
+			result.Message = "reenroll finished without a new certificate, see the node's status"
+		case now.Sub(run.StartTime.Time) > RenewalTimeout:
+			result.Message = fmt.Sprintf("certificate not renewed within %s", RenewalTimeout)
+		default:
+			running = append(running, run)
+			continue
+		}
+		instance.Record(result)
+	}
+	instance.Status.InProgress = running
+}
+
+func setStatus(instance *current.RenewalPolicy, statusType current.IBPCRStatusType, message string) {
+	instance.Status.Type = statusType
+	instance.Status.Status = current.True
+	instance.Status.Message = message
+	instance.Status.LastHeartbeatTime = metav1.Now()
+}
+
+func (b *baseRenewalPolicy) patchStatus(instance *current.RenewalPolicy) error {
+	return b.client.PatchStatus(context.TODO(), instance, nil, controllerclient.PatchOption{
+		Resilient: &controllerclient.ResilientPatch{
+			Retry:    2,
+			Into:     &current.RenewalPolicy{},
+			Strategy: client.MergeFrom,
+		},
+	})
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package renewalpolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	cmocks "github.com/IBM-Blockchain/fabric-operator/controllers/mocks"
+	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util/testcert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestWindow(t *testing.T) {
+	windows := []current.MaintenanceWindow{
+		{Schedule: "0 2 * * 6", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+		{Schedule: "0 22 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+	}
+	// a saturday
+	saturday := time.Date(2022, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	if open, next := Window(nil, saturday); !open || !next.IsZero() {
+		t.Fatalf("renewals start any time without windows, get %v %v", open, next)
+	}
+	if open, next := Window(windows, saturday.Add(time.Hour)); open || !next.Equal(saturday.Add(2*time.Hour)) {
+		t.Fatalf("expect closed until 02:00, get %v %v", open, next)
+	}
+	if open, next := Window(windows, saturday.Add(5*time.Hour)); !open || !next.Equal(saturday.Add(22*time.Hour)) {
+		t.Fatalf("expect open until 06:00, get %v %v", open, next)
+	}
+	if open, _ := Window(windows, saturday.Add(7*time.Hour)); open {
+		t.Fatal("expect closed after 06:00")
+	}
+	if open, _ := Window(windows, saturday.Add(22*time.Hour+30*time.Minute)); !open {
+		t.Fatal("expect open after 22:00")
+	}
+}
+
+// cluster keeps peers and their ecert secrets for the mock client
+type cluster struct {
+	peers   map[string]*current.IBPPeer
+	secrets map[string][]byte
+}
+
+func (cl *cluster) client() *cmocks.Client {
+	c := &cmocks.Client{}
+	c.GetStub = func(ctx context.Context, key types.NamespacedName, obj client.Object) error {
+		switch o := obj.(type) {
+		case *current.IBPPeer:
+			peer, ok := cl.peers[key.Name]
+			if !ok {
+				return k8serrors.NewNotFound(schema.GroupResource{Resource: "ibppeers"}, key.Name)
+			}
+			peer.DeepCopyInto(o)
+		case *corev1.Secret:
+			cert, ok := cl.secrets[key.Name]
+			if !ok {
+				return k8serrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, key.Name)
+			}
+			o.Data = map[string][]byte{"cert.pem": cert}
+		}
+		return nil
+	}
+	c.ListStub = func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+		if l, ok := list.(*current.IBPPeerList); ok {
+			for _, name := range []string{"peer1", "peer2"} {
+				l.Items = append(l.Items, *cl.peers[name])
+			}
+		}
+		return nil
+	}
+	c.PatchStub = func(ctx context.Context, obj client.Object, patch client.Patch, opts ...controllerclient.PatchOption) error {
+		cl.peers[obj.GetName()] = obj.(*current.IBPPeer).DeepCopy()
+		return nil
+	}
+	return c
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Now()
+	peer := func(name string) *current.IBPPeer {
+		return &current.IBPPeer{ObjectMeta: metav1.ObjectMeta{Namespace: "org1", Name: name, Labels: map[string]string{"renew": "true"}}}
+	}
+	cl := &cluster{
+		peers: map[string]*current.IBPPeer{"peer1": peer("peer1"), "peer2": peer("peer2")},
+		secrets: map[string][]byte{
+			"ecert-peer1-signcert": testcert.New(t, "peer1", nil, testcert.NotAfter(now.AddDate(0, 0, 10))).PEM,
+			"ecert-peer2-signcert": testcert.New(t, "peer2", nil, testcert.NotAfter(now.AddDate(0, 0, 5))).PEM,
+			"tls-peer1-signcert":   testcert.New(t, "peer1-tls", nil, testcert.NotAfter(now.AddDate(1, 0, 0))).PEM,
+			"tls-peer2-signcert":   testcert.New(t, "peer2-tls", nil, testcert.NotAfter(now.AddDate(1, 0, 0))).PEM,
+		},
+	}
+	policy := New(cl.client(), nil, &config.Config{})
+	instance := &current.RenewalPolicy{
+		Spec: current.RenewalPolicySpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"renew": "true"}},
+		},
+	}
+
+	// the soonest expiring certificate is renewed first
+	if _, err := policy.Reconcile(instance); err != nil {
+		t.Fatal(err)
+	}
+	if len(instance.Status.InProgress) != 1 || instance.Status.InProgress[0].Name != "peer2" || instance.Status.Pending != 1 {
+		t.Fatalf("expect peer2 renewing and peer1 pending, get %+v", instance.Status)
+	}
+	if !cl.peers["peer2"].Spec.Action.Reenroll.Ecert {
+		t.Fatal("expect the ecert reenroll action of peer2 set")
+	}
+
+	// the renewal runs until the node resets the action
+	if _, err := policy.Reconcile(instance); err != nil {
+		t.Fatal(err)
+	}
+	if len(instance.Status.InProgress) != 1 || len(instance.Status.History) != 0 {
+		t.Fatalf("expect peer2 still renewing, get %+v", instance.Status)
+	}
+
+	cl.secrets["ecert-peer2-signcert"] = testcert.New(t, "peer2", nil, testcert.NotAfter(now.AddDate(1, 0, 0))).PEM
+	cl.peers["peer2"].Spec.Action.Reenroll.Ecert = false
+	if _, err := policy.Reconcile(instance); err != nil {
+		t.Fatal(err)
+	}
+	if len(instance.Status.History) != 1 || !instance.Status.History[0].Succeeded || instance.Status.History[0].Name != "peer2" {
+		t.Fatalf("expect peer2 renewed, get %+v", instance.Status.History)
+	}
+	if len(instance.Status.InProgress) != 1 || instance.Status.InProgress[0].Name != "peer1" || instance.Status.Pending != 0 {
+		t.Fatalf("expect peer1 renewing, get %+v", instance.Status)
+	}
+
+	// a reset action without a new certificate is a failed renewal
+	cl.peers["peer1"].Spec.Action.Reenroll.Ecert = false
+	if _, err := policy.Reconcile(instance); err != nil {
+		t.Fatal(err)
+	}
+	if len(instance.Status.History) != 2 || instance.Status.History[1].Succeeded {
+		t.Fatalf("expect peer1 renewal failed, get %+v", instance.Status.History)
+	}
+	if len(instance.Status.InProgress) != 0 || instance.Status.Pending != 0 {
+		t.Fatalf("expect the failed renewal to wait for a retry, get %+v", instance.Status)
+	}
+}
+
+func TestSetAction(t *testing.T) {
+	peer := &current.IBPPeer{}
+	setAction(peer, current.CertificateTLS, true)
+	if !peer.Spec.Action.Reenroll.TLSCertNewKey || !renewing(peer) {
+		t.Fatalf("expect tls reenroll with a new key, get %+v", peer.Spec.Action.Reenroll)
+	}
+	ca := &current.IBPCA{}
+	setAction(ca, current.CertificateTLS, false)
+	if !ca.Spec.Action.Renew.TLSCert || !renewing(ca) {
+		t.Fatal("expect the CA tls renewal set")
+	}
+}
@@ -0,0 +1,345 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package restore
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	config "github.com/IBM-Blockchain/fabric-operator/operatorconfig"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/base/backup"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var log = logf.Log.WithName("base_restore")
+
+type Restore interface {
+	Reconcile(*current.Restore) (common.Result, error)
+}
+
+type baseRestore struct {
+	client controllerclient.Client
+
+	Scheme *runtime.Scheme
+	Config *config.Config
+}
+
+var _ Restore = &baseRestore{}
+
+func New(client controllerclient.Client, scheme *runtime.Scheme, conf *config.Config) Restore {
+	return &baseRestore{client: client, Scheme: scheme, Config: conf}
+}
+
+// Reconcile restores the crypto secrets and spec of the node, recreating it when missing,
+// then replaces the node's volumes while it is scaled down
+func (r *baseRestore) Reconcile(instance *current.Restore) (common.Result, error) {
+	if instance.HasFinished() {
+		return common.Result{}, nil
+	}
+
+	now := time.Now()
+	phase := instance.Status.Phase
+	var err error
+	switch phase {
+	case "", current.RestorePending:
+		err = r.restoreNode(instance)
+	case current.RestoreWaitingForNode:
+		err = r.waitForNode(instance, now)
+	case current.RestoreQuiescing:
+		err = r.quiesce(instance, now)
+	case current.RestoreRestoring:
+		err = r.restoreVolumes(instance)
+	case current.RestoreResuming:
+		err = r.resume(instance)
+	}
+	if err != nil {
+		log.Error(err, fmt.Sprintf("restore phase %s failed", phase), "restore", instance.GetName())
+		switch phase {
+		case current.RestoreResuming:
+			// keep resuming until the node is back
+		case current.RestoreQuiescing, current.RestoreRestoring:
+			// the job replaces a volume's data only after its archive is extracted, so the node comes back on its previous data
+			instance.Status.Message = err.Error()
+			instance.Status.Phase = current.RestoreResuming
+		default:
+			instance.Status.Message = err.Error()
+			instance.Status.Phase = current.RestoreFailed
+		}
+	}
+	if instance.Status.Phase != phase {
+		transition := metav1.NewTime(now)
+		instance.Status.LastTransitionTime = &transition
+		if instance.HasFinished() {
+			instance.Status.CompletionTime = &transition
+		}
+	}
+
+	if err = r.client.PatchStatus(context.TODO(), instance, nil, controllerclient.PatchOption{
+		Resilient: &controllerclient.ResilientPatch{
+			Retry:    2,
+			Into:     &current.Restore{},
+			Strategy: client.MergeFrom,
+		},
+	}); err != nil {
+		return common.Result{}, err
+	}
+	if instance.HasFinished() {
+		return common.Result{}, nil
+	}
+	return common.Result{Result: reconcile.Result{RequeueAfter: backup.PollInterval}}, nil
+}
+
+// restoreNode restores secrets before the node, so a recreated node finds its crypto instead of enrolling again
+func (r *baseRestore) restoreNode(instance *current.Restore) error {
+	startTime := metav1.Now()
+	instance.Status.StartTime = &startTime
+	manifest, err := r.getManifest(instance)
+	if err != nil {
+		return err
+	}
+	if err = r.restoreSecrets(instance.GetNamespace(), manifest, nil); err != nil {
+		return err
+	}
+
+	target := instance.Spec.Target
+	node := target.NewObject()
+	err = r.client.Get(context.TODO(), types.NamespacedName{Namespace: instance.GetNamespace(), Name: target.Name}, node)
+	switch {
+	case k8serrors.IsNotFound(err):
+		if node, err = manifest.NewNode(); err != nil {
+			return err
+		}
+		node.SetNamespace(instance.GetNamespace())
+		log.Info(fmt.Sprintf("recreate %s %s from archive %s", target.Kind, target.Name, instance.Spec.Archive), "restore", instance.GetName())
+		if err = r.client.Create(context.TODO(), node); err != nil {
+			return errors.Wrap(err, "create node")
+		}
+	case err != nil:
+		return errors.Wrap(err, "get node")
+	default:
+		if err = manifest.ApplySpec(node); err != nil {
+			return err
+		}
+		if err = r.client.Update(context.TODO(), node); err != nil {
+			return errors.Wrap(err, "update node spec")
+		}
+	}
+	// secrets are cleaned up with the node again
+	if err = r.restoreSecrets(instance.GetNamespace(), manifest, node); err != nil {
+		return err
+	}
+
+	instance.Status.Volumes = manifest.Volumes
+	instance.Status.Phase = current.RestoreWaitingForNode
+	return nil
+}
+
+func (r *baseRestore) getManifest(instance *current.Restore) (*backup.Manifest, error) {
+	key, err := backup.ManifestKey(r.client, instance.GetNamespace(), *instance.Spec.Storage)
+	if err != nil {
+		return nil, err
+	}
+	store, err := backup.NewStorageClient(r.client, r.Config, instance.GetNamespace(), *instance.Spec.Storage)
+	if err != nil {
+		return nil, err
+	}
+	object := instance.Spec.Storage.ArchiveObject(instance.GetNamespace(), *instance.Spec.Target, instance.Spec.Archive, current.BackupManifestObject)
+	data, err := store.GetObject(context.TODO(), instance.Spec.Storage.Bucket, object)
+	if err != nil {
+		return nil, errors.Wrap(err, "download manifest")
+	}
+	manifest, err := backup.OpenManifest(data, key)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Kind != instance.Spec.Target.Kind {
+		return nil, errors.Errorf("archive %s is of %s %s", instance.Spec.Archive, manifest.Kind, manifest.Name)
+	}
+	return manifest, nil
+}
+
+// restoreSecrets writes secrets archived from an external store back through the configured store
+func (r *baseRestore) restoreSecrets(namespace string, manifest *backup.Manifest, owner client.Object) error {
+	store := secretstore.New(r.client, r.Scheme, r.Config.Operator.SecretStore)
+	for _, s := range manifest.Secrets {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      s.Name,
+				Namespace: namespace,
+				Labels:    s.Labels,
+			},
+			Type: s.Type,
+			Data: s.Data,
+		}
+		var err error
+		if s.Store != "" {
+			err = store.Put(secret, owner)
+		} else {
+			err = r.client.CreateOrUpdate(context.TODO(), secret, controllerclient.CreateOrUpdateOption{Owner: owner, Scheme: r.Scheme})
+		}
+		if err != nil {
+			return errors.Wrapf(err, "restore secret %s", s.Name)
+		}
+	}
+	return nil
+}
+
+// waitForNode waits for the node's deployment with all archived volumes, then quiesces it
+func (r *baseRestore) waitForNode(instance *current.Restore, now time.Time) error {
+	dep, err := backup.NodeDeployment(r.client, instance.GetNamespace(), instance.Spec.Target.Name)
+	if err == nil {
+		err = MissingVolumes(dep, instance.Status.Volumes)
+	}
+	if err != nil {
+		if !k8serrors.IsNotFound(err) && !errors.Is(err, errMissingVolumes) {
+			return err
+		}
+		if now.Sub(instance.Status.LastTransitionTime.Time) > r.Config.Operator.Backup.Timeouts.JobCompletion.Get() {
+			return errors.Wrap(err, "timed out waiting for the node")
+		}
+		return nil
+	}
+
+	instance.Status.Replicas = backup.Replicas(dep)
+	if instance.Status.Replicas == 0 {
+		instance.Status.Replicas = 1
+	}
+	if err = backup.Scale(r.client, dep, 0); err != nil {
+		return err
+	}
+	instance.Status.Phase = current.RestoreQuiescing
+	return nil
+}
+
+var errMissingVolumes = errors.New("node is missing archived volumes")
+
+// MissingVolumes returns an error if dep does not mount all archived volumes
+func MissingVolumes(dep *appsv1.Deployment, volumes []string) error {
+	mounted := make(map[string]bool)
+	for _, name := range backup.VolumeNames(backup.PVCVolumes(dep)) {
+		mounted[name] = true
+	}
+	var missing []string
+	for _, v := range volumes {
+		if !mounted[v] {
+			missing = append(missing, v)
+		}
+	}
+	if len(missing) != 0 {
+		return errors.Wrapf(errMissingVolumes, "%v", missing)
+	}
+	return nil
+}
+
+func (r *baseRestore) quiesce(instance *current.Restore, now time.Time) error {
+	dep, err := backup.NodeDeployment(r.client, instance.GetNamespace(), instance.Spec.Target.Name)
+	if err != nil {
+		return errors.Wrap(err, "get node deployment")
+	}
+	if err = backup.Scale(r.client, dep, 0); err != nil {
+		return err
+	}
+	quiesced, err := backup.Quiesced(r.client, dep)
+	if err != nil {
+		return err
+	}
+	if !quiesced {
+		if now.Sub(instance.Status.LastTransitionTime.Time) > r.Config.Operator.Backup.Timeouts.ReplicaChange.Get() {
+			return errors.New("timed out waiting for the node to scale down")
+		}
+		return nil
+	}
+
+	storage := instance.Spec.Storage
+	store, err := backup.NewStorageClient(r.client, r.Config, instance.GetNamespace(), *storage)
+	if err != nil {
+		return err
+	}
+	deadline := r.Config.Operator.Backup.Timeouts.JobCompletion.Get()
+	transfers := make([]backup.Transfer, 0, len(instance.Status.Volumes))
+	for _, volume := range instance.Status.Volumes {
+		key := storage.ArchiveObject(instance.GetNamespace(), *instance.Spec.Target, instance.Spec.Archive, current.BackupVolumeObject(volume))
+		transfers = append(transfers, backup.Transfer{Volume: volume, URL: store.PresignedURL(http.MethodGet, storage.Bucket, key, deadline)})
+	}
+	job := backup.TransferJob(backup.JobName(instance.GetName()), instance.GetName(), dep, r.Config.Operator.Backup.Image, true, transfers, deadline)
+	if err = backup.DeleteJob(r.client, job.GetNamespace(), job.GetName()); err != nil {
+		return err
+	}
+	if err = r.client.Create(context.TODO(), job, controllerclient.CreateOption{Owner: instance, Scheme: r.Scheme}); err != nil {
+		return errors.Wrap(err, "create restore job")
+	}
+	instance.Status.Phase = current.RestoreRestoring
+	return nil
+}
+
+func (r *baseRestore) restoreVolumes(instance *current.Restore) error {
+	job := &batchv1.Job{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: instance.GetNamespace(), Name: backup.JobName(instance.GetName())}, job); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return errors.New("restore job is gone")
+		}
+		return err
+	}
+	finished, succeeded := backup.JobFinished(job)
+	if !finished {
+		return nil
+	}
+	if !succeeded {
+		return errors.Errorf("restore job %s failed", job.GetName())
+	}
+	instance.Status.Phase = current.RestoreResuming
+	return nil
+}
+
+// resume scales the node back, a restore fails if any earlier phase left its error in the message
+func (r *baseRestore) resume(instance *current.Restore) error {
+	dep, err := backup.NodeDeployment(r.client, instance.GetNamespace(), instance.Spec.Target.Name)
+	if err != nil {
+		return errors.Wrap(err, "get node deployment")
+	}
+	if err = backup.Scale(r.client, dep, instance.Status.Replicas); err != nil {
+		return err
+	}
+	if err = backup.DeleteJob(r.client, instance.GetNamespace(), backup.JobName(instance.GetName())); err != nil {
+		return err
+	}
+	if instance.Status.Message != "" {
+		instance.Status.Phase = current.RestoreFailed
+		return nil
+	}
+	instance.Status.Phase = current.RestoreSucceeded
+	instance.Status.Message = fmt.Sprintf("%s %s restored from archive %s", instance.Spec.Target.Kind, instance.Spec.Target.Name, instance.Spec.Archive)
+	return nil
+}
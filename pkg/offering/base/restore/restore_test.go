@@ -0,0 +1,42 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package restore
+
+import (
+	"errors"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestMissingVolumes(t *testing.T) {
+	dep := &appsv1.Deployment{}
+	dep.Spec.Template.Spec.Volumes = []corev1.Volume{
+		{Name: "fabric-peer-0", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "org1peer1-pvc"}}},
+		{Name: "db-data", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+	}
+	if err := MissingVolumes(dep, []string{"fabric-peer-0"}); err != nil {
+		t.Fatal(err)
+	}
+	// a volume which is no longer backed by a claim can not be restored
+	if err := MissingVolumes(dep, []string{"fabric-peer-0", "db-data"}); !errors.Is(err, errMissingVolumes) {
+		t.Fatalf("expect missing volumes error, get %v", err)
+	}
+}
@@ -43,7 +43,10 @@ func (o *Override) SyncRoleBinding(instance *current.Vote, rb *rbacv1.RoleBindin
 	if err != nil {
 		return err
 	}
-	rb.Subjects = append(rb.Subjects, common.GetDefaultSubject(organization.Spec.Admin, organization.GetUserNamespace(), o.SubjectKind))
+	// voters of declared roles patch votes through the organization's role
+	for _, admin := range organization.GetAdmins() {
+		rb.Subjects = append(rb.Subjects, common.GetDefaultSubject(admin, organization.GetUserNamespace(), o.SubjectKind))
+	}
 	rb.OwnerReferences = []v1.OwnerReference{
 		{
 			Kind:       "Vote",
@@ -27,6 +27,7 @@ import (
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common"
 	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -53,9 +54,10 @@ type Crypto struct {
 	CA         *current.MSP
 }
 
-func BackupCrypto(client k8sclient.Client, scheme *runtime.Scheme, instance v1.Object, labels map[string]string) error {
-	tlsCrypto := GetCrypto("tls", client, instance)
-	ecertCrypto := GetCrypto("ecert", client, instance)
+// BackupCrypto backs up the crypto of a node, the backup holds private keys and is kept in store as the keys are
+func BackupCrypto(client k8sclient.Client, store secretstore.Store, scheme *runtime.Scheme, instance v1.Object, labels map[string]string) error {
+	tlsCrypto := GetCrypto("tls", client, store, instance)
+	ecertCrypto := GetCrypto("ecert", client, store, instance)
 
 	if tlsCrypto == nil && ecertCrypto == nil {
 		// No backup required if crypto doesn't exist/no found
@@ -68,11 +70,12 @@ func BackupCrypto(client k8sclient.Client, scheme *runtime.Scheme, instance v1.O
 		Ecert: ecertCrypto,
 	}
 
-	return backupCrypto(client, scheme, instance, labels, crypto)
+	return backupCrypto(store, instance, labels, crypto)
 }
 
-func BackupCACrypto(client k8sclient.Client, scheme *runtime.Scheme, instance v1.Object, labels map[string]string) error {
-	caCrypto, operationsCrypto, tlsCrypto := GetCACrypto(client, instance)
+// BackupCACrypto backs up the crypto of a CA in store, which also keeps the CA's keys
+func BackupCACrypto(client k8sclient.Client, store secretstore.Store, scheme *runtime.Scheme, instance v1.Object, labels map[string]string) error {
+	caCrypto, operationsCrypto, tlsCrypto := GetCACrypto(store, instance)
 
 	if caCrypto == nil && operationsCrypto == nil && tlsCrypto == nil {
 		log.Info(fmt.Sprintf("No crypto found for %s, not performing backup", instance.GetName()))
@@ -84,11 +87,11 @@ func BackupCACrypto(client k8sclient.Client, scheme *runtime.Scheme, instance v1
 		Operations: operationsCrypto,
 		TLS:        tlsCrypto,
 	}
-	return backupCrypto(client, scheme, instance, labels, crypto)
+	return backupCrypto(store, instance, labels, crypto)
 }
 
-func backupCrypto(client k8sclient.Client, scheme *runtime.Scheme, instance v1.Object, labels map[string]string, crypto *Crypto) error {
-	backupSecret, err := GetBackupSecret(client, instance)
+func backupCrypto(store secretstore.Store, instance v1.Object, labels map[string]string, crypto *Crypto) error {
+	backupSecret, err := store.Get(instance.GetNamespace(), fmt.Sprintf("%s-crypto-backup", instance.GetName()))
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			// Create secret
@@ -107,7 +110,7 @@ func backupCrypto(client k8sclient.Client, scheme *runtime.Scheme, instance v1.O
 				Type: corev1.SecretTypeOpaque,
 			}
 
-			err = CreateBackupSecret(client, scheme, instance, newSecret)
+			err = store.Put(newSecret, instance)
 			if err != nil {
 				return errors.Wrap(err, "failed to create backup secret")
 			}
@@ -123,7 +126,7 @@ func backupCrypto(client k8sclient.Client, scheme *runtime.Scheme, instance v1.O
 	}
 	backupSecret.Data = data
 
-	err = UpdateBackupSecret(client, scheme, instance, backupSecret)
+	err = store.Put(backupSecret, instance)
 	if err != nil {
 		return errors.Wrap(err, "failed to update backup secret")
 	}
@@ -262,7 +265,7 @@ func getUpdatedBackup(data []byte, crypto *current.MSP) (*Backup, error) {
 	return backup, nil
 }
 
-func GetCrypto(prefix common.SecretType, client k8sclient.Client, instance v1.Object) *current.MSP {
+func GetCrypto(prefix common.SecretType, client k8sclient.Client, store secretstore.Store, instance v1.Object) *current.MSP {
 	var cryptoExists bool
 
 	// Doesn't return error if can't get secret/secret not found
@@ -271,7 +274,7 @@ func GetCrypto(prefix common.SecretType, client k8sclient.Client, instance v1.Ob
 		cryptoExists = true
 	}
 
-	keystore, err := getKeystoreEncoded(prefix, client, instance)
+	keystore, err := getKeystoreEncoded(prefix, store, instance)
 	if err == nil && keystore != "" {
 		cryptoExists = true
 	}
@@ -304,8 +307,8 @@ func GetCrypto(prefix common.SecretType, client k8sclient.Client, instance v1.Ob
 	return nil
 }
 
-func GetCACrypto(client k8sclient.Client, instance v1.Object) (*current.MSP, *current.MSP, *current.MSP) {
-	encoded, err := GetCACryptoEncoded(client, instance)
+func GetCACrypto(store secretstore.Store, instance v1.Object) (*current.MSP, *current.MSP, *current.MSP) {
+	encoded, err := GetCACryptoEncoded(store, instance)
 	if err != nil || encoded == nil {
 		return nil, nil, nil
 	}
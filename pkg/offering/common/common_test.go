@@ -37,6 +37,7 @@ import (
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	"github.com/IBM-Blockchain/fabric-operator/controllers/mocks"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/offering/common"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
 )
 
 var _ = Describe("Common", func() {
@@ -132,18 +133,18 @@ var _ = Describe("Common", func() {
 		Context("get crypto", func() {
 			It("returns nil if fails to get secret", func() {
 				mockKubeClient.GetReturns(errors.New("get error"))
-				crypto := common.GetCrypto("tls", mockKubeClient, instance)
+				crypto := common.GetCrypto("tls", mockKubeClient, secretstore.NewKubernetes(mockKubeClient, nil), instance)
 				Expect(crypto).To(BeNil())
 			})
 
 			It("returns nil if no secrets are found", func() {
 				mockKubeClient.GetReturns(k8serrors.NewNotFound(schema.GroupResource{}, "not found"))
-				crypto := common.GetCrypto("tls", mockKubeClient, instance)
+				crypto := common.GetCrypto("tls", mockKubeClient, secretstore.NewKubernetes(mockKubeClient, nil), instance)
 				Expect(crypto).To(BeNil())
 			})
 
 			It("returns tls crypto", func() {
-				crypto := common.GetCrypto("tls", mockKubeClient, instance)
+				crypto := common.GetCrypto("tls", mockKubeClient, secretstore.NewKubernetes(mockKubeClient, nil), instance)
 				Expect(crypto).NotTo(BeNil())
 				Expect(crypto).To(Equal(&current.MSP{
 					SignCerts: encodedtestcert,
@@ -153,7 +154,7 @@ var _ = Describe("Common", func() {
 			})
 
 			It("returns ecert crypto", func() {
-				crypto := common.GetCrypto("ecert", mockKubeClient, instance)
+				crypto := common.GetCrypto("ecert", mockKubeClient, secretstore.NewKubernetes(mockKubeClient, nil), instance)
 				Expect(crypto).NotTo(BeNil())
 				Expect(crypto).To(Equal(&current.MSP{
 					SignCerts: encodedtestcert,
@@ -248,21 +249,21 @@ var _ = Describe("Common", func() {
 		Context("backup crypto", func() {
 			It("returns nil if neither TLS nor ecert crypto is found", func() {
 				mockKubeClient.GetReturns(errors.New("get error"))
-				err := common.BackupCrypto(mockKubeClient, &runtime.Scheme{}, instance, map[string]string{})
+				err := common.BackupCrypto(mockKubeClient, secretstore.NewKubernetes(mockKubeClient, nil), &runtime.Scheme{}, instance, map[string]string{})
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			It("returns error if fails to update backup secret", func() {
-				mockKubeClient.UpdateReturns(errors.New("create or update error"))
-				err := common.BackupCrypto(mockKubeClient, &runtime.Scheme{}, instance, map[string]string{})
+				mockKubeClient.CreateOrUpdateReturns(errors.New("create or update error"))
+				err := common.BackupCrypto(mockKubeClient, secretstore.NewKubernetes(mockKubeClient, nil), &runtime.Scheme{}, instance, map[string]string{})
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("failed to update backup secret: create or update error"))
 			})
 
 			It("updates backup secret if one exists for instance", func() {
-				err := common.BackupCrypto(mockKubeClient, &runtime.Scheme{}, instance, map[string]string{})
+				err := common.BackupCrypto(mockKubeClient, secretstore.NewKubernetes(mockKubeClient, nil), &runtime.Scheme{}, instance, map[string]string{})
 				Expect(err).NotTo(HaveOccurred())
-				Expect(mockKubeClient.UpdateCallCount()).To(Equal(1))
+				Expect(mockKubeClient.CreateOrUpdateCallCount()).To(Equal(1))
 
 				newCrypto := &current.MSP{
 					SignCerts: encodedtestcert,
@@ -301,14 +302,14 @@ var _ = Describe("Common", func() {
 
 			It("returns nil if CA TLS crypto is not found", func() {
 				mockKubeClient.GetReturns(errors.New("get error"))
-				err := common.BackupCACrypto(mockKubeClient, &runtime.Scheme{}, instance, map[string]string{})
+				err := common.BackupCACrypto(mockKubeClient, secretstore.NewKubernetes(mockKubeClient, nil), &runtime.Scheme{}, instance, map[string]string{})
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			It("updates backup secret if one exists for instance", func() {
-				err := common.BackupCACrypto(mockKubeClient, &runtime.Scheme{}, instance, map[string]string{})
+				err := common.BackupCACrypto(mockKubeClient, secretstore.NewKubernetes(mockKubeClient, nil), &runtime.Scheme{}, instance, map[string]string{})
 				Expect(err).NotTo(HaveOccurred())
-				Expect(mockKubeClient.UpdateCallCount()).To(Equal(1))
+				Expect(mockKubeClient.CreateOrUpdateCallCount()).To(Equal(1))
 
 				newCrypto := &current.MSP{
 					SignCerts: encodedtestcert,
@@ -21,7 +21,11 @@ package common
 import (
 	"os"
 
+	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources/clusterrolebinding"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources/container"
+	dep "github.com/IBM-Blockchain/fabric-operator/pkg/manager/resources/deployment"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -126,3 +130,21 @@ func GetDefaultSubject(name, namespace string, kind clusterrolebinding.SubjectKi
 		Namespace: namespace,
 	}
 }
+
+// FetchKeysFromStore replaces the keystore secret volumes, whose keys are kept in vault, by in memory
+// volumes of the same names that an init container fills at pod start
+func FetchKeysFromStore(client k8sclient.Client, config secretstore.Config, deployment *dep.Deployment, namespace string, volumes []secretstore.KeyVolume) {
+	if !config.UsingVault() {
+		return
+	}
+
+	referenced := secretstore.ReferencedVolumes(client, namespace, volumes)
+	if len(referenced) == 0 {
+		return
+	}
+	for _, v := range referenced {
+		deployment.AppendEmptyDirVolumeIfMissing(v.Volume, corev1.StorageMediumMemory)
+	}
+	fetchKeys := secretstore.FetchKeysContainer(config.Vault, referenced)
+	deployment.AddInitContainer(*container.New(&fetchKeys))
+}
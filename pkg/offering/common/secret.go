@@ -26,6 +26,7 @@ import (
 
 	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common"
 	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/secretstore"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,8 +37,8 @@ func GetTLSSignCertEncoded(client k8sclient.Client, instance v1.Object) (string,
 	return getSignCertEncoded("tls", client, instance)
 }
 
-func GetTLSKeystoreEncoded(client k8sclient.Client, instance v1.Object) (string, error) {
-	return getKeystoreEncoded("tls", client, instance)
+func GetTLSKeystoreEncoded(store secretstore.Store, instance v1.Object) (string, error) {
+	return getKeystoreEncoded("tls", store, instance)
 }
 
 func GetTLSCACertEncoded(client k8sclient.Client, instance v1.Object) ([]string, error) {
@@ -48,8 +49,8 @@ func GetEcertSignCertEncoded(client k8sclient.Client, instance v1.Object) (strin
 	return getSignCertEncoded("ecert", client, instance)
 }
 
-func GetEcertKeystoreEncoded(client k8sclient.Client, instance v1.Object) (string, error) {
-	return getKeystoreEncoded("ecert", client, instance)
+func GetEcertKeystoreEncoded(store secretstore.Store, instance v1.Object) (string, error) {
+	return getKeystoreEncoded("ecert", store, instance)
 }
 
 func GetEcertCACertEncoded(client k8sclient.Client, instance v1.Object) ([]string, error) {
@@ -92,15 +93,9 @@ func getSignCertBytes(prefix common.SecretType, client k8sclient.Client, instanc
 	return nil, fmt.Errorf("cannot get %s signcert", prefix)
 }
 
-func getKeystoreBytes(prefix common.SecretType, client k8sclient.Client, instance v1.Object) ([]byte, error) {
+func getKeystoreBytes(prefix common.SecretType, store secretstore.Store, instance v1.Object) ([]byte, error) {
 	secretName := fmt.Sprintf("%s-%s-keystore", prefix, instance.GetName())
-	namespacedName := types.NamespacedName{
-		Name:      secretName,
-		Namespace: instance.GetNamespace(),
-	}
-
-	secret := &corev1.Secret{}
-	err := client.Get(context.TODO(), namespacedName, secret)
+	secret, err := store.Get(instance.GetNamespace(), secretName)
 	if err != nil {
 		return nil, err
 	}
@@ -217,8 +212,8 @@ func getSignCertEncoded(prefix common.SecretType, client k8sclient.Client, insta
 	return cert, nil
 }
 
-func getKeystoreEncoded(prefix common.SecretType, client k8sclient.Client, instance v1.Object) (string, error) {
-	keyBytes, err := getKeystoreBytes(prefix, client, instance)
+func getKeystoreEncoded(prefix common.SecretType, store secretstore.Store, instance v1.Object) (string, error) {
+	keyBytes, err := getKeystoreBytes(prefix, store, instance)
 	if err != nil {
 		return "", err
 	}
@@ -278,15 +273,8 @@ type CACryptoBytes struct {
 	TLSKey         []byte
 }
 
-func GetCACryptoBytes(client k8sclient.Client, instance v1.Object) (*CACryptoBytes, error) {
-	secretName := fmt.Sprintf("%s-ca-crypto", instance.GetName())
-	namespacedName := types.NamespacedName{
-		Name:      secretName,
-		Namespace: instance.GetNamespace(),
-	}
-
-	secret := &corev1.Secret{}
-	err := client.Get(context.TODO(), namespacedName, secret)
+func GetCACryptoBytes(store secretstore.Store, instance v1.Object) (*CACryptoBytes, error) {
+	secret, err := store.Get(instance.GetNamespace(), fmt.Sprintf("%s-ca-crypto", instance.GetName()))
 	if err != nil {
 		return nil, err
 	}
@@ -309,15 +297,8 @@ func GetCACryptoBytes(client k8sclient.Client, instance v1.Object) (*CACryptoByt
 	}, nil
 }
 
-func GetTLSCACryptoBytes(client k8sclient.Client, instance v1.Object) (*CACryptoBytes, error) {
-	secretName := fmt.Sprintf("%s-tlsca-crypto", instance.GetName())
-	namespacedName := types.NamespacedName{
-		Name:      secretName,
-		Namespace: instance.GetNamespace(),
-	}
-
-	secret := &corev1.Secret{}
-	err := client.Get(context.TODO(), namespacedName, secret)
+func GetTLSCACryptoBytes(store secretstore.Store, instance v1.Object) (*CACryptoBytes, error) {
+	secret, err := store.Get(instance.GetNamespace(), fmt.Sprintf("%s-tlsca-crypto", instance.GetName()))
 	if err != nil {
 		return nil, err
 	}
@@ -343,8 +324,8 @@ type CACryptoEncoded struct {
 	TLSKey         string
 }
 
-func GetCACryptoEncoded(client k8sclient.Client, instance v1.Object) (*CACryptoEncoded, error) {
-	bytes, err := GetCACryptoBytes(client, instance)
+func GetCACryptoEncoded(store secretstore.Store, instance v1.Object) (*CACryptoEncoded, error) {
+	bytes, err := GetCACryptoBytes(store, instance)
 	if err != nil {
 		return nil, err
 	}
@@ -360,8 +341,8 @@ func GetCACryptoEncoded(client k8sclient.Client, instance v1.Object) (*CACryptoE
 	return encoded, err
 }
 
-func GetTLSCACryptoEncoded(client k8sclient.Client, instance v1.Object) (*CACryptoEncoded, error) {
-	bytes, err := GetTLSCACryptoBytes(client, instance)
+func GetTLSCACryptoEncoded(store secretstore.Store, instance v1.Object) (*CACryptoEncoded, error) {
+	bytes, err := GetTLSCACryptoBytes(store, instance)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,122 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orgbundle
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/url"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/connector"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Export builds the bundle of organization from its MSP crypto and deployed nodes.
+// All deployed peers are anchor peers, and all deployed consensus nodes are orderer endpoints.
+func Export(c controllerclient.Client, organization *current.Organization) (*Bundle, error) {
+	secret := &corev1.Secret{}
+	err := c.Get(context.TODO(), organization.GetMSPCrypto(), secret)
+	if err != nil {
+		return nil, err
+	}
+	definition := Definition{
+		Name:      organization.GetName(),
+		AdminCert: secret.Data["admin-signcert"],
+		NodeOUs:   secret.Data["ou-config"],
+	}
+	if definition.RootCert, err = base64.StdEncoding.DecodeString(string(secret.Data["org-ca-signcert"])); err != nil {
+		return nil, errors.Wrap(err, "invalid root certificate encoding")
+	}
+	if definition.TLSRootCert, err = base64.StdEncoding.DecodeString(string(secret.Data["org-tlsca-signcert"])); err != nil {
+		return nil, errors.Wrap(err, "invalid tls root certificate encoding")
+	}
+	admin, err := parseSigner(secret.Data["admin-keystore"])
+	if err != nil {
+		return nil, err
+	}
+
+	peers := &current.IBPPeerList{}
+	if err = c.List(context.TODO(), peers, client.InNamespace(organization.GetUserNamespace())); err != nil {
+		return nil, err
+	}
+	for _, peer := range peers.Items {
+		if peer.Status.Type != current.Deployed {
+			continue
+		}
+		address, err := nodeAddress(c, current.NamespacedName{Namespace: peer.GetNamespace(), Name: peer.GetName()})
+		if err != nil {
+			return nil, err
+		}
+		definition.AnchorPeers = append(definition.AnchorPeers, address)
+	}
+
+	orderers := &current.IBPOrdererList{}
+	if err = c.List(context.TODO(), orderers, client.InNamespace(organization.GetUserNamespace())); err != nil {
+		return nil, err
+	}
+	for _, orderer := range orderers.Items {
+		// only cluster nodes serve
+		if orderer.Spec.NodeNumber == nil || orderer.Status.Type != current.Deployed {
+			continue
+		}
+		address, err := nodeAddress(c, current.NamespacedName{Namespace: orderer.GetNamespace(), Name: orderer.GetName()})
+		if err != nil {
+			return nil, err
+		}
+		definition.OrdererEndpoints = append(definition.OrdererEndpoints, address)
+	}
+
+	return New(definition, admin)
+}
+
+// nodeAddress returns the `host:port` of node's api endpoint
+func nodeAddress(c controllerclient.Client, node current.NamespacedName) (string, error) {
+	endpoint, err := connector.GetNodeEndpoint(c, node)
+	if err != nil {
+		return "", errors.Wrapf(err, "get endpoint of %s", node.String())
+	}
+	u, err := url.Parse(endpoint.URL)
+	if err != nil || u.Host == "" {
+		return "", errors.Errorf("invalid endpoint %s of %s", endpoint.URL, node.String())
+	}
+	return u.Host, nil
+}
+
+func parseSigner(keyPEM []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("invalid admin key pem")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse admin key")
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("admin key can not sign")
+	}
+	return signer, nil
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orgbundle
+
+import (
+	"context"
+	"reflect"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// BundleKey is the key of the bundle in configmaps
+	BundleKey = "bundle.json"
+	// ImportLabel marks a configmap in an organization's namespace which holds a bundle to import
+	ImportLabel = current.BundleImportLabel
+	// ImportLabelValue is the value of ImportLabel
+	ImportLabelValue = current.BundleImportLabelValue
+)
+
+// Import validates the bundle and creates or updates its ExternalOrganization.
+// It returns whether the ExternalOrganization is changed.
+func Import(c controllerclient.Client, data []byte) (*current.ExternalOrganization, bool, error) {
+	bundle, err := Parse(data)
+	if err != nil {
+		return nil, false, err
+	}
+	if err = bundle.Validate(); err != nil {
+		return nil, false, err
+	}
+	desired, err := bundle.ExternalOrganization()
+	if err != nil {
+		return nil, false, err
+	}
+
+	existing, err := current.GetExternalOrganization(context.TODO(), c, bundle.Name)
+	if err != nil {
+		return nil, false, err
+	}
+	if existing == nil {
+		organization := &current.Organization{}
+		err = c.Get(context.TODO(), types.NamespacedName{Name: bundle.Name}, organization)
+		if err == nil {
+			return nil, false, errors.Errorf("organization %s is in this cluster", bundle.Name)
+		}
+		if !k8serrors.IsNotFound(err) {
+			return nil, false, err
+		}
+		if err = c.Create(context.TODO(), desired); err != nil {
+			return nil, false, err
+		}
+		return desired, true, nil
+	}
+	// keep the display name given in this cluster
+	desired.Spec.DisplayName = existing.Spec.DisplayName
+	if reflect.DeepEqual(existing.Spec, desired.Spec) {
+		return existing, false, nil
+	}
+	// the operator updates with its own client, so the webhook's check on updates is repeated here
+	if err = desired.VerifyUpdate(existing); err != nil {
+		return nil, false, err
+	}
+	existing.Spec = desired.Spec
+	if err = c.Update(context.TODO(), existing); err != nil {
+		return nil, false, err
+	}
+	return existing, true, nil
+}
@@ -0,0 +1,448 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package orgbundle exports an Organization as a portable bundle and imports the bundles of
+// organizations in other clusters as ExternalOrganizations.
+//
+// A bundle carries the organization's application org group and, for organizations running
+// orderers, its orderer org group, both in the JSON format printed by `configtxgen -printOrg`.
+// The groups hold the MSP definition, anchor peers and orderer endpoints. A join package signed
+// by an organization admin proves the bundle comes from the organization.
+package orgbundle
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"strconv"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/artifact"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/orderer/configtx"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/validator"
+	"github.com/golang/protobuf/proto"
+	fabconfig "github.com/hyperledger/fabric-config/configtx"
+	"github.com/hyperledger/fabric-config/configtx/membership"
+	"github.com/hyperledger/fabric-config/protolator"
+	"github.com/hyperledger/fabric-config/protolator/protoext/ordererext"
+	"github.com/hyperledger/fabric-config/protolator/protoext/peerext"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric/msp"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Bundle is the portable definition of an organization
+type Bundle struct {
+	// Name is the MSP ID of the organization
+	Name string `json:"name"`
+	// Application is the application org group with the MSP and anchor peers
+	Application json.RawMessage `json:"application"`
+	// Orderer is the orderer org group with the MSP and orderer endpoints
+	Orderer json.RawMessage `json:"orderer,omitempty"`
+	// JoinPackage is signed by an admin over the ExternalOrganization materialized from the groups
+	JoinPackage current.SignedArtifact `json:"joinPackage"`
+}
+
+// Definition is what an organization shares with other clusters
+type Definition struct {
+	Name string
+	// RootCert, TLSRootCert and AdminCert are PEM certificates
+	RootCert    []byte
+	TLSRootCert []byte
+	AdminCert   []byte
+	// NodeOUs is the MSP config.yaml, certificate paths are relative to the MSP directory
+	NodeOUs []byte
+	// AnchorPeers and OrdererEndpoints are `host:port` addresses
+	AnchorPeers      []string
+	OrdererEndpoints []string
+}
+
+// New builds the bundle of definition and signs its join package with the admin's key
+func New(definition Definition, admin crypto.Signer) (*Bundle, error) {
+	rootCert, err := parsePEM(definition.RootCert)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid root certificate")
+	}
+	tlsRootCert, err := parsePEM(definition.TLSRootCert)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid tls root certificate")
+	}
+	adminCert, err := parsePEM(definition.AdminCert)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid admin certificate")
+	}
+	nodeOUs, err := parseNodeOUs(definition.NodeOUs, map[string]*x509.Certificate{
+		// the layout of organization msp directories, see ou_config.yaml
+		"cacerts/ca-signcert.pem": rootCert,
+	}, rootCert)
+	if err != nil {
+		return nil, err
+	}
+
+	org := fabconfig.Organization{
+		Name: definition.Name,
+		MSP: fabconfig.MSP{
+			Name:         definition.Name,
+			RootCerts:    []*x509.Certificate{rootCert},
+			Admins:       []*x509.Certificate{adminCert},
+			TLSRootCerts: []*x509.Certificate{tlsRootCert},
+			NodeOUs:      nodeOUs,
+			CryptoConfig: membership.CryptoConfig{
+				SignatureHashFamily:            "SHA2",
+				IdentityIdentifierHashFunction: "SHA256",
+			},
+		},
+		OrdererEndpoints: definition.OrdererEndpoints,
+	}
+	for _, anchor := range definition.AnchorPeers {
+		address, err := parseAddress(anchor)
+		if err != nil {
+			return nil, err
+		}
+		org.AnchorPeers = append(org.AnchorPeers, address)
+	}
+
+	c := newConfigTx()
+	org.Policies = policies(configtx.DefaultOrganization(org.Name))
+	if err = c.Application().SetOrganization(org); err != nil {
+		return nil, err
+	}
+	bundle := &Bundle{Name: org.Name}
+	bundle.Application, err = marshalGroup(&peerext.DynamicApplicationOrgGroup{ConfigGroup: applicationGroup(c.UpdatedConfig(), org.Name)})
+	if err != nil {
+		return nil, err
+	}
+	if len(org.OrdererEndpoints) > 0 {
+		org.Policies = policies(configtx.DefaultOrdererOrganization(org.Name))
+		if err = c.Orderer().SetOrganization(org); err != nil {
+			return nil, err
+		}
+		bundle.Orderer, err = marshalGroup(&ordererext.DynamicOrdererOrgGroup{ConfigGroup: ordererGroup(c.UpdatedConfig(), org.Name)})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	external, err := bundle.ExternalOrganization()
+	if err != nil {
+		return nil, err
+	}
+	bundle.JoinPackage.Signature, err = artifact.Sign(admin, external.JoinPayload())
+	if err != nil {
+		return nil, err
+	}
+	bundle.JoinPackage.Certificate = encodeCert(adminCert)
+	return bundle, nil
+}
+
+// Parse decodes a bundle
+func Parse(data []byte) (*Bundle, error) {
+	bundle := &Bundle{}
+	if err := json.Unmarshal(data, bundle); err != nil {
+		return nil, errors.Wrap(err, "invalid bundle")
+	}
+	return bundle, nil
+}
+
+// Marshal encodes the bundle
+func (b *Bundle) Marshal() ([]byte, error) {
+	return json.MarshalIndent(b, "", "  ")
+}
+
+// Validate checks the certificates of the bundle and its join package
+func (b *Bundle) Validate() error {
+	external, err := b.ExternalOrganization()
+	if err != nil {
+		return err
+	}
+	msp := external.Spec.MSP
+	if len(msp.RootCerts) == 0 || len(msp.TLSRootCerts) == 0 || len(msp.Admins) == 0 {
+		return errors.New("bundle must have root, tls root and admin certificates")
+	}
+	for kind, certs := range map[string][]string{
+		"root":             msp.RootCerts,
+		"intermediate":     msp.IntermediateCerts,
+		"tls root":         msp.TLSRootCerts,
+		"tls intermediate": msp.TLSIntermediateCerts,
+		"admin":            msp.Admins,
+		"join package":     {b.JoinPackage.Certificate},
+	} {
+		for _, cert := range certs {
+			certPem, err := base64.StdEncoding.DecodeString(cert)
+			if err != nil {
+				return errors.Wrapf(err, "invalid %s certificate encoding", kind)
+			}
+			if err = validator.ValidateCert(certPem); err != nil {
+				return errors.Wrapf(err, "invalid %s certificate", kind)
+			}
+		}
+	}
+	return external.VerifyJoinPackage()
+}
+
+// ExternalOrganization materializes the bundle as an ExternalOrganization.
+// Peers are the anchor peers and orderers are the orderer endpoints, both named by their hosts
+// and using the first TLS root certificate of the MSP.
+func (b *Bundle) ExternalOrganization() (*current.ExternalOrganization, error) {
+	if b.Name == "" {
+		return nil, errors.New("bundle has no name")
+	}
+	if len(b.Application) == 0 {
+		return nil, errors.New("bundle has no application org group")
+	}
+	c := newConfigTx()
+	config := c.UpdatedConfig()
+	group := &cb.ConfigGroup{}
+	if err := protolator.DeepUnmarshalJSON(bytes.NewReader(b.Application), &peerext.DynamicApplicationOrgGroup{ConfigGroup: group}); err != nil {
+		return nil, errors.Wrap(err, "invalid application org group")
+	}
+	config.ChannelGroup.Groups[fabconfig.ApplicationGroupKey].Groups[b.Name] = group
+	if len(b.Orderer) > 0 {
+		group := &cb.ConfigGroup{}
+		if err := protolator.DeepUnmarshalJSON(bytes.NewReader(b.Orderer), &ordererext.DynamicOrdererOrgGroup{ConfigGroup: group}); err != nil {
+			return nil, errors.Wrap(err, "invalid orderer org group")
+		}
+		config.ChannelGroup.Groups[fabconfig.OrdererGroupKey].Groups[b.Name] = group
+	}
+
+	application, err := c.Application().Organization(b.Name).Configuration()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid application org group")
+	}
+	if application.MSP.Name != b.Name {
+		return nil, errors.Errorf("msp %s does not match bundle %s", application.MSP.Name, b.Name)
+	}
+
+	external := &current.ExternalOrganization{
+		ObjectMeta: metav1.ObjectMeta{Name: b.Name},
+		Spec: current.ExternalOrganizationSpec{
+			MSP: current.ExternalMSP{
+				RootCerts:            encodeCerts(application.MSP.RootCerts),
+				IntermediateCerts:    encodeCerts(application.MSP.IntermediateCerts),
+				TLSRootCerts:         encodeCerts(application.MSP.TLSRootCerts),
+				TLSIntermediateCerts: encodeCerts(application.MSP.TLSIntermediateCerts),
+				Admins:               encodeCerts(application.MSP.Admins),
+			},
+			JoinPackage: b.JoinPackage,
+		},
+	}
+	external.Spec.MSP.NodeOUs, err = nodeOUsConfig(application.MSP)
+	if err != nil {
+		return nil, err
+	}
+	for _, anchor := range application.AnchorPeers {
+		external.Spec.Peers = append(external.Spec.Peers, externalNode(anchor.Host, anchor.Port))
+	}
+	if len(b.Orderer) > 0 {
+		orderer, err := c.Orderer().Organization(b.Name).Configuration()
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid orderer org group")
+		}
+		for _, endpoint := range orderer.OrdererEndpoints {
+			address, err := parseAddress(endpoint)
+			if err != nil {
+				return nil, err
+			}
+			external.Spec.Orderers = append(external.Spec.Orderers, externalNode(address.Host, address.Port))
+		}
+	}
+	return external, nil
+}
+
+func externalNode(host string, port int) current.ExternalNode {
+	return current.ExternalNode{
+		Name: host,
+		URL:  fmt.Sprintf("grpcs://%s", net.JoinHostPort(host, strconv.Itoa(port))),
+	}
+}
+
+// newConfigTx returns a channel config with empty application and orderer groups,
+// which hosts standalone org groups for fabric-config
+func newConfigTx() fabconfig.ConfigTx {
+	c := fabconfig.New(&cb.Config{})
+	// the updated config is a clone which drops empty maps, so fill it in place
+	c.UpdatedConfig().ChannelGroup = newConfigGroup()
+	c.UpdatedConfig().ChannelGroup.Groups[fabconfig.ApplicationGroupKey] = newConfigGroup()
+	c.UpdatedConfig().ChannelGroup.Groups[fabconfig.OrdererGroupKey] = newConfigGroup()
+	return c
+}
+
+func newConfigGroup() *cb.ConfigGroup {
+	return &cb.ConfigGroup{
+		Groups:   map[string]*cb.ConfigGroup{},
+		Values:   map[string]*cb.ConfigValue{},
+		Policies: map[string]*cb.ConfigPolicy{},
+	}
+}
+
+func applicationGroup(config *cb.Config, org string) *cb.ConfigGroup {
+	return config.ChannelGroup.Groups[fabconfig.ApplicationGroupKey].Groups[org]
+}
+
+func ordererGroup(config *cb.Config, org string) *cb.ConfigGroup {
+	return config.ChannelGroup.Groups[fabconfig.OrdererGroupKey].Groups[org]
+}
+
+func marshalGroup(group proto.Message) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	if err := protolator.DeepMarshalJSON(&buf, group); err != nil {
+		return nil, errors.Wrap(err, "failed to marshal org group")
+	}
+	return buf.Bytes(), nil
+}
+
+// policies converts the default policies of configtxgen organizations
+func policies(org *configtx.Organization) map[string]fabconfig.Policy {
+	result := make(map[string]fabconfig.Policy, len(org.Policies))
+	for name, p := range org.Policies {
+		result[name] = fabconfig.Policy{Type: p.Type, Rule: p.Rule}
+	}
+	return result
+}
+
+// parseNodeOUs reads the MSP config.yaml. An OU without certificate is bound to the root CA.
+func parseNodeOUs(config []byte, files map[string]*x509.Certificate, root *x509.Certificate) (membership.NodeOUs, error) {
+	configuration := &msp.Configuration{}
+	if err := yaml.Unmarshal(config, configuration); err != nil {
+		return membership.NodeOUs{}, errors.Wrap(err, "invalid node ou config")
+	}
+	if configuration.NodeOUs == nil {
+		return membership.NodeOUs{}, nil
+	}
+	identifier := func(ou *msp.OrganizationalUnitIdentifiersConfiguration) (membership.OUIdentifier, error) {
+		if ou == nil {
+			return membership.OUIdentifier{Certificate: root}, nil
+		}
+		cert := root
+		if ou.Certificate != "" {
+			var ok bool
+			if cert, ok = files[ou.Certificate]; !ok {
+				return membership.OUIdentifier{}, errors.Errorf("unknown certificate %s in node ou config", ou.Certificate)
+			}
+		}
+		return membership.OUIdentifier{Certificate: cert, OrganizationalUnitIdentifier: ou.OrganizationalUnitIdentifier}, nil
+	}
+	var err error
+	nodeOUs := membership.NodeOUs{Enable: configuration.NodeOUs.Enable}
+	if nodeOUs.ClientOUIdentifier, err = identifier(configuration.NodeOUs.ClientOUIdentifier); err != nil {
+		return nodeOUs, err
+	}
+	if nodeOUs.PeerOUIdentifier, err = identifier(configuration.NodeOUs.PeerOUIdentifier); err != nil {
+		return nodeOUs, err
+	}
+	if nodeOUs.AdminOUIdentifier, err = identifier(configuration.NodeOUs.AdminOUIdentifier); err != nil {
+		return nodeOUs, err
+	}
+	if nodeOUs.OrdererOUIdentifier, err = identifier(configuration.NodeOUs.OrdererOUIdentifier); err != nil {
+		return nodeOUs, err
+	}
+	return nodeOUs, nil
+}
+
+// nodeOUsConfig writes the MSP config.yaml with certificate paths in the layout of
+// ExternalOrganization msp directories, see GetExternalApplicationOrganization
+func nodeOUsConfig(m fabconfig.MSP) (string, error) {
+	if m.NodeOUs == (membership.NodeOUs{}) {
+		return "", nil
+	}
+	path := func(cert *x509.Certificate) (string, error) {
+		if cert == nil {
+			return "", nil
+		}
+		for i, root := range m.RootCerts {
+			if root.Equal(cert) {
+				return fmt.Sprintf("cacerts/cert-%d.pem", i), nil
+			}
+		}
+		for i, intermediate := range m.IntermediateCerts {
+			if intermediate.Equal(cert) {
+				return fmt.Sprintf("intermediatecerts/cert-%d.pem", i), nil
+			}
+		}
+		return "", errors.New("node ou certificate is not a root or intermediate certificate of the msp")
+	}
+	identifier := func(ou membership.OUIdentifier) (*msp.OrganizationalUnitIdentifiersConfiguration, error) {
+		certPath, err := path(ou.Certificate)
+		if err != nil {
+			return nil, err
+		}
+		return &msp.OrganizationalUnitIdentifiersConfiguration{Certificate: certPath, OrganizationalUnitIdentifier: ou.OrganizationalUnitIdentifier}, nil
+	}
+	var err error
+	nodeOUs := &msp.NodeOUs{Enable: m.NodeOUs.Enable}
+	if nodeOUs.ClientOUIdentifier, err = identifier(m.NodeOUs.ClientOUIdentifier); err != nil {
+		return "", err
+	}
+	if nodeOUs.PeerOUIdentifier, err = identifier(m.NodeOUs.PeerOUIdentifier); err != nil {
+		return "", err
+	}
+	if nodeOUs.AdminOUIdentifier, err = identifier(m.NodeOUs.AdminOUIdentifier); err != nil {
+		return "", err
+	}
+	if nodeOUs.OrdererOUIdentifier, err = identifier(m.NodeOUs.OrdererOUIdentifier); err != nil {
+		return "", err
+	}
+	config, err := yaml.Marshal(&msp.Configuration{NodeOUs: nodeOUs})
+	if err != nil {
+		return "", err
+	}
+	return string(config), nil
+}
+
+func parsePEM(certPEM []byte) (*x509.Certificate, error) {
+	if err := validator.ValidateCert(certPEM); err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(certPEM)
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func encodeCert(cert *x509.Certificate) string {
+	return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
+
+func encodeCerts(certs []*x509.Certificate) []string {
+	if len(certs) == 0 {
+		return nil
+	}
+	encoded := make([]string, len(certs))
+	for i, cert := range certs {
+		encoded[i] = encodeCert(cert)
+	}
+	return encoded
+}
+
+// parseAddress returns the host and port of an endpoint like host:port
+func parseAddress(endpoint string) (fabconfig.Address, error) {
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return fabconfig.Address{}, errors.Wrapf(err, "invalid endpoint %s", endpoint)
+	}
+	portNum, err := strconv.Atoi(port)
+	if err != nil {
+		return fabconfig.Address{}, errors.Wrapf(err, "invalid port in endpoint %s", endpoint)
+	}
+	return fabconfig.Address{Host: host, Port: portNum}, nil
+}
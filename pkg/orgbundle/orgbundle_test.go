@@ -0,0 +1,190 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package orgbundle_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	controllermocks "github.com/IBM-Blockchain/fabric-operator/controllers/mocks"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/orgbundle"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util/testcert"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const ouConfig = `NodeOUs:
+  Enable: true
+  ClientOUIdentifier:
+    Certificate: cacerts/ca-signcert.pem
+    OrganizationalUnitIdentifier: client
+  PeerOUIdentifier:
+    Certificate: cacerts/ca-signcert.pem
+    OrganizationalUnitIdentifier: peer
+  AdminOUIdentifier:
+    Certificate: cacerts/ca-signcert.pem
+    OrganizationalUnitIdentifier: admin
+  OrdererOUIdentifier:
+    Certificate: cacerts/ca-signcert.pem
+    OrganizationalUnitIdentifier: orderer
+`
+
+func newBundle(t *testing.T) (*orgbundle.Bundle, *testcert.Identity) {
+	ca := testcert.New(t, "ca", nil)
+	tlsca := testcert.New(t, "tlsca", nil)
+	admin := testcert.New(t, "admin", ca)
+	bundle, err := orgbundle.New(orgbundle.Definition{
+		Name:             "org1",
+		RootCert:         ca.PEM,
+		TLSRootCert:      tlsca.PEM,
+		AdminCert:        admin.PEM,
+		NodeOUs:          []byte(ouConfig),
+		AnchorPeers:      []string{"org1-peer1.example.com:443"},
+		OrdererEndpoints: []string{"org1-orderer1.example.com:443"},
+	}, admin.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bundle, admin
+}
+
+func TestExportImport(t *testing.T) {
+	bundle, admin := newBundle(t)
+	data, err := bundle.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the org groups are printed like configtxgen -printOrg
+	var application struct {
+		Values map[string]struct {
+			Value map[string]interface{} `json:"value"`
+		} `json:"values"`
+		Policies map[string]interface{} `json:"policies"`
+	}
+	if err = json.Unmarshal(bundle.Application, &application); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"MSP", "AnchorPeers"} {
+		if _, ok := application.Values[key]; !ok {
+			t.Fatalf("expect value %s in application org group", key)
+		}
+	}
+	for _, key := range []string{"Admins", "Readers", "Writers", "Endorsement"} {
+		if _, ok := application.Policies[key]; !ok {
+			t.Fatalf("expect policy %s in application org group", key)
+		}
+	}
+	if !strings.Contains(string(bundle.Orderer), "org1-orderer1.example.com:443") {
+		t.Fatalf("expect orderer endpoints in orderer org group, got %s", bundle.Orderer)
+	}
+
+	parsed, err := orgbundle.Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = parsed.Validate(); err != nil {
+		t.Fatalf("expect valid bundle, got %v", err)
+	}
+	external, err := parsed.ExternalOrganization()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if external.GetName() != "org1" {
+		t.Fatalf("expect org1, got %s", external.GetName())
+	}
+	if len(external.Spec.MSP.Admins) != 1 || external.Spec.JoinPackage.Certificate != external.Spec.MSP.Admins[0] {
+		t.Fatalf("expect join package signed by the admin %s", admin.Cert.Subject.CommonName)
+	}
+	if !strings.Contains(external.Spec.MSP.NodeOUs, "cacerts/cert-0.pem") {
+		t.Fatalf("expect node ous bound to the first root cert, got %s", external.Spec.MSP.NodeOUs)
+	}
+	if len(external.Spec.Peers) != 1 || external.Spec.Peers[0].URL != "grpcs://org1-peer1.example.com:443" {
+		t.Fatalf("expect anchor peer, got %+v", external.Spec.Peers)
+	}
+	if len(external.Spec.Orderers) != 1 || external.Spec.Orderers[0].Name != "org1-orderer1.example.com" {
+		t.Fatalf("expect orderer, got %+v", external.Spec.Orderers)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	bundle, _ := newBundle(t)
+	other, _ := newBundle(t)
+
+	tampered := *bundle
+	tampered.Orderer = nil
+	if err := tampered.Validate(); err == nil {
+		t.Fatal("expect tampered bundle rejected")
+	}
+
+	forged := *bundle
+	forged.JoinPackage = other.JoinPackage
+	if err := forged.Validate(); err == nil {
+		t.Fatal("expect join package of another organization rejected")
+	}
+
+	renamed := *bundle
+	renamed.Name = "org2"
+	if err := renamed.Validate(); err == nil {
+		t.Fatal("expect bundle renamed from its msp rejected")
+	}
+
+	if _, err := orgbundle.Parse([]byte("{")); err == nil {
+		t.Fatal("expect invalid json rejected")
+	}
+}
+
+func TestImportExisting(t *testing.T) {
+	bundle, _ := newBundle(t)
+	existing, err := bundle.ExternalOrganization()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &controllermocks.Client{}
+	c.GetStub = func(ctx context.Context, key types.NamespacedName, obj client.Object) error {
+		if o, ok := obj.(*current.ExternalOrganization); ok {
+			existing.DeepCopyInto(o)
+		}
+		return nil
+	}
+
+	data, err := bundle.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, changed, err := orgbundle.Import(c, data); err != nil || changed {
+		t.Fatalf("expect the same bundle imported unchanged, got %v %v", changed, err)
+	}
+
+	// a bundle with the same name but another msp is valid by itself
+	takeover, _ := newBundle(t)
+	data, err = takeover.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = orgbundle.Import(c, data); err == nil {
+		t.Fatal("expect bundle replacing the msp without existing admins rejected")
+	}
+	if c.UpdateCallCount() != 0 {
+		t.Fatal("expect external organization not updated")
+	}
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package provenance signs and verifies the provenance of chaincode images.
+// Verification is offline: signatures are checked against trusted public keys and
+// image digests are resolved by a Registry, which may be a local stand-in of the real registry.
+package provenance
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Statement is what a build signs. The payload is its JSON encoding, so a pipeline can sign it
+// with `cosign sign-blob` and publish the base64 signature as the SIGNATURE result.
+type Statement struct {
+	Source     string `json:"source"`
+	Commit     string `json:"commit,omitempty"`
+	Image      string `json:"image"`
+	Digest     string `json:"digest"`
+	SBOMDigest string `json:"sbomDigest,omitempty"`
+}
+
+// Payload returns the signed bytes of statement, stable as its fields are encoded in declaration order
+func (s Statement) Payload() []byte {
+	b, _ := json.Marshal(s)
+	return b
+}
+
+// Sign returns the base64 encoded ASN.1 ECDSA signature of the statement payload
+func Sign(signer crypto.Signer, s Statement) (string, error) {
+	digest := sha256.Sum256(s.Payload())
+	sig, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign statement")
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// ParsePublicKey parses a PEM encoded ECDSA public key
+func ParsePublicKey(keyPEM []byte) (*ecdsa.PublicKey, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("invalid public key pem")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse public key")
+	}
+	pub, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("public key is not ecdsa")
+	}
+	return pub, nil
+}
+
+// Verify checks the signature of statement was made by one of the trusted keys
+func Verify(trustedKeys []string, s Statement, signature string) error {
+	if signature == "" {
+		return errors.New("build is not signed")
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return errors.Wrap(err, "invalid signature encoding")
+	}
+	digest := sha256.Sum256(s.Payload())
+	for _, k := range trustedKeys {
+		pub, err := ParsePublicKey([]byte(k))
+		if err != nil {
+			return err
+		}
+		if ecdsa.VerifyASN1(pub, digest[:], sig) {
+			return nil
+		}
+	}
+	return errors.New("signature is not made by a trusted key")
+}
+
+// Registry resolves the digest of an image
+type Registry interface {
+	Digest(image string) (string, error)
+}
+
+// StaticRegistry is a registry stand-in mapping images to digests
+type StaticRegistry map[string]string
+
+func (r StaticRegistry) Digest(image string) (string, error) {
+	digest, ok := r[image]
+	if !ok {
+		return "", fmt.Errorf("image %s not found", image)
+	}
+	return digest, nil
+}
+
+// LayoutRegistry resolves images from the index.json of an OCI image layout directory,
+// matching the org.opencontainers.image.ref.name annotation of each manifest.
+type LayoutRegistry struct {
+	Dir string
+}
+
+const refNameAnnotation = "org.opencontainers.image.ref.name"
+
+type layoutIndex struct {
+	Manifests []struct {
+		Digest      string            `json:"digest"`
+		Annotations map[string]string `json:"annotations,omitempty"`
+	} `json:"manifests"`
+}
+
+func (r LayoutRegistry) Digest(image string) (string, error) {
+	b, err := os.ReadFile(filepath.Join(r.Dir, "index.json"))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read oci layout index")
+	}
+	index := layoutIndex{}
+	if err = json.Unmarshal(b, &index); err != nil {
+		return "", errors.Wrap(err, "invalid oci layout index")
+	}
+	for _, m := range index.Manifests {
+		ref := m.Annotations[refNameAnnotation]
+		if ref == "" {
+			continue
+		}
+		// a ref name is either the full reference or only the tag
+		if ref == image || strings.HasSuffix(image, ":"+ref) {
+			return m.Digest, nil
+		}
+	}
+	return "", fmt.Errorf("image %s not found in %s", image, r.Dir)
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provenance_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/provenance"
+	pipelinev1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+)
+
+const (
+	image  = "registry.local/chaincode/basic:v1"
+	digest = "sha256:1111"
+)
+
+func newKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestSignVerify(t *testing.T) {
+	key, pub := newKey(t)
+	_, other := newKey(t)
+	s := provenance.Statement{Source: "https://example.com/basic.git", Commit: "abc", Image: image, Digest: digest}
+	sig, err := provenance.Sign(key, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = provenance.Verify([]string{other, pub}, s, sig); err != nil {
+		t.Errorf("expect verified by a trusted key: %s", err)
+	}
+	if err = provenance.Verify([]string{other}, s, sig); err == nil {
+		t.Error("expect error with untrusted key")
+	}
+	s.Digest = "sha256:2222"
+	if err = provenance.Verify([]string{pub}, s, sig); err == nil {
+		t.Error("expect error when statement changed")
+	}
+	if err = provenance.Verify([]string{pub}, s, ""); err == nil {
+		t.Error("expect error without signature")
+	}
+}
+
+func TestLayoutRegistry(t *testing.T) {
+	dir := t.TempDir()
+	index := `{"schemaVersion":2,"manifests":[{"mediaType":"application/vnd.oci.image.manifest.v1+json","digest":"sha256:1111","size":1,"annotations":{"org.opencontainers.image.ref.name":"registry.local/chaincode/basic:v1"}},{"digest":"sha256:3333","annotations":{"org.opencontainers.image.ref.name":"v2"}}]}`
+	if err := os.WriteFile(filepath.Join(dir, "index.json"), []byte(index), 0600); err != nil {
+		t.Fatal(err)
+	}
+	r := provenance.LayoutRegistry{Dir: dir}
+	if d, err := r.Digest(image); err != nil || d != digest {
+		t.Errorf("unexpected digest %s, %v", d, err)
+	}
+	if d, err := r.Digest("registry.local/chaincode/basic:v2"); err != nil || d != "sha256:3333" {
+		t.Errorf("unexpected digest of tag %s, %v", d, err)
+	}
+	if _, err := r.Digest("registry.local/chaincode/basic:v3"); err == nil {
+		t.Error("expect error for missing image")
+	}
+}
+
+func TestVerifyChaincodeBuild(t *testing.T) {
+	key, pub := newKey(t)
+	ccb := &current.ChaincodeBuild{}
+	ccb.Name = "basic"
+	ccb.Spec.PipelineRunSpec.Git = &current.Git{URL: "https://example.com/basic.git", Reference: "main"}
+	result := func(name, value string) pipelinev1beta1.PipelineRunResult {
+		return pipelinev1beta1.PipelineRunResult{Name: name, Value: value}
+	}
+	ccb.Status.PipelineRunResults = []pipelinev1beta1.PipelineRunResult{
+		result(current.IMAGE_URL, image),
+		result(current.IMAGE_DIGEST, digest),
+		result(current.SOURCE_COMMIT, "abc"),
+		result(current.SBOM_FORMAT, "spdx-json"),
+		result(current.SBOM_DIGEST, "sha256:5555"),
+	}
+	ccb.RecordProvenance()
+	p := ccb.Status.Provenance
+	if p.Source != "https://example.com/basic.git@main" || p.Commit != "abc" || p.SBOM.Digest != "sha256:5555" {
+		t.Fatalf("unexpected provenance %+v", p)
+	}
+
+	policy := &current.ProvenancePolicy{TrustedKeys: []string{pub}, Sources: []string{"https://example.com/"}, RequireSBOM: true}
+	if err := policy.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ccb.VerifyProvenance(policy, nil); err == nil {
+		t.Error("expect error for unsigned build")
+	}
+
+	sig, err := provenance.Sign(key, p.Statement())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ccb.Status.PipelineRunResults = append(ccb.Status.PipelineRunResults, result(current.SIGNATURE, sig))
+	ccb.RecordProvenance()
+	if err = ccb.VerifyProvenance(policy, provenance.StaticRegistry{image: digest}); err != nil {
+		t.Errorf("expect verified: %s", err)
+	}
+	if err = ccb.VerifyProvenance(policy, provenance.StaticRegistry{image: "sha256:9999"}); err == nil {
+		t.Error("expect error when registry digest differs")
+	}
+	policy.Sources = []string{"https://github.com/"}
+	if err = ccb.VerifyProvenance(policy, nil); err == nil {
+		t.Error("expect error for untrusted source")
+	}
+}
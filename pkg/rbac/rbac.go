@@ -32,16 +32,41 @@ type RoleType string
 const (
 	Admin  RoleType = "admin"
 	Client RoleType = "client"
+
+	// Predefined roles which organizations bind to users by `Organization.Spec.Roles`
+	Auditor           RoleType = "auditor"
+	ChaincodeOperator RoleType = "chaincode-operator"
+	Voter             RoleType = "voter"
 )
 
 func (rt RoleType) String() string {
 	return string(rt)
 }
 
+// Suffix of the role's Role/RoleBinding/ClusterRole/ClusterRoleBinding names
+func (rt RoleType) Suffix() string {
+	switch rt {
+	case Admin:
+		return AdminSuffix
+	case Client:
+		return ClientSuffix
+	}
+	return "blockchain:" + string(rt)
+}
+
+// Roles returns the role types users are labeled with in organizations,
+// other roles are labeled as the one of their CA identity type
 func Roles() []string {
 	return []string{Admin.String(), Client.String()}
 }
 
+const (
+	// OrganizationLabel is set on the rbac resources of organization's declared roles
+	OrganizationLabel = "bestchains.rbac.organization"
+	// RoleLabel is set on the rbac resources of declared roles with the role's name
+	RoleLabel = "bestchains.rbac.role"
+)
+
 const (
 	// AdminSuffix used to keep same format with pkg/manager's rolo/clusterrole reconcile
 	AdminSuffix = "blockchain:admin"
@@ -53,18 +78,12 @@ const (
 
 // GetRole returns namespaced Role info by instance(organziation) and role type
 func GetRole(instance types.NamespacedName, role RoleType) types.NamespacedName {
-	if role == Admin {
-		return types.NamespacedName{Name: fmt.Sprintf("%s-%s-role", instance.Name, AdminSuffix), Namespace: instance.Namespace}
-	}
-	return types.NamespacedName{Name: fmt.Sprintf("%s-%s-role", instance.Name, ClientSuffix), Namespace: instance.Namespace}
+	return types.NamespacedName{Name: fmt.Sprintf("%s-%s-role", instance.Name, role.Suffix()), Namespace: instance.Namespace}
 }
 
 // GetRoleBinding returns namespaced RoleBinding info by instance(organziation) and role type
 func GetRoleBinding(instance types.NamespacedName, role RoleType) types.NamespacedName {
-	if role == Admin {
-		return types.NamespacedName{Name: fmt.Sprintf("%s-%s-rolebinding", instance.Name, AdminSuffix), Namespace: instance.Namespace}
-	}
-	return types.NamespacedName{Name: fmt.Sprintf("%s-%s-rolebinding", instance.Name, ClientSuffix), Namespace: instance.Namespace}
+	return types.NamespacedName{Name: fmt.Sprintf("%s-%s-rolebinding", instance.Name, role.Suffix()), Namespace: instance.Namespace}
 }
 
 // RoleRef build a rbacv1.RoleRef by instance(organziation) and role type
@@ -80,18 +99,12 @@ func RoleRef(instance types.NamespacedName, role RoleType) rbacv1.RoleRef {
 
 // GetClusterRole returns ClusterRole info by instance(organziation) and role type
 func GetClusterRole(instance types.NamespacedName, role RoleType) types.NamespacedName {
-	if role == Admin {
-		return types.NamespacedName{Name: fmt.Sprintf("%s-%s-clusterrole", instance.Name, AdminSuffix)}
-	}
-	return types.NamespacedName{Name: fmt.Sprintf("%s-%s-clusterrole", instance.Name, ClientSuffix)}
+	return types.NamespacedName{Name: fmt.Sprintf("%s-%s-clusterrole", instance.Name, role.Suffix())}
 }
 
 // GetClusterRoleBinding returns ClusterRoleBinding info by instance(organziation) and role type
 func GetClusterRoleBinding(instance types.NamespacedName, role RoleType) types.NamespacedName {
-	if role == Admin {
-		return types.NamespacedName{Name: fmt.Sprintf("%s-%s-clusterrolebinding", instance.Name, AdminSuffix)}
-	}
-	return types.NamespacedName{Name: fmt.Sprintf("%s-%s-clusterrolebinding", instance.Name, ClientSuffix)}
+	return types.NamespacedName{Name: fmt.Sprintf("%s-%s-clusterrolebinding", instance.Name, role.Suffix())}
 }
 
 // ClusterRoleRef build a rbacv1.RoleRef by instance(organziation) and role type
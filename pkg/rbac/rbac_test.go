@@ -68,6 +68,10 @@ var _ = Describe("RBAC default settings", func() {
 			clusterRoleBinding = GetClusterRoleBinding(instance, Client)
 			Expect(clusterRoleBinding.Name).To(Equal("org1-blockchain:client-clusterrolebinding"))
 		})
+		It("Declared role settings", func() {
+			Expect(GetRole(instance, Voter).Name).To(Equal("org1-blockchain:voter-role"))
+			Expect(GetClusterRoleBinding(instance, RoleType("operator")).Name).To(Equal("org1-blockchain:operator-clusterrolebinding"))
+		})
 		It("ClusterRoleRef", func() {
 			crf := ClusterRoleRef(instance, Admin)
 			Expect(crf.Name).To(Equal(GetClusterRole(instance, Admin).Name))
@@ -0,0 +1,164 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rbac
+
+import (
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// RoleDefinition declares what the users bound to a role are allowed to do in an organization
+type RoleDefinition struct {
+	Type RoleType
+	// Rules granted in organization's namespace.
+	// Rules of Admin and Client come from the organization definitions instead
+	Rules []rbacv1.PolicyRule
+	// ClusterVerbs granted on the cluster scope resources which organization takes part in
+	ClusterVerbs map[Resource][]Verb
+	// InitiatorVerbs replace ClusterVerbs on the resources initiated by organization
+	InitiatorVerbs map[Resource][]Verb
+}
+
+// Verbs returns the verbs granted on a resource of kind
+func (def RoleDefinition) Verbs(kind Resource, initiator bool) []Verb {
+	if verbs, ok := def.InitiatorVerbs[kind]; ok && initiator {
+		return verbs
+	}
+	return def.ClusterVerbs[kind]
+}
+
+// SyncedResources are the cluster scope resources whose rules are synced to organizations' clusterroles
+var SyncedResources = []Resource{Federation, Proposal, Network, Channel}
+
+var readVerbs = []Verb{Get, List, Watch}
+
+var predefinedRoles = map[RoleType]RoleDefinition{
+	Admin: {
+		Type: Admin,
+		ClusterVerbs: map[Resource][]Verb{
+			Federation: {Get, Delete},
+			Proposal:   {Get},
+			Network:    {Get, Delete},
+			Channel:    {Get, Update, Patch},
+		},
+		// the initiator records the signed votes of external organizations in the proposal
+		InitiatorVerbs: map[Resource][]Verb{
+			Proposal: {Get, Update, Patch},
+		},
+	},
+	Client: {
+		Type: Client,
+	},
+	// Auditor reads everything but secrets and configmaps, connection profiles in configmaps
+	// carry the organization admin's private key
+	Auditor: {
+		Type: Auditor,
+		Rules: []rbacv1.PolicyRule{
+			namespacedRule("", []string{"pods", "pods/log", "events"}, readVerbs...),
+			namespacedRule(GroupVersion.Group, []string{
+				"ibppeers", "ibppeers/status",
+				"ibporderers", "ibporderers/status",
+				"ibpcas", "ibpcas/status",
+				"votes", "votes/status",
+				"backups", "backups/status",
+				"restores", "restores/status",
+				"identities", "identities/status",
+			}, readVerbs...),
+		},
+		ClusterVerbs: map[Resource][]Verb{
+			Federation: {Get},
+			Proposal:   {Get},
+			Network:    {Get},
+			Channel:    {Get},
+		},
+	},
+	// ChaincodeOperator builds and deploys chaincodes on the organization's peers.
+	// ChaincodeBuilds and Chaincodes can be created by all blockchain users
+	ChaincodeOperator: {
+		Type: ChaincodeOperator,
+		Rules: []rbacv1.PolicyRule{
+			namespacedRule("", []string{"pods", "pods/log"}, readVerbs...),
+			namespacedRule(GroupVersion.Group, []string{"ibppeers", "ibppeers/status"}, readVerbs...),
+		},
+		ClusterVerbs: map[Resource][]Verb{
+			Network: {Get},
+			Channel: {Get},
+		},
+	},
+	// Voter decides on the organization's votes
+	Voter: {
+		Type: Voter,
+		Rules: []rbacv1.PolicyRule{
+			namespacedRule(GroupVersion.Group, []string{"votes"}, Get, List, Watch, Patch),
+			namespacedRule(GroupVersion.Group, []string{"votes/status"}, Get),
+		},
+		ClusterVerbs: map[Resource][]Verb{
+			Proposal: {Get},
+		},
+	},
+}
+
+func namespacedRule(group string, resources []string, verbs ...Verb) rbacv1.PolicyRule {
+	verbsStr := make([]string, len(verbs))
+	for index, verb := range verbs {
+		verbsStr[index] = verb.String()
+	}
+	return rbacv1.PolicyRule{
+		APIGroups: []string{group},
+		Resources: resources,
+		Verbs:     verbsStr,
+	}
+}
+
+// IsPredefined checks whether role is defined by the operator
+func IsPredefined(role RoleType) bool {
+	_, ok := predefinedRoles[role]
+	return ok
+}
+
+// DefinitionOf resolves a role declared by organization, the rules and cluster verbs
+// it declares replace the predefined ones
+func DefinitionOf(role current.OrganizationRole) RoleDefinition {
+	def, ok := predefinedRoles[RoleType(role.Name)]
+	if !ok {
+		def = RoleDefinition{Type: RoleType(role.Name)}
+	}
+	if len(role.Rules) != 0 {
+		def.Rules = role.Rules
+	}
+	if len(role.ClusterVerbs) != 0 {
+		def.ClusterVerbs = make(map[Resource][]Verb, len(role.ClusterVerbs))
+		def.InitiatorVerbs = nil
+		for kind, verbs := range role.ClusterVerbs {
+			for _, verb := range verbs {
+				def.ClusterVerbs[Resource(kind)] = append(def.ClusterVerbs[Resource(kind)], Verb(verb))
+			}
+		}
+	}
+	return def
+}
+
+// Definitions returns the definitions of all roles in organization, Admin and Client included
+func Definitions(organization *current.Organization) []RoleDefinition {
+	defs := []RoleDefinition{predefinedRoles[Admin], predefinedRoles[Client]}
+	for _, role := range organization.Spec.Roles {
+		defs = append(defs, DefinitionOf(role))
+	}
+	return defs
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rbac
+
+import (
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Role definitions", func() {
+	It("defines the roles predefined in organization", func() {
+		for _, role := range current.PredefinedOrganizationRoles {
+			Expect(IsPredefined(RoleType(role))).To(BeTrue(), role)
+		}
+	})
+
+	It("keeps admin's verbs on cluster scope resources", func() {
+		organization := &current.Organization{ObjectMeta: v1.ObjectMeta{Name: "org1"}}
+		defs := Definitions(organization)
+		Expect(defs).To(HaveLen(2))
+		Expect(defs[0].Verbs(Proposal, false)).To(Equal([]Verb{Get}))
+		Expect(defs[0].Verbs(Proposal, true)).To(Equal([]Verb{Get, Update, Patch}))
+		Expect(defs[1].Verbs(Channel, false)).To(BeEmpty())
+	})
+
+	It("grants voters votes only", func() {
+		def := DefinitionOf(current.OrganizationRole{Name: Voter.String()})
+		Expect(def.Verbs(Proposal, false)).To(Equal([]Verb{Get}))
+		Expect(def.Verbs(Channel, false)).To(BeEmpty())
+		for _, rule := range def.Rules {
+			Expect(rule.Resources).To(Or(Equal([]string{"votes"}), Equal([]string{"votes/status"})))
+			Expect(rule.Verbs).NotTo(ContainElement(Update.String()))
+		}
+	})
+
+	It("replaces predefined rules and verbs by declared ones", func() {
+		rules := []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}}
+		def := DefinitionOf(current.OrganizationRole{
+			Name:         Auditor.String(),
+			Rules:        rules,
+			ClusterVerbs: map[string][]string{"Channel": {"get", "list"}},
+		})
+		Expect(def.Type).To(Equal(Auditor))
+		Expect(def.Rules).To(Equal(rules))
+		Expect(def.Verbs(Channel, false)).To(Equal([]Verb{Get, List}))
+		Expect(def.Verbs(Federation, false)).To(BeEmpty())
+
+		def = DefinitionOf(current.OrganizationRole{Name: "operator", ClusterVerbs: map[string][]string{"Network": {"get"}}})
+		Expect(IsPredefined(def.Type)).To(BeFalse())
+		Expect(def.Rules).To(BeEmpty())
+		Expect(def.Verbs(Network, true)).To(Equal([]Verb{Get}))
+	})
+})
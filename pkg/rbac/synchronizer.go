@@ -29,7 +29,6 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -57,25 +56,21 @@ func EmptySynchronizer(c controllerclient.Client, o v1.Object, ra ResourceAction
 
 // SyncFederation triggers synchronization based on Federation's action(create/update/delete)
 func SyncFederation(c controllerclient.Client, o v1.Object, ra ResourceAction) error {
-	var err error
-
 	federation, ok := o.(*current.Federation)
 	if !ok {
 		return ErrBadSynchronizer
 	}
 
-	// PolicyRule which should be appended/removed from role's rules
-	targetRule := PolicyRule(Federation, []v1.Object{o}, []Verb{Get, Delete})
-
-	// Make sure each organization sync on above rule
+	// Make sure each organization's roles sync on federation
 	for _, member := range federation.GetMembers() {
-		organization := &current.Organization{}
-		err = c.Get(context.TODO(), types.NamespacedName{Name: member.Name}, organization)
+		organization, err := getLocalOrganization(c, member.Name)
 		if err != nil {
 			return err
 		}
-		key := GetClusterRole(organization.GetNamespaced(), Admin)
-		err = SyncClusterRole(c, key, targetRule, ra)
+		if organization == nil {
+			continue
+		}
+		err = syncOrganizationRoles(c, organization, Federation, o, false, ra)
 		if err != nil {
 			return err
 		}
@@ -92,9 +87,6 @@ func SyncProposal(c controllerclient.Client, o v1.Object, ra ResourceAction) err
 	if !ok {
 		return ErrBadSynchronizer
 	}
-	// PolicyRule which should be appended/removed from role's rules
-	targetRule := PolicyRule(Proposal, []v1.Object{o}, []Verb{Get})
-
 	// candidates stands for the organizations which are expected within this federation(cluster scope)
 	candidates, err := proposal.GetCandidateOrganizations(context.TODO(), c)
 	if err != nil {
@@ -102,13 +94,14 @@ func SyncProposal(c controllerclient.Client, o v1.Object, ra ResourceAction) err
 	}
 
 	for _, candidate := range candidates {
-		organization := &current.Organization{ObjectMeta: v1.ObjectMeta{Name: candidate}}
-		err = c.Get(context.TODO(), client.ObjectKeyFromObject(organization), organization)
+		organization, err := getLocalOrganization(c, candidate)
 		if err != nil {
 			return err
 		}
-		key := GetClusterRole(organization.GetNamespaced(), Admin)
-		err = SyncClusterRole(c, key, targetRule, ra)
+		if organization == nil {
+			continue
+		}
+		err = syncOrganizationRoles(c, organization, Proposal, o, candidate == proposal.Spec.InitiatorOrganization, ra)
 		if err != nil {
 			return err
 		}
@@ -119,23 +112,20 @@ func SyncProposal(c controllerclient.Client, o v1.Object, ra ResourceAction) err
 
 // SyncNetwork triggers synchronization upon Network's action(create/update/delete)
 func SyncNetwork(c controllerclient.Client, o v1.Object, ra ResourceAction) error {
-	var err error
-
 	network, ok := o.(*current.Network)
 	if !ok {
 		return ErrBadSynchronizer
 	}
-	// PolicyRule which should be appended/removed from role's rules
-	targetRule := PolicyRule(Network, []v1.Object{o}, []Verb{Get, Delete})
-	// Make sure each organization sync on above rule
+	// Make sure each organization's roles sync on network
 	for _, member := range network.GetMembers() {
-		organization := &current.Organization{}
-		err = c.Get(context.TODO(), types.NamespacedName{Name: member.Name}, organization)
+		organization, err := getLocalOrganization(c, member.Name)
 		if err != nil {
 			return err
 		}
-		key := GetClusterRole(organization.GetNamespaced(), Admin)
-		err = SyncClusterRole(c, key, targetRule, ra)
+		if organization == nil {
+			continue
+		}
+		err = syncOrganizationRoles(c, organization, Network, o, false, ra)
 		if err != nil {
 			return err
 		}
@@ -146,28 +136,102 @@ func SyncNetwork(c controllerclient.Client, o v1.Object, ra ResourceAction) erro
 
 // SyncChannel triggers synchronization upon Channel's action(create/update/delete)
 func SyncChannel(c controllerclient.Client, o v1.Object, ra ResourceAction) error {
-	var err error
-
 	channel, ok := o.(*current.Channel)
 	if !ok {
 		return ErrBadSynchronizer
 	}
-	// PolicyRule which should be appended/removed from role's rules
-	targetRule := PolicyRule(Channel, []v1.Object{o}, []Verb{Get, Update, Patch})
-	// Make sure each organization sync on above rule
+	// Make sure each organization's roles sync on channel
+	members := make(map[string]bool, len(channel.GetMembers()))
 	for _, member := range channel.GetMembers() {
-		organization := &current.Organization{}
-		err = c.Get(context.TODO(), types.NamespacedName{Name: member.Name}, organization)
+		members[member.Name] = true
+		organization, err := getLocalOrganization(c, member.Name)
 		if err != nil {
 			return err
 		}
-		key := GetClusterRole(organization.GetNamespaced(), Admin)
-		err = SyncClusterRole(c, key, targetRule, ra)
+		if organization == nil {
+			continue
+		}
+		err = syncOrganizationRoles(c, organization, Channel, o, false, ra)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Revoke channel's rule from organizations removed from this channel
+	if ra == ResourceUpdate {
+		return RevokeClusterRoles(c, PolicyRule(Channel, []v1.Object{o}, nil), members)
+	}
+
+	return nil
+}
+
+// syncOrganizationRoles syncs the rule on o to the clusterroles of organization's roles
+// which grant verbs on kind
+func syncOrganizationRoles(c controllerclient.Client, organization *current.Organization, kind Resource, o v1.Object, initiator bool, ra ResourceAction) error {
+	for _, def := range Definitions(organization) {
+		verbs := def.Verbs(kind, initiator)
+		if len(verbs) == 0 {
+			continue
+		}
+		key := GetClusterRole(organization.GetNamespaced(), def.Type)
+		err := SyncClusterRole(c, key, PolicyRule(kind, []v1.Object{o}, verbs), ra)
 		if err != nil {
+			// clusterroles of declared roles are seeded from admin's once organization reconciles them
+			if apierrors.IsNotFound(err) && def.Type != Admin {
+				continue
+			}
 			return err
 		}
 	}
+	return nil
+}
+
+// getLocalOrganization returns the organization hosted in this cluster, or nil if name refers
+// to an external organization, which has no clusterroles here
+func getLocalOrganization(c controllerclient.Client, name string) (*current.Organization, error) {
+	external, err := current.IsExternalOrganization(context.TODO(), c, name)
+	if err != nil || external {
+		return nil, err
+	}
+	organization := &current.Organization{}
+	if err = c.Get(context.TODO(), types.NamespacedName{Name: name}, organization); err != nil {
+		return nil, err
+	}
+	return organization, nil
+}
 
+// RevokeClusterRoles removes rule from the clusterroles of all organizations' roles except the ones in excluded.
+// Rules are matched by resources and resource names, so verbs of rule are ignored
+func RevokeClusterRoles(c controllerclient.Client, rule rbacv1.PolicyRule, excluded map[string]bool) error {
+	organizations := &current.OrganizationList{}
+	err := c.List(context.TODO(), organizations)
+	if err != nil {
+		return err
+	}
+	for _, organization := range organizations.Items {
+		if excluded[organization.GetName()] {
+			continue
+		}
+		for _, def := range Definitions(&organization) {
+			key := GetClusterRole(organization.GetNamespaced(), def.Type)
+			clusterRole := &rbacv1.ClusterRole{}
+			err = c.Get(context.TODO(), key, clusterRole)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+			if _, ok := CheckPolicyRule(clusterRole.Rules, rule); !ok {
+				continue
+			}
+			rbaclog.Info("revoke rule from clusterrole", "clusterrole", key.Name, "rule", PolicyRuleString(rule))
+			err = SyncClusterRole(c, key, rule, ResourceDelete)
+			if err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -196,10 +260,13 @@ func syncClusterRoleOnce(c controllerclient.Client, key types.NamespacedName, ru
 	}
 	switch ra {
 	case ResourceCreate, ResourceUpdate:
-		_, ok := CheckPolicyRule(clusterRole.Rules, rule)
+		pos, ok := CheckPolicyRule(clusterRole.Rules, rule)
 		if !ok {
 			// create if not exist
 			clusterRole.Rules = append(clusterRole.Rules, rule)
+		} else {
+			// verbs follow the role's definition
+			clusterRole.Rules[pos] = rule
 		}
 	case ResourceDelete:
 		pos, ok := CheckPolicyRule(clusterRole.Rules, rule)
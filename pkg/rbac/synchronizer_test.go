@@ -0,0 +1,158 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rbac
+
+import (
+	"context"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	cmocks "github.com/IBM-Blockchain/fabric-operator/controllers/mocks"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Synchronizer tests", func() {
+	var (
+		mockKubeClient *cmocks.Client
+		channel        *current.Channel
+		rule           rbacv1.PolicyRule
+		clusterRoles   map[string]*rbacv1.ClusterRole
+	)
+
+	BeforeEach(func() {
+		mockKubeClient = &cmocks.Client{}
+		channel = &current.Channel{ObjectMeta: v1.ObjectMeta{Name: "channel-sample"}}
+		rule = PolicyRule(Channel, []v1.Object{channel}, []Verb{Get, Update, Patch})
+
+		clusterRoles = make(map[string]*rbacv1.ClusterRole)
+		for _, org := range []string{"org1", "org2"} {
+			key := GetClusterRole(types.NamespacedName{Name: org, Namespace: org}, Admin)
+			clusterRoles[key.Name] = &rbacv1.ClusterRole{
+				ObjectMeta: v1.ObjectMeta{Name: key.Name},
+				Rules:      []rbacv1.PolicyRule{rule},
+			}
+		}
+
+		mockKubeClient.ListStub = func(ctx context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+			organizations := obj.(*current.OrganizationList)
+			for _, org := range []string{"org1", "org2", "org3"} {
+				organizations.Items = append(organizations.Items, current.Organization{ObjectMeta: v1.ObjectMeta{Name: org}})
+			}
+			return nil
+		}
+		mockKubeClient.GetStub = func(ctx context.Context, key types.NamespacedName, obj client.Object) error {
+			cr, ok := clusterRoles[key.Name]
+			if !ok {
+				return k8serrors.NewNotFound(schema.GroupResource{}, key.Name)
+			}
+			cr.DeepCopyInto(obj.(*rbacv1.ClusterRole))
+			return nil
+		}
+		mockKubeClient.UpdateStub = func(ctx context.Context, obj client.Object, opts ...controllerclient.UpdateOption) error {
+			cr := obj.(*rbacv1.ClusterRole)
+			clusterRoles[cr.Name] = cr.DeepCopy()
+			return nil
+		}
+	})
+
+	It("revokes rule from organizations not in channel", func() {
+		err := RevokeClusterRoles(mockKubeClient, rule, map[string]bool{"org1": true})
+		Expect(err).To(BeNil())
+
+		Expect(mockKubeClient.UpdateCallCount()).To(Equal(1))
+		org1 := GetClusterRole(types.NamespacedName{Name: "org1", Namespace: "org1"}, Admin)
+		org2 := GetClusterRole(types.NamespacedName{Name: "org2", Namespace: "org2"}, Admin)
+		Expect(clusterRoles[org1.Name].Rules).To(HaveLen(1))
+		Expect(clusterRoles[org2.Name].Rules).To(BeEmpty())
+	})
+
+	Context("organization with declared roles", func() {
+		var organization *current.Organization
+
+		BeforeEach(func() {
+			organization = &current.Organization{
+				ObjectMeta: v1.ObjectMeta{Name: "org1"},
+				Spec: current.OrganizationSpec{
+					Admin: "admin",
+					Roles: []current.OrganizationRole{
+						{Name: Auditor.String(), Users: []string{"auditor"}},
+						{Name: Voter.String(), Users: []string{"voter"}},
+					},
+				},
+			}
+			for _, role := range []RoleType{Auditor, Voter} {
+				key := GetClusterRole(organization.GetNamespaced(), role)
+				clusterRoles[key.Name] = &rbacv1.ClusterRole{ObjectMeta: v1.ObjectMeta{Name: key.Name}}
+			}
+			mockKubeClient.GetStub = func(ctx context.Context, key types.NamespacedName, obj client.Object) error {
+				switch o := obj.(type) {
+				case *current.ExternalOrganization:
+					return k8serrors.NewNotFound(schema.GroupResource{}, key.Name)
+				case *current.Organization:
+					organization.DeepCopyInto(o)
+					return nil
+				}
+				cr, ok := clusterRoles[key.Name]
+				if !ok {
+					return k8serrors.NewNotFound(schema.GroupResource{}, key.Name)
+				}
+				cr.DeepCopyInto(obj.(*rbacv1.ClusterRole))
+				return nil
+			}
+			mockKubeClient.ListStub = func(ctx context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+				obj.(*current.OrganizationList).Items = []current.Organization{*organization}
+				return nil
+			}
+		})
+
+		It("grants the verbs of each role on channel", func() {
+			channel.Spec.Members = []current.Member{{Name: "org1"}}
+			err := SyncChannel(mockKubeClient, channel, ResourceCreate)
+			Expect(err).To(BeNil())
+
+			admin := clusterRoles[GetClusterRole(organization.GetNamespaced(), Admin).Name]
+			Expect(admin.Rules).To(HaveLen(1))
+			Expect(admin.Rules[0].Verbs).To(Equal([]string{"get", "update", "patch"}))
+			auditor := clusterRoles[GetClusterRole(organization.GetNamespaced(), Auditor).Name]
+			Expect(auditor.Rules).To(HaveLen(1))
+			Expect(auditor.Rules[0].Verbs).To(Equal([]string{"get"}))
+			// voters have nothing to do with channels
+			voter := clusterRoles[GetClusterRole(organization.GetNamespaced(), Voter).Name]
+			Expect(voter.Rules).To(BeEmpty())
+		})
+
+		It("revokes channel from all roles once organization leaves", func() {
+			channel.Spec.Members = []current.Member{{Name: "org1"}}
+			Expect(SyncChannel(mockKubeClient, channel, ResourceCreate)).To(BeNil())
+
+			channel.Spec.Members = []current.Member{{Name: "org2"}}
+			Expect(RevokeClusterRoles(mockKubeClient, rule, map[string]bool{"org2": true})).To(BeNil())
+			for _, role := range []RoleType{Admin, Auditor} {
+				Expect(clusterRoles[GetClusterRole(organization.GetNamespaced(), role).Name].Rules).To(BeEmpty())
+			}
+		})
+	})
+})
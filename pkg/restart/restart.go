@@ -23,12 +23,15 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Blockchain/fabric-operator/pkg/event"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/initializer/common"
 	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/restart/configmap"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/restart/staggerrestarts"
 	"github.com/pkg/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -41,6 +44,8 @@ type RestartManager struct {
 	WaitTime               time.Duration
 	ConfigMapManager       *configmap.Manager
 	StaggerRestartsService *staggerrestarts.StaggerRestartsService
+	// Recorder, if set, records an event on the instance when it is queued for restart
+	Recorder record.EventRecorder
 }
 
 func New(client k8sclient.Client, waitTime, timeout time.Duration) *RestartManager {
@@ -302,6 +307,10 @@ func (r *RestartManager) RestartDeployment(instance Instance, reason string) err
 		return errors.Wrap(err, "failed to add restart request to queue")
 	}
 
+	if obj, ok := instance.(runtime.Object); ok {
+		event.Normal(r.Recorder, obj, event.RestartQueued, "queued for restart: %s", reason)
+	}
+
 	return nil
 }
 
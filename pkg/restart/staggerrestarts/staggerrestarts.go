@@ -29,6 +29,7 @@ import (
 	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/action"
 	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/metrics"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/restart/configmap"
 	"github.com/pkg/errors"
 
@@ -315,7 +316,11 @@ func (s *StaggerRestartsService) GetConfig(componentType, namespace string) (*Re
 
 func (s *StaggerRestartsService) UpdateConfig(componentType, namespace string, cfg *RestartConfig) error {
 	cmName := fmt.Sprintf("%s-restart-config", componentType)
-	return s.ConfigMapManager.UpdateConfig(cmName, namespace, cfg)
+	if err := s.ConfigMapManager.UpdateConfig(cmName, namespace, cfg); err != nil {
+		return err
+	}
+	metrics.StaggerRestartQueueDepth.WithLabelValues(componentType, namespace).Set(float64(cfg.QueueDepth()))
+	return nil
 }
 
 func (s *StaggerRestartsService) RestartDeployment(name, namespace string) error {
@@ -63,3 +63,12 @@ func (r *RestartConfig) AddToQueue(mspid string, component *Component) {
 func (r *RestartConfig) PopFromQueue(mspid string) {
 	r.Queues[mspid] = r.Queues[mspid][1:]
 }
+
+// QueueDepth returns the number of components waiting in all queues
+func (r *RestartConfig) QueueDepth() int {
+	depth := 0
+	for _, queue := range r.Queues {
+		depth += len(queue)
+	}
+	return depth
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretstore
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	FetchKeysContainerName = "fetch-keys"
+	// KeyFile is the key of private keys in keystore secrets
+	KeyFile = "key.pem"
+
+	DefaultFetchImage       = "hashicorp/vault:1.12.2"
+	DefaultFetchTokenSecret = "vault-token"
+
+	fetchMountPath = "/keys"
+)
+
+// KeyVolume is a volume of a node, which mounts the keystore secret
+type KeyVolume struct {
+	Volume string
+	Secret string
+	// Keys of the secret's data written into the volume, only KeyFile when empty
+	Keys []string
+	// Path is the location of the secret's data in the external store, as recorded on the reference
+	Path string
+}
+
+// ReferencedVolumes returns the volumes whose secret only references data kept in an external store
+func ReferencedVolumes(client k8sclient.Client, namespace string, volumes []KeyVolume) []KeyVolume {
+	referenced := []KeyVolume{}
+	for _, v := range volumes {
+		secret := &corev1.Secret{}
+		err := client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: v.Secret}, secret)
+		if err == nil && IsReference(secret) {
+			if keys := secret.Annotations[KeysAnnotation]; keys != "" {
+				v.Keys = strings.Split(keys, ",")
+			}
+			v.Path = secret.Annotations[PathAnnotation]
+			referenced = append(referenced, v)
+		}
+	}
+	return referenced
+}
+
+// FetchKeysContainer returns an init container writing the data of referenced volumes from vault
+// into volumes of the same names, which are expected to be in memory emptyDirs
+func FetchKeysContainer(config VaultConfig, volumes []KeyVolume) corev1.Container {
+	image := config.FetchImage
+	if image == "" {
+		image = DefaultFetchImage
+	}
+	tokenSecret := config.FetchTokenSecret
+	if tokenSecret == "" {
+		tokenSecret = DefaultFetchTokenSecret
+	}
+
+	script := []string{"set -e"}
+	mounts := []corev1.VolumeMount{}
+	for _, v := range volumes {
+		dir := filepath.Join(fetchMountPath, v.Volume)
+		keys := v.Keys
+		if len(keys) == 0 {
+			keys = []string{KeyFile}
+		}
+		for _, key := range keys {
+			script = append(script, fmt.Sprintf("vault kv get -mount=%s -field=%s %s > %s",
+				config.GetMount(), key, v.Path, filepath.Join(dir, key)))
+		}
+		mounts = append(mounts, corev1.VolumeMount{Name: v.Volume, MountPath: dir})
+	}
+
+	return corev1.Container{
+		Name:            FetchKeysContainerName,
+		Image:           image,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         []string{"sh", "-c", strings.Join(script, "\n")},
+		Env: []corev1.EnvVar{
+			{Name: "VAULT_ADDR", Value: config.Address},
+			{
+				Name: "VAULT_TOKEN",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: tokenSecret},
+						Key:                  "token",
+					},
+				},
+			},
+			// the cli would otherwise try to write a token helper file into a read only home
+			{Name: "HOME", Value: "/tmp"},
+		},
+		VolumeMounts: mounts,
+	}
+}
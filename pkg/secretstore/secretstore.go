@@ -0,0 +1,147 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretstore
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	k8sclient "github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("secret_store")
+
+const (
+	BackendKubernetes = "kubernetes"
+	BackendVault      = "vault"
+
+	// StoreAnnotation marks a secret whose data is kept in an external store
+	StoreAnnotation = "ibp.com/secret-store"
+	// PathAnnotation is the location of the secret's data in the external store
+	PathAnnotation = "ibp.com/secret-path"
+	// KeysAnnotation lists the comma separated keys of the secret's data in the external store
+	KeysAnnotation = "ibp.com/secret-keys"
+)
+
+// Store persists secrets holding private key material
+type Store interface {
+	// Get returns the secret with its data, or a not found error
+	Get(namespace, name string) (*corev1.Secret, error)
+	// Put creates or updates the secret, owner may be nil
+	Put(secret *corev1.Secret, owner v1.Object) error
+	// Delete removes the secret and its data, a missing secret is not an error
+	Delete(namespace, name string) error
+}
+
+// Config selects and configures the store backend
+type Config struct {
+	// Backend is either kubernetes (default) or vault
+	Backend string      `json:"backend" yaml:"backend"`
+	Vault   VaultConfig `json:"vault" yaml:"vault"`
+}
+
+// VaultConfig configures a Vault compatible KV version 2 secrets engine
+type VaultConfig struct {
+	Address string `json:"address" yaml:"address"`
+	// Mount is the path of the KV engine, defaults to secret
+	Mount string `json:"mount" yaml:"mount"`
+	// Prefix is prepended to <namespace>/<name> of every secret, defaults to fabric
+	Prefix string `json:"prefix" yaml:"prefix"`
+	// Token is used by the operator, TokenFile is read when it is empty
+	Token     string `json:"token" yaml:"token"`
+	TokenFile string `json:"tokenFile" yaml:"tokenFile"`
+	// FetchImage provides the vault cli to fetch keys in the init container of nodes
+	FetchImage string `json:"fetchImage" yaml:"fetchImage"`
+	// FetchTokenSecret is the secret in the node's namespace with the key "token"
+	// the init container authenticates with
+	FetchTokenSecret string `json:"fetchTokenSecret" yaml:"fetchTokenSecret"`
+}
+
+// UsingVault returns true if keys are kept in vault
+func (c Config) UsingVault() bool {
+	return c.Backend == BackendVault
+}
+
+// New returns the store selected by config
+func New(client k8sclient.Client, scheme *runtime.Scheme, config Config) Store {
+	kube := NewKubernetes(client, scheme)
+	if !config.UsingVault() {
+		return kube
+	}
+	return &Vault{
+		Kubernetes: kube,
+		Config:     config.Vault,
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// IsReference returns true if the data of secret is kept in an external store
+func IsReference(secret *corev1.Secret) bool {
+	return secret.GetAnnotations()[StoreAnnotation] != ""
+}
+
+// Kubernetes keeps the data in the secret itself
+type Kubernetes struct {
+	Client k8sclient.Client
+	Scheme *runtime.Scheme
+}
+
+func NewKubernetes(client k8sclient.Client, scheme *runtime.Scheme) *Kubernetes {
+	return &Kubernetes{
+		Client: client,
+		Scheme: scheme,
+	}
+}
+
+func (k *Kubernetes) Get(namespace, name string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	err := k.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, secret)
+	if err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+func (k *Kubernetes) Put(secret *corev1.Secret, owner v1.Object) error {
+	if secret.Type == "" {
+		secret.Type = corev1.SecretTypeOpaque
+	}
+	return k.Client.CreateOrUpdate(context.TODO(), secret, k8sclient.CreateOrUpdateOption{
+		Owner:  owner,
+		Scheme: k.Scheme,
+	})
+}
+
+func (k *Kubernetes) Delete(namespace, name string) error {
+	secret := &corev1.Secret{}
+	secret.Namespace, secret.Name = namespace, name
+	err := k.Client.Delete(context.TODO(), secret)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete secret '%s'", name)
+	}
+	return nil
+}
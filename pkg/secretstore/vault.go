@@ -0,0 +1,215 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	DefaultVaultMount  = "secret"
+	DefaultVaultPrefix = "fabric"
+)
+
+// Vault keeps the data in a KV version 2 secrets engine and only a reference secret
+// without data in the cluster. Secrets created before switching to vault are read as they are
+// and move to vault on their next update.
+type Vault struct {
+	Kubernetes *Kubernetes
+	Config     VaultConfig
+	HTTPClient *http.Client
+}
+
+type kvRequest struct {
+	Data map[string]string `json:"data"`
+}
+
+type kvResponse struct {
+	Data struct {
+		Data map[string]string `json:"data"`
+	} `json:"data"`
+}
+
+// Path returns the location of a secret relative to the mount
+func (c VaultConfig) Path(namespace, name string) string {
+	prefix := c.Prefix
+	if prefix == "" {
+		prefix = DefaultVaultPrefix
+	}
+	return path.Join(prefix, namespace, name)
+}
+
+// GetMount returns the mount of the KV engine
+func (c VaultConfig) GetMount() string {
+	if c.Mount == "" {
+		return DefaultVaultMount
+	}
+	return strings.Trim(c.Mount, "/")
+}
+
+func (s *Vault) Get(namespace, name string) (*corev1.Secret, error) {
+	secret, err := s.Kubernetes.Get(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if !IsReference(secret) {
+		return secret, nil
+	}
+
+	resp := &kvResponse{}
+	found, err := s.do(http.MethodGet, "data", secret.Annotations[PathAnnotation], nil, resp)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read secret '%s' from vault", name)
+	}
+	if !found || resp.Data.Data == nil {
+		return nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+	}
+	secret.Data = map[string][]byte{}
+	for k, v := range resp.Data.Data {
+		secret.Data[k] = []byte(v)
+	}
+	return secret, nil
+}
+
+func (s *Vault) Put(secret *corev1.Secret, owner v1.Object) error {
+	data := map[string]string{}
+	keys := make([]string, 0, len(secret.Data))
+	for k, v := range secret.Data {
+		if !utf8.Valid(v) {
+			return errors.Errorf("value of '%s' in secret '%s' is not text", k, secret.GetName())
+		}
+		data[k] = string(v)
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	p := s.Config.Path(secret.GetNamespace(), secret.GetName())
+	if _, err := s.do(http.MethodPost, "data", p, &kvRequest{Data: data}, nil); err != nil {
+		return errors.Wrapf(err, "failed to write secret '%s' to vault", secret.GetName())
+	}
+
+	log.Info(fmt.Sprintf("Stored data of secret '%s' in vault at '%s'", secret.GetName(), p))
+	ref := secret.DeepCopy()
+	ref.Data = nil
+	ref.StringData = nil
+	if ref.Annotations == nil {
+		ref.Annotations = map[string]string{}
+	}
+	ref.Annotations[StoreAnnotation] = BackendVault
+	ref.Annotations[PathAnnotation] = p
+	ref.Annotations[KeysAnnotation] = strings.Join(keys, ",")
+	return s.Kubernetes.Put(ref, owner)
+}
+
+func (s *Vault) Delete(namespace, name string) error {
+	secret, err := s.Kubernetes.Get(namespace, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if IsReference(secret) {
+		// removing the metadata removes all versions
+		if _, err = s.do(http.MethodDelete, "metadata", secret.Annotations[PathAnnotation], nil, nil); err != nil {
+			return errors.Wrapf(err, "failed to delete secret '%s' from vault", name)
+		}
+	}
+	return s.Kubernetes.Delete(namespace, name)
+}
+
+// do sends a request to the KV engine, it returns false if the path does not exist
+func (s *Vault) do(method, api, p string, in, out interface{}) (bool, error) {
+	token, err := s.token()
+	if err != nil {
+		return false, err
+	}
+
+	var body *bytes.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return false, err
+		}
+		body = bytes.NewReader(b)
+	} else {
+		body = bytes.NewReader(nil)
+	}
+
+	url := fmt.Sprintf("%s/v1/%s/%s/%s", strings.TrimRight(s.Config.Address, "/"), s.Config.GetMount(), api, strings.TrimLeft(p, "/"))
+	req, err := http.NewRequestWithContext(context.TODO(), method, url, body)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.HTTPClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return false, nil
+	case resp.StatusCode >= 300:
+		return false, errors.Errorf("vault returned %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	if out != nil && len(respBody) > 0 {
+		if err = json.Unmarshal(respBody, out); err != nil {
+			return false, errors.Wrap(err, "failed to decode vault response")
+		}
+	}
+	return true, nil
+}
+
+func (s *Vault) token() (string, error) {
+	if s.Config.Token != "" {
+		return s.Config.Token, nil
+	}
+	if s.Config.TokenFile == "" {
+		return "", errors.New("no vault token configured")
+	}
+	token, err := ioutil.ReadFile(s.Config.TokenFile)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read vault token")
+	}
+	return strings.TrimSpace(string(token)), nil
+}
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"errors"
 
+	bcrbac "github.com/IBM-Blockchain/fabric-operator/pkg/rbac"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -198,6 +199,15 @@ func (idType IDType) String() string {
 	return string(idType)
 }
 
+// IDTypeOf maps the role a user is bound to in organization to the type of its CA identity.
+// Only admins are registrars, users of other roles are clients
+func IDTypeOf(role bcrbac.RoleType) IDType {
+	if role == bcrbac.Admin {
+		return ADMIN
+	}
+	return CLIENT
+}
+
 // ID stands for a Fabric-CA identity
 type ID struct {
 	Name                 string            `json:"name"`
@@ -241,6 +251,14 @@ func BuildClientID(id string) ID {
 	}
 }
 
+// BuildRoleID builds the CA identity of a user bound to role
+func BuildRoleID(role bcrbac.RoleType, id string) ID {
+	if IDTypeOf(role) == ADMIN {
+		return BuildAdminID(id)
+	}
+	return BuildClientID(id)
+}
+
 func BuildPeerID(id string) ID {
 	return ID{
 		Name: id,
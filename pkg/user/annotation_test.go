@@ -19,6 +19,7 @@
 package user
 
 import (
+	bcrbac "github.com/IBM-Blockchain/fabric-operator/pkg/rbac"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -45,6 +46,18 @@ var _ = Describe("Annotation", func() {
 		Expect(id.Attributes["hf.Type"]).To(Equal(string(ORDERER)))
 	})
 
+	It("Test build ID by role", func() {
+		id := BuildRoleID(bcrbac.Admin, "admin")
+		Expect(id.Type).To(Equal(ADMIN))
+		Expect(id.Attributes["hf.Registrar.Roles"]).To(Equal("*"))
+
+		for _, role := range []bcrbac.RoleType{bcrbac.Client, bcrbac.Auditor, bcrbac.ChaincodeOperator, bcrbac.Voter, bcrbac.RoleType("custom")} {
+			id = BuildRoleID(role, "user")
+			Expect(id.Type).To(Equal(CLIENT))
+			Expect(id.Attributes).NotTo(HaveKey("hf.Registrar.Roles"))
+		}
+	})
+
 	It("Test BlockchainAnnotation", func() {
 		annotation := NewBlockchainAnnotation(organization, BuildAdminID(org1admin))
 		Expect(annotation.Organization).To(Equal(organization))
@@ -0,0 +1,158 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"reflect"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	bcrbac "github.com/IBM-Blockchain/fabric-operator/pkg/rbac"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReconcileIdentity creates/updates the Identity of id owned by targetUser in organization's namespace upon Add.
+// Upon Remove admin/client identities are marked Revoking for the organization to revoke them, other identities are deleted.
+// Identities are owned by the organization. The identity is returned as written, nil once deleted or never recorded
+func ReconcileIdentity(c controllerclient.Client, organization, targetUser string, id ID, action UnaryAction) (*current.Identity, error) {
+	org := &current.Organization{}
+	err := c.Get(context.TODO(), types.NamespacedName{Name: organization}, org)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get organization %s", organization)
+	}
+	key := org.GetIdentity(id.Name)
+
+	identity := &current.Identity{}
+	err = c.Get(context.TODO(), key, identity)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+	exist := err == nil
+
+	if action == Remove {
+		if !exist {
+			return nil, nil
+		}
+		if identity.IsRevoked() {
+			return identity, nil
+		}
+		// revoked identities are kept as the record of revocation
+		if identity.Spec.Type == current.AdminIdentity || identity.Spec.Type == current.ClientIdentity {
+			if identity.Status.Revocation == current.IdentityRevoking {
+				return identity, nil
+			}
+			identity.Status.Revocation = current.IdentityRevoking
+			return identity, c.UpdateStatus(context.TODO(), identity)
+		}
+		err = c.Delete(context.TODO(), identity)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	spec := current.IdentitySpec{
+		Organization: organization,
+		EnrollmentID: id.Name,
+		Type:         current.IdentityType(id.Type),
+		User:         targetUser,
+		Attributes:   id.Attributes,
+	}
+	labels := current.IdentityLabels(spec)
+	if exist {
+		if identity.IsRevoked() {
+			return nil, errors.Errorf("identity %s is revoked in CA of %s and can't be bound again", id.Name, organization)
+		}
+		if !reflect.DeepEqual(identity.Spec, spec) || !reflect.DeepEqual(identity.Labels, labels) {
+			identity.Spec = spec
+			identity.Labels = labels
+			if err = c.Update(context.TODO(), identity); err != nil {
+				return nil, err
+			}
+		}
+		// bound again before being revoked
+		if identity.Status.Revocation == current.IdentityRevoking {
+			identity.Status.Revocation = current.IdentityValid
+			return identity, c.UpdateStatus(context.TODO(), identity)
+		}
+		return identity, nil
+	}
+
+	identity.Name = key.Name
+	identity.Namespace = key.Namespace
+	identity.Labels = labels
+	identity.OwnerReferences = []metav1.OwnerReference{bcrbac.OwnerReference(bcrbac.Organization, org)}
+	identity.Spec = spec
+	err = c.Create(context.TODO(), identity)
+	if err != nil {
+		return nil, err
+	}
+	identity.Status.Revocation = current.IdentityValid
+	return identity, c.UpdateStatus(context.TODO(), identity)
+}
+
+// RevokeIdentity marks identity as revoked in CA for reason
+func RevokeIdentity(c controllerclient.Client, identity *current.Identity, reason string) error {
+	now := metav1.Now()
+	identity.Status.Revocation = current.IdentityRevoked
+	identity.Status.RevocationTime = &now
+	identity.Status.Reason = reason
+	return c.UpdateStatus(context.TODO(), identity)
+}
+
+// SetIdentityExpiry records the expiry of certPEM, the enrollment certificate of enrollmentID,
+// on its Identity in organization's namespace if the identity exists
+func SetIdentityExpiry(c controllerclient.Client, organization *current.Organization, enrollmentID string, certPEM []byte) error {
+	cert, err := util.GetCertificateFromPEMBytes(certPEM)
+	if err != nil {
+		return err
+	}
+	identity := &current.Identity{}
+	err = c.Get(context.TODO(), organization.GetIdentity(enrollmentID), identity)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	expiry := metav1.NewTime(cert.NotAfter)
+	if identity.Status.Expiry != nil && identity.Status.Expiry.Equal(&expiry) {
+		return nil
+	}
+	identity.Status.Expiry = &expiry
+	return c.UpdateStatus(context.TODO(), identity)
+}
+
+// ListIdentities lists the identities of targetUser in all organizations, filtered by identity types if any
+func ListIdentities(c controllerclient.Client, targetUser string, identityTypes ...current.IdentityType) (*current.IdentityList, error) {
+	identities := &current.IdentityList{}
+	err := c.List(context.TODO(), identities, &client.ListOptions{
+		LabelSelector: current.IdentitySelector(targetUser, identityTypes...),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return identities, nil
+}
@@ -0,0 +1,234 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"time"
+
+	iam "github.com/IBM-Blockchain/fabric-operator/api/iam/v1alpha1"
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	cmocks "github.com/IBM-Blockchain/fabric-operator/controllers/mocks"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util/testcert"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Identity", func() {
+	var (
+		mockClient *cmocks.Client
+		identities map[string]*current.Identity
+	)
+
+	BeforeEach(func() {
+		identities = make(map[string]*current.Identity)
+		mockClient = &cmocks.Client{}
+		mockClient.GetStub = func(ctx context.Context, key types.NamespacedName, obj client.Object) error {
+			switch o := obj.(type) {
+			case *current.Organization:
+				o.Name = key.Name
+				o.Spec.Admin = "org1admin"
+			case *current.Identity:
+				stored, ok := identities[key.Namespace+"/"+key.Name]
+				if !ok {
+					return k8serrors.NewNotFound(schema.GroupResource{}, key.Name)
+				}
+				stored.DeepCopyInto(o)
+			}
+			return nil
+		}
+		store := func(obj client.Object) error {
+			identity := obj.(*current.Identity)
+			identities[identity.Namespace+"/"+identity.Name] = identity.DeepCopy()
+			return nil
+		}
+		mockClient.CreateStub = func(ctx context.Context, obj client.Object, opts ...controllerclient.CreateOption) error {
+			return store(obj)
+		}
+		mockClient.UpdateStub = func(ctx context.Context, obj client.Object, opts ...controllerclient.UpdateOption) error {
+			return store(obj)
+		}
+		mockClient.UpdateStatusStub = func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+			return store(obj)
+		}
+		mockClient.DeleteStub = func(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+			delete(identities, obj.GetNamespace()+"/"+obj.GetName())
+			return nil
+		}
+	})
+
+	It("records identities of an organization", func() {
+		_, err := ReconcileIdentity(mockClient, "org1", "org1admin", BuildAdminID("org1admin"), Add)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = ReconcileIdentity(mockClient, "org1", "org1admin", BuildPeerID("org1peer1"), Add)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(identities).To(HaveLen(2))
+		admin := identities["org1/org1admin"]
+		Expect(admin.Spec.Type).To(Equal(current.AdminIdentity))
+		Expect(admin.Spec.User).To(Equal("org1admin"))
+		Expect(admin.Status.Revocation).To(Equal(current.IdentityValid))
+		Expect(admin.Labels[current.IdentityOrganizationLabel]).To(Equal("org1"))
+		Expect(admin.OwnerReferences).To(HaveLen(1))
+		Expect(identities["org1/org1peer1"].Spec.Type).To(Equal(current.PeerIdentity))
+
+		By("skipping identities not changed")
+		_, err = ReconcileIdentity(mockClient, "org1", "org1admin", BuildAdminID("org1admin"), Add)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mockClient.UpdateCallCount()).To(Equal(0))
+
+		By("removing identities")
+		_, err = ReconcileIdentity(mockClient, "org1", "org1admin", BuildPeerID("org1peer1"), Remove)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identities).NotTo(HaveKey("org1/org1peer1"))
+		_, err = ReconcileIdentity(mockClient, "org1", "org1admin", BuildPeerID("org1peer1"), Remove)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("marking removed clients for revocation")
+		_, err = ReconcileIdentity(mockClient, "org1", "org1client", BuildClientID("org1client"), Add)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = ReconcileIdentity(mockClient, "org1", "org1client", BuildClientID("org1client"), Remove)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identities["org1/org1client"].Status.Revocation).To(Equal(current.IdentityRevoking))
+		_, err = ReconcileIdentity(mockClient, "org1", "org1client", BuildClientID("org1client"), Add)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identities["org1/org1client"].Status.Revocation).To(Equal(current.IdentityValid))
+
+		By("keeping revoked identities")
+		err = RevokeIdentity(mockClient, identities["org1/org1client"].DeepCopy(), "affiliationchanged")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = ReconcileIdentity(mockClient, "org1", "org1client", BuildClientID("org1client"), Remove)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identities).To(HaveKey("org1/org1client"))
+		Expect(identities["org1/org1client"].IsRevoked()).To(BeTrue())
+		Expect(identities["org1/org1client"].Status.Reason).To(Equal("affiliationchanged"))
+
+		By("refusing to bind revoked identities again")
+		_, err = ReconcileIdentity(mockClient, "org1", "org1client", BuildClientID("org1client"), Add)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("records the expiry of enrollment certificates", func() {
+		org := &current.Organization{}
+		org.Name = "org1"
+		notAfter := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+
+		By("skipping identities not recorded")
+		err := SetIdentityExpiry(mockClient, org, "org1admin", testcert.New(GinkgoT(), "org1admin", nil, testcert.NotAfter(notAfter)).PEM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identities).To(BeEmpty())
+
+		_, err = ReconcileIdentity(mockClient, "org1", "org1admin", BuildAdminID("org1admin"), Add)
+		Expect(err).NotTo(HaveOccurred())
+		err = SetIdentityExpiry(mockClient, org, "org1admin", testcert.New(GinkgoT(), "org1admin", nil, testcert.NotAfter(notAfter)).PEM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identities["org1/org1admin"].Status.Expiry.Time.Equal(notAfter)).To(BeTrue())
+		Expect(identities["org1/org1admin"].Status.Revocation).To(Equal(current.IdentityValid))
+
+		err = SetIdentityExpiry(mockClient, org, "org1admin", []byte("invalid"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("syncs the blockchain annotation of users from their identities", func() {
+		u := iam.User{}
+		u.Name = "org1admin"
+		u.Spec.Name = "org1admin"
+		u.Labels = map[string]string{"t7d.io.username": "org1admin", OrganizationLabel.String("org2"): CLIENT.String()}
+		// the identities just written are not listed yet
+		stale := true
+		mockClient.ListStub = func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+			switch l := list.(type) {
+			case *iam.UserList:
+				l.Items = []iam.User{*u.DeepCopy()}
+			case *current.IdentityList:
+				if stale {
+					return nil
+				}
+				selector := opts[0].(*client.ListOptions).LabelSelector
+				for _, identity := range identities {
+					if selector.Matches(labels.Set(identity.Labels)) {
+						l.Items = append(l.Items, *identity)
+					}
+				}
+			}
+			return nil
+		}
+		mockClient.PatchStub = func(ctx context.Context, obj client.Object, patch client.Patch, opts ...controllerclient.PatchOption) error {
+			obj.(*iam.User).DeepCopyInto(&u)
+			return nil
+		}
+		annotationOf := func() *BlockchainAnnotationList {
+			list := NewBlockchainAnnotationList()
+			Expect(list.Unmarshal([]byte(u.Annotations[BlockchainAnnotationKey]))).To(Succeed())
+			return list
+		}
+
+		err := Reconcile(mockClient, "org1admin", "org1", "", ADMIN, Add)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identities).To(HaveKey("org1/org1admin"))
+		annotation, err := annotationOf().GetAnnotation("org1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(annotation.IDs).To(HaveKey("org1admin"))
+		Expect(u.Labels).To(HaveKeyWithValue(OrganizationLabel.String("org1"), ADMIN.String()))
+		Expect(u.Labels).NotTo(HaveKey(OrganizationLabel.String("org2")))
+
+		stale = false
+		err = ReconcileMultiple(mockClient, "org1admin", "org1", ORDERER, Add, "network0", "network1")
+		Expect(err).NotTo(HaveOccurred())
+		annotation, _ = annotationOf().GetAnnotation("org1")
+		Expect(annotation.IDs).To(HaveLen(3))
+		Expect(annotation.IDs["network0"].Type).To(Equal(ORDERER))
+
+		By("skipping users already in sync")
+		patched := mockClient.PatchCallCount()
+		Expect(SyncUser(mockClient, "org1admin")).To(Succeed())
+		Expect(mockClient.PatchCallCount()).To(Equal(patched))
+
+		By("dropping removed and revoking identities")
+		err = ReconcileMultiple(mockClient, "org1admin", "org1", ORDERER, Remove, "network1")
+		Expect(err).NotTo(HaveOccurred())
+		err = Reconcile(mockClient, "org1admin", "org1", "", ADMIN, Remove)
+		Expect(err).NotTo(HaveOccurred())
+		annotation, _ = annotationOf().GetAnnotation("org1")
+		Expect(annotation.IDs).To(HaveLen(1))
+		Expect(annotation.IDs).To(HaveKey("network0"))
+		Expect(u.Labels).NotTo(HaveKey(OrganizationLabel.String("org1")))
+
+		By("dropping organizations being deleted")
+		Expect(SyncUser(mockClient, "org1admin", "org1")).To(Succeed())
+		Expect(annotationOf().List).To(BeEmpty())
+	})
+
+	It("lists identities of a user by labels", func() {
+		_, err := ListIdentities(mockClient, "org1admin", current.AdminIdentity, current.ClientIdentity)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mockClient.ListCallCount()).To(Equal(1))
+		_, _, opts := mockClient.ListArgsForCall(0)
+		selector := opts[0].(*client.ListOptions).LabelSelector
+		Expect(selector.Matches(labels.Set(current.IdentityLabels(current.IdentitySpec{Organization: "org1", User: "org1admin", Type: current.ClientIdentity})))).To(BeTrue())
+		Expect(selector.Matches(labels.Set(current.IdentityLabels(current.IdentitySpec{Organization: "org1", User: "org1admin", Type: current.PeerIdentity})))).To(BeFalse())
+		Expect(selector.Matches(labels.Set(current.IdentityLabels(current.IdentitySpec{Organization: "org1", User: "org1client", Type: current.ClientIdentity})))).To(BeFalse())
+	})
+})
@@ -20,9 +20,13 @@ package user
 
 import (
 	"context"
+	"reflect"
+	"strings"
 
 	iam "github.com/IBM-Blockchain/fabric-operator/api/iam/v1alpha1"
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
 	"github.com/IBM-Blockchain/fabric-operator/pkg/k8s/controllerclient"
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,193 +40,224 @@ const (
 )
 
 // Reconcile targetUser by `organization` and its `id type`
-// - set blockchain annotation
-// - add blockchain organization label
+// - record the identity as Identity
+// - sync blockchain annotation and organization labels from the user's identities
 func Reconcile(c controllerclient.Client, targetUser string, organization, enrollmentID string, idType IDType, action UnaryAction) error {
 	return ReconcileMultiple(c, targetUser, organization, idType, action, enrollmentID)
 }
 
 // ReconcileMultiple targetUser by `organization` and its `id type`
-// - set blockchain annotation
-// - add blockchain organization label
+// - record the identities as Identity
+// - sync blockchain annotation and organization labels from the user's identities
 // **note: these enrollmentID must has same idType**
 func ReconcileMultiple(c controllerclient.Client, targetUser, organization string, idType IDType, action UnaryAction, enrollmentIDs ...string) error {
-	var err error
-
 	u, err := GetUser(c, targetUser)
 	if err != nil {
 		return err
 	}
 
-	switch action {
-	case Add:
-		for _, enrollmentID := range enrollmentIDs {
-			err = ReconcileAdd(u, organization, enrollmentID, idType)
-			if err != nil {
-				return err
-			}
-		}
-	case Remove:
-		for _, enrollmentID := range enrollmentIDs {
-			_, err = ReconcileRemove(u, organization, enrollmentID, idType)
-			if err != nil {
-				return err
-			}
+	reconciled := make(map[identityKey]*current.Identity, len(enrollmentIDs))
+	for _, enrollmentID := range enrollmentIDs {
+		id := buildID(u, enrollmentID, idType)
+		identity, err := ReconcileIdentity(c, organization, targetUser, id, action)
+		if err != nil {
+			return errors.Wrapf(err, "failed to reconcile identity %s", enrollmentID)
 		}
+		reconciled[identityKey{organization: organization, enrollmentID: id.Name}] = identity
 	}
 
-	err = PatchUsers(c, *u)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return syncUser(c, targetUser, u, reconciled)
 }
 
-func ReconcileAdd(u *iam.User, organization, enrollmentID string, idType IDType) error {
-	var err error
-
-	// add a organization label to targetUser
-	if idType == CLIENT || idType == ADMIN {
-		u.Labels[OrganizationLabel.String(organization)] = idType.String()
-	}
-
-	// set  annotation to current admin User
-	annotationList := NewBlockchainAnnotationList()
-	err = annotationList.Unmarshal([]byte(u.Annotations[BlockchainAnnotationKey]))
-	if err != nil {
-		return err
-	}
-	var id ID
+// buildID builds the CA identity of user `u` in type `idType`.
+// Admins and clients are identified by username, peers and orderers by enrollmentID
+func buildID(u *iam.User, enrollmentID string, idType IDType) ID {
 	switch idType {
 	case ADMIN:
-		id = BuildAdminID(GetUsername(u))
+		return BuildAdminID(GetUsername(u))
 	case ORDERER:
-		id = BuildOrdererID(enrollmentID)
+		return BuildOrdererID(enrollmentID)
 	case PEER:
-		id = BuildPeerID(enrollmentID)
+		return BuildPeerID(enrollmentID)
 	default:
-		id = BuildClientID(GetUsername(u))
+		return BuildClientID(GetUsername(u))
+	}
+}
+
+// ReconcileTransfer reconcile the `Admin` transfer from `origin user` to `target user`
+// transfer means:
+//   - admin identity of `origin user` will be revoked
+//   - admin identity of `target user` will be recorded
+//   - annotations and labels of both users will be synced from their identities
+func ReconcileTransfer(c controllerclient.Client, originUser string, targetUser string, organization string) error {
+	origin, err := GetUser(c, originUser)
+	if err != nil {
+		return errors.Wrap(err, "unable to find originUser")
 	}
 
-	var annotation BlockchainAnnotation
-	annotation, err = annotationList.GetAnnotation(organization)
-	if errors.Is(err, ErrAnnotationNotExist) {
-		annotation = *NewBlockchainAnnotation(organization, id)
+	target, err := GetUser(c, targetUser)
+	if err != nil {
+		return errors.Wrap(err, "unable to find targetUser")
 	}
-	err = annotation.SetID(id)
+
+	removed, err := ReconcileIdentity(c, organization, originUser, BuildAdminID(originUser), Remove)
 	if err != nil {
 		return err
 	}
-	err = annotationList.SetAnnotation(organization, annotation)
+	err = syncUser(c, originUser, origin, map[identityKey]*current.Identity{{organization: organization, enrollmentID: originUser}: removed})
 	if err != nil {
 		return err
 	}
 
-	raw, err := annotationList.Marshal()
+	added, err := ReconcileIdentity(c, organization, targetUser, BuildAdminID(targetUser), Add)
 	if err != nil {
 		return err
 	}
-
-	u.Annotations[BlockchainAnnotationKey] = string(raw)
-
-	return nil
+	return syncUser(c, targetUser, target, map[identityKey]*current.Identity{{organization: organization, enrollmentID: targetUser}: added})
 }
 
-// ReconcileRemove remove organization's organization from User
-func ReconcileRemove(u *iam.User, organization, enrollmentID string, idType IDType) (BlockchainAnnotation, error) {
-	var err error
-
-	// remove organization labels
-	if idType == CLIENT || idType == ADMIN {
-		delete(u.Labels, OrganizationLabel.String(organization))
+// SyncUser rewrites the blockchain annotation and organization labels of targetUser from its identities,
+// leaving out the identities of excludedOrganizations, like an organization being deleted
+func SyncUser(c controllerclient.Client, targetUser string, excludedOrganizations ...string) error {
+	u, err := GetUser(c, targetUser)
+	if err != nil {
+		return err
 	}
+	return syncUser(c, targetUser, u, nil, excludedOrganizations...)
+}
+
+// identityKey identifies an Identity by its organization and enrollment ID
+type identityKey struct {
+	organization string
+	enrollmentID string
+}
 
-	// remove annotation under relevant organization
-	annotationList := NewBlockchainAnnotationList()
-	err = annotationList.Unmarshal([]byte(u.Annotations[BlockchainAnnotationKey]))
+// syncUser patches u when the annotation and labels built from the identities of targetUser differ from its own.
+// reconciled overrides the listed identities, which may lag behind the ones just written; a nil identity is gone
+func syncUser(c controllerclient.Client, targetUser string, u *iam.User, reconciled map[identityKey]*current.Identity, excludedOrganizations ...string) error {
+	identities, err := ListIdentities(c, targetUser)
 	if err != nil {
-		return BlockchainAnnotation{}, err
+		return errors.Wrapf(err, "failed to list identities of user %s", targetUser)
 	}
-	// cache annotation for return
-	annotation, err := annotationList.GetAnnotation(organization)
-	if err != nil {
-		return BlockchainAnnotation{}, errors.Wrap(err, "organization "+organization)
+	merged := make(map[identityKey]*current.Identity, len(identities.Items)+len(reconciled))
+	for i := range identities.Items {
+		identity := &identities.Items[i]
+		merged[identityKey{organization: identity.Spec.Organization, enrollmentID: identity.Spec.EnrollmentID}] = identity
 	}
-	switch idType {
-	case ORDERER, PEER:
-		err = annotation.RemoveID(enrollmentID)
-		if err == nil {
-			_ = annotationList.SetAnnotation(organization, annotation)
-		}
-	default:
-		// delete organization from user's annotation
-		err = annotationList.DeleteAnnotation(organization)
-		if err != nil && err != ErrAnnotationNotExist {
-			return BlockchainAnnotation{}, err
+	for key, identity := range reconciled {
+		if identity == nil {
+			delete(merged, key)
+			continue
 		}
+		merged[key] = identity
 	}
-	raw, err := annotationList.Marshal()
-	if err != nil {
-		return BlockchainAnnotation{}, err
+	list := make([]*current.Identity, 0, len(merged))
+	for key, identity := range merged {
+		if !util.ContainsValue(key.organization, excludedOrganizations) {
+			list = append(list, identity)
+		}
 	}
-	u.Annotations[BlockchainAnnotationKey] = string(raw)
-
-	return annotation, nil
-}
-
-// ReconcileTransfer reconcile the `Admin` transfer from `origin user` to `target user`
-// transfer means:
-//   - annotation will be removed from `origin user`
-//   - annotation will be set to `target user`
-func ReconcileTransfer(c controllerclient.Client, originUser string, targetUser string, organization string) error {
-	var err error
 
-	origin, err := GetUser(c, originUser)
-	if err != nil {
-		return errors.Wrap(err, "unable to find originUser")
+	existing := NewBlockchainAnnotationList()
+	if err = existing.Unmarshal([]byte(u.Annotations[BlockchainAnnotationKey])); err != nil {
+		return err
 	}
-
-	target, err := GetUser(c, targetUser)
-	if err != nil {
-		return errors.Wrap(err, "unable to find targetUser")
+	annotations, organizationLabels := BuildAnnotations(list, existing)
+	if sameIDs(existing, annotations) && reflect.DeepEqual(currentOrganizationLabels(u), organizationLabels) {
+		return nil
 	}
 
-	annotation, err := ReconcileRemove(origin, organization, "", ADMIN)
+	raw, err := annotations.Marshal()
 	if err != nil {
 		return err
 	}
-
-	// origin user's id should be removed
-	err = annotation.RemoveID(originUser)
-	if err != nil {
-		return err
+	if u.Annotations == nil {
+		u.Annotations = make(map[string]string)
 	}
-	// target user's id should be set
-	err = annotation.SetID(BuildAdminID(targetUser))
-	if err != nil {
-		return err
+	u.Annotations[BlockchainAnnotationKey] = string(raw)
+	if u.Labels == nil {
+		u.Labels = make(map[string]string)
 	}
-
-	// add annotation to target admin
-	toAnnotationList := NewBlockchainAnnotationList()
-	toAnnotationList.SetAnnotation(organization, annotation)
-	raw, err := toAnnotationList.Marshal()
-	if err != nil {
-		return err
+	for key := range currentOrganizationLabels(u) {
+		delete(u.Labels, key)
+	}
+	for key, value := range organizationLabels {
+		u.Labels[key] = value
 	}
-	target.Annotations[BlockchainAnnotationKey] = string(raw)
+	return PatchUsers(c, *u)
+}
 
-	// add organization label to target admin
-	target.Labels[OrganizationLabel.String(organization)] = ADMIN.String()
+// BuildAnnotations builds the blockchain annotation fabric-ca reads to register identities, along with the organization labels,
+// from the identities of a user which are not being revoked. Timestamps of existing annotations are kept
+func BuildAnnotations(identities []*current.Identity, existing *BlockchainAnnotationList) (*BlockchainAnnotationList, map[string]string) {
+	annotations := NewBlockchainAnnotationList()
+	if existing != nil && !existing.CreationTimestamp.IsZero() {
+		annotations.CreationTimestamp = existing.CreationTimestamp
+	}
+	organizationLabels := make(map[string]string)
+	for _, identity := range identities {
+		if identity.Status.Revocation == current.IdentityRevoking || identity.IsRevoked() {
+			continue
+		}
+		organization := identity.Spec.Organization
+		annotation, err := annotations.GetAnnotation(organization)
+		if err != nil {
+			annotation = *NewBlockchainAnnotation(organization)
+			if old, err := existing.GetAnnotation(organization); err == nil {
+				annotation.CreationTimestamp = old.CreationTimestamp
+			}
+		}
+		_ = annotation.SetID(ID{
+			Name:                 identity.Spec.EnrollmentID,
+			Type:                 IDType(identity.Spec.Type),
+			Attributes:           identity.Spec.Attributes,
+			CreationTimestamp:    identity.CreationTimestamp,
+			LastAppliedTimestamp: identity.CreationTimestamp,
+		})
+		_ = annotations.SetAnnotation(organization, annotation)
+
+		switch identity.Spec.Type {
+		case current.AdminIdentity:
+			organizationLabels[OrganizationLabel.String(organization)] = ADMIN.String()
+		case current.ClientIdentity:
+			if _, ok := organizationLabels[OrganizationLabel.String(organization)]; !ok {
+				organizationLabels[OrganizationLabel.String(organization)] = CLIENT.String()
+			}
+		}
+	}
+	return annotations, organizationLabels
+}
 
-	// patch users along with the changes
-	err = PatchUsers(c, *origin, *target)
-	if err != nil {
-		return err
+// sameIDs checks both annotation lists register the same identities, regardless of timestamps
+func sameIDs(a, b *BlockchainAnnotationList) bool {
+	if len(a.List) != len(b.List) {
+		return false
+	}
+	for organization, annotation := range a.List {
+		other, ok := b.List[organization]
+		if !ok || len(annotation.IDs) != len(other.IDs) {
+			return false
+		}
+		for name, id := range annotation.IDs {
+			otherID, ok := other.IDs[name]
+			if !ok || id.Type != otherID.Type || !reflect.DeepEqual(id.Attributes, otherID.Attributes) {
+				return false
+			}
+		}
 	}
+	return true
+}
 
-	return nil
+// currentOrganizationLabels returns the organization labels of u
+func currentOrganizationLabels(u *iam.User) map[string]string {
+	organizationLabels := make(map[string]string)
+	prefix := OrganizationLabel.String() + "."
+	for key, value := range u.Labels {
+		if strings.HasPrefix(key, prefix) {
+			organizationLabels[key] = value
+		}
+	}
+	return organizationLabels
 }
 
 // GetUsername return `user.spec.name` as its username
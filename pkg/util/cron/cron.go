@@ -0,0 +1,205 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule returns the next activation time after a given time
+type Schedule interface {
+	Next(time.Time) time.Time
+}
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type bounds struct {
+	min, max uint
+}
+
+var (
+	minutes = bounds{0, 59}
+	hours   = bounds{0, 23}
+	dom     = bounds{1, 31}
+	months  = bounds{1, 12}
+	// 7 is accepted as sunday as well
+	dow = bounds{0, 7}
+)
+
+// Parse parses a standard five fields cron expression (minute hour day-of-month month day-of-week),
+// the predefined descriptors like @daily, or an interval like "@every 12h"
+func Parse(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "@every ") {
+		d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval %q: %v", spec, err)
+		}
+		if d < time.Minute {
+			return nil, fmt.Errorf("interval %q is less than one minute", spec)
+		}
+		return every(d), nil
+	}
+	if d, ok := descriptors[spec]; ok {
+		spec = d
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields in %q, found %d", spec, len(fields))
+	}
+	s := &specSchedule{}
+	var err error
+	if s.minute, err = parseField(fields[0], minutes); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseField(fields[1], hours); err != nil {
+		return nil, err
+	}
+	if s.dom, err = parseField(fields[2], dom); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseField(fields[3], months); err != nil {
+		return nil, err
+	}
+	if s.dow, err = parseField(fields[4], dow); err != nil {
+		return nil, err
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domStar, s.dowStar = fields[2] == "*" || fields[2] == "?", fields[4] == "*" || fields[4] == "?"
+	return s, nil
+}
+
+type every time.Duration
+
+func (e every) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(e)).Truncate(time.Second)
+}
+
+type specSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// day of month and day of week match either one when both are restricted
+	domStar, dowStar bool
+}
+
+// Next returns the first matching minute after t, or the zero time if nothing matches within five years
+func (s *specSchedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *specSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// parseField parses comma separated ranges like "*", "*/15", "1-5", "1-30/2" and "3" into a bit set
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, expr := range strings.Split(field, ",") {
+		rangeAndStep := strings.SplitN(expr, "/", 2)
+		lowAndHigh := strings.SplitN(rangeAndStep[0], "-", 2)
+
+		var start, end uint
+		var err error
+		switch {
+		case lowAndHigh[0] == "*" || lowAndHigh[0] == "?":
+			if len(lowAndHigh) != 1 {
+				return 0, fmt.Errorf("invalid range %q", expr)
+			}
+			start, end = b.min, b.max
+		default:
+			if start, err = parseUint(lowAndHigh[0]); err != nil {
+				return 0, err
+			}
+			end = start
+			if len(lowAndHigh) == 2 {
+				if end, err = parseUint(lowAndHigh[1]); err != nil {
+					return 0, err
+				}
+			} else if len(rangeAndStep) == 2 {
+				// "3/10" means starting at 3 every 10 until the end
+				end = b.max
+			}
+		}
+
+		step := uint(1)
+		if len(rangeAndStep) == 2 {
+			if step, err = parseUint(rangeAndStep[1]); err != nil {
+				return 0, err
+			}
+			if step == 0 {
+				return 0, fmt.Errorf("step of %q must be positive", expr)
+			}
+		}
+
+		if start < b.min || end > b.max || start > end {
+			return 0, fmt.Errorf("%q is out of range %d-%d", expr, b.min, b.max)
+		}
+		for i := start; i <= end; i += step {
+			bits |= 1 << i
+		}
+	}
+	return bits, nil
+}
+
+func parseUint(s string) (uint, error) {
+	v, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	return uint(v), nil
+}
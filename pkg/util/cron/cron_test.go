@@ -0,0 +1,61 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNext(t *testing.T) {
+	from := time.Date(2022, time.December, 30, 10, 17, 30, 0, time.UTC)
+	for _, c := range []struct {
+		spec string
+		next time.Time
+	}{
+		{"*/15 * * * *", time.Date(2022, time.December, 30, 10, 30, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2022, time.December, 31, 2, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2022, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2022, time.December, 30, 11, 0, 0, 0, time.UTC)},
+		// 2023-01-01 is a sunday
+		{"30 3 * * 7", time.Date(2023, time.January, 1, 3, 30, 0, 0, time.UTC)},
+		{"0 0 1 */3 *", time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		// either day of month or day of week matches when both are restricted, 2022-12-30 is a friday
+		{"0 12 15 * 1-5", time.Date(2022, time.December, 30, 12, 0, 0, 0, time.UTC)},
+		{"5,10 10 30 12 *", time.Date(2023, time.December, 30, 10, 5, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"@every 36h", time.Date(2022, time.December, 31, 22, 17, 30, 0, time.UTC)},
+	} {
+		s, err := Parse(c.spec)
+		if err != nil {
+			t.Fatalf("parse %q: %v", c.spec, err)
+		}
+		if next := s.Next(from); !next.Equal(c.next) {
+			t.Errorf("%q: expect next %v, get %v", c.spec, c.next, next)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "0 0 0 * *", "*/0 * * * *", "5-1 * * * *", "a * * * *", "@every 10s", "@every day"} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("expect error for %q", spec)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultRegion is used by minio unless configured otherwise
+	DefaultRegion = "us-east-1"
+
+	algorithm       = "AWS4-HMAC-SHA256"
+	iso8601         = "20060102T150405Z"
+	yyyymmdd        = "20060102"
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+)
+
+// ErrNotFound is returned when the bucket or object does not exist
+var ErrNotFound = errors.New("object not found")
+
+// Client talks to an S3 compatible object store such as minio with path style requests signed by AWS signature v4
+type Client struct {
+	Host      string
+	Secure    bool
+	Region    string
+	AccessKey string
+	SecretKey string
+
+	HTTPClient *http.Client
+	now        func() time.Time
+}
+
+// New returns a client of the object store at host (host:port without scheme)
+func New(host string, secure bool, accessKey, secretKey string) *Client {
+	return &Client{
+		Host:       host,
+		Secure:     secure,
+		Region:     DefaultRegion,
+		AccessKey:  accessKey,
+		SecretKey:  secretKey,
+		HTTPClient: &http.Client{Timeout: 5 * time.Minute},
+		now:        time.Now,
+	}
+}
+
+// EnsureBucket creates bucket if it does not exist yet
+func (c *Client) EnsureBucket(ctx context.Context, bucket string) error {
+	_, err := c.do(ctx, http.MethodHead, bucket, "", nil)
+	if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	_, err = c.do(ctx, http.MethodPut, bucket, "", nil)
+	return err
+}
+
+// PutObject uploads data as object key of bucket
+func (c *Client) PutObject(ctx context.Context, bucket, key string, data []byte) error {
+	_, err := c.do(ctx, http.MethodPut, bucket, key, data)
+	return err
+}
+
+// GetObject downloads object key of bucket
+func (c *Client) GetObject(ctx context.Context, bucket, key string) ([]byte, error) {
+	return c.do(ctx, http.MethodGet, bucket, key, nil)
+}
+
+// RemoveObject deletes object key of bucket, removing a missing object is not an error
+func (c *Client) RemoveObject(ctx context.Context, bucket, key string) error {
+	_, err := c.do(ctx, http.MethodDelete, bucket, key, nil)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// PresignedURL returns an url which allows anyone holding it to call method on object key of bucket
+// without credentials until it expires, so jobs can transfer data with plain http tools
+func (c *Client) PresignedURL(method, bucket, key string, expires time.Duration) string {
+	u := c.objectURL(bucket, key)
+	now := c.now().UTC()
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", algorithm)
+	query.Set("X-Amz-Credential", c.AccessKey+"/"+c.scope(now))
+	query.Set("X-Amz-Date", now.Format(iso8601))
+	query.Set("X-Amz-Expires", fmt.Sprintf("%d", int64(expires/time.Second)))
+	query.Set("X-Amz-SignedHeaders", "host")
+	u.RawQuery = canonicalQuery(query)
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		u.EscapedPath(),
+		u.RawQuery,
+		"host:" + u.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+	u.RawQuery += "&X-Amz-Signature=" + c.signature(now, canonicalRequest)
+	return u.String()
+}
+
+func (c *Client) do(ctx context.Context, method, bucket, key string, body []byte) ([]byte, error) {
+	u := c.objectURL(bucket, key)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+	c.sign(req, body)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, 64<<20))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("%s %s: %w", method, u.Path, ErrNotFound)
+	case resp.StatusCode >= 300:
+		return nil, fmt.Errorf("%s %s: status %d: %s", method, u.Path, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return data, nil
+}
+
+// sign adds the authorization header of AWS signature v4 to req
+func (c *Client) sign(req *http.Request, body []byte) {
+	now := c.now().UTC()
+	payloadHash := sha256Hex(body)
+	req.Header.Set("X-Amz-Date", now.Format(iso8601))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name := range req.Header {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-amz-") || lower == "range" {
+			headers[lower] = strings.TrimSpace(req.Header.Get(name))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		algorithm, c.AccessKey, c.scope(now), signedHeaders, c.signature(now, canonicalRequest)))
+}
+
+func (c *Client) signature(now time.Time, canonicalRequest string) string {
+	stringToSign := strings.Join([]string{algorithm, now.Format(iso8601), c.scope(now), sha256Hex([]byte(canonicalRequest))}, "\n")
+	key := hmacSHA256([]byte("AWS4"+c.SecretKey), now.Format(yyyymmdd))
+	for _, part := range []string{c.Region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func (c *Client) scope(now time.Time) string {
+	return strings.Join([]string{now.Format(yyyymmdd), c.Region, "s3", "aws4_request"}, "/")
+}
+
+func (c *Client) objectURL(bucket, key string) *url.URL {
+	scheme := "http"
+	if c.Secure {
+		scheme = "https"
+	}
+	path := "/" + bucket
+	if key != "" {
+		path += "/" + key
+	}
+	return &url.URL{Scheme: scheme, Host: c.Host, Path: path}
+}
+
+// canonicalQuery encodes query sorted by key with spaces as %20 as signature v4 requires
+func canonicalQuery(query url.Values) string {
+	return strings.ReplaceAll(query.Encode(), "+", "%20")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// PolicyRulesCover checks whether owner's rules grant every verb, resource and non-resource url rules grant.
+// A role declared by a user who holds owner's rules can't escalate beyond them
+func PolicyRulesCover(owner []rbacv1.PolicyRule, rules ...rbacv1.PolicyRule) bool {
+	for _, rule := range rules {
+		for _, verb := range rule.Verbs {
+			for _, url := range rule.NonResourceURLs {
+				if !anyRule(owner, func(o rbacv1.PolicyRule) bool {
+					return matches(o.Verbs, verb) && matchesURL(o.NonResourceURLs, url)
+				}) {
+					return false
+				}
+			}
+			for _, group := range rule.APIGroups {
+				for _, resource := range rule.Resources {
+					names := rule.ResourceNames
+					if len(names) == 0 {
+						// all names are only covered by rules without resourceNames
+						names = []string{""}
+					}
+					for _, name := range names {
+						if !anyRule(owner, func(o rbacv1.PolicyRule) bool {
+							return matches(o.Verbs, verb) && matches(o.APIGroups, group) && matches(o.Resources, resource) &&
+								(len(o.ResourceNames) == 0 || (name != "" && ContainsValue(name, o.ResourceNames)))
+						}) {
+							return false
+						}
+					}
+				}
+			}
+		}
+	}
+	return true
+}
+
+func anyRule(rules []rbacv1.PolicyRule, match func(rbacv1.PolicyRule) bool) bool {
+	for _, rule := range rules {
+		if match(rule) {
+			return true
+		}
+	}
+	return false
+}
+
+func matches(values []string, value string) bool {
+	return ContainsValue(rbacv1.VerbAll, values) || ContainsValue(value, values)
+}
+
+func matchesURL(urls []string, url string) bool {
+	for _, u := range urls {
+		if u == rbacv1.NonResourceAll || u == url ||
+			(len(u) > 1 && u[len(u)-1] == '*' && len(url) >= len(u)-1 && url[:len(u)-1] == u[:len(u)-1]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util_test
+
+import (
+	"github.com/IBM-Blockchain/fabric-operator/pkg/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+var _ = Describe("PolicyRulesCover", func() {
+	owner := []rbacv1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"pods", "pods/log"}, Verbs: []string{"get", "list", "watch"}},
+		{APIGroups: []string{"ibp.com"}, Resources: []string{"votes"}, Verbs: []string{"*"}},
+		{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"org1-crl"}, Verbs: []string{"get"}},
+		{NonResourceURLs: []string{"/healthz/*"}, Verbs: []string{"get"}},
+	}
+
+	It("covers rules granted by owner", func() {
+		Expect(util.PolicyRulesCover(owner,
+			rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}},
+			rbacv1.PolicyRule{APIGroups: []string{"ibp.com"}, Resources: []string{"votes"}, Verbs: []string{"patch", "delete"}},
+			rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"org1-crl"}, Verbs: []string{"get"}},
+			rbacv1.PolicyRule{NonResourceURLs: []string{"/healthz/ready"}, Verbs: []string{"get"}},
+		)).To(BeTrue())
+	})
+
+	It("doesn't cover verbs, resources or groups owner lacks", func() {
+		Expect(util.PolicyRulesCover(owner, rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"delete"}})).To(BeFalse())
+		Expect(util.PolicyRulesCover(owner, rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"get"}})).To(BeFalse())
+		Expect(util.PolicyRulesCover(owner, rbacv1.PolicyRule{APIGroups: []string{"ibp.com"}, Resources: []string{"pods"}, Verbs: []string{"get"}})).To(BeFalse())
+		Expect(util.PolicyRulesCover(owner, rbacv1.PolicyRule{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}})).To(BeFalse())
+		Expect(util.PolicyRulesCover(owner, rbacv1.PolicyRule{NonResourceURLs: []string{"/metrics"}, Verbs: []string{"get"}})).To(BeFalse())
+	})
+
+	It("doesn't cover all names with rules limited to some", func() {
+		Expect(util.PolicyRulesCover(owner, rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}})).To(BeFalse())
+		Expect(util.PolicyRulesCover(owner, rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"org1-msp-crypto"}, Verbs: []string{"get"}})).To(BeFalse())
+	})
+})
@@ -0,0 +1,136 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package testcert issues ECDSA certificates for tests
+package testcert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"time"
+)
+
+// T is the part of testing.T, and of ginkgo's GinkgoT(), used to fail a test
+type T interface {
+	Helper()
+	Fatal(args ...interface{})
+}
+
+// Identity is a certificate along with its key
+type Identity struct {
+	Key  *ecdsa.PrivateKey
+	Cert *x509.Certificate
+	// PEM is the PEM encoded certificate
+	PEM []byte
+}
+
+// Option customizes the certificate template
+type Option func(*x509.Certificate)
+
+// Validity sets the validity period of the certificate, one hour around now by default
+func Validity(notBefore, notAfter time.Time) Option {
+	return func(template *x509.Certificate) {
+		template.NotBefore = notBefore
+		template.NotAfter = notAfter
+	}
+}
+
+// NotAfter sets the expiry of the certificate
+func NotAfter(notAfter time.Time) Option {
+	return func(template *x509.Certificate) {
+		template.NotAfter = notAfter
+	}
+}
+
+// Hosts adds DNS names and IP addresses to the certificate
+func Hosts(hosts ...string) Option {
+	return func(template *x509.Certificate) {
+		for _, h := range hosts {
+			if ip := net.ParseIP(h); ip != nil {
+				template.IPAddresses = append(template.IPAddresses, ip)
+			} else {
+				template.DNSNames = append(template.DNSNames, h)
+			}
+		}
+	}
+}
+
+// CA makes the certificate a CA, which self signed certificates are by default
+func CA() Option {
+	return func(template *x509.Certificate) {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	}
+}
+
+// New issues a certificate for cn signed by parent, or a self signed CA certificate when parent is nil
+func New(t T, cn string, parent *Identity, opts ...Option) *Identity {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	if parent == nil {
+		CA()(template)
+	}
+	for _, opt := range opts {
+		opt(template)
+	}
+
+	issuer, signer := template, key
+	if parent != nil {
+		issuer, signer = parent.Cert, parent.Key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, &key.PublicKey, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Identity{Key: key, Cert: cert, PEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// KeyPEM returns the PKCS8 PEM encoded private key
+func (id *Identity) KeyPEM(t T) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(id.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
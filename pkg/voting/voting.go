@@ -0,0 +1,341 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package voting decides the outcome of a proposal from the weighted
+// decisions of the organizations allowed to vote on it.
+//
+// Every policy is evaluated as a Rule made of a threshold, an optional
+// quorum and an optional veto. A proposal is decided as soon as its
+// outcome can no longer change, whatever the organizations which have
+// not voted yet decide:
+//   - it is Adopted once the threshold and the quorum are reached even if
+//     every pending vote turns into the least favorable decision. A rule
+//     with veto never adopts a proposal while votes are pending.
+//   - it is Rejected once a veto is cast, or once the threshold stays out
+//     of reach even if every pending vote approves.
+//
+// When no vote is pending the proposal is always decided. A quorum only
+// matters when voting is closed before every organization took part, see
+// Rule.Close.
+package voting
+
+import (
+	"fmt"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"github.com/pkg/errors"
+)
+
+// Decision is the position of one organization on a proposal
+type Decision int
+
+const (
+	// Pending means the organization has not voted yet
+	Pending Decision = iota
+	Approve
+	Reject
+	Abstain
+)
+
+func (d Decision) String() string {
+	switch d {
+	case Approve:
+		return "Approve"
+	case Reject:
+		return "Reject"
+	case Abstain:
+		return "Abstain"
+	default:
+		return "Pending"
+	}
+}
+
+// Ballot is the weighted decision of one organization
+type Ballot struct {
+	Organization string
+	Weight       int64
+	Decision     Decision
+}
+
+// Outcome of a proposal
+type Outcome int
+
+const (
+	// Undecided means the outcome still depends on pending votes
+	Undecided Outcome = iota
+	Adopted
+	Rejected
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case Adopted:
+		return "Adopted"
+	case Rejected:
+		return "Rejected"
+	default:
+		return "Undecided"
+	}
+}
+
+// Reasons of a decided outcome
+const (
+	ReasonThresholdReached     = "ThresholdReached"
+	ReasonThresholdUnreachable = "ThresholdUnreachable"
+	ReasonQuorumNotReached     = "QuorumNotReached"
+	ReasonVetoed               = "Vetoed"
+)
+
+// Result of evaluating a Rule against a set of ballots
+type Result struct {
+	Outcome Outcome
+	// Reason is set when the outcome is decided
+	Reason string
+	Tally  current.VoteTally
+}
+
+func (r Result) String() string {
+	t := r.Tally
+	msg := fmt.Sprintf("approved %d, rejected %d, abstained %d, pending %d of %d", t.Approved, t.Rejected, t.Abstained, t.Pending, t.Electorate)
+	if r.Reason == "" {
+		return msg
+	}
+	return fmt.Sprintf("%s: %s", r.Reason, msg)
+}
+
+// Ratio is an N-of-M fraction
+type Ratio struct {
+	Numerator   int64
+	Denominator int64
+	// Exclusive requires strictly more than the fraction
+	Exclusive bool
+}
+
+// ReachedBy returns true if part is enough of whole. Nothing is enough of zero.
+func (r Ratio) ReachedBy(part, whole int64) bool {
+	if whole <= 0 {
+		return false
+	}
+	if r.Exclusive {
+		return part*r.Denominator > r.Numerator*whole
+	}
+	return part*r.Denominator >= r.Numerator*whole
+}
+
+// Rule is the tallying engine behind every proposal policy
+type Rule struct {
+	// Threshold is the share of weight which must approve
+	Threshold Ratio
+	// Base is the weight Threshold is measured against
+	Base current.ThresholdBase
+	// Quorum is the share of the electorate which must take part. Nil means no quorum.
+	Quorum *Ratio
+	// Veto makes a single rejection fail the proposal
+	Veto bool
+}
+
+// Presets of Rule for the predefined policies
+var (
+	// OneVoteVeto adopts a proposal once every organization took part and
+	// nobody rejected it. Abstentions are allowed but at least one
+	// organization must approve.
+	OneVoteVeto = Rule{
+		Threshold: Ratio{Numerator: 1, Denominator: 1},
+		Base:      current.ThresholdBaseCast,
+		Quorum:    &Ratio{Numerator: 1, Denominator: 1},
+		Veto:      true,
+	}
+	// Majority adopts a proposal once more than half of the electorate weight approves.
+	Majority = Rule{
+		Threshold: Ratio{Numerator: 1, Denominator: 2, Exclusive: true},
+		Base:      current.ThresholdBaseElectorate,
+	}
+	// All adopts a proposal once the whole electorate approves, so a single
+	// rejection or abstention fails it.
+	All = Rule{
+		Threshold: Ratio{Numerator: 1, Denominator: 1},
+		Base:      current.ThresholdBaseElectorate,
+	}
+)
+
+// RuleFor returns the Rule of a policy. The voting rule is only used by the Custom policy.
+func RuleFor(policy current.Policy, rule *current.VotingRule) (Rule, error) {
+	switch policy.String() {
+	case current.OneVoteVeto.String():
+		return OneVoteVeto, nil
+	case current.Majority.String():
+		return Majority, nil
+	case current.ALL.String():
+		return All, nil
+	case current.Custom.String():
+		if rule == nil {
+			return Rule{}, errors.New("custom policy without voting rule")
+		}
+		if err := rule.Validate(); err != nil {
+			return Rule{}, err
+		}
+		r := Rule{
+			Threshold: ratio(rule.Threshold),
+			Base:      rule.ThresholdBase,
+			Veto:      rule.Veto,
+		}
+		if r.Base == "" {
+			r.Base = current.ThresholdBaseElectorate
+		}
+		if rule.Quorum != nil {
+			q := ratio(*rule.Quorum)
+			r.Quorum = &q
+		}
+		return r, nil
+	default:
+		return Rule{}, errors.Errorf("unknown policy %s", policy)
+	}
+}
+
+func ratio(r current.Ratio) Ratio {
+	return Ratio{
+		Numerator:   int64(r.Numerator),
+		Denominator: int64(r.Denominator),
+		Exclusive:   r.Exclusive,
+	}
+}
+
+// Count sums the weight of ballots by decision
+func Count(ballots []Ballot) current.VoteTally {
+	t := current.VoteTally{}
+	for _, b := range ballots {
+		t.Electorate += b.Weight
+		switch b.Decision {
+		case Approve:
+			t.Approved += b.Weight
+		case Reject:
+			t.Rejected += b.Weight
+		case Abstain:
+			t.Abstained += b.Weight
+		default:
+			t.Pending += b.Weight
+		}
+	}
+	return t
+}
+
+// Evaluate decides the outcome of ballots under the rule while voting is open.
+// An empty electorate never decides anything.
+func (r Rule) Evaluate(ballots []Ballot) Result {
+	t := Count(ballots)
+	res := Result{Outcome: Undecided, Tally: t}
+	if t.Electorate <= 0 {
+		return res
+	}
+
+	// Rejected as soon as nothing the pending organizations do can adopt it.
+	// Approving is the most favorable decision, and pending organizations can
+	// always take part so the quorum stays reachable while voting is open.
+	switch {
+	case r.Veto && t.Rejected > 0:
+		res.Outcome, res.Reason = Rejected, ReasonVetoed
+		return res
+	case !r.Threshold.ReachedBy(t.Approved+t.Pending, r.base(t, t.Pending)):
+		res.Outcome, res.Reason = Rejected, ReasonThresholdUnreachable
+		return res
+	}
+
+	// Adopted as soon as nothing the pending organizations do can reject it.
+	// For the threshold the least favorable decision is rejecting, or abstaining
+	// when abstentions are left out of the base. Not voting at all is the least
+	// favorable decision for the quorum.
+	if r.Veto && t.Pending > 0 {
+		return res
+	}
+	if !r.quorumReached(t) {
+		return res
+	}
+	if !r.Threshold.ReachedBy(t.Approved, r.base(t, t.Pending)) || !r.Threshold.ReachedBy(t.Approved, r.base(t, 0)) {
+		return res
+	}
+	res.Outcome, res.Reason = Adopted, ReasonThresholdReached
+	return res
+}
+
+// Close decides the outcome of ballots once voting is over, for example when the
+// proposal expires. Pending organizations are counted as not taking part.
+func (r Rule) Close(ballots []Ballot) Result {
+	t := Count(ballots)
+	res := Result{Outcome: Rejected, Tally: t}
+	switch {
+	case t.Electorate <= 0:
+		res.Reason = ReasonThresholdUnreachable
+	case r.Veto && t.Rejected > 0:
+		res.Reason = ReasonVetoed
+	case !r.quorumReached(t):
+		res.Reason = ReasonQuorumNotReached
+	case !r.Threshold.ReachedBy(t.Approved, r.base(t, 0)):
+		res.Reason = ReasonThresholdUnreachable
+	default:
+		res.Outcome, res.Reason = Adopted, ReasonThresholdReached
+	}
+	return res
+}
+
+func (r Rule) quorumReached(t current.VoteTally) bool {
+	if r.Quorum == nil {
+		return true
+	}
+	return r.Quorum.ReachedBy(t.Approved+t.Rejected+t.Abstained, t.Electorate)
+}
+
+// base returns the weight the threshold is measured against when the given
+// pending weight turns into approvals or rejections.
+func (r Rule) base(t current.VoteTally, pending int64) int64 {
+	if r.Base == current.ThresholdBaseCast {
+		return t.Approved + t.Rejected + pending
+	}
+	return t.Electorate
+}
+
+// DecisionOf returns the decision recorded in a vote result
+func DecisionOf(vote current.VoteResult) Decision {
+	switch {
+	case vote.Abstain:
+		return Abstain
+	case vote.Decision == nil:
+		return Pending
+	case *vote.Decision:
+		return Approve
+	default:
+		return Reject
+	}
+}
+
+// Ballots weighs vote results with the federation weights.
+// Organizations without a weight count as 1.
+func Ballots(votes []current.VoteResult, weights map[string]int32) []Ballot {
+	ballots := make([]Ballot, 0, len(votes))
+	for _, v := range votes {
+		weight := int64(1)
+		if w, ok := weights[v.Organization.Name]; ok {
+			weight = int64(w)
+		}
+		ballots = append(ballots, Ballot{
+			Organization: v.Organization.Name,
+			Weight:       weight,
+			Decision:     DecisionOf(v),
+		})
+	}
+	return ballots
+}
@@ -0,0 +1,225 @@
+/*
+ * Copyright contributors to the Hyperledger Fabric Operator project
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ * 	  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package voting
+
+import (
+	"testing"
+
+	current "github.com/IBM-Blockchain/fabric-operator/api/v1beta1"
+	"k8s.io/utils/pointer"
+)
+
+// ballots builds one ballot of weight 1 per decision
+func ballots(decisions ...Decision) []Ballot {
+	res := make([]Ballot, 0, len(decisions))
+	for _, d := range decisions {
+		res = append(res, Ballot{Weight: 1, Decision: d})
+	}
+	return res
+}
+
+func TestPresets(t *testing.T) {
+	cases := []struct {
+		name    string
+		rule    Rule
+		ballots []Ballot
+		outcome Outcome
+		reason  string
+	}{
+		{"all waits for every approval", All, ballots(Approve, Approve, Pending), Undecided, ""},
+		{"all adopts when everyone approves", All, ballots(Approve, Approve, Approve), Adopted, ReasonThresholdReached},
+		{"all rejects on first rejection", All, ballots(Reject, Pending, Pending), Rejected, ReasonThresholdUnreachable},
+		{"all rejects on first abstention", All, ballots(Abstain, Pending, Pending), Rejected, ReasonThresholdUnreachable},
+		{"veto waits for every vote", OneVoteVeto, ballots(Approve, Approve, Pending), Undecided, ""},
+		{"veto rejects on first rejection", OneVoteVeto, ballots(Approve, Reject, Pending), Rejected, ReasonVetoed},
+		{"veto allows abstention", OneVoteVeto, ballots(Approve, Abstain, Approve), Adopted, ReasonThresholdReached},
+		{"veto needs one approval", OneVoteVeto, ballots(Abstain, Abstain), Rejected, ReasonThresholdUnreachable},
+		{"majority is not reached by half", Majority, ballots(Approve, Approve, Pending, Pending), Undecided, ""},
+		{"majority adopts early", Majority, ballots(Approve, Approve, Approve, Pending), Adopted, ReasonThresholdReached},
+		{"majority rejects early", Majority, ballots(Reject, Reject, Pending, Pending), Rejected, ReasonThresholdUnreachable},
+		{"majority rejects a tie", Majority, ballots(Approve, Approve, Reject, Reject), Rejected, ReasonThresholdUnreachable},
+		{"majority counts abstention against", Majority, ballots(Approve, Abstain, Pending), Undecided, ""},
+		{"empty electorate is undecided", Majority, nil, Undecided, ""},
+	}
+	for _, c := range cases {
+		res := c.rule.Evaluate(c.ballots)
+		if res.Outcome != c.outcome || res.Reason != c.reason {
+			t.Errorf("%s: expect %s(%s) get %s(%s)", c.name, c.outcome, c.reason, res.Outcome, res.Reason)
+		}
+	}
+}
+
+func TestCustomRule(t *testing.T) {
+	// two thirds of the cast votes with half of the electorate taking part
+	rule, err := RuleFor(current.Custom, &current.VotingRule{
+		Threshold:     current.Ratio{Numerator: 2, Denominator: 3},
+		ThresholdBase: current.ThresholdBaseCast,
+		Quorum:        &current.Ratio{Numerator: 1, Denominator: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name    string
+		ballots []Ballot
+		outcome Outcome
+		reason  string
+	}{
+		{"quorum not reached yet", ballots(Approve, Pending, Pending, Pending), Undecided, ""},
+		{"abstention counts for quorum only", ballots(Approve, Abstain, Pending, Pending), Undecided, ""},
+		{"adopted once pending votes can not change it", ballots(Approve, Approve, Approve, Approve, Abstain, Pending), Adopted, ReasonThresholdReached},
+		{"threshold unreachable", ballots(Approve, Reject, Reject, Pending), Rejected, ReasonThresholdUnreachable},
+		{"all abstain", ballots(Abstain, Abstain, Abstain), Rejected, ReasonThresholdUnreachable},
+	}
+	for _, c := range cases {
+		res := rule.Evaluate(c.ballots)
+		if res.Outcome != c.outcome || res.Reason != c.reason {
+			t.Errorf("%s: expect %s(%s) get %s(%s)", c.name, c.outcome, c.reason, res.Outcome, res.Reason)
+		}
+	}
+}
+
+func TestClose(t *testing.T) {
+	rule, err := RuleFor(current.Custom, &current.VotingRule{
+		Threshold:     current.Ratio{Numerator: 1, Denominator: 2, Exclusive: true},
+		ThresholdBase: current.ThresholdBaseCast,
+		Quorum:        &current.Ratio{Numerator: 1, Denominator: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name    string
+		rule    Rule
+		ballots []Ballot
+		outcome Outcome
+		reason  string
+	}{
+		{"quorum not reached", rule, ballots(Approve, Pending, Pending), Rejected, ReasonQuorumNotReached},
+		{"majority of a quorum", rule, ballots(Approve, Approve, Reject, Pending, Pending), Adopted, ReasonThresholdReached},
+		{"pending votes count against all", All, ballots(Approve, Approve, Pending), Rejected, ReasonThresholdUnreachable},
+		{"pending votes fail the veto quorum", OneVoteVeto, ballots(Approve, Pending), Rejected, ReasonQuorumNotReached},
+		{"veto", OneVoteVeto, ballots(Approve, Reject, Pending), Rejected, ReasonVetoed},
+		{"empty electorate", Majority, nil, Rejected, ReasonThresholdUnreachable},
+	}
+	for _, c := range cases {
+		res := c.rule.Close(c.ballots)
+		if res.Outcome != c.outcome || res.Reason != c.reason {
+			t.Errorf("%s: expect %s(%s) get %s(%s)", c.name, c.outcome, c.reason, res.Outcome, res.Reason)
+		}
+	}
+}
+
+func TestWeights(t *testing.T) {
+	votes := []current.VoteResult{
+		{Organization: current.NamespacedName{Name: "org1"}, Decision: pointer.Bool(true)},
+		{Organization: current.NamespacedName{Name: "org2"}, Decision: pointer.Bool(false)},
+		{Organization: current.NamespacedName{Name: "org3"}},
+	}
+	weights := map[string]int32{"org1": 3}
+
+	res := Majority.Evaluate(Ballots(votes, weights))
+	if res.Outcome != Adopted {
+		t.Fatalf("expect org1 alone to carry the majority, get %s", res)
+	}
+	if res.Tally.Electorate != 5 || res.Tally.Approved != 3 || res.Tally.Rejected != 1 || res.Tally.Pending != 1 {
+		t.Fatalf("unexpected tally %+v", res.Tally)
+	}
+
+	res = Majority.Evaluate(Ballots(votes, nil))
+	if res.Outcome != Undecided {
+		t.Fatalf("expect unweighted vote to be undecided, get %s", res)
+	}
+}
+
+func TestRuleFor(t *testing.T) {
+	if _, err := RuleFor(current.Custom, nil); err == nil {
+		t.Fatal("expect error for custom policy without rule")
+	}
+	if _, err := RuleFor(current.Custom, &current.VotingRule{Threshold: current.Ratio{Numerator: 3, Denominator: 2}}); err == nil {
+		t.Fatal("expect error for threshold above 1")
+	}
+	if _, err := RuleFor(current.Custom, &current.VotingRule{Threshold: current.Ratio{Numerator: 0, Denominator: 2}}); err == nil {
+		t.Fatal("expect error for threshold reached without approvals")
+	}
+	if _, err := RuleFor(current.Custom, &current.VotingRule{Threshold: current.Ratio{Numerator: 1, Denominator: 2}, Quorum: &current.Ratio{Denominator: 2}}); err == nil {
+		t.Fatal("expect error for quorum of 0")
+	}
+	if _, err := RuleFor("Unknown", nil); err == nil {
+		t.Fatal("expect error for unknown policy")
+	}
+	rule, err := RuleFor("majority", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.Threshold != Majority.Threshold {
+		t.Fatalf("expect majority preset, get %+v", rule)
+	}
+}
+
+func TestFederationPolicy(t *testing.T) {
+	federation := &current.Federation{Spec: current.FederationSpec{
+		Policy:     current.Custom,
+		VotingRule: &current.VotingRule{Threshold: current.Ratio{Numerator: 2, Denominator: 3}},
+	}}
+
+	proposal := &current.Proposal{}
+	rule, err := RuleFor(proposal.VotingPolicy(federation))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.Threshold != (Ratio{Numerator: 2, Denominator: 3}) {
+		t.Errorf("expect the federation's rule, get %+v", rule)
+	}
+
+	proposal.Spec.Policy = current.Custom
+	proposal.Spec.VotingRule = &current.VotingRule{Threshold: current.Ratio{Numerator: 1, Denominator: 3}}
+	if rule, _ = RuleFor(proposal.VotingPolicy(federation)); rule.Threshold != (Ratio{Numerator: 1, Denominator: 3}) {
+		t.Errorf("expect the proposal's own rule, get %+v", rule)
+	}
+
+	proposal.Spec.Policy = current.ALL
+	if rule, _ = RuleFor(proposal.VotingPolicy(federation)); rule.Threshold != All.Threshold || rule.Base != All.Base {
+		t.Errorf("expect all preset, get %+v", rule)
+	}
+
+	// the federation may be gone
+	if _, err = RuleFor((&current.Proposal{}).VotingPolicy(&current.Federation{})); err == nil {
+		t.Error("expect error without any policy")
+	}
+}
+
+func TestFederationWeights(t *testing.T) {
+	federation := &current.Federation{Spec: current.FederationSpec{Weights: map[string]int32{"org1": 3}}}
+	votes := []current.VoteResult{
+		{Organization: current.NamespacedName{Name: "org1"}, Decision: pointer.Bool(true)},
+		{Organization: current.NamespacedName{Name: "org2"}, Decision: pointer.Bool(false)},
+	}
+
+	// proposals created before weights were copied into them follow the federation
+	proposal := &current.Proposal{}
+	if res := Majority.Evaluate(Ballots(votes, proposal.VotingWeights(federation))); res.Outcome != Adopted {
+		t.Errorf("expect the federation's weights, get %s", res)
+	}
+
+	proposal.Spec.Weights = map[string]int32{"org2": 4}
+	if res := Majority.Evaluate(Ballots(votes, proposal.VotingWeights(federation))); res.Outcome != Rejected {
+		t.Errorf("expect the proposal's weights, get %s", res)
+	}
+}